@@ -11,6 +11,7 @@ import (
 	_ "github.com/go-kratos/kratos/v2/errors"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -47,33 +48,113 @@ const (
 	ErrorReason_UNAUTHORIZED ErrorReason = 8
 	// 无效的刷新Token
 	ErrorReason_INVALID_REFRESH_TOKEN ErrorReason = 9
+	// 会话不存在
+	ErrorReason_SESSION_NOT_FOUND ErrorReason = 10
+	// 两步验证码错误
+	ErrorReason_INVALID_MFA_CODE ErrorReason = 11
+	// 两步验证登录挑战无效或已过期
+	ErrorReason_INVALID_MFA_TOKEN ErrorReason = 12
+	// 已启用两步验证
+	ErrorReason_MFA_ALREADY_ENABLED ErrorReason = 13
+	// 未启用两步验证
+	ErrorReason_MFA_NOT_ENABLED ErrorReason = 14
+	// 邮箱未验证
+	ErrorReason_EMAIL_NOT_VERIFIED ErrorReason = 15
+	// 邮箱验证或密码重置链接无效或已过期
+	ErrorReason_INVALID_VERIFICATION_TOKEN ErrorReason = 16
+	// 未配置该身份提供方
+	ErrorReason_OIDC_PROVIDER_NOT_FOUND ErrorReason = 17
+	// 第三方登录失败（state 无效、授权码无效或 ID Token 校验失败）
+	ErrorReason_OIDC_LOGIN_FAILED ErrorReason = 18
+	// 外部身份未关联本地用户
+	ErrorReason_IDENTITY_NOT_LINKED ErrorReason = 19
+	// 外部身份已关联其他用户，或当前用户已关联该身份提供方
+	ErrorReason_IDENTITY_ALREADY_LINKED ErrorReason = 20
+	// 未关联该身份提供方
+	ErrorReason_IDENTITY_NOT_FOUND ErrorReason = 21
+	// 解除关联后将无法登录
+	ErrorReason_LAST_LOGIN_METHOD ErrorReason = 22
+	// 登录失败次数过多，账号或 IP 被暂时锁定；metadata 中的 retry_after 为剩余秒数
+	ErrorReason_ACCOUNT_LOCKED ErrorReason = 23
+	// 登录失败后需要等待一段时间再重试；metadata 中的 retry_after 为剩余秒数
+	ErrorReason_TOO_MANY_LOGIN_ATTEMPTS ErrorReason = 24
+	// 密码不符合密码策略
+	ErrorReason_WEAK_PASSWORD ErrorReason = 25
+	// 账号已被管理员停用
+	ErrorReason_USER_DISABLED ErrorReason = 26
+	// 当前注册方式不允许该邮箱注册（需要邀请码或邮箱域名不在白名单中）
+	ErrorReason_SIGNUP_NOT_ALLOWED ErrorReason = 27
+	// 邀请码不存在、已过期或已用完
+	ErrorReason_INVALID_INVITE_CODE ErrorReason = 28
+	// 代登录（管理员模拟用户）期间不允许执行该操作
+	ErrorReason_IMPERSONATION_NOT_ALLOWED ErrorReason = 29
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "USER_NOT_FOUND",
-		1: "USER_ALREADY_EXISTS",
-		2: "INCORRECT_PASSWORD",
-		3: "INVALID_CREDENTIALS",
-		4: "INVALID_TOKEN_TYPE",
-		5: "TOKEN_EXPIRED",
-		6: "MISSING_TOKEN",
-		7: "TOKEN_GENERATION_FAILED",
-		8: "UNAUTHORIZED",
-		9: "INVALID_REFRESH_TOKEN",
+		0:  "USER_NOT_FOUND",
+		1:  "USER_ALREADY_EXISTS",
+		2:  "INCORRECT_PASSWORD",
+		3:  "INVALID_CREDENTIALS",
+		4:  "INVALID_TOKEN_TYPE",
+		5:  "TOKEN_EXPIRED",
+		6:  "MISSING_TOKEN",
+		7:  "TOKEN_GENERATION_FAILED",
+		8:  "UNAUTHORIZED",
+		9:  "INVALID_REFRESH_TOKEN",
+		10: "SESSION_NOT_FOUND",
+		11: "INVALID_MFA_CODE",
+		12: "INVALID_MFA_TOKEN",
+		13: "MFA_ALREADY_ENABLED",
+		14: "MFA_NOT_ENABLED",
+		15: "EMAIL_NOT_VERIFIED",
+		16: "INVALID_VERIFICATION_TOKEN",
+		17: "OIDC_PROVIDER_NOT_FOUND",
+		18: "OIDC_LOGIN_FAILED",
+		19: "IDENTITY_NOT_LINKED",
+		20: "IDENTITY_ALREADY_LINKED",
+		21: "IDENTITY_NOT_FOUND",
+		22: "LAST_LOGIN_METHOD",
+		23: "ACCOUNT_LOCKED",
+		24: "TOO_MANY_LOGIN_ATTEMPTS",
+		25: "WEAK_PASSWORD",
+		26: "USER_DISABLED",
+		27: "SIGNUP_NOT_ALLOWED",
+		28: "INVALID_INVITE_CODE",
+		29: "IMPERSONATION_NOT_ALLOWED",
 	}
 	ErrorReason_value = map[string]int32{
-		"USER_NOT_FOUND":          0,
-		"USER_ALREADY_EXISTS":     1,
-		"INCORRECT_PASSWORD":      2,
-		"INVALID_CREDENTIALS":     3,
-		"INVALID_TOKEN_TYPE":      4,
-		"TOKEN_EXPIRED":           5,
-		"MISSING_TOKEN":           6,
-		"TOKEN_GENERATION_FAILED": 7,
-		"UNAUTHORIZED":            8,
-		"INVALID_REFRESH_TOKEN":   9,
+		"USER_NOT_FOUND":             0,
+		"USER_ALREADY_EXISTS":        1,
+		"INCORRECT_PASSWORD":         2,
+		"INVALID_CREDENTIALS":        3,
+		"INVALID_TOKEN_TYPE":         4,
+		"TOKEN_EXPIRED":              5,
+		"MISSING_TOKEN":              6,
+		"TOKEN_GENERATION_FAILED":    7,
+		"UNAUTHORIZED":               8,
+		"INVALID_REFRESH_TOKEN":      9,
+		"SESSION_NOT_FOUND":          10,
+		"INVALID_MFA_CODE":           11,
+		"INVALID_MFA_TOKEN":          12,
+		"MFA_ALREADY_ENABLED":        13,
+		"MFA_NOT_ENABLED":            14,
+		"EMAIL_NOT_VERIFIED":         15,
+		"INVALID_VERIFICATION_TOKEN": 16,
+		"OIDC_PROVIDER_NOT_FOUND":    17,
+		"OIDC_LOGIN_FAILED":          18,
+		"IDENTITY_NOT_LINKED":        19,
+		"IDENTITY_ALREADY_LINKED":    20,
+		"IDENTITY_NOT_FOUND":         21,
+		"LAST_LOGIN_METHOD":          22,
+		"ACCOUNT_LOCKED":             23,
+		"TOO_MANY_LOGIN_ATTEMPTS":    24,
+		"WEAK_PASSWORD":              25,
+		"USER_DISABLED":              26,
+		"SIGNUP_NOT_ALLOWED":         27,
+		"INVALID_INVITE_CODE":        28,
+		"IMPERSONATION_NOT_ALLOWED":  29,
 	}
 )
 
@@ -109,11 +190,11 @@ type SignupByEmailRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 用户名最小长度5
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// 密码最小长度5，最大长度10
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// 确认密码最小长度5，最大长度10
+	// 密码规则由服务端配置的密码策略校验，这里只限制最大长度
+	Password        string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	PasswordConfirm string `protobuf:"bytes,3,opt,name=password_confirm,json=passwordConfirm,proto3" json:"password_confirm,omitempty"`
-	Email           string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"` // 邮箱格式验证
+	Email           string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                             // 邮箱格式验证
+	InviteCode      string `protobuf:"bytes,5,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // 邀请码，invite 模式必填
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -176,6 +257,13 @@ func (x *SignupByEmailRequest) GetEmail() string {
 	return ""
 }
 
+func (x *SignupByEmailRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 // 邮箱注册响应
 type SignupByEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -298,12 +386,15 @@ func (x *LoginByEmailPasswordRequest) GetPassword() string {
 	return ""
 }
 
-// 密码登录响应
+// 密码登录响应，启用两步验证时只返回 mfa_token，需要调用 VerifyMFA 换取 Token
 type LoginByEmailPasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // Access Token过期时间(秒)
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`            // Access Token过期时间(秒)
+	MfaRequired   bool                   `protobuf:"varint,4,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`      // 是否需要两步验证
+	MfaToken      string                 `protobuf:"bytes,5,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`                // 两步验证登录挑战
+	MfaExpiresIn  int64                  `protobuf:"varint,6,opt,name=mfa_expires_in,json=mfaExpiresIn,proto3" json:"mfa_expires_in,omitempty"` // 登录挑战过期时间(秒)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -359,6 +450,27 @@ func (x *LoginByEmailPasswordResponse) GetExpiresIn() int64 {
 	return 0
 }
 
+func (x *LoginByEmailPasswordResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginByEmailPasswordResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginByEmailPasswordResponse) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
 // 刷新Token请求
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -555,59 +667,2151 @@ func (x *LogoutResponse) GetSuccess() bool {
 	return false
 }
 
-var File_auth_service_v1_auth_proto protoreflect.FileDescriptor
+// 登录会话，每次登录产生一个会话，刷新Token不会改变会话ID
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"` // 登录设备的 User-Agent
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`                                // 最近一次使用的 IP
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // 最近一次刷新Token的时间
+	Current       bool                   `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`                          // 是否为发起请求的会话
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_service_v1_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x1aauth/service/v1/auth.proto\x12\x0fauth.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x13errors/errors.proto\"\xaf\x01\n" +
-	"\x14SignupByEmailRequest\x12\x1b\n" +
-	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x05R\x04name\x12%\n" +
-	"\bpassword\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\n" +
-	"R\bpassword\x124\n" +
-	"\x10password_confirm\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\n" +
-	"R\x0fpasswordConfirm\x12\x1d\n" +
-	"\x05email\x18\x04 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"e\n" +
-	"\x15SignupByEmailResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\"Z\n" +
-	"\x1bLoginByEmailPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12%\n" +
-	"\bpassword\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18\n" +
-	"R\bpassword\"\x85\x01\n" +
-	"\x1cLoginByEmailPasswordResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"C\n" +
-	"\x13RefreshTokenRequest\x12,\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\frefreshToken\"}\n" +
-	"\x14RefreshTokenResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"=\n" +
-	"\rLogoutRequest\x12,\n" +
-	"\rrefresh_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\frefreshToken\"*\n" +
-	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*\xb5\x02\n" +
-	"\vErrorReason\x12\x18\n" +
-	"\x0eUSER_NOT_FOUND\x10\x00\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
-	"\x13USER_ALREADY_EXISTS\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
-	"\x12INCORRECT_PASSWORD\x10\x02\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
-	"\x13INVALID_CREDENTIALS\x10\x03\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
-	"\x12INVALID_TOKEN_TYPE\x10\x04\x1a\x04\xa8E\x90\x03\x12\x17\n" +
-	"\rTOKEN_EXPIRED\x10\x05\x1a\x04\xa8E\x91\x03\x12\x17\n" +
-	"\rMISSING_TOKEN\x10\x06\x1a\x04\xa8E\x91\x03\x12!\n" +
-	"\x17TOKEN_GENERATION_FAILED\x10\a\x1a\x04\xa8E\xf4\x03\x12\x16\n" +
-	"\fUNAUTHORIZED\x10\b\x1a\x04\xa8E\x93\x03\x12\x1f\n" +
-	"\x15INVALID_REFRESH_TOKEN\x10\t\x1a\x04\xa8E\x91\x03\x1a\x04\xa0E\xf4\x032\x8a\x03\n" +
-	"\vAuthService\x12^\n" +
-	"\rSignupByEmail\x12%.auth.service.v1.SignupByEmailRequest\x1a&.auth.service.v1.SignupByEmailResponse\x12s\n" +
-	"\x14LoginByEmailPassword\x12,.auth.service.v1.LoginByEmailPasswordRequest\x1a-.auth.service.v1.LoginByEmailPasswordResponse\x12[\n" +
-	"\fRefreshToken\x12$.auth.service.v1.RefreshTokenRequest\x1a%.auth.service.v1.RefreshTokenResponse\x12I\n" +
-	"\x06Logout\x12\x1e.auth.service.v1.LogoutRequest\x1a\x1f.auth.service.v1.LogoutResponseB\xc1\x01\n" +
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// 查询当前用户会话请求
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+// 查询当前用户会话响应
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// 注销会话请求
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// 注销会话响应
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 注销其他会话请求
+type RevokeOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+// 注销其他会话响应
+type RevokeOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"` // 被注销的会话数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeOtherSessionsResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// 两步验证请求
+type VerifyMFARequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// TOTP 验证码或恢复码
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARequest) Reset() {
+	*x = VerifyMFARequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARequest) ProtoMessage() {}
+
+func (x *VerifyMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifyMFARequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 两步验证响应
+type VerifyMFAResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken            string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken           string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn              int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`                                          // Access Token过期时间(秒)
+	RecoveryCodesRemaining int32                  `protobuf:"varint,4,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"` // 使用恢复码登录时返回剩余可用数量
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VerifyMFAResponse) Reset() {
+	*x = VerifyMFAResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAResponse) ProtoMessage() {}
+
+func (x *VerifyMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMFAResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyMFAResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *VerifyMFAResponse) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+// 登记 TOTP 请求
+type EnrollTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRequest) ProtoMessage() {}
+
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+// 登记 TOTP 响应，需要调用 ConfirmTOTP 后才会启用
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                           // base32 密钥，用于手动输入
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"` // otpauth:// 链接，用于生成二维码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// 确认 TOTP 请求
+type ConfirmTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 确认 TOTP 响应
+type ConfirmTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"` // 一次性恢复码，只在此时返回明文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// 关闭 TOTP 请求
+type DisableTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TOTP 验证码或恢复码
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 关闭 TOTP 响应
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisableTOTPResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 邮箱验证请求
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 验证邮件中的一次性 token
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 邮箱验证响应
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VerifyEmailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 重新发送验证邮件请求
+type ResendVerificationEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailRequest) Reset() {
+	*x = ResendVerificationEmailRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *ResendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 重新发送验证邮件响应，无论邮箱是否存在都返回成功
+type ResendVerificationEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationEmailResponse) Reset() {
+	*x = ResendVerificationEmailResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *ResendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResendVerificationEmailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 申请重置密码请求
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 申请重置密码响应，无论邮箱是否存在都返回成功
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RequestPasswordResetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 重置密码请求
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 重置邮件中的一次性 token
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// 新密码，规则与注册一致
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 重置密码响应
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 可用的第三方登录方式
+type OIDCProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCProvider) Reset() {
+	*x = OIDCProvider{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCProvider) ProtoMessage() {}
+
+func (x *OIDCProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCProvider.ProtoReflect.Descriptor instead.
+func (*OIDCProvider) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OIDCProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ListOIDCProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersRequest) Reset() {
+	*x = ListOIDCProvidersRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersRequest) ProtoMessage() {}
+
+func (x *ListOIDCProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+type ListOIDCProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*OIDCProvider        `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersResponse) Reset() {
+	*x = ListOIDCProvidersResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersResponse) ProtoMessage() {}
+
+func (x *ListOIDCProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListOIDCProvidersResponse) GetProviders() []*OIDCProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// 发起第三方登录或关联请求
+type StartOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginRequest) Reset() {
+	*x = StartOIDCLoginRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginRequest) ProtoMessage() {}
+
+func (x *StartOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StartOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// 发起第三方登录响应，前端跳转到 authorization_url
+type StartOIDCLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresIn        int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // 授权请求有效期（秒）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOIDCLoginResponse) Reset() {
+	*x = StartOIDCLoginResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOIDCLoginResponse) ProtoMessage() {}
+
+func (x *StartOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartOIDCLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StartOIDCLoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// 第三方登录回调请求，code 和 state 来自身份提供方重定向的查询参数。
+// 关联流程的回调需要带上发起关联的用户的 Access Token
+type OIDCCallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCCallbackRequest) Reset() {
+	*x = OIDCCallbackRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackRequest) ProtoMessage() {}
+
+func (x *OIDCCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OIDCCallbackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OIDCCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OIDCCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// 第三方登录回调响应。登录流程返回 token（启用两步验证时返回 mfa_token）；关联流程只返回 linked
+type OIDCCallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	MfaRequired   bool                   `protobuf:"varint,4,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,5,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresIn  int64                  `protobuf:"varint,6,opt,name=mfa_expires_in,json=mfaExpiresIn,proto3" json:"mfa_expires_in,omitempty"`
+	Linked        bool                   `protobuf:"varint,7,opt,name=linked,proto3" json:"linked,omitempty"`   // 本次回调把外部身份关联到了已有用户
+	Created       bool                   `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"` // 本次回调创建了新用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCCallbackResponse) Reset() {
+	*x = OIDCCallbackResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCCallbackResponse) ProtoMessage() {}
+
+func (x *OIDCCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCCallbackResponse.ProtoReflect.Descriptor instead.
+func (*OIDCCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OIDCCallbackResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *OIDCCallbackResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *OIDCCallbackResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *OIDCCallbackResponse) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
+func (x *OIDCCallbackResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *OIDCCallbackResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// 已关联的外部身份
+type Identity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{39}
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*Identity            `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UnlinkIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 以其他用户身份登录（管理员），用于排查用户看到的问题
+type ImpersonateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 代登录原因，写入审计日志
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRequest) Reset() {
+	*x = ImpersonateRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRequest) ProtoMessage() {}
+
+func (x *ImpersonateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImpersonateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImpersonateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 代登录的 Access Token 有效期较短且没有 Refresh Token，
+// 其中同时记录被模拟的用户和实际操作的管理员
+type ImpersonateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateResponse) Reset() {
+	*x = ImpersonateResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateResponse) ProtoMessage() {}
+
+func (x *ImpersonateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImpersonateResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonateResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// 结束代登录，使用代登录的 Access Token 调用
+type EndImpersonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndImpersonationRequest) Reset() {
+	*x = EndImpersonationRequest{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndImpersonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndImpersonationRequest) ProtoMessage() {}
+
+func (x *EndImpersonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndImpersonationRequest.ProtoReflect.Descriptor instead.
+func (*EndImpersonationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{45}
+}
+
+type EndImpersonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndImpersonationResponse) Reset() {
+	*x = EndImpersonationResponse{}
+	mi := &file_auth_service_v1_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndImpersonationResponse) ProtoMessage() {}
+
+func (x *EndImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_v1_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*EndImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_v1_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EndImpersonationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_auth_service_v1_auth_proto protoreflect.FileDescriptor
+
+const file_auth_service_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x1aauth/service/v1/auth.proto\x12\x0fauth.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x13errors/errors.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
+	"\x14SignupByEmailRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x05R\x04name\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\bpassword\x125\n" +
+	"\x10password_confirm\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\x0fpasswordConfirm\x12\x1d\n" +
+	"\x05email\x18\x04 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12(\n" +
+	"\vinvite_code\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18@R\n" +
+	"inviteCode\"e\n" +
+	"\x15SignupByEmailResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"[\n" +
+	"\x1bLoginByEmailPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\bpassword\"\xeb\x01\n" +
+	"\x1cLoginByEmailPasswordResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fmfa_required\x18\x04 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x05 \x01(\tR\bmfaToken\x12$\n" +
+	"\x0emfa_expires_in\x18\x06 \x01(\x03R\fmfaExpiresIn\"C\n" +
+	"\x13RefreshTokenRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\frefreshToken\"}\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"=\n" +
+	"\rLogoutRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\frefreshToken\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdb\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrent\"\x15\n" +
+	"\x13ListSessionsRequest\"L\n" +
+	"\x14ListSessionsResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.auth.service.v1.SessionR\bsessions\">\n" +
+	"\x14RevokeSessionRequest\x12&\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aRevokeOtherSessionsRequest\"7\n" +
+	"\x1bRevokeOtherSessionsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"W\n" +
+	"\x10VerifyMFARequest\x12$\n" +
+	"\tmfa_token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bmfaToken\x12\x1d\n" +
+	"\x04code\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x06\x18 R\x04code\"\xb4\x01\n" +
+	"\x11VerifyMFAResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x128\n" +
+	"\x18recovery_codes_remaining\x18\x04 \x01(\x05R\x16recoveryCodesRemaining\"\x13\n" +
+	"\x11EnrollTOTPRequest\"M\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"2\n" +
+	"\x12ConfirmTOTPRequest\x12\x1c\n" +
+	"\x04code\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x98\x01\x06R\x04code\"<\n" +
+	"\x13ConfirmTOTPResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"3\n" +
+	"\x12DisableTOTPRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x06\x18 R\x04code\"/\n" +
+	"\x13DisableTOTPResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
+	"\x12VerifyEmailRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\"/\n" +
+	"\x13VerifyEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x1eResendVerificationEmailRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\";\n" +
+	"\x1fResendVerificationEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x1bRequestPasswordResetRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"8\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"]\n" +
+	"\x14ResetPasswordRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\bpassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\fOIDCProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x1a\n" +
+	"\x18ListOIDCProvidersRequest\"X\n" +
+	"\x19ListOIDCProvidersResponse\x12;\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1d.auth.service.v1.OIDCProviderR\tproviders\"<\n" +
+	"\x15StartOIDCLoginRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\"z\n" +
+	"\x16StartOIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"v\n" +
+	"\x13OIDCCallbackRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\x12\x1d\n" +
+	"\x05state\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05state\"\x95\x02\n" +
+	"\x14OIDCCallbackResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fmfa_required\x18\x04 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x05 \x01(\tR\bmfaToken\x12$\n" +
+	"\x0emfa_expires_in\x18\x06 \x01(\x03R\fmfaExpiresIn\x12\x16\n" +
+	"\x06linked\x18\a \x01(\bR\x06linked\x12\x18\n" +
+	"\acreated\x18\b \x01(\bR\acreated\"\x91\x01\n" +
+	"\bIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x17\n" +
+	"\x15ListIdentitiesRequest\"S\n" +
+	"\x16ListIdentitiesResponse\x129\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x19.auth.service.v1.IdentityR\n" +
+	"identities\"<\n" +
+	"\x15UnlinkIdentityRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\"2\n" +
+	"\x16UnlinkIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\x12ImpersonateRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\"W\n" +
+	"\x13ImpersonateResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"\x19\n" +
+	"\x17EndImpersonationRequest\"4\n" +
+	"\x18EndImpersonationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*\x97\a\n" +
+	"\vErrorReason\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\x00\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x13USER_ALREADY_EXISTS\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12INCORRECT_PASSWORD\x10\x02\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x13INVALID_CREDENTIALS\x10\x03\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
+	"\x12INVALID_TOKEN_TYPE\x10\x04\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rTOKEN_EXPIRED\x10\x05\x1a\x04\xa8E\x91\x03\x12\x17\n" +
+	"\rMISSING_TOKEN\x10\x06\x1a\x04\xa8E\x91\x03\x12!\n" +
+	"\x17TOKEN_GENERATION_FAILED\x10\a\x1a\x04\xa8E\xf4\x03\x12\x16\n" +
+	"\fUNAUTHORIZED\x10\b\x1a\x04\xa8E\x93\x03\x12\x1f\n" +
+	"\x15INVALID_REFRESH_TOKEN\x10\t\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x11SESSION_NOT_FOUND\x10\n" +
+	"\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10INVALID_MFA_CODE\x10\v\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x11INVALID_MFA_TOKEN\x10\f\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x13MFA_ALREADY_ENABLED\x10\r\x1a\x04\xa8E\x90\x03\x12\x19\n" +
+	"\x0fMFA_NOT_ENABLED\x10\x0e\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12EMAIL_NOT_VERIFIED\x10\x0f\x1a\x04\xa8E\x93\x03\x12$\n" +
+	"\x1aINVALID_VERIFICATION_TOKEN\x10\x10\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17OIDC_PROVIDER_NOT_FOUND\x10\x11\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11OIDC_LOGIN_FAILED\x10\x12\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x13IDENTITY_NOT_LINKED\x10\x13\x1a\x04\xa8E\x93\x03\x12!\n" +
+	"\x17IDENTITY_ALREADY_LINKED\x10\x14\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12IDENTITY_NOT_FOUND\x10\x15\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11LAST_LOGIN_METHOD\x10\x16\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eACCOUNT_LOCKED\x10\x17\x1a\x04\xa8E\xad\x03\x12!\n" +
+	"\x17TOO_MANY_LOGIN_ATTEMPTS\x10\x18\x1a\x04\xa8E\xad\x03\x12\x17\n" +
+	"\rWEAK_PASSWORD\x10\x19\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rUSER_DISABLED\x10\x1a\x1a\x04\xa8E\x93\x03\x12\x1c\n" +
+	"\x12SIGNUP_NOT_ALLOWED\x10\x1b\x1a\x04\xa8E\x93\x03\x12\x1d\n" +
+	"\x13INVALID_INVITE_CODE\x10\x1c\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19IMPERSONATION_NOT_ALLOWED\x10\x1d\x1a\x04\xa8E\x93\x03\x1a\x04\xa0E\xf4\x032\xdc\x11\n" +
+	"\vAuthService\x12^\n" +
+	"\rSignupByEmail\x12%.auth.service.v1.SignupByEmailRequest\x1a&.auth.service.v1.SignupByEmailResponse\x12s\n" +
+	"\x14LoginByEmailPassword\x12,.auth.service.v1.LoginByEmailPasswordRequest\x1a-.auth.service.v1.LoginByEmailPasswordResponse\x12[\n" +
+	"\fRefreshToken\x12$.auth.service.v1.RefreshTokenRequest\x1a%.auth.service.v1.RefreshTokenResponse\x12I\n" +
+	"\x06Logout\x12\x1e.auth.service.v1.LogoutRequest\x1a\x1f.auth.service.v1.LogoutResponse\x12[\n" +
+	"\fListSessions\x12$.auth.service.v1.ListSessionsRequest\x1a%.auth.service.v1.ListSessionsResponse\x12^\n" +
+	"\rRevokeSession\x12%.auth.service.v1.RevokeSessionRequest\x1a&.auth.service.v1.RevokeSessionResponse\x12p\n" +
+	"\x13RevokeOtherSessions\x12+.auth.service.v1.RevokeOtherSessionsRequest\x1a,.auth.service.v1.RevokeOtherSessionsResponse\x12R\n" +
+	"\tVerifyMFA\x12!.auth.service.v1.VerifyMFARequest\x1a\".auth.service.v1.VerifyMFAResponse\x12U\n" +
+	"\n" +
+	"EnrollTOTP\x12\".auth.service.v1.EnrollTOTPRequest\x1a#.auth.service.v1.EnrollTOTPResponse\x12X\n" +
+	"\vConfirmTOTP\x12#.auth.service.v1.ConfirmTOTPRequest\x1a$.auth.service.v1.ConfirmTOTPResponse\x12X\n" +
+	"\vDisableTOTP\x12#.auth.service.v1.DisableTOTPRequest\x1a$.auth.service.v1.DisableTOTPResponse\x12X\n" +
+	"\vVerifyEmail\x12#.auth.service.v1.VerifyEmailRequest\x1a$.auth.service.v1.VerifyEmailResponse\x12|\n" +
+	"\x17ResendVerificationEmail\x12/.auth.service.v1.ResendVerificationEmailRequest\x1a0.auth.service.v1.ResendVerificationEmailResponse\x12s\n" +
+	"\x14RequestPasswordReset\x12,.auth.service.v1.RequestPasswordResetRequest\x1a-.auth.service.v1.RequestPasswordResetResponse\x12^\n" +
+	"\rResetPassword\x12%.auth.service.v1.ResetPasswordRequest\x1a&.auth.service.v1.ResetPasswordResponse\x12j\n" +
+	"\x11ListOIDCProviders\x12).auth.service.v1.ListOIDCProvidersRequest\x1a*.auth.service.v1.ListOIDCProvidersResponse\x12a\n" +
+	"\x0eStartOIDCLogin\x12&.auth.service.v1.StartOIDCLoginRequest\x1a'.auth.service.v1.StartOIDCLoginResponse\x12[\n" +
+	"\fOIDCCallback\x12$.auth.service.v1.OIDCCallbackRequest\x1a%.auth.service.v1.OIDCCallbackResponse\x12`\n" +
+	"\rStartOIDCLink\x12&.auth.service.v1.StartOIDCLoginRequest\x1a'.auth.service.v1.StartOIDCLoginResponse\x12a\n" +
+	"\x0eListIdentities\x12&.auth.service.v1.ListIdentitiesRequest\x1a'.auth.service.v1.ListIdentitiesResponse\x12a\n" +
+	"\x0eUnlinkIdentity\x12&.auth.service.v1.UnlinkIdentityRequest\x1a'.auth.service.v1.UnlinkIdentityResponse\x12X\n" +
+	"\vImpersonate\x12#.auth.service.v1.ImpersonateRequest\x1a$.auth.service.v1.ImpersonateResponse\x12g\n" +
+	"\x10EndImpersonation\x12(.auth.service.v1.EndImpersonationRequest\x1a).auth.service.v1.EndImpersonationResponseB\xc1\x01\n" +
 	"\x13com.auth.service.v1B\tAuthProtoP\x01ZAgithub.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1;authpb\xa2\x02\x03ASX\xaa\x02\x0fAuth.Service.V1\xca\x02\x0fAuth\\Service\\V1\xe2\x02\x1bAuth\\Service\\V1\\GPBMetadata\xea\x02\x11Auth::Service::V1b\x06proto3"
 
 var (
@@ -623,32 +2827,116 @@ func file_auth_service_v1_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_auth_service_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_auth_service_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_auth_service_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_auth_service_v1_auth_proto_goTypes = []any{
-	(ErrorReason)(0),                     // 0: auth.service.v1.ErrorReason
-	(*SignupByEmailRequest)(nil),         // 1: auth.service.v1.SignupByEmailRequest
-	(*SignupByEmailResponse)(nil),        // 2: auth.service.v1.SignupByEmailResponse
-	(*LoginByEmailPasswordRequest)(nil),  // 3: auth.service.v1.LoginByEmailPasswordRequest
-	(*LoginByEmailPasswordResponse)(nil), // 4: auth.service.v1.LoginByEmailPasswordResponse
-	(*RefreshTokenRequest)(nil),          // 5: auth.service.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),         // 6: auth.service.v1.RefreshTokenResponse
-	(*LogoutRequest)(nil),                // 7: auth.service.v1.LogoutRequest
-	(*LogoutResponse)(nil),               // 8: auth.service.v1.LogoutResponse
+	(ErrorReason)(0),                        // 0: auth.service.v1.ErrorReason
+	(*SignupByEmailRequest)(nil),            // 1: auth.service.v1.SignupByEmailRequest
+	(*SignupByEmailResponse)(nil),           // 2: auth.service.v1.SignupByEmailResponse
+	(*LoginByEmailPasswordRequest)(nil),     // 3: auth.service.v1.LoginByEmailPasswordRequest
+	(*LoginByEmailPasswordResponse)(nil),    // 4: auth.service.v1.LoginByEmailPasswordResponse
+	(*RefreshTokenRequest)(nil),             // 5: auth.service.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 6: auth.service.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),                   // 7: auth.service.v1.LogoutRequest
+	(*LogoutResponse)(nil),                  // 8: auth.service.v1.LogoutResponse
+	(*Session)(nil),                         // 9: auth.service.v1.Session
+	(*ListSessionsRequest)(nil),             // 10: auth.service.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 11: auth.service.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 12: auth.service.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 13: auth.service.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),      // 14: auth.service.v1.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil),     // 15: auth.service.v1.RevokeOtherSessionsResponse
+	(*VerifyMFARequest)(nil),                // 16: auth.service.v1.VerifyMFARequest
+	(*VerifyMFAResponse)(nil),               // 17: auth.service.v1.VerifyMFAResponse
+	(*EnrollTOTPRequest)(nil),               // 18: auth.service.v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),              // 19: auth.service.v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),              // 20: auth.service.v1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),             // 21: auth.service.v1.ConfirmTOTPResponse
+	(*DisableTOTPRequest)(nil),              // 22: auth.service.v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),             // 23: auth.service.v1.DisableTOTPResponse
+	(*VerifyEmailRequest)(nil),              // 24: auth.service.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 25: auth.service.v1.VerifyEmailResponse
+	(*ResendVerificationEmailRequest)(nil),  // 26: auth.service.v1.ResendVerificationEmailRequest
+	(*ResendVerificationEmailResponse)(nil), // 27: auth.service.v1.ResendVerificationEmailResponse
+	(*RequestPasswordResetRequest)(nil),     // 28: auth.service.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 29: auth.service.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 30: auth.service.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 31: auth.service.v1.ResetPasswordResponse
+	(*OIDCProvider)(nil),                    // 32: auth.service.v1.OIDCProvider
+	(*ListOIDCProvidersRequest)(nil),        // 33: auth.service.v1.ListOIDCProvidersRequest
+	(*ListOIDCProvidersResponse)(nil),       // 34: auth.service.v1.ListOIDCProvidersResponse
+	(*StartOIDCLoginRequest)(nil),           // 35: auth.service.v1.StartOIDCLoginRequest
+	(*StartOIDCLoginResponse)(nil),          // 36: auth.service.v1.StartOIDCLoginResponse
+	(*OIDCCallbackRequest)(nil),             // 37: auth.service.v1.OIDCCallbackRequest
+	(*OIDCCallbackResponse)(nil),            // 38: auth.service.v1.OIDCCallbackResponse
+	(*Identity)(nil),                        // 39: auth.service.v1.Identity
+	(*ListIdentitiesRequest)(nil),           // 40: auth.service.v1.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),          // 41: auth.service.v1.ListIdentitiesResponse
+	(*UnlinkIdentityRequest)(nil),           // 42: auth.service.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),          // 43: auth.service.v1.UnlinkIdentityResponse
+	(*ImpersonateRequest)(nil),              // 44: auth.service.v1.ImpersonateRequest
+	(*ImpersonateResponse)(nil),             // 45: auth.service.v1.ImpersonateResponse
+	(*EndImpersonationRequest)(nil),         // 46: auth.service.v1.EndImpersonationRequest
+	(*EndImpersonationResponse)(nil),        // 47: auth.service.v1.EndImpersonationResponse
+	(*timestamppb.Timestamp)(nil),           // 48: google.protobuf.Timestamp
 }
 var file_auth_service_v1_auth_proto_depIdxs = []int32{
-	1, // 0: auth.service.v1.AuthService.SignupByEmail:input_type -> auth.service.v1.SignupByEmailRequest
-	3, // 1: auth.service.v1.AuthService.LoginByEmailPassword:input_type -> auth.service.v1.LoginByEmailPasswordRequest
-	5, // 2: auth.service.v1.AuthService.RefreshToken:input_type -> auth.service.v1.RefreshTokenRequest
-	7, // 3: auth.service.v1.AuthService.Logout:input_type -> auth.service.v1.LogoutRequest
-	2, // 4: auth.service.v1.AuthService.SignupByEmail:output_type -> auth.service.v1.SignupByEmailResponse
-	4, // 5: auth.service.v1.AuthService.LoginByEmailPassword:output_type -> auth.service.v1.LoginByEmailPasswordResponse
-	6, // 6: auth.service.v1.AuthService.RefreshToken:output_type -> auth.service.v1.RefreshTokenResponse
-	8, // 7: auth.service.v1.AuthService.Logout:output_type -> auth.service.v1.LogoutResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	48, // 0: auth.service.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	48, // 1: auth.service.v1.Session.last_used_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: auth.service.v1.ListSessionsResponse.sessions:type_name -> auth.service.v1.Session
+	32, // 3: auth.service.v1.ListOIDCProvidersResponse.providers:type_name -> auth.service.v1.OIDCProvider
+	48, // 4: auth.service.v1.Identity.created_at:type_name -> google.protobuf.Timestamp
+	39, // 5: auth.service.v1.ListIdentitiesResponse.identities:type_name -> auth.service.v1.Identity
+	1,  // 6: auth.service.v1.AuthService.SignupByEmail:input_type -> auth.service.v1.SignupByEmailRequest
+	3,  // 7: auth.service.v1.AuthService.LoginByEmailPassword:input_type -> auth.service.v1.LoginByEmailPasswordRequest
+	5,  // 8: auth.service.v1.AuthService.RefreshToken:input_type -> auth.service.v1.RefreshTokenRequest
+	7,  // 9: auth.service.v1.AuthService.Logout:input_type -> auth.service.v1.LogoutRequest
+	10, // 10: auth.service.v1.AuthService.ListSessions:input_type -> auth.service.v1.ListSessionsRequest
+	12, // 11: auth.service.v1.AuthService.RevokeSession:input_type -> auth.service.v1.RevokeSessionRequest
+	14, // 12: auth.service.v1.AuthService.RevokeOtherSessions:input_type -> auth.service.v1.RevokeOtherSessionsRequest
+	16, // 13: auth.service.v1.AuthService.VerifyMFA:input_type -> auth.service.v1.VerifyMFARequest
+	18, // 14: auth.service.v1.AuthService.EnrollTOTP:input_type -> auth.service.v1.EnrollTOTPRequest
+	20, // 15: auth.service.v1.AuthService.ConfirmTOTP:input_type -> auth.service.v1.ConfirmTOTPRequest
+	22, // 16: auth.service.v1.AuthService.DisableTOTP:input_type -> auth.service.v1.DisableTOTPRequest
+	24, // 17: auth.service.v1.AuthService.VerifyEmail:input_type -> auth.service.v1.VerifyEmailRequest
+	26, // 18: auth.service.v1.AuthService.ResendVerificationEmail:input_type -> auth.service.v1.ResendVerificationEmailRequest
+	28, // 19: auth.service.v1.AuthService.RequestPasswordReset:input_type -> auth.service.v1.RequestPasswordResetRequest
+	30, // 20: auth.service.v1.AuthService.ResetPassword:input_type -> auth.service.v1.ResetPasswordRequest
+	33, // 21: auth.service.v1.AuthService.ListOIDCProviders:input_type -> auth.service.v1.ListOIDCProvidersRequest
+	35, // 22: auth.service.v1.AuthService.StartOIDCLogin:input_type -> auth.service.v1.StartOIDCLoginRequest
+	37, // 23: auth.service.v1.AuthService.OIDCCallback:input_type -> auth.service.v1.OIDCCallbackRequest
+	35, // 24: auth.service.v1.AuthService.StartOIDCLink:input_type -> auth.service.v1.StartOIDCLoginRequest
+	40, // 25: auth.service.v1.AuthService.ListIdentities:input_type -> auth.service.v1.ListIdentitiesRequest
+	42, // 26: auth.service.v1.AuthService.UnlinkIdentity:input_type -> auth.service.v1.UnlinkIdentityRequest
+	44, // 27: auth.service.v1.AuthService.Impersonate:input_type -> auth.service.v1.ImpersonateRequest
+	46, // 28: auth.service.v1.AuthService.EndImpersonation:input_type -> auth.service.v1.EndImpersonationRequest
+	2,  // 29: auth.service.v1.AuthService.SignupByEmail:output_type -> auth.service.v1.SignupByEmailResponse
+	4,  // 30: auth.service.v1.AuthService.LoginByEmailPassword:output_type -> auth.service.v1.LoginByEmailPasswordResponse
+	6,  // 31: auth.service.v1.AuthService.RefreshToken:output_type -> auth.service.v1.RefreshTokenResponse
+	8,  // 32: auth.service.v1.AuthService.Logout:output_type -> auth.service.v1.LogoutResponse
+	11, // 33: auth.service.v1.AuthService.ListSessions:output_type -> auth.service.v1.ListSessionsResponse
+	13, // 34: auth.service.v1.AuthService.RevokeSession:output_type -> auth.service.v1.RevokeSessionResponse
+	15, // 35: auth.service.v1.AuthService.RevokeOtherSessions:output_type -> auth.service.v1.RevokeOtherSessionsResponse
+	17, // 36: auth.service.v1.AuthService.VerifyMFA:output_type -> auth.service.v1.VerifyMFAResponse
+	19, // 37: auth.service.v1.AuthService.EnrollTOTP:output_type -> auth.service.v1.EnrollTOTPResponse
+	21, // 38: auth.service.v1.AuthService.ConfirmTOTP:output_type -> auth.service.v1.ConfirmTOTPResponse
+	23, // 39: auth.service.v1.AuthService.DisableTOTP:output_type -> auth.service.v1.DisableTOTPResponse
+	25, // 40: auth.service.v1.AuthService.VerifyEmail:output_type -> auth.service.v1.VerifyEmailResponse
+	27, // 41: auth.service.v1.AuthService.ResendVerificationEmail:output_type -> auth.service.v1.ResendVerificationEmailResponse
+	29, // 42: auth.service.v1.AuthService.RequestPasswordReset:output_type -> auth.service.v1.RequestPasswordResetResponse
+	31, // 43: auth.service.v1.AuthService.ResetPassword:output_type -> auth.service.v1.ResetPasswordResponse
+	34, // 44: auth.service.v1.AuthService.ListOIDCProviders:output_type -> auth.service.v1.ListOIDCProvidersResponse
+	36, // 45: auth.service.v1.AuthService.StartOIDCLogin:output_type -> auth.service.v1.StartOIDCLoginResponse
+	38, // 46: auth.service.v1.AuthService.OIDCCallback:output_type -> auth.service.v1.OIDCCallbackResponse
+	36, // 47: auth.service.v1.AuthService.StartOIDCLink:output_type -> auth.service.v1.StartOIDCLoginResponse
+	41, // 48: auth.service.v1.AuthService.ListIdentities:output_type -> auth.service.v1.ListIdentitiesResponse
+	43, // 49: auth.service.v1.AuthService.UnlinkIdentity:output_type -> auth.service.v1.UnlinkIdentityResponse
+	45, // 50: auth.service.v1.AuthService.Impersonate:output_type -> auth.service.v1.ImpersonateResponse
+	47, // 51: auth.service.v1.AuthService.EndImpersonation:output_type -> auth.service.v1.EndImpersonationResponse
+	29, // [29:52] is the sub-list for method output_type
+	6,  // [6:29] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_auth_service_v1_auth_proto_init() }
@@ -662,7 +2950,7 @@ func file_auth_service_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_service_v1_auth_proto_rawDesc), len(file_auth_service_v1_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -150,3 +150,283 @@ func IsInvalidRefreshToken(err error) bool {
 func ErrorInvalidRefreshToken(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, ErrorReason_INVALID_REFRESH_TOKEN.String(), fmt.Sprintf(format, args...))
 }
+
+// 会话不存在
+func IsSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SESSION_NOT_FOUND.String() && e.Code == 404
+}
+
+// 会话不存在
+func ErrorSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SESSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证码错误
+func IsInvalidMfaCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_MFA_CODE.String() && e.Code == 401
+}
+
+// 两步验证码错误
+func ErrorInvalidMfaCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_INVALID_MFA_CODE.String(), fmt.Sprintf(format, args...))
+}
+
+// 两步验证登录挑战无效或已过期
+func IsInvalidMfaToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_MFA_TOKEN.String() && e.Code == 401
+}
+
+// 两步验证登录挑战无效或已过期
+func ErrorInvalidMfaToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_INVALID_MFA_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
+// 已启用两步验证
+func IsMfaAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFA_ALREADY_ENABLED.String() && e.Code == 400
+}
+
+// 已启用两步验证
+func ErrorMfaAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MFA_ALREADY_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
+// 未启用两步验证
+func IsMfaNotEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFA_NOT_ENABLED.String() && e.Code == 400
+}
+
+// 未启用两步验证
+func ErrorMfaNotEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MFA_NOT_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邮箱未验证
+func IsEmailNotVerified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_NOT_VERIFIED.String() && e.Code == 403
+}
+
+// 邮箱未验证
+func ErrorEmailNotVerified(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_EMAIL_NOT_VERIFIED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邮箱验证或密码重置链接无效或已过期
+func IsInvalidVerificationToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_VERIFICATION_TOKEN.String() && e.Code == 400
+}
+
+// 邮箱验证或密码重置链接无效或已过期
+func ErrorInvalidVerificationToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_VERIFICATION_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
+// 未配置该身份提供方
+func IsOidcProviderNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDC_PROVIDER_NOT_FOUND.String() && e.Code == 404
+}
+
+// 未配置该身份提供方
+func ErrorOidcProviderNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_OIDC_PROVIDER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 第三方登录失败（state 无效、授权码无效或 ID Token 校验失败）
+func IsOidcLoginFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDC_LOGIN_FAILED.String() && e.Code == 401
+}
+
+// 第三方登录失败（state 无效、授权码无效或 ID Token 校验失败）
+func ErrorOidcLoginFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_OIDC_LOGIN_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+// 外部身份未关联本地用户
+func IsIdentityNotLinked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IDENTITY_NOT_LINKED.String() && e.Code == 403
+}
+
+// 外部身份未关联本地用户
+func ErrorIdentityNotLinked(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_IDENTITY_NOT_LINKED.String(), fmt.Sprintf(format, args...))
+}
+
+// 外部身份已关联其他用户，或当前用户已关联该身份提供方
+func IsIdentityAlreadyLinked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IDENTITY_ALREADY_LINKED.String() && e.Code == 400
+}
+
+// 外部身份已关联其他用户，或当前用户已关联该身份提供方
+func ErrorIdentityAlreadyLinked(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_IDENTITY_ALREADY_LINKED.String(), fmt.Sprintf(format, args...))
+}
+
+// 未关联该身份提供方
+func IsIdentityNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IDENTITY_NOT_FOUND.String() && e.Code == 404
+}
+
+// 未关联该身份提供方
+func ErrorIdentityNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_IDENTITY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 解除关联后将无法登录
+func IsLastLoginMethod(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_LAST_LOGIN_METHOD.String() && e.Code == 400
+}
+
+// 解除关联后将无法登录
+func ErrorLastLoginMethod(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_LAST_LOGIN_METHOD.String(), fmt.Sprintf(format, args...))
+}
+
+// 登录失败次数过多，账号或 IP 被暂时锁定；metadata 中的 retry_after 为剩余秒数
+func IsAccountLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ACCOUNT_LOCKED.String() && e.Code == 429
+}
+
+// 登录失败次数过多，账号或 IP 被暂时锁定；metadata 中的 retry_after 为剩余秒数
+func ErrorAccountLocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_ACCOUNT_LOCKED.String(), fmt.Sprintf(format, args...))
+}
+
+// 登录失败后需要等待一段时间再重试；metadata 中的 retry_after 为剩余秒数
+func IsTooManyLoginAttempts(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOO_MANY_LOGIN_ATTEMPTS.String() && e.Code == 429
+}
+
+// 登录失败后需要等待一段时间再重试；metadata 中的 retry_after 为剩余秒数
+func ErrorTooManyLoginAttempts(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TOO_MANY_LOGIN_ATTEMPTS.String(), fmt.Sprintf(format, args...))
+}
+
+// 密码不符合密码策略
+func IsWeakPassword(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_WEAK_PASSWORD.String() && e.Code == 400
+}
+
+// 密码不符合密码策略
+func ErrorWeakPassword(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_WEAK_PASSWORD.String(), fmt.Sprintf(format, args...))
+}
+
+// 账号已被管理员停用
+func IsUserDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_DISABLED.String() && e.Code == 403
+}
+
+// 账号已被管理员停用
+func ErrorUserDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_USER_DISABLED.String(), fmt.Sprintf(format, args...))
+}
+
+// 当前注册方式不允许该邮箱注册（需要邀请码或邮箱域名不在白名单中）
+func IsSignupNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SIGNUP_NOT_ALLOWED.String() && e.Code == 403
+}
+
+// 当前注册方式不允许该邮箱注册（需要邀请码或邮箱域名不在白名单中）
+func ErrorSignupNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_SIGNUP_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邀请码不存在、已过期或已用完
+func IsInvalidInviteCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_INVITE_CODE.String() && e.Code == 400
+}
+
+// 邀请码不存在、已过期或已用完
+func ErrorInvalidInviteCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_INVITE_CODE.String(), fmt.Sprintf(format, args...))
+}
+
+// 代登录（管理员模拟用户）期间不允许执行该操作
+func IsImpersonationNotAllowed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_IMPERSONATION_NOT_ALLOWED.String() && e.Code == 403
+}
+
+// 代登录（管理员模拟用户）期间不允许执行该操作
+func ErrorImpersonationNotAllowed(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_IMPERSONATION_NOT_ALLOWED.String(), fmt.Sprintf(format, args...))
+}
@@ -722,6 +722,7 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Client        *Data_Client           `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	EventBus      *Data_EventBus         `protobuf:"bytes,4,opt,name=event_bus,json=eventBus,proto3" json:"event_bus,omitempty"` // 事件总线配置
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -777,17 +778,39 @@ func (x *Data) GetClient() *Data_Client {
 	return nil
 }
 
+func (x *Data) GetEventBus() *Data_EventBus {
+	if x != nil {
+		return x.EventBus
+	}
+	return nil
+}
+
 // 应用配置
 type App struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Env           string                 `protobuf:"bytes,1,opt,name=env,proto3" json:"env,omitempty"` // dev test prod
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Jwt           *App_Jwt               `protobuf:"bytes,4,opt,name=jwt,proto3" json:"jwt,omitempty"`                                                                                     // JWT配置
-	Log           *App_Log               `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`                                                                                     // 日志配置
-	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 元数据
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	Env                 string                   `protobuf:"bytes,1,opt,name=env,proto3" json:"env,omitempty"` // dev test prod
+	Name                string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version             string                   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Jwt                 *App_Jwt                 `protobuf:"bytes,4,opt,name=jwt,proto3" json:"jwt,omitempty"`                                                                                     // JWT配置
+	Log                 *App_Log                 `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`                                                                                     // 日志配置
+	Metadata            map[string]string        `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 元数据
+	Mail                *App_Mail                `protobuf:"bytes,7,opt,name=mail,proto3" json:"mail,omitempty"`                                                                                   // 邮件配置
+	Webhook             *App_Webhook             `protobuf:"bytes,8,opt,name=webhook,proto3" json:"webhook,omitempty"`                                                                             // Webhook 投递配置
+	Notification        *App_Notification        `protobuf:"bytes,9,opt,name=notification,proto3" json:"notification,omitempty"`                                                                   // 用户通知配置
+	Outbox              *App_Outbox              `protobuf:"bytes,10,opt,name=outbox,proto3" json:"outbox,omitempty"`                                                                              // 领域事件 outbox 转发配置
+	Mfa                 *App_Mfa                 `protobuf:"bytes,11,opt,name=mfa,proto3" json:"mfa,omitempty"`                                                                                    // 两步验证配置
+	Account             *App_Account             `protobuf:"bytes,12,opt,name=account,proto3" json:"account,omitempty"`                                                                            // 邮箱验证与密码重置配置
+	Oidc                *App_Oidc                `protobuf:"bytes,13,opt,name=oidc,proto3" json:"oidc,omitempty"`                                                                                  // OIDC 第三方登录配置
+	Lockout             *App_Lockout             `protobuf:"bytes,14,opt,name=lockout,proto3" json:"lockout,omitempty"`                                                                            // 登录失败限制配置
+	PasswordPolicy      *App_PasswordPolicy      `protobuf:"bytes,15,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`                                        // 密码策略与密码哈希配置
+	PersonalAccessToken *App_PersonalAccessToken `protobuf:"bytes,16,opt,name=personal_access_token,json=personalAccessToken,proto3" json:"personal_access_token,omitempty"`                       // 个人访问令牌配置
+	Rbac                *App_Rbac                `protobuf:"bytes,17,opt,name=rbac,proto3" json:"rbac,omitempty"`                                                                                  // 角色与权限配置
+	Workspace           *App_Workspace           `protobuf:"bytes,18,opt,name=workspace,proto3" json:"workspace,omitempty"`                                                                        // 工作空间配置
+	Signup              *App_Signup              `protobuf:"bytes,19,opt,name=signup,proto3" json:"signup,omitempty"`                                                                              // 注册方式配置
+	Impersonation       *App_Impersonation       `protobuf:"bytes,20,opt,name=impersonation,proto3" json:"impersonation,omitempty"`                                                                // 管理员代登录配置
+	Ldap                *App_Ldap                `protobuf:"bytes,21,opt,name=ldap,proto3" json:"ldap,omitempty"`                                                                                  // LDAP 登录配置
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *App) Reset() {
@@ -862,6 +885,111 @@ func (x *App) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *App) GetMail() *App_Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *App) GetWebhook() *App_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *App) GetNotification() *App_Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *App) GetOutbox() *App_Outbox {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+func (x *App) GetMfa() *App_Mfa {
+	if x != nil {
+		return x.Mfa
+	}
+	return nil
+}
+
+func (x *App) GetAccount() *App_Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *App) GetOidc() *App_Oidc {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *App) GetLockout() *App_Lockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *App) GetPasswordPolicy() *App_PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *App) GetPersonalAccessToken() *App_PersonalAccessToken {
+	if x != nil {
+		return x.PersonalAccessToken
+	}
+	return nil
+}
+
+func (x *App) GetRbac() *App_Rbac {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *App) GetWorkspace() *App_Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *App) GetSignup() *App_Signup {
+	if x != nil {
+		return x.Signup
+	}
+	return nil
+}
+
+func (x *App) GetImpersonation() *App_Impersonation {
+	if x != nil {
+		return x.Impersonation
+	}
+	return nil
+}
+
+func (x *App) GetLdap() *App_Ldap {
+	if x != nil {
+		return x.Ldap
+	}
+	return nil
+}
+
 // 注册中心配置
 type Registry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1290,14 +1418,15 @@ func (x *Metrics) GetMeterName() string {
 }
 
 type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Tls           *TLSConfig             `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`   // 为 HTTP 添加 TLS 配置
-	Cors          *CORS                  `protobuf:"bytes,5,opt,name=cors,proto3" json:"cors,omitempty"` // CORS 配置
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Network               string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr                  string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout               *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Tls                   *TLSConfig             `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`                                                                     // 为 HTTP 添加 TLS 配置
+	Cors                  *CORS                  `protobuf:"bytes,5,opt,name=cors,proto3" json:"cors,omitempty"`                                                                   // CORS 配置
+	TrustForwardedHeaders bool                   `protobuf:"varint,6,opt,name=trust_forwarded_headers,json=trustForwardedHeaders,proto3" json:"trust_forwarded_headers,omitempty"` // 部署在反向代理之后时，从 X-Forwarded-For / X-Real-IP 获取客户端 IP
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Server_HTTP) Reset() {
@@ -1365,6 +1494,13 @@ func (x *Server_HTTP) GetCors() *CORS {
 	return nil
 }
 
+func (x *Server_HTTP) GetTrustForwardedHeaders() bool {
+	if x != nil {
+		return x.TrustForwardedHeaders
+	}
+	return false
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -1689,6 +1825,90 @@ func (x *Data_Client) GetHttp() []*Data_Client_HTTP {
 	return nil
 }
 
+type Data_EventBus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                                     // redis（Redis Streams）或 memory（进程内，仅用于开发和测试）
+	StreamMaxLen  int64                  `protobuf:"varint,2,opt,name=stream_max_len,json=streamMaxLen,proto3" json:"stream_max_len,omitempty"`  // 每个 topic 保留的最大消息数（近似裁剪），0 表示不裁剪
+	MaxDeliveries int32                  `protobuf:"varint,3,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"` // 最大投递次数，超过后进入死信 topic
+	RetryBackoff  *durationpb.Duration   `protobuf:"bytes,4,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`     // 处理失败后重新投递前的等待时间
+	BlockTimeout  *durationpb.Duration   `protobuf:"bytes,5,opt,name=block_timeout,json=blockTimeout,proto3" json:"block_timeout,omitempty"`     // 读取新消息的阻塞等待时间
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`             // 每次读取的消息数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_EventBus) Reset() {
+	*x = Data_EventBus{}
+	mi := &file_conf_v1_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_EventBus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_EventBus) ProtoMessage() {}
+
+func (x *Data_EventBus) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_EventBus.ProtoReflect.Descriptor instead.
+func (*Data_EventBus) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{9, 3}
+}
+
+func (x *Data_EventBus) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_EventBus) GetStreamMaxLen() int64 {
+	if x != nil {
+		return x.StreamMaxLen
+	}
+	return 0
+}
+
+func (x *Data_EventBus) GetMaxDeliveries() int32 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+func (x *Data_EventBus) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *Data_EventBus) GetBlockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.BlockTimeout
+	}
+	return nil
+}
+
+func (x *Data_EventBus) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
 type Data_Client_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
@@ -1700,7 +1920,7 @@ type Data_Client_HTTP struct {
 
 func (x *Data_Client_HTTP) Reset() {
 	*x = Data_Client_HTTP{}
-	mi := &file_conf_v1_conf_proto_msgTypes[23]
+	mi := &file_conf_v1_conf_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1712,7 +1932,7 @@ func (x *Data_Client_HTTP) String() string {
 func (*Data_Client_HTTP) ProtoMessage() {}
 
 func (x *Data_Client_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_v1_conf_proto_msgTypes[23]
+	mi := &file_conf_v1_conf_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +1980,7 @@ type Data_Client_GRPC struct {
 
 func (x *Data_Client_GRPC) Reset() {
 	*x = Data_Client_GRPC{}
-	mi := &file_conf_v1_conf_proto_msgTypes[24]
+	mi := &file_conf_v1_conf_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1772,7 +1992,7 @@ func (x *Data_Client_GRPC) String() string {
 func (*Data_Client_GRPC) ProtoMessage() {}
 
 func (x *Data_Client_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_v1_conf_proto_msgTypes[24]
+	mi := &file_conf_v1_conf_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,20 +2030,24 @@ func (x *Data_Client_GRPC) GetTimeout() *durationpb.Duration {
 }
 
 type App_Jwt struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessSecret  string                 `protobuf:"bytes,1,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`     // Access Token密钥
-	RefreshSecret string                 `protobuf:"bytes,2,opt,name=refresh_secret,json=refreshSecret,proto3" json:"refresh_secret,omitempty"`  // Refresh Token密钥
-	AccessExpire  int32                  `protobuf:"varint,3,opt,name=access_expire,json=accessExpire,proto3" json:"access_expire,omitempty"`    // Access Token过期时间，单位秒
-	RefreshExpire int32                  `protobuf:"varint,4,opt,name=refresh_expire,json=refreshExpire,proto3" json:"refresh_expire,omitempty"` // Refresh Token过期时间，单位秒
-	Issuer        string                 `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`                                     // JWT签发者
-	Audience      string                 `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`                                 // JWT受众
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccessSecret     string                 `protobuf:"bytes,1,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`                  // Access Token密钥
+	RefreshSecret    string                 `protobuf:"bytes,2,opt,name=refresh_secret,json=refreshSecret,proto3" json:"refresh_secret,omitempty"`               // Refresh Token密钥
+	AccessExpire     int32                  `protobuf:"varint,3,opt,name=access_expire,json=accessExpire,proto3" json:"access_expire,omitempty"`                 // Access Token过期时间，单位秒
+	RefreshExpire    int32                  `protobuf:"varint,4,opt,name=refresh_expire,json=refreshExpire,proto3" json:"refresh_expire,omitempty"`              // Refresh Token过期时间，单位秒
+	Issuer           string                 `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`                                                  // JWT签发者
+	Audience         string                 `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`                                              // JWT受众
+	RevokeAllOnReuse bool                   `protobuf:"varint,7,opt,name=revoke_all_on_reuse,json=revokeAllOnReuse,proto3" json:"revoke_all_on_reuse,omitempty"` // 检测到已轮换的Refresh Token被重放时，注销该用户的全部会话（默认只注销该次登录的token族）
+	// 配置后 Access Token 改用非对称签名，access_secret 不再使用，公钥通过 /.well-known/jwks.json 发布。
+	// 第一个密钥为当前签名密钥（必须是私钥），其余为轮换期间仍用于验证的旧密钥（可以只有公钥）
+	SigningKeys   []*App_Jwt_SigningKey `protobuf:"bytes,8,rep,name=signing_keys,json=signingKeys,proto3" json:"signing_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *App_Jwt) Reset() {
 	*x = App_Jwt{}
-	mi := &file_conf_v1_conf_proto_msgTypes[25]
+	mi := &file_conf_v1_conf_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1835,7 +2059,7 @@ func (x *App_Jwt) String() string {
 func (*App_Jwt) ProtoMessage() {}
 
 func (x *App_Jwt) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_v1_conf_proto_msgTypes[25]
+	mi := &file_conf_v1_conf_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,6 +2117,20 @@ func (x *App_Jwt) GetAudience() string {
 	return ""
 }
 
+func (x *App_Jwt) GetRevokeAllOnReuse() bool {
+	if x != nil {
+		return x.RevokeAllOnReuse
+	}
+	return false
+}
+
+func (x *App_Jwt) GetSigningKeys() []*App_Jwt_SigningKey {
+	if x != nil {
+		return x.SigningKeys
+	}
+	return nil
+}
+
 type App_Log struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Level         int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`                             // 0:debug, 1:info, 2:warn, 3:error, 4:fatal
@@ -1907,7 +2145,7 @@ type App_Log struct {
 
 func (x *App_Log) Reset() {
 	*x = App_Log{}
-	mi := &file_conf_v1_conf_proto_msgTypes[26]
+	mi := &file_conf_v1_conf_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1919,7 +2157,7 @@ func (x *App_Log) String() string {
 func (*App_Log) ProtoMessage() {}
 
 func (x *App_Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_v1_conf_proto_msgTypes[26]
+	mi := &file_conf_v1_conf_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1977,89 +2215,1624 @@ func (x *App_Log) GetCompress() bool {
 	return false
 }
 
-var File_conf_v1_conf_proto protoreflect.FileDescriptor
+type App_Mail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                                    // smtp, file, memory
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                        // 发件人，例如 "Krathub <no-reply@example.com>"
+	Smtp          *App_Mail_SMTP         `protobuf:"bytes,3,opt,name=smtp,proto3" json:"smtp,omitempty"`                                        // SMTP 配置
+	FileDir       string                 `protobuf:"bytes,4,opt,name=file_dir,json=fileDir,proto3" json:"file_dir,omitempty"`                   // file 驱动的邮件输出目录
+	DefaultLocale string                 `protobuf:"bytes,5,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"` // 默认语言，例如 zh-CN
+	TemplateDir   string                 `protobuf:"bytes,6,opt,name=template_dir,json=templateDir,proto3" json:"template_dir,omitempty"`       // 自定义模板目录，为空则使用内置模板
+	Workers       int32                  `protobuf:"varint,7,opt,name=workers,proto3" json:"workers,omitempty"`                                 // 发送队列并发数
+	MaxAttempts   int32                  `protobuf:"varint,8,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`      // 最大投递次数，超过后进入死信队列
+	RetryBackoff  *durationpb.Duration   `protobuf:"bytes,9,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`    // 重试初始退避时间，按指数增长
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_conf_v1_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x12conf/v1/conf.proto\x12\aconf.v1\x1a\x1egoogle/protobuf/duration.proto\"\xfc\x02\n" +
-	"\tBootstrap\x12\x1e\n" +
-	"\x03app\x18\x01 \x01(\v2\f.conf.v1.AppR\x03app\x12'\n" +
-	"\x06server\x18\x02 \x01(\v2\x0f.conf.v1.ServerR\x06server\x12'\n" +
-	"\x06client\x18\x03 \x01(\v2\x0f.conf.v1.ClientR\x06client\x12!\n" +
-	"\x04data\x18\x04 \x01(\v2\r.conf.v1.DataR\x04data\x12-\n" +
-	"\bregistry\x18\x05 \x01(\v2\x11.conf.v1.RegistryR\bregistry\x120\n" +
-	"\tdiscovery\x18\x06 \x01(\v2\x12.conf.v1.DiscoveryR\tdiscovery\x12'\n" +
-	"\x06config\x18\a \x01(\v2\x0f.conf.v1.ConfigR\x06config\x12$\n" +
-	"\x05trace\x18\b \x01(\v2\x0e.conf.v1.TraceR\x05trace\x12*\n" +
-	"\ametrics\x18\t \x01(\v2\x10.conf.v1.MetricsR\ametrics\"t\n" +
-	"\tTLSConfig\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x1b\n" +
-	"\tcert_path\x18\x02 \x01(\tR\bcertPath\x12\x19\n" +
-	"\bkey_path\x18\x03 \x01(\tR\akeyPath\x12\x17\n" +
-	"\aca_path\x18\x04 \x01(\tR\x06caPath\"\xa3\x02\n" +
-	"\x04CORS\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\x12'\n" +
-	"\x0fallowed_origins\x18\x02 \x03(\tR\x0eallowedOrigins\x12'\n" +
-	"\x0fallowed_methods\x18\x03 \x03(\tR\x0eallowedMethods\x12'\n" +
-	"\x0fallowed_headers\x18\x04 \x03(\tR\x0eallowedHeaders\x12'\n" +
-	"\x0fexposed_headers\x18\x05 \x03(\tR\x0eexposedHeaders\x12+\n" +
-	"\x11allow_credentials\x18\x06 \x01(\bR\x10allowCredentials\x122\n" +
-	"\amax_age\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\"\xcb\x01\n" +
-	"\fConsulConfig\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1e\n" +
-	"\n" +
-	"datacenter\x18\x04 \x01(\tR\n" +
-	"datacenter\x123\n" +
-	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x10\n" +
-	"\x03key\x18\a \x01(\tR\x03key\"\xc7\x01\n" +
-	"\n" +
-	"EtcdConfig\x12\x1c\n" +
-	"\tendpoints\x18\x01 \x03(\tR\tendpoints\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x123\n" +
-	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x10\n" +
-	"\x03key\x18\x05 \x01(\tR\x03key\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\xef\x01\n" +
-	"\vNacosConfig\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x12\n" +
-	"\x04port\x18\x02 \x01(\x04R\x04port\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
-	"\x05group\x18\x04 \x01(\tR\x05group\x12\x1a\n" +
-	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x06 \x01(\tR\bpassword\x123\n" +
-	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x17\n" +
-	"\adata_id\x18\b \x01(\tR\x06dataId\"*\n" +
-	"\x10KubernetesConfig\x12\x16\n" +
-	"\x06enable\x18\x01 \x01(\bR\x06enable\"\xa3\x03\n" +
-	"\x06Server\x12(\n" +
-	"\x04http\x18\x01 \x01(\v2\x14.conf.v1.Server.HTTPR\x04http\x12(\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x14.conf.v1.Server.GRPCR\x04grpc\x1a\xb2\x01\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12$\n" +
-	"\x03tls\x18\x04 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\x12!\n" +
-	"\x04cors\x18\x05 \x01(\v2\r.conf.v1.CORSR\x04cors\x1a\x8f\x01\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12$\n" +
-	"\x03tls\x18\x04 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\"\xd0\x01\n" +
-	"\x06Client\x12-\n" +
-	"\x04grpc\x18\x01 \x03(\v2\x19.conf.v1.Client.GrpcEntryR\x04grpc\x1aH\n" +
-	"\x04GRPC\x12\x1a\n" +
-	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12$\n" +
-	"\x03tls\x18\x02 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\x1aM\n" +
-	"\tGrpcEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.conf.v1.Client.GRPCR\x05value:\x028\x01\"\xed\x06\n" +
+func (x *App_Mail) Reset() {
+	*x = App_Mail{}
+	mi := &file_conf_v1_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Mail) ProtoMessage() {}
+
+func (x *App_Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Mail.ProtoReflect.Descriptor instead.
+func (*App_Mail) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *App_Mail) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *App_Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *App_Mail) GetSmtp() *App_Mail_SMTP {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *App_Mail) GetFileDir() string {
+	if x != nil {
+		return x.FileDir
+	}
+	return ""
+}
+
+func (x *App_Mail) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *App_Mail) GetTemplateDir() string {
+	if x != nil {
+		return x.TemplateDir
+	}
+	return ""
+}
+
+func (x *App_Mail) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *App_Mail) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *App_Mail) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+type App_Webhook struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts          int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                             // 最大投递次数，超过后标记为失败
+	RetryBackoff         *durationpb.Duration   `protobuf:"bytes,2,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"`                           // 重试初始退避时间，按指数增长
+	MaxBackoff           *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`                                 // 最大退避时间
+	Timeout              *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                         // 单次请求超时时间
+	PollInterval         *durationpb.Duration   `protobuf:"bytes,5,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`                           // 扫描待投递记录的间隔
+	AllowedInternalHosts []string               `protobuf:"bytes,6,rep,name=allowed_internal_hosts,json=allowedInternalHosts,proto3" json:"allowed_internal_hosts,omitempty"` // 允许投递的内网目标（主机名、IP 或 CIDR），默认拒绝内网、回环和链路本地地址
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *App_Webhook) Reset() {
+	*x = App_Webhook{}
+	mi := &file_conf_v1_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Webhook) ProtoMessage() {}
+
+func (x *App_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Webhook.ProtoReflect.Descriptor instead.
+func (*App_Webhook) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 3}
+}
+
+func (x *App_Webhook) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *App_Webhook) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *App_Webhook) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *App_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *App_Webhook) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *App_Webhook) GetAllowedInternalHosts() []string {
+	if x != nil {
+		return x.AllowedInternalHosts
+	}
+	return nil
+}
+
+type App_Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DigestInterval *durationpb.Duration   `protobuf:"bytes,1,opt,name=digest_interval,json=digestInterval,proto3" json:"digest_interval,omitempty"` // 扫描到期汇总通知的间隔
+	DefaultMode    string                 `protobuf:"bytes,2,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"`          // 用户未设置偏好时的默认方式：immediate, hourly, daily, off
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *App_Notification) Reset() {
+	*x = App_Notification{}
+	mi := &file_conf_v1_conf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Notification) ProtoMessage() {}
+
+func (x *App_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Notification.ProtoReflect.Descriptor instead.
+func (*App_Notification) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 4}
+}
+
+func (x *App_Notification) GetDigestInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DigestInterval
+	}
+	return nil
+}
+
+func (x *App_Notification) GetDefaultMode() string {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return ""
+}
+
+type App_Outbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PollInterval  *durationpb.Duration   `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"` // 扫描待转发事件的间隔
+	RetryBackoff  *durationpb.Duration   `protobuf:"bytes,2,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff,omitempty"` // 转发失败后的初始退避时间，按指数增长
+	MaxBackoff    *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`       // 最大退避时间
+	BatchSize     int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`         // 每批转发的事件数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Outbox) Reset() {
+	*x = App_Outbox{}
+	mi := &file_conf_v1_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Outbox) ProtoMessage() {}
+
+func (x *App_Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Outbox.ProtoReflect.Descriptor instead.
+func (*App_Outbox) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 5}
+}
+
+func (x *App_Outbox) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *App_Outbox) GetRetryBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.RetryBackoff
+	}
+	return nil
+}
+
+func (x *App_Outbox) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *App_Outbox) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type App_Mfa struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`                                     // 验证器应用中显示的发行方，默认使用应用名称
+	ChallengeTtl  *durationpb.Duration   `protobuf:"bytes,2,opt,name=challenge_ttl,json=challengeTtl,proto3" json:"challenge_ttl,omitempty"`     // 登录第二步（VerifyMFA）的有效期
+	MaxAttempts   int32                  `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`       // 每个登录挑战允许的验证码尝试次数，关闭两步验证时在挑战有效期内按用户同样限制
+	RecoveryCodes int32                  `protobuf:"varint,4,opt,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"` // 启用时生成的恢复码数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Mfa) Reset() {
+	*x = App_Mfa{}
+	mi := &file_conf_v1_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Mfa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Mfa) ProtoMessage() {}
+
+func (x *App_Mfa) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Mfa.ProtoReflect.Descriptor instead.
+func (*App_Mfa) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 6}
+}
+
+func (x *App_Mfa) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *App_Mfa) GetChallengeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ChallengeTtl
+	}
+	return nil
+}
+
+func (x *App_Mfa) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *App_Mfa) GetRecoveryCodes() int32 {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return 0
+}
+
+type App_Account struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RequireEmailVerification bool                   `protobuf:"varint,1,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"` // 邮箱验证前禁止登录
+	VerifyEmailTtl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=verify_email_ttl,json=verifyEmailTtl,proto3" json:"verify_email_ttl,omitempty"`                                // 邮箱验证链接有效期
+	PasswordResetTtl         *durationpb.Duration   `protobuf:"bytes,3,opt,name=password_reset_ttl,json=passwordResetTtl,proto3" json:"password_reset_ttl,omitempty"`                          // 密码重置链接有效期
+	VerifyEmailUrl           string                 `protobuf:"bytes,4,opt,name=verify_email_url,json=verifyEmailUrl,proto3" json:"verify_email_url,omitempty"`                                // 前端邮箱验证页面地址，token 以查询参数附加
+	PasswordResetUrl         string                 `protobuf:"bytes,5,opt,name=password_reset_url,json=passwordResetUrl,proto3" json:"password_reset_url,omitempty"`                          // 前端密码重置页面地址，token 以查询参数附加
+	DeletionGracePeriod      *durationpb.Duration   `protobuf:"bytes,6,opt,name=deletion_grace_period,json=deletionGracePeriod,proto3" json:"deletion_grace_period,omitempty"`                 // 删除账号后可以恢复的宽限期，之后清除个人信息，默认 30 天
+	PurgeInterval            *durationpb.Duration   `protobuf:"bytes,7,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"`                                     // 扫描宽限期已过的删除账号的间隔，默认 1 小时
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *App_Account) Reset() {
+	*x = App_Account{}
+	mi := &file_conf_v1_conf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Account) ProtoMessage() {}
+
+func (x *App_Account) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Account.ProtoReflect.Descriptor instead.
+func (*App_Account) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 7}
+}
+
+func (x *App_Account) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
+
+func (x *App_Account) GetVerifyEmailTtl() *durationpb.Duration {
+	if x != nil {
+		return x.VerifyEmailTtl
+	}
+	return nil
+}
+
+func (x *App_Account) GetPasswordResetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PasswordResetTtl
+	}
+	return nil
+}
+
+func (x *App_Account) GetVerifyEmailUrl() string {
+	if x != nil {
+		return x.VerifyEmailUrl
+	}
+	return ""
+}
+
+func (x *App_Account) GetPasswordResetUrl() string {
+	if x != nil {
+		return x.PasswordResetUrl
+	}
+	return ""
+}
+
+func (x *App_Account) GetDeletionGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DeletionGracePeriod
+	}
+	return nil
+}
+
+func (x *App_Account) GetPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return nil
+}
+
+type App_Oidc struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*App_Oidc_Provider   `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	StateTtl      *durationpb.Duration   `protobuf:"bytes,2,opt,name=state_ttl,json=stateTtl,proto3" json:"state_ttl,omitempty"`             // 授权请求的有效期
+	AutoSignup    bool                   `protobuf:"varint,3,opt,name=auto_signup,json=autoSignup,proto3" json:"auto_signup,omitempty"`      // 外部身份未关联本地用户时自动创建用户
+	LinkByEmail   bool                   `protobuf:"varint,4,opt,name=link_by_email,json=linkByEmail,proto3" json:"link_by_email,omitempty"` // 身份提供方确认邮箱已验证时，自动关联邮箱相同的本地用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Oidc) Reset() {
+	*x = App_Oidc{}
+	mi := &file_conf_v1_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Oidc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Oidc) ProtoMessage() {}
+
+func (x *App_Oidc) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Oidc.ProtoReflect.Descriptor instead.
+func (*App_Oidc) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 8}
+}
+
+func (x *App_Oidc) GetProviders() []*App_Oidc_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *App_Oidc) GetStateTtl() *durationpb.Duration {
+	if x != nil {
+		return x.StateTtl
+	}
+	return nil
+}
+
+func (x *App_Oidc) GetAutoSignup() bool {
+	if x != nil {
+		return x.AutoSignup
+	}
+	return false
+}
+
+func (x *App_Oidc) GetLinkByEmail() bool {
+	if x != nil {
+		return x.LinkByEmail
+	}
+	return false
+}
+
+type App_Lockout struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Window             *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`                                                      // 统计登录失败次数的滑动窗口
+	MaxAccountFailures int32                  `protobuf:"varint,2,opt,name=max_account_failures,json=maxAccountFailures,proto3" json:"max_account_failures,omitempty"` // 窗口内同一账号失败达到该次数后锁定账号
+	MaxIpFailures      int32                  `protobuf:"varint,3,opt,name=max_ip_failures,json=maxIpFailures,proto3" json:"max_ip_failures,omitempty"`                // 窗口内同一 IP 失败达到该次数后锁定该 IP
+	LockoutDuration    *durationpb.Duration   `protobuf:"bytes,4,opt,name=lockout_duration,json=lockoutDuration,proto3" json:"lockout_duration,omitempty"`             // 锁定时长
+	DelayAfter         int32                  `protobuf:"varint,5,opt,name=delay_after,json=delayAfter,proto3" json:"delay_after,omitempty"`                           // 同一账号失败达到该次数后开始要求等待
+	DelayBase          *durationpb.Duration   `protobuf:"bytes,6,opt,name=delay_base,json=delayBase,proto3" json:"delay_base,omitempty"`                               // 第一次等待的时长，之后每次失败翻倍
+	MaxDelay           *durationpb.Duration   `protobuf:"bytes,7,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`                                  // 单次等待的最长时长
+	Disabled           bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`                                                 // 关闭登录失败限制
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *App_Lockout) Reset() {
+	*x = App_Lockout{}
+	mi := &file_conf_v1_conf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Lockout) ProtoMessage() {}
+
+func (x *App_Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Lockout.ProtoReflect.Descriptor instead.
+func (*App_Lockout) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 9}
+}
+
+func (x *App_Lockout) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *App_Lockout) GetMaxAccountFailures() int32 {
+	if x != nil {
+		return x.MaxAccountFailures
+	}
+	return 0
+}
+
+func (x *App_Lockout) GetMaxIpFailures() int32 {
+	if x != nil {
+		return x.MaxIpFailures
+	}
+	return 0
+}
+
+func (x *App_Lockout) GetLockoutDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockoutDuration
+	}
+	return nil
+}
+
+func (x *App_Lockout) GetDelayAfter() int32 {
+	if x != nil {
+		return x.DelayAfter
+	}
+	return 0
+}
+
+func (x *App_Lockout) GetDelayBase() *durationpb.Duration {
+	if x != nil {
+		return x.DelayBase
+	}
+	return nil
+}
+
+func (x *App_Lockout) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+func (x *App_Lockout) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type App_PasswordPolicy struct {
+	state                 protoimpl.MessageState       `protogen:"open.v1"`
+	MinLength             int32                        `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`                                      // 最小长度（按字符计），默认 8
+	MaxLength             int32                        `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`                                      // 最大长度（按字符计），默认 128
+	MinCharacterClasses   int32                        `protobuf:"varint,3,opt,name=min_character_classes,json=minCharacterClasses,proto3" json:"min_character_classes,omitempty"`      // 至少包含的字符类别数（大写、小写、数字、符号），0 表示不限制
+	BreachedPasswordsFile string                       `protobuf:"bytes,4,opt,name=breached_passwords_file,json=breachedPasswordsFile,proto3" json:"breached_passwords_file,omitempty"` // 已泄露密码列表文件，每行一个明文密码或 SHA-1 十六进制摘要
+	Algorithm             string                       `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                                                        // 新密码使用的哈希算法：argon2id（默认）或 bcrypt，其他算法的旧哈希在登录成功后自动升级
+	Argon2Id              *App_PasswordPolicy_Argon2Id `protobuf:"bytes,6,opt,name=argon2id,proto3" json:"argon2id,omitempty"`
+	BcryptCost            int32                        `protobuf:"varint,7,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"` // 默认 12
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *App_PasswordPolicy) Reset() {
+	*x = App_PasswordPolicy{}
+	mi := &file_conf_v1_conf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_PasswordPolicy) ProtoMessage() {}
+
+func (x *App_PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*App_PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 10}
+}
+
+func (x *App_PasswordPolicy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *App_PasswordPolicy) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *App_PasswordPolicy) GetMinCharacterClasses() int32 {
+	if x != nil {
+		return x.MinCharacterClasses
+	}
+	return 0
+}
+
+func (x *App_PasswordPolicy) GetBreachedPasswordsFile() string {
+	if x != nil {
+		return x.BreachedPasswordsFile
+	}
+	return ""
+}
+
+func (x *App_PasswordPolicy) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *App_PasswordPolicy) GetArgon2Id() *App_PasswordPolicy_Argon2Id {
+	if x != nil {
+		return x.Argon2Id
+	}
+	return nil
+}
+
+func (x *App_PasswordPolicy) GetBcryptCost() int32 {
+	if x != nil {
+		return x.BcryptCost
+	}
+	return 0
+}
+
+type App_PersonalAccessToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxPerUser    int32                  `protobuf:"varint,1,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"` // 每个用户最多拥有的令牌数，默认 50
+	MaxLifetime   *durationpb.Duration   `protobuf:"bytes,2,opt,name=max_lifetime,json=maxLifetime,proto3" json:"max_lifetime,omitempty"` // 令牌最长有效期，为空表示允许不过期的令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_PersonalAccessToken) Reset() {
+	*x = App_PersonalAccessToken{}
+	mi := &file_conf_v1_conf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_PersonalAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_PersonalAccessToken) ProtoMessage() {}
+
+func (x *App_PersonalAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_PersonalAccessToken.ProtoReflect.Descriptor instead.
+func (*App_PersonalAccessToken) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 11}
+}
+
+func (x *App_PersonalAccessToken) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *App_PersonalAccessToken) GetMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLifetime
+	}
+	return nil
+}
+
+type App_Rbac struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Roles          []*App_Rbac_Role       `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`                                         // 新增角色，或替换同名内置角色的权限；数据库 roles 表中的同名角色优先
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 从数据库重新加载角色的间隔，默认 1 分钟
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *App_Rbac) Reset() {
+	*x = App_Rbac{}
+	mi := &file_conf_v1_conf_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Rbac) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Rbac) ProtoMessage() {}
+
+func (x *App_Rbac) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Rbac.ProtoReflect.Descriptor instead.
+func (*App_Rbac) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 12}
+}
+
+func (x *App_Rbac) GetRoles() []*App_Rbac_Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *App_Rbac) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+type App_Workspace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationTtl *durationpb.Duration   `protobuf:"bytes,1,opt,name=invitation_ttl,json=invitationTtl,proto3" json:"invitation_ttl,omitempty"` // 邀请链接有效期，默认 7 天
+	InvitationUrl string                 `protobuf:"bytes,2,opt,name=invitation_url,json=invitationUrl,proto3" json:"invitation_url,omitempty"` // 前端接受邀请页面地址，token 以查询参数附加
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Workspace) Reset() {
+	*x = App_Workspace{}
+	mi := &file_conf_v1_conf_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Workspace) ProtoMessage() {}
+
+func (x *App_Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Workspace.ProtoReflect.Descriptor instead.
+func (*App_Workspace) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 13}
+}
+
+func (x *App_Workspace) GetInvitationTtl() *durationpb.Duration {
+	if x != nil {
+		return x.InvitationTtl
+	}
+	return nil
+}
+
+func (x *App_Workspace) GetInvitationUrl() string {
+	if x != nil {
+		return x.InvitationUrl
+	}
+	return ""
+}
+
+type App_Signup struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Mode           string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                           // open（默认，任何人可注册）、invite（必须使用邀请码）、domain（邮箱域名在白名单中，或使用邀请码）
+	AllowedDomains []string               `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"` // domain 模式允许的邮箱域名，例如 example.com，不区分大小写；按域名注册的账号验证邮箱后才能登录
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *App_Signup) Reset() {
+	*x = App_Signup{}
+	mi := &file_conf_v1_conf_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Signup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Signup) ProtoMessage() {}
+
+func (x *App_Signup) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Signup.ProtoReflect.Descriptor instead.
+func (*App_Signup) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 14}
+}
+
+func (x *App_Signup) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *App_Signup) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+type App_Impersonation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenTtl      *durationpb.Duration   `protobuf:"bytes,1,opt,name=token_ttl,json=tokenTtl,proto3" json:"token_ttl,omitempty"` // 代登录 Access Token 的有效期，默认 15 分钟，最长 1 小时
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Impersonation) Reset() {
+	*x = App_Impersonation{}
+	mi := &file_conf_v1_conf_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Impersonation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Impersonation) ProtoMessage() {}
+
+func (x *App_Impersonation) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Impersonation.ProtoReflect.Descriptor instead.
+func (*App_Impersonation) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 15}
+}
+
+func (x *App_Impersonation) GetTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.TokenTtl
+	}
+	return nil
+}
+
+type App_Ldap struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Url                string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                                            // ldap://host:389 或 ldaps://host:636，为空时不启用 LDAP 登录
+	StartTls           bool                   `protobuf:"varint,2,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`                                 // 使用 ldap:// 时通过 StartTLS 加密连接
+	InsecureSkipVerify bool                   `protobuf:"varint,3,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"` // 不校验服务器证书，仅用于测试环境
+	BindDn             string                 `protobuf:"bytes,4,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`                                        // 查找用户的服务账号，为空时匿名查找
+	BindPassword       string                 `protobuf:"bytes,5,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	BaseDn             string                 `protobuf:"bytes,6,opt,name=base_dn,json=baseDn,proto3" json:"base_dn,omitempty"`                          // 查找用户的起点，例如 ou=people,dc=example,dc=com
+	UserFilter         string                 `protobuf:"bytes,7,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`              // 查找用户的过滤条件，{email} 替换为登录邮箱，默认 (mail={email})
+	IdAttribute        string                 `protobuf:"bytes,8,opt,name=id_attribute,json=idAttribute,proto3" json:"id_attribute,omitempty"`           // 用户唯一标识的属性，例如 entryUUID 或 objectGUID，默认使用 DN
+	NameAttribute      string                 `protobuf:"bytes,9,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`     // 用户名属性，默认 uid
+	EmailAttribute     string                 `protobuf:"bytes,10,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"` // 邮箱属性，默认 mail
+	GroupAttribute     string                 `protobuf:"bytes,11,opt,name=group_attribute,json=groupAttribute,proto3" json:"group_attribute,omitempty"` // 用户所属组的属性，默认 memberOf
+	GroupRoles         []*App_Ldap_GroupRole  `protobuf:"bytes,12,rep,name=group_roles,json=groupRoles,proto3" json:"group_roles,omitempty"`             // 组与角色的映射，按顺序取第一个匹配的；配置后每次登录同步角色，不属于任何组时为 user
+	LinkByEmail        bool                   `protobuf:"varint,13,opt,name=link_by_email,json=linkByEmail,proto3" json:"link_by_email,omitempty"`       // 目录用户首次登录时关联邮箱相同的本地用户，否则拒绝登录
+	Timeout            *durationpb.Duration   `protobuf:"bytes,14,opt,name=timeout,proto3" json:"timeout,omitempty"`                                     // 连接和请求超时，默认 10 秒
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *App_Ldap) Reset() {
+	*x = App_Ldap{}
+	mi := &file_conf_v1_conf_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Ldap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Ldap) ProtoMessage() {}
+
+func (x *App_Ldap) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Ldap.ProtoReflect.Descriptor instead.
+func (*App_Ldap) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 16}
+}
+
+func (x *App_Ldap) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+func (x *App_Ldap) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *App_Ldap) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetBaseDn() string {
+	if x != nil {
+		return x.BaseDn
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetIdAttribute() string {
+	if x != nil {
+		return x.IdAttribute
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetGroupAttribute() string {
+	if x != nil {
+		return x.GroupAttribute
+	}
+	return ""
+}
+
+func (x *App_Ldap) GetGroupRoles() []*App_Ldap_GroupRole {
+	if x != nil {
+		return x.GroupRoles
+	}
+	return nil
+}
+
+func (x *App_Ldap) GetLinkByEmail() bool {
+	if x != nil {
+		return x.LinkByEmail
+	}
+	return false
+}
+
+func (x *App_Ldap) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// 非对称签名密钥（PEM），RSA 密钥使用 RS256，Ed25519 密钥使用 EdDSA
+type App_Jwt_SigningKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kid           string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`                        // 写入 JWT 头部的 kid，为空时使用 RFC 7638 指纹
+	KeyFile       string                 `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"` // PEM 文件路径，私钥或公钥
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`                        // PEM 内容，优先于 key_file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Jwt_SigningKey) Reset() {
+	*x = App_Jwt_SigningKey{}
+	mi := &file_conf_v1_conf_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Jwt_SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Jwt_SigningKey) ProtoMessage() {}
+
+func (x *App_Jwt_SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Jwt_SigningKey.ProtoReflect.Descriptor instead.
+func (*App_Jwt_SigningKey) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
+func (x *App_Jwt_SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *App_Jwt_SigningKey) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *App_Jwt_SigningKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type App_Mail_SMTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`                                   // SMTP 服务器地址
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`                                  // SMTP 端口
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                           // 用户名，为空则不认证
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                           // 密码
+	ImplicitTls   bool                   `protobuf:"varint,5,opt,name=implicit_tls,json=implicitTls,proto3" json:"implicit_tls,omitempty"` // 是否使用隐式 TLS（465 端口），否则在服务器支持时使用 STARTTLS
+	Timeout       *durationpb.Duration   `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`                             // 单次发送超时时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Mail_SMTP) Reset() {
+	*x = App_Mail_SMTP{}
+	mi := &file_conf_v1_conf_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Mail_SMTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Mail_SMTP) ProtoMessage() {}
+
+func (x *App_Mail_SMTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Mail_SMTP.ProtoReflect.Descriptor instead.
+func (*App_Mail_SMTP) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 2, 0}
+}
+
+func (x *App_Mail_SMTP) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *App_Mail_SMTP) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *App_Mail_SMTP) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *App_Mail_SMTP) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *App_Mail_SMTP) GetImplicitTls() bool {
+	if x != nil {
+		return x.ImplicitTls
+	}
+	return false
+}
+
+func (x *App_Mail_SMTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type App_Oidc_Provider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // 接口路径中的标识，例如 google
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 登录页展示的名称
+	Issuer        string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`                              // 发现文档位于 {issuer}/.well-known/openid-configuration
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl   string                 `protobuf:"bytes,6,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"` // 在身份提供方登记的回调地址（前端页面，收到 code 和 state 后调用 OIDCCallback）
+	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`                              // 默认 openid email profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Oidc_Provider) Reset() {
+	*x = App_Oidc_Provider{}
+	mi := &file_conf_v1_conf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Oidc_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Oidc_Provider) ProtoMessage() {}
+
+func (x *App_Oidc_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Oidc_Provider.ProtoReflect.Descriptor instead.
+func (*App_Oidc_Provider) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 8, 0}
+}
+
+func (x *App_Oidc_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *App_Oidc_Provider) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type App_PasswordPolicy_Argon2Id struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        uint32                 `protobuf:"varint,1,opt,name=memory,proto3" json:"memory,omitempty"`           // 内存，单位 KiB，默认 19456
+	Iterations    uint32                 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`   // 迭代次数，默认 2
+	Parallelism   uint32                 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"` // 并行度，默认 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_PasswordPolicy_Argon2Id) Reset() {
+	*x = App_PasswordPolicy_Argon2Id{}
+	mi := &file_conf_v1_conf_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_PasswordPolicy_Argon2Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_PasswordPolicy_Argon2Id) ProtoMessage() {}
+
+func (x *App_PasswordPolicy_Argon2Id) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_PasswordPolicy_Argon2Id.ProtoReflect.Descriptor instead.
+func (*App_PasswordPolicy_Argon2Id) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 10, 0}
+}
+
+func (x *App_PasswordPolicy_Argon2Id) GetMemory() uint32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *App_PasswordPolicy_Argon2Id) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *App_PasswordPolicy_Argon2Id) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+type App_Rbac_Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"` // 权限名，例如 user:update:any；支持 * 和 user:* 形式的通配
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Rbac_Role) Reset() {
+	*x = App_Rbac_Role{}
+	mi := &file_conf_v1_conf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Rbac_Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Rbac_Role) ProtoMessage() {}
+
+func (x *App_Rbac_Role) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Rbac_Role.ProtoReflect.Descriptor instead.
+func (*App_Rbac_Role) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 12, 0}
+}
+
+func (x *App_Rbac_Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *App_Rbac_Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type App_Ldap_GroupRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"` // 组的 DN，不区分大小写
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`   // 组成员的角色
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Ldap_GroupRole) Reset() {
+	*x = App_Ldap_GroupRole{}
+	mi := &file_conf_v1_conf_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Ldap_GroupRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Ldap_GroupRole) ProtoMessage() {}
+
+func (x *App_Ldap_GroupRole) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_v1_conf_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Ldap_GroupRole.ProtoReflect.Descriptor instead.
+func (*App_Ldap_GroupRole) Descriptor() ([]byte, []int) {
+	return file_conf_v1_conf_proto_rawDescGZIP(), []int{10, 16, 0}
+}
+
+func (x *App_Ldap_GroupRole) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *App_Ldap_GroupRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+var File_conf_v1_conf_proto protoreflect.FileDescriptor
+
+const file_conf_v1_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x12conf/v1/conf.proto\x12\aconf.v1\x1a\x1egoogle/protobuf/duration.proto\"\xfc\x02\n" +
+	"\tBootstrap\x12\x1e\n" +
+	"\x03app\x18\x01 \x01(\v2\f.conf.v1.AppR\x03app\x12'\n" +
+	"\x06server\x18\x02 \x01(\v2\x0f.conf.v1.ServerR\x06server\x12'\n" +
+	"\x06client\x18\x03 \x01(\v2\x0f.conf.v1.ClientR\x06client\x12!\n" +
+	"\x04data\x18\x04 \x01(\v2\r.conf.v1.DataR\x04data\x12-\n" +
+	"\bregistry\x18\x05 \x01(\v2\x11.conf.v1.RegistryR\bregistry\x120\n" +
+	"\tdiscovery\x18\x06 \x01(\v2\x12.conf.v1.DiscoveryR\tdiscovery\x12'\n" +
+	"\x06config\x18\a \x01(\v2\x0f.conf.v1.ConfigR\x06config\x12$\n" +
+	"\x05trace\x18\b \x01(\v2\x0e.conf.v1.TraceR\x05trace\x12*\n" +
+	"\ametrics\x18\t \x01(\v2\x10.conf.v1.MetricsR\ametrics\"t\n" +
+	"\tTLSConfig\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12\x1b\n" +
+	"\tcert_path\x18\x02 \x01(\tR\bcertPath\x12\x19\n" +
+	"\bkey_path\x18\x03 \x01(\tR\akeyPath\x12\x17\n" +
+	"\aca_path\x18\x04 \x01(\tR\x06caPath\"\xa3\x02\n" +
+	"\x04CORS\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\x12'\n" +
+	"\x0fallowed_origins\x18\x02 \x03(\tR\x0eallowedOrigins\x12'\n" +
+	"\x0fallowed_methods\x18\x03 \x03(\tR\x0eallowedMethods\x12'\n" +
+	"\x0fallowed_headers\x18\x04 \x03(\tR\x0eallowedHeaders\x12'\n" +
+	"\x0fexposed_headers\x18\x05 \x03(\tR\x0eexposedHeaders\x12+\n" +
+	"\x11allow_credentials\x18\x06 \x01(\bR\x10allowCredentials\x122\n" +
+	"\amax_age\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\"\xcb\x01\n" +
+	"\fConsulConfig\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1e\n" +
+	"\n" +
+	"datacenter\x18\x04 \x01(\tR\n" +
+	"datacenter\x123\n" +
+	"\atimeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x10\n" +
+	"\x03key\x18\a \x01(\tR\x03key\"\xc7\x01\n" +
+	"\n" +
+	"EtcdConfig\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12\x1c\n" +
+	"\tnamespace\x18\x06 \x01(\tR\tnamespace\"\xef\x01\n" +
+	"\vNacosConfig\x12\x12\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x04R\x04port\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05group\x18\x04 \x01(\tR\x05group\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x123\n" +
+	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x17\n" +
+	"\adata_id\x18\b \x01(\tR\x06dataId\"*\n" +
+	"\x10KubernetesConfig\x12\x16\n" +
+	"\x06enable\x18\x01 \x01(\bR\x06enable\"\xdb\x03\n" +
+	"\x06Server\x12(\n" +
+	"\x04http\x18\x01 \x01(\v2\x14.conf.v1.Server.HTTPR\x04http\x12(\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x14.conf.v1.Server.GRPCR\x04grpc\x1a\xea\x01\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12$\n" +
+	"\x03tls\x18\x04 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\x12!\n" +
+	"\x04cors\x18\x05 \x01(\v2\r.conf.v1.CORSR\x04cors\x126\n" +
+	"\x17trust_forwarded_headers\x18\x06 \x01(\bR\x15trustForwardedHeaders\x1a\x8f\x01\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12$\n" +
+	"\x03tls\x18\x04 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\"\xd0\x01\n" +
+	"\x06Client\x12-\n" +
+	"\x04grpc\x18\x01 \x03(\v2\x19.conf.v1.Client.GrpcEntryR\x04grpc\x1aH\n" +
+	"\x04GRPC\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12$\n" +
+	"\x03tls\x18\x02 \x01(\v2\x12.conf.v1.TLSConfigR\x03tls\x1aM\n" +
+	"\tGrpcEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.conf.v1.Client.GRPCR\x05value:\x028\x01\"\xb3\t\n" +
 	"\x04Data\x122\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x16.conf.v1.Data.DatabaseR\bdatabase\x12)\n" +
 	"\x05redis\x18\x02 \x01(\v2\x13.conf.v1.Data.RedisR\x05redis\x12,\n" +
-	"\x06client\x18\x03 \x01(\v2\x14.conf.v1.Data.ClientR\x06client\x1a:\n" +
+	"\x06client\x18\x03 \x01(\v2\x14.conf.v1.Data.ClientR\x06client\x123\n" +
+	"\tevent_bus\x18\x04 \x01(\v2\x16.conf.v1.Data.EventBusR\beventBus\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xba\x02\n" +
@@ -2082,21 +3855,52 @@ const file_conf_v1_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x81\x05\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x8e\x02\n" +
+	"\bEventBus\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12$\n" +
+	"\x0estream_max_len\x18\x02 \x01(\x03R\fstreamMaxLen\x12%\n" +
+	"\x0emax_deliveries\x18\x03 \x01(\x05R\rmaxDeliveries\x12>\n" +
+	"\rretry_backoff\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x12>\n" +
+	"\rblock_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fblockTimeout\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x06 \x01(\x05R\tbatchSize\"\xa9-\n" +
 	"\x03App\x12\x10\n" +
 	"\x03env\x18\x01 \x01(\tR\x03env\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\"\n" +
 	"\x03jwt\x18\x04 \x01(\v2\x10.conf.v1.App.JwtR\x03jwt\x12\"\n" +
 	"\x03log\x18\x05 \x01(\v2\x10.conf.v1.App.LogR\x03log\x126\n" +
-	"\bmetadata\x18\x06 \x03(\v2\x1a.conf.v1.App.MetadataEntryR\bmetadata\x1a\xd1\x01\n" +
+	"\bmetadata\x18\x06 \x03(\v2\x1a.conf.v1.App.MetadataEntryR\bmetadata\x12%\n" +
+	"\x04mail\x18\a \x01(\v2\x11.conf.v1.App.MailR\x04mail\x12.\n" +
+	"\awebhook\x18\b \x01(\v2\x14.conf.v1.App.WebhookR\awebhook\x12=\n" +
+	"\fnotification\x18\t \x01(\v2\x19.conf.v1.App.NotificationR\fnotification\x12+\n" +
+	"\x06outbox\x18\n" +
+	" \x01(\v2\x13.conf.v1.App.OutboxR\x06outbox\x12\"\n" +
+	"\x03mfa\x18\v \x01(\v2\x10.conf.v1.App.MfaR\x03mfa\x12.\n" +
+	"\aaccount\x18\f \x01(\v2\x14.conf.v1.App.AccountR\aaccount\x12%\n" +
+	"\x04oidc\x18\r \x01(\v2\x11.conf.v1.App.OidcR\x04oidc\x12.\n" +
+	"\alockout\x18\x0e \x01(\v2\x14.conf.v1.App.LockoutR\alockout\x12D\n" +
+	"\x0fpassword_policy\x18\x0f \x01(\v2\x1b.conf.v1.App.PasswordPolicyR\x0epasswordPolicy\x12T\n" +
+	"\x15personal_access_token\x18\x10 \x01(\v2 .conf.v1.App.PersonalAccessTokenR\x13personalAccessToken\x12%\n" +
+	"\x04rbac\x18\x11 \x01(\v2\x11.conf.v1.App.RbacR\x04rbac\x124\n" +
+	"\tworkspace\x18\x12 \x01(\v2\x16.conf.v1.App.WorkspaceR\tworkspace\x12+\n" +
+	"\x06signup\x18\x13 \x01(\v2\x13.conf.v1.App.SignupR\x06signup\x12@\n" +
+	"\rimpersonation\x18\x14 \x01(\v2\x1a.conf.v1.App.ImpersonationR\rimpersonation\x12%\n" +
+	"\x04ldap\x18\x15 \x01(\v2\x11.conf.v1.App.LdapR\x04ldap\x1a\x8d\x03\n" +
 	"\x03Jwt\x12#\n" +
 	"\raccess_secret\x18\x01 \x01(\tR\faccessSecret\x12%\n" +
 	"\x0erefresh_secret\x18\x02 \x01(\tR\rrefreshSecret\x12#\n" +
 	"\raccess_expire\x18\x03 \x01(\x05R\faccessExpire\x12%\n" +
 	"\x0erefresh_expire\x18\x04 \x01(\x05R\rrefreshExpire\x12\x16\n" +
 	"\x06issuer\x18\x05 \x01(\tR\x06issuer\x12\x1a\n" +
-	"\baudience\x18\x06 \x01(\tR\baudience\x1a\xa8\x01\n" +
+	"\baudience\x18\x06 \x01(\tR\baudience\x12-\n" +
+	"\x13revoke_all_on_reuse\x18\a \x01(\bR\x10revokeAllOnReuse\x12>\n" +
+	"\fsigning_keys\x18\b \x03(\v2\x1b.conf.v1.App.Jwt.SigningKeyR\vsigningKeys\x1aK\n" +
+	"\n" +
+	"SigningKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x19\n" +
+	"\bkey_file\x18\x02 \x01(\tR\akeyFile\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x1a\xa8\x01\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x19\n" +
@@ -2104,7 +3908,136 @@ const file_conf_v1_conf_proto_rawDesc = "" +
 	"\vmax_backups\x18\x04 \x01(\x05R\n" +
 	"maxBackups\x12\x17\n" +
 	"\amax_age\x18\x05 \x01(\x05R\x06maxAge\x12\x1a\n" +
-	"\bcompress\x18\x06 \x01(\bR\bcompress\x1a;\n" +
+	"\bcompress\x18\x06 \x01(\bR\bcompress\x1a\x81\x04\n" +
+	"\x04Mail\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12*\n" +
+	"\x04smtp\x18\x03 \x01(\v2\x16.conf.v1.App.Mail.SMTPR\x04smtp\x12\x19\n" +
+	"\bfile_dir\x18\x04 \x01(\tR\afileDir\x12%\n" +
+	"\x0edefault_locale\x18\x05 \x01(\tR\rdefaultLocale\x12!\n" +
+	"\ftemplate_dir\x18\x06 \x01(\tR\vtemplateDir\x12\x18\n" +
+	"\aworkers\x18\a \x01(\x05R\aworkers\x12!\n" +
+	"\fmax_attempts\x18\b \x01(\x05R\vmaxAttempts\x12>\n" +
+	"\rretry_backoff\x18\t \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x1a\xbe\x01\n" +
+	"\x04SMTP\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12!\n" +
+	"\fimplicit_tls\x18\x05 \x01(\bR\vimplicitTls\x123\n" +
+	"\atimeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xd3\x02\n" +
+	"\aWebhook\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12>\n" +
+	"\rretry_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
+	"\rpoll_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x124\n" +
+	"\x16allowed_internal_hosts\x18\x06 \x03(\tR\x14allowedInternalHosts\x1au\n" +
+	"\fNotification\x12B\n" +
+	"\x0fdigest_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0edigestInterval\x12!\n" +
+	"\fdefault_mode\x18\x02 \x01(\tR\vdefaultMode\x1a\xe3\x01\n" +
+	"\x06Outbox\x12>\n" +
+	"\rpoll_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12>\n" +
+	"\rretry_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fretryBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x1a\xa7\x01\n" +
+	"\x03Mfa\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12>\n" +
+	"\rchallenge_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fchallengeTtl\x12!\n" +
+	"\fmax_attempts\x18\x03 \x01(\x05R\vmaxAttempts\x12%\n" +
+	"\x0erecovery_codes\x18\x04 \x01(\x05R\rrecoveryCodes\x1a\xbe\x03\n" +
+	"\aAccount\x12<\n" +
+	"\x1arequire_email_verification\x18\x01 \x01(\bR\x18requireEmailVerification\x12C\n" +
+	"\x10verify_email_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0everifyEmailTtl\x12G\n" +
+	"\x12password_reset_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10passwordResetTtl\x12(\n" +
+	"\x10verify_email_url\x18\x04 \x01(\tR\x0everifyEmailUrl\x12,\n" +
+	"\x12password_reset_url\x18\x05 \x01(\tR\x10passwordResetUrl\x12M\n" +
+	"\x15deletion_grace_period\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x13deletionGracePeriod\x12@\n" +
+	"\x0epurge_interval\x18\a \x01(\v2\x19.google.protobuf.DurationR\rpurgeInterval\x1a\x96\x03\n" +
+	"\x04Oidc\x128\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1a.conf.v1.App.Oidc.ProviderR\tproviders\x126\n" +
+	"\tstate_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bstateTtl\x12\x1f\n" +
+	"\vauto_signup\x18\x03 \x01(\bR\n" +
+	"autoSignup\x12\"\n" +
+	"\rlink_by_email\x18\x04 \x01(\bR\vlinkByEmail\x1a\xd6\x01\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x05 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x06 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\x1a\x8b\x03\n" +
+	"\aLockout\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x120\n" +
+	"\x14max_account_failures\x18\x02 \x01(\x05R\x12maxAccountFailures\x12&\n" +
+	"\x0fmax_ip_failures\x18\x03 \x01(\x05R\rmaxIpFailures\x12D\n" +
+	"\x10lockout_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0flockoutDuration\x12\x1f\n" +
+	"\vdelay_after\x18\x05 \x01(\x05R\n" +
+	"delayAfter\x128\n" +
+	"\n" +
+	"delay_base\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\tdelayBase\x126\n" +
+	"\tmax_delay\x18\a \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\x1a\xa1\x03\n" +
+	"\x0ePasswordPolicy\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x02 \x01(\x05R\tmaxLength\x122\n" +
+	"\x15min_character_classes\x18\x03 \x01(\x05R\x13minCharacterClasses\x126\n" +
+	"\x17breached_passwords_file\x18\x04 \x01(\tR\x15breachedPasswordsFile\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\tR\talgorithm\x12@\n" +
+	"\bargon2id\x18\x06 \x01(\v2$.conf.v1.App.PasswordPolicy.Argon2idR\bargon2id\x12\x1f\n" +
+	"\vbcrypt_cost\x18\a \x01(\x05R\n" +
+	"bcryptCost\x1ad\n" +
+	"\bArgon2id\x12\x16\n" +
+	"\x06memory\x18\x01 \x01(\rR\x06memory\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x02 \x01(\rR\n" +
+	"iterations\x12 \n" +
+	"\vparallelism\x18\x03 \x01(\rR\vparallelism\x1au\n" +
+	"\x13PersonalAccessToken\x12 \n" +
+	"\fmax_per_user\x18\x01 \x01(\x05R\n" +
+	"maxPerUser\x12<\n" +
+	"\fmax_lifetime\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vmaxLifetime\x1a\xb6\x01\n" +
+	"\x04Rbac\x12,\n" +
+	"\x05roles\x18\x01 \x03(\v2\x16.conf.v1.App.Rbac.RoleR\x05roles\x12B\n" +
+	"\x0freload_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\x1a<\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\x1at\n" +
+	"\tWorkspace\x12@\n" +
+	"\x0einvitation_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rinvitationTtl\x12%\n" +
+	"\x0einvitation_url\x18\x02 \x01(\tR\rinvitationUrl\x1aE\n" +
+	"\x06Signup\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12'\n" +
+	"\x0fallowed_domains\x18\x02 \x03(\tR\x0eallowedDomains\x1aG\n" +
+	"\rImpersonation\x126\n" +
+	"\ttoken_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\btokenTtl\x1a\xc9\x04\n" +
+	"\x04Ldap\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tstart_tls\x18\x02 \x01(\bR\bstartTls\x120\n" +
+	"\x14insecure_skip_verify\x18\x03 \x01(\bR\x12insecureSkipVerify\x12\x17\n" +
+	"\abind_dn\x18\x04 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x05 \x01(\tR\fbindPassword\x12\x17\n" +
+	"\abase_dn\x18\x06 \x01(\tR\x06baseDn\x12\x1f\n" +
+	"\vuser_filter\x18\a \x01(\tR\n" +
+	"userFilter\x12!\n" +
+	"\fid_attribute\x18\b \x01(\tR\vidAttribute\x12%\n" +
+	"\x0ename_attribute\x18\t \x01(\tR\rnameAttribute\x12'\n" +
+	"\x0femail_attribute\x18\n" +
+	" \x01(\tR\x0eemailAttribute\x12'\n" +
+	"\x0fgroup_attribute\x18\v \x01(\tR\x0egroupAttribute\x12<\n" +
+	"\vgroup_roles\x18\f \x03(\v2\x1b.conf.v1.App.Ldap.GroupRoleR\n" +
+	"groupRoles\x12\"\n" +
+	"\rlink_by_email\x18\r \x01(\bR\vlinkByEmail\x123\n" +
+	"\atimeout\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a5\n" +
+	"\tGroupRole\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdd\x01\n" +
@@ -2150,37 +4083,59 @@ func file_conf_v1_conf_proto_rawDescGZIP() []byte {
 	return file_conf_v1_conf_proto_rawDescData
 }
 
-var file_conf_v1_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_conf_v1_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_conf_v1_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: conf.v1.Bootstrap
-	(*TLSConfig)(nil),           // 1: conf.v1.TLSConfig
-	(*CORS)(nil),                // 2: conf.v1.CORS
-	(*ConsulConfig)(nil),        // 3: conf.v1.ConsulConfig
-	(*EtcdConfig)(nil),          // 4: conf.v1.EtcdConfig
-	(*NacosConfig)(nil),         // 5: conf.v1.NacosConfig
-	(*KubernetesConfig)(nil),    // 6: conf.v1.KubernetesConfig
-	(*Server)(nil),              // 7: conf.v1.Server
-	(*Client)(nil),              // 8: conf.v1.Client
-	(*Data)(nil),                // 9: conf.v1.Data
-	(*App)(nil),                 // 10: conf.v1.App
-	(*Registry)(nil),            // 11: conf.v1.Registry
-	(*Discovery)(nil),           // 12: conf.v1.Discovery
-	(*Config)(nil),              // 13: conf.v1.Config
-	(*Trace)(nil),               // 14: conf.v1.Trace
-	(*Metrics)(nil),             // 15: conf.v1.Metrics
-	(*Server_HTTP)(nil),         // 16: conf.v1.Server.HTTP
-	(*Server_GRPC)(nil),         // 17: conf.v1.Server.GRPC
-	(*Client_GRPC)(nil),         // 18: conf.v1.Client.GRPC
-	nil,                         // 19: conf.v1.Client.GrpcEntry
-	(*Data_Database)(nil),       // 20: conf.v1.Data.Database
-	(*Data_Redis)(nil),          // 21: conf.v1.Data.Redis
-	(*Data_Client)(nil),         // 22: conf.v1.Data.Client
-	(*Data_Client_HTTP)(nil),    // 23: conf.v1.Data.Client.HTTP
-	(*Data_Client_GRPC)(nil),    // 24: conf.v1.Data.Client.GRPC
-	(*App_Jwt)(nil),             // 25: conf.v1.App.Jwt
-	(*App_Log)(nil),             // 26: conf.v1.App.Log
-	nil,                         // 27: conf.v1.App.MetadataEntry
-	(*durationpb.Duration)(nil), // 28: google.protobuf.Duration
+	(*Bootstrap)(nil),                   // 0: conf.v1.Bootstrap
+	(*TLSConfig)(nil),                   // 1: conf.v1.TLSConfig
+	(*CORS)(nil),                        // 2: conf.v1.CORS
+	(*ConsulConfig)(nil),                // 3: conf.v1.ConsulConfig
+	(*EtcdConfig)(nil),                  // 4: conf.v1.EtcdConfig
+	(*NacosConfig)(nil),                 // 5: conf.v1.NacosConfig
+	(*KubernetesConfig)(nil),            // 6: conf.v1.KubernetesConfig
+	(*Server)(nil),                      // 7: conf.v1.Server
+	(*Client)(nil),                      // 8: conf.v1.Client
+	(*Data)(nil),                        // 9: conf.v1.Data
+	(*App)(nil),                         // 10: conf.v1.App
+	(*Registry)(nil),                    // 11: conf.v1.Registry
+	(*Discovery)(nil),                   // 12: conf.v1.Discovery
+	(*Config)(nil),                      // 13: conf.v1.Config
+	(*Trace)(nil),                       // 14: conf.v1.Trace
+	(*Metrics)(nil),                     // 15: conf.v1.Metrics
+	(*Server_HTTP)(nil),                 // 16: conf.v1.Server.HTTP
+	(*Server_GRPC)(nil),                 // 17: conf.v1.Server.GRPC
+	(*Client_GRPC)(nil),                 // 18: conf.v1.Client.GRPC
+	nil,                                 // 19: conf.v1.Client.GrpcEntry
+	(*Data_Database)(nil),               // 20: conf.v1.Data.Database
+	(*Data_Redis)(nil),                  // 21: conf.v1.Data.Redis
+	(*Data_Client)(nil),                 // 22: conf.v1.Data.Client
+	(*Data_EventBus)(nil),               // 23: conf.v1.Data.EventBus
+	(*Data_Client_HTTP)(nil),            // 24: conf.v1.Data.Client.HTTP
+	(*Data_Client_GRPC)(nil),            // 25: conf.v1.Data.Client.GRPC
+	(*App_Jwt)(nil),                     // 26: conf.v1.App.Jwt
+	(*App_Log)(nil),                     // 27: conf.v1.App.Log
+	(*App_Mail)(nil),                    // 28: conf.v1.App.Mail
+	(*App_Webhook)(nil),                 // 29: conf.v1.App.Webhook
+	(*App_Notification)(nil),            // 30: conf.v1.App.Notification
+	(*App_Outbox)(nil),                  // 31: conf.v1.App.Outbox
+	(*App_Mfa)(nil),                     // 32: conf.v1.App.Mfa
+	(*App_Account)(nil),                 // 33: conf.v1.App.Account
+	(*App_Oidc)(nil),                    // 34: conf.v1.App.Oidc
+	(*App_Lockout)(nil),                 // 35: conf.v1.App.Lockout
+	(*App_PasswordPolicy)(nil),          // 36: conf.v1.App.PasswordPolicy
+	(*App_PersonalAccessToken)(nil),     // 37: conf.v1.App.PersonalAccessToken
+	(*App_Rbac)(nil),                    // 38: conf.v1.App.Rbac
+	(*App_Workspace)(nil),               // 39: conf.v1.App.Workspace
+	(*App_Signup)(nil),                  // 40: conf.v1.App.Signup
+	(*App_Impersonation)(nil),           // 41: conf.v1.App.Impersonation
+	(*App_Ldap)(nil),                    // 42: conf.v1.App.Ldap
+	nil,                                 // 43: conf.v1.App.MetadataEntry
+	(*App_Jwt_SigningKey)(nil),          // 44: conf.v1.App.Jwt.SigningKey
+	(*App_Mail_SMTP)(nil),               // 45: conf.v1.App.Mail.SMTP
+	(*App_Oidc_Provider)(nil),           // 46: conf.v1.App.Oidc.Provider
+	(*App_PasswordPolicy_Argon2Id)(nil), // 47: conf.v1.App.PasswordPolicy.Argon2id
+	(*App_Rbac_Role)(nil),               // 48: conf.v1.App.Rbac.Role
+	(*App_Ldap_GroupRole)(nil),          // 49: conf.v1.App.Ldap.GroupRole
+	(*durationpb.Duration)(nil),         // 50: google.protobuf.Duration
 }
 var file_conf_v1_conf_proto_depIdxs = []int32{
 	10, // 0: conf.v1.Bootstrap.app:type_name -> conf.v1.App
@@ -2192,49 +4147,98 @@ var file_conf_v1_conf_proto_depIdxs = []int32{
 	13, // 6: conf.v1.Bootstrap.config:type_name -> conf.v1.Config
 	14, // 7: conf.v1.Bootstrap.trace:type_name -> conf.v1.Trace
 	15, // 8: conf.v1.Bootstrap.metrics:type_name -> conf.v1.Metrics
-	28, // 9: conf.v1.CORS.max_age:type_name -> google.protobuf.Duration
-	28, // 10: conf.v1.ConsulConfig.timeout:type_name -> google.protobuf.Duration
-	28, // 11: conf.v1.EtcdConfig.timeout:type_name -> google.protobuf.Duration
-	28, // 12: conf.v1.NacosConfig.timeout:type_name -> google.protobuf.Duration
+	50, // 9: conf.v1.CORS.max_age:type_name -> google.protobuf.Duration
+	50, // 10: conf.v1.ConsulConfig.timeout:type_name -> google.protobuf.Duration
+	50, // 11: conf.v1.EtcdConfig.timeout:type_name -> google.protobuf.Duration
+	50, // 12: conf.v1.NacosConfig.timeout:type_name -> google.protobuf.Duration
 	16, // 13: conf.v1.Server.http:type_name -> conf.v1.Server.HTTP
 	17, // 14: conf.v1.Server.grpc:type_name -> conf.v1.Server.GRPC
 	19, // 15: conf.v1.Client.grpc:type_name -> conf.v1.Client.GrpcEntry
 	20, // 16: conf.v1.Data.database:type_name -> conf.v1.Data.Database
 	21, // 17: conf.v1.Data.redis:type_name -> conf.v1.Data.Redis
 	22, // 18: conf.v1.Data.client:type_name -> conf.v1.Data.Client
-	25, // 19: conf.v1.App.jwt:type_name -> conf.v1.App.Jwt
-	26, // 20: conf.v1.App.log:type_name -> conf.v1.App.Log
-	27, // 21: conf.v1.App.metadata:type_name -> conf.v1.App.MetadataEntry
-	3,  // 22: conf.v1.Registry.consul:type_name -> conf.v1.ConsulConfig
-	4,  // 23: conf.v1.Registry.etcd:type_name -> conf.v1.EtcdConfig
-	5,  // 24: conf.v1.Registry.nacos:type_name -> conf.v1.NacosConfig
-	6,  // 25: conf.v1.Registry.kubernetes:type_name -> conf.v1.KubernetesConfig
-	3,  // 26: conf.v1.Discovery.consul:type_name -> conf.v1.ConsulConfig
-	4,  // 27: conf.v1.Discovery.etcd:type_name -> conf.v1.EtcdConfig
-	5,  // 28: conf.v1.Discovery.nacos:type_name -> conf.v1.NacosConfig
-	6,  // 29: conf.v1.Discovery.kubernetes:type_name -> conf.v1.KubernetesConfig
-	3,  // 30: conf.v1.Config.consul:type_name -> conf.v1.ConsulConfig
-	4,  // 31: conf.v1.Config.etcd:type_name -> conf.v1.EtcdConfig
-	5,  // 32: conf.v1.Config.nacos:type_name -> conf.v1.NacosConfig
-	28, // 33: conf.v1.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	1,  // 34: conf.v1.Server.HTTP.tls:type_name -> conf.v1.TLSConfig
-	2,  // 35: conf.v1.Server.HTTP.cors:type_name -> conf.v1.CORS
-	28, // 36: conf.v1.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	1,  // 37: conf.v1.Server.GRPC.tls:type_name -> conf.v1.TLSConfig
-	1,  // 38: conf.v1.Client.GRPC.tls:type_name -> conf.v1.TLSConfig
-	18, // 39: conf.v1.Client.GrpcEntry.value:type_name -> conf.v1.Client.GRPC
-	28, // 40: conf.v1.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	28, // 41: conf.v1.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	28, // 42: conf.v1.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	24, // 43: conf.v1.Data.Client.grpc:type_name -> conf.v1.Data.Client.GRPC
-	23, // 44: conf.v1.Data.Client.http:type_name -> conf.v1.Data.Client.HTTP
-	28, // 45: conf.v1.Data.Client.HTTP.timeout:type_name -> google.protobuf.Duration
-	28, // 46: conf.v1.Data.Client.GRPC.timeout:type_name -> google.protobuf.Duration
-	47, // [47:47] is the sub-list for method output_type
-	47, // [47:47] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	23, // 19: conf.v1.Data.event_bus:type_name -> conf.v1.Data.EventBus
+	26, // 20: conf.v1.App.jwt:type_name -> conf.v1.App.Jwt
+	27, // 21: conf.v1.App.log:type_name -> conf.v1.App.Log
+	43, // 22: conf.v1.App.metadata:type_name -> conf.v1.App.MetadataEntry
+	28, // 23: conf.v1.App.mail:type_name -> conf.v1.App.Mail
+	29, // 24: conf.v1.App.webhook:type_name -> conf.v1.App.Webhook
+	30, // 25: conf.v1.App.notification:type_name -> conf.v1.App.Notification
+	31, // 26: conf.v1.App.outbox:type_name -> conf.v1.App.Outbox
+	32, // 27: conf.v1.App.mfa:type_name -> conf.v1.App.Mfa
+	33, // 28: conf.v1.App.account:type_name -> conf.v1.App.Account
+	34, // 29: conf.v1.App.oidc:type_name -> conf.v1.App.Oidc
+	35, // 30: conf.v1.App.lockout:type_name -> conf.v1.App.Lockout
+	36, // 31: conf.v1.App.password_policy:type_name -> conf.v1.App.PasswordPolicy
+	37, // 32: conf.v1.App.personal_access_token:type_name -> conf.v1.App.PersonalAccessToken
+	38, // 33: conf.v1.App.rbac:type_name -> conf.v1.App.Rbac
+	39, // 34: conf.v1.App.workspace:type_name -> conf.v1.App.Workspace
+	40, // 35: conf.v1.App.signup:type_name -> conf.v1.App.Signup
+	41, // 36: conf.v1.App.impersonation:type_name -> conf.v1.App.Impersonation
+	42, // 37: conf.v1.App.ldap:type_name -> conf.v1.App.Ldap
+	3,  // 38: conf.v1.Registry.consul:type_name -> conf.v1.ConsulConfig
+	4,  // 39: conf.v1.Registry.etcd:type_name -> conf.v1.EtcdConfig
+	5,  // 40: conf.v1.Registry.nacos:type_name -> conf.v1.NacosConfig
+	6,  // 41: conf.v1.Registry.kubernetes:type_name -> conf.v1.KubernetesConfig
+	3,  // 42: conf.v1.Discovery.consul:type_name -> conf.v1.ConsulConfig
+	4,  // 43: conf.v1.Discovery.etcd:type_name -> conf.v1.EtcdConfig
+	5,  // 44: conf.v1.Discovery.nacos:type_name -> conf.v1.NacosConfig
+	6,  // 45: conf.v1.Discovery.kubernetes:type_name -> conf.v1.KubernetesConfig
+	3,  // 46: conf.v1.Config.consul:type_name -> conf.v1.ConsulConfig
+	4,  // 47: conf.v1.Config.etcd:type_name -> conf.v1.EtcdConfig
+	5,  // 48: conf.v1.Config.nacos:type_name -> conf.v1.NacosConfig
+	50, // 49: conf.v1.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	1,  // 50: conf.v1.Server.HTTP.tls:type_name -> conf.v1.TLSConfig
+	2,  // 51: conf.v1.Server.HTTP.cors:type_name -> conf.v1.CORS
+	50, // 52: conf.v1.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	1,  // 53: conf.v1.Server.GRPC.tls:type_name -> conf.v1.TLSConfig
+	1,  // 54: conf.v1.Client.GRPC.tls:type_name -> conf.v1.TLSConfig
+	18, // 55: conf.v1.Client.GrpcEntry.value:type_name -> conf.v1.Client.GRPC
+	50, // 56: conf.v1.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	50, // 57: conf.v1.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	50, // 58: conf.v1.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	25, // 59: conf.v1.Data.Client.grpc:type_name -> conf.v1.Data.Client.GRPC
+	24, // 60: conf.v1.Data.Client.http:type_name -> conf.v1.Data.Client.HTTP
+	50, // 61: conf.v1.Data.EventBus.retry_backoff:type_name -> google.protobuf.Duration
+	50, // 62: conf.v1.Data.EventBus.block_timeout:type_name -> google.protobuf.Duration
+	50, // 63: conf.v1.Data.Client.HTTP.timeout:type_name -> google.protobuf.Duration
+	50, // 64: conf.v1.Data.Client.GRPC.timeout:type_name -> google.protobuf.Duration
+	44, // 65: conf.v1.App.Jwt.signing_keys:type_name -> conf.v1.App.Jwt.SigningKey
+	45, // 66: conf.v1.App.Mail.smtp:type_name -> conf.v1.App.Mail.SMTP
+	50, // 67: conf.v1.App.Mail.retry_backoff:type_name -> google.protobuf.Duration
+	50, // 68: conf.v1.App.Webhook.retry_backoff:type_name -> google.protobuf.Duration
+	50, // 69: conf.v1.App.Webhook.max_backoff:type_name -> google.protobuf.Duration
+	50, // 70: conf.v1.App.Webhook.timeout:type_name -> google.protobuf.Duration
+	50, // 71: conf.v1.App.Webhook.poll_interval:type_name -> google.protobuf.Duration
+	50, // 72: conf.v1.App.Notification.digest_interval:type_name -> google.protobuf.Duration
+	50, // 73: conf.v1.App.Outbox.poll_interval:type_name -> google.protobuf.Duration
+	50, // 74: conf.v1.App.Outbox.retry_backoff:type_name -> google.protobuf.Duration
+	50, // 75: conf.v1.App.Outbox.max_backoff:type_name -> google.protobuf.Duration
+	50, // 76: conf.v1.App.Mfa.challenge_ttl:type_name -> google.protobuf.Duration
+	50, // 77: conf.v1.App.Account.verify_email_ttl:type_name -> google.protobuf.Duration
+	50, // 78: conf.v1.App.Account.password_reset_ttl:type_name -> google.protobuf.Duration
+	50, // 79: conf.v1.App.Account.deletion_grace_period:type_name -> google.protobuf.Duration
+	50, // 80: conf.v1.App.Account.purge_interval:type_name -> google.protobuf.Duration
+	46, // 81: conf.v1.App.Oidc.providers:type_name -> conf.v1.App.Oidc.Provider
+	50, // 82: conf.v1.App.Oidc.state_ttl:type_name -> google.protobuf.Duration
+	50, // 83: conf.v1.App.Lockout.window:type_name -> google.protobuf.Duration
+	50, // 84: conf.v1.App.Lockout.lockout_duration:type_name -> google.protobuf.Duration
+	50, // 85: conf.v1.App.Lockout.delay_base:type_name -> google.protobuf.Duration
+	50, // 86: conf.v1.App.Lockout.max_delay:type_name -> google.protobuf.Duration
+	47, // 87: conf.v1.App.PasswordPolicy.argon2id:type_name -> conf.v1.App.PasswordPolicy.Argon2id
+	50, // 88: conf.v1.App.PersonalAccessToken.max_lifetime:type_name -> google.protobuf.Duration
+	48, // 89: conf.v1.App.Rbac.roles:type_name -> conf.v1.App.Rbac.Role
+	50, // 90: conf.v1.App.Rbac.reload_interval:type_name -> google.protobuf.Duration
+	50, // 91: conf.v1.App.Workspace.invitation_ttl:type_name -> google.protobuf.Duration
+	50, // 92: conf.v1.App.Impersonation.token_ttl:type_name -> google.protobuf.Duration
+	49, // 93: conf.v1.App.Ldap.group_roles:type_name -> conf.v1.App.Ldap.GroupRole
+	50, // 94: conf.v1.App.Ldap.timeout:type_name -> google.protobuf.Duration
+	50, // 95: conf.v1.App.Mail.SMTP.timeout:type_name -> google.protobuf.Duration
+	96, // [96:96] is the sub-list for method output_type
+	96, // [96:96] is the sub-list for method input_type
+	96, // [96:96] is the sub-list for extension type_name
+	96, // [96:96] is the sub-list for extension extendee
+	0,  // [0:96] is the sub-list for field type_name
 }
 
 func init() { file_conf_v1_conf_proto_init() }
@@ -2265,7 +4269,7 @@ func file_conf_v1_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_v1_conf_proto_rawDesc), len(file_conf_v1_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1559,6 +1559,35 @@ func (m *Data) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetEventBus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "EventBus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "EventBus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventBus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "EventBus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DataMultiError(errors)
 	}
@@ -1723,287 +1752,3896 @@ func (m *App) validate(all bool) error {
 
 	// no validation rules for Metadata
 
-	if len(errors) > 0 {
-		return AppMultiError(errors)
-	}
-
-	return nil
-}
-
-// AppMultiError is an error wrapping multiple validation errors returned by
-// App.ValidateAll() if the designated constraints aren't met.
-type AppMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m AppMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m AppMultiError) AllErrors() []error { return m }
-
-// AppValidationError is the validation error returned by App.Validate if the
-// designated constraints aren't met.
-type AppValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e AppValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e AppValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e AppValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e AppValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e AppValidationError) ErrorName() string { return "AppValidationError" }
-
-// Error satisfies the builtin error interface
-func (e AppValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sApp.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = AppValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = AppValidationError{}
-
-// Validate checks the field values on Registry with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Registry) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on Registry with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RegistryMultiError, or nil
-// if none found.
-func (m *Registry) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *Registry) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	switch v := m.Registry.(type) {
-	case *Registry_Consul:
-		if v == nil {
-			err := RegistryValidationError{
-				field:  "Registry",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetMail()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Mail",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Mail",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMail()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Mail",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetConsul()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RegistryValidationError{
-					field:  "Consul",
+				errors = append(errors, AppValidationError{
+					field:  "Webhook",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Registry_Etcd:
-		if v == nil {
-			err := RegistryValidationError{
-				field:  "Registry",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetEtcd()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Etcd",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Etcd",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RegistryValidationError{
-					field:  "Etcd",
+				errors = append(errors, AppValidationError{
+					field:  "Notification",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Registry_Nacos:
-		if v == nil {
-			err := RegistryValidationError{
-				field:  "Registry",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetNacos()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Nacos",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetOutbox()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Outbox",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Outbox",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutbox()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Outbox",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMfa()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Mfa",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Mfa",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMfa()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Mfa",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOidc()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Oidc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Oidc",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOidc()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Oidc",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLockout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Lockout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Lockout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLockout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Lockout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPasswordPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "PasswordPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "PasswordPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "PasswordPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPersonalAccessToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "PersonalAccessToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "PersonalAccessToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPersonalAccessToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "PersonalAccessToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Rbac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSignup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Signup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Signup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSignup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Signup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetImpersonation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Impersonation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Impersonation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImpersonation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Impersonation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLdap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Ldap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Ldap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLdap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Ldap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AppMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppMultiError is an error wrapping multiple validation errors returned by
+// App.ValidateAll() if the designated constraints aren't met.
+type AppMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppMultiError) AllErrors() []error { return m }
+
+// AppValidationError is the validation error returned by App.Validate if the
+// designated constraints aren't met.
+type AppValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppValidationError) ErrorName() string { return "AppValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppValidationError{}
+
+// Validate checks the field values on Registry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Registry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Registry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RegistryMultiError, or nil
+// if none found.
+func (m *Registry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Registry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Registry.(type) {
+	case *Registry_Consul:
+		if v == nil {
+			err := RegistryValidationError{
+				field:  "Registry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConsul()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistryValidationError{
+					field:  "Consul",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Registry_Etcd:
+		if v == nil {
+			err := RegistryValidationError{
+				field:  "Registry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEtcd()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistryValidationError{
+					field:  "Etcd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Registry_Nacos:
+		if v == nil {
+			err := RegistryValidationError{
+				field:  "Registry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNacos()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistryValidationError{
+					field:  "Nacos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Registry_Kubernetes:
+		if v == nil {
+			err := RegistryValidationError{
+				field:  "Registry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKubernetes()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegistryValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegistryValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return RegistryMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegistryMultiError is an error wrapping multiple validation errors returned
+// by Registry.ValidateAll() if the designated constraints aren't met.
+type RegistryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegistryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegistryMultiError) AllErrors() []error { return m }
+
+// RegistryValidationError is the validation error returned by
+// Registry.Validate if the designated constraints aren't met.
+type RegistryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegistryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegistryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegistryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegistryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegistryValidationError) ErrorName() string { return "RegistryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RegistryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegistry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegistryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegistryValidationError{}
+
+// Validate checks the field values on Discovery with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Discovery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Discovery with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiscoveryMultiError, or nil
+// if none found.
+func (m *Discovery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Discovery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Discovery.(type) {
+	case *Discovery_Consul:
+		if v == nil {
+			err := DiscoveryValidationError{
+				field:  "Discovery",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConsul()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoveryValidationError{
+					field:  "Consul",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Discovery_Etcd:
+		if v == nil {
+			err := DiscoveryValidationError{
+				field:  "Discovery",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEtcd()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoveryValidationError{
+					field:  "Etcd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Discovery_Nacos:
+		if v == nil {
+			err := DiscoveryValidationError{
+				field:  "Discovery",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNacos()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoveryValidationError{
+					field:  "Nacos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Discovery_Kubernetes:
+		if v == nil {
+			err := DiscoveryValidationError{
+				field:  "Discovery",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKubernetes()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoveryValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoveryValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DiscoveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoveryMultiError is an error wrapping multiple validation errors returned
+// by Discovery.ValidateAll() if the designated constraints aren't met.
+type DiscoveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoveryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoveryMultiError) AllErrors() []error { return m }
+
+// DiscoveryValidationError is the validation error returned by
+// Discovery.Validate if the designated constraints aren't met.
+type DiscoveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoveryValidationError) ErrorName() string { return "DiscoveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiscoveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscovery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoveryValidationError{}
+
+// Validate checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Config) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ConfigMultiError, or nil if none found.
+func (m *Config) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Config) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Config.(type) {
+	case *Config_Consul:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetConsul()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Consul",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Consul",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_Etcd:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEtcd()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Etcd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Etcd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Config_Nacos:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "Config",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNacos()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Nacos",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Nacos",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigMultiError is an error wrapping multiple validation errors returned by
+// Config.ValidateAll() if the designated constraints aren't met.
+type ConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigMultiError) AllErrors() []error { return m }
+
+// ConfigValidationError is the validation error returned by Config.Validate if
+// the designated constraints aren't met.
+type ConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigValidationError{}
+
+// Validate checks the field values on Trace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Trace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Trace with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TraceMultiError, or nil if none found.
+func (m *Trace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Trace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Endpoint
+
+	if len(errors) > 0 {
+		return TraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// TraceMultiError is an error wrapping multiple validation errors returned by
+// Trace.ValidateAll() if the designated constraints aren't met.
+type TraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TraceMultiError) AllErrors() []error { return m }
+
+// TraceValidationError is the validation error returned by Trace.Validate if
+// the designated constraints aren't met.
+type TraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TraceValidationError) ErrorName() string { return "TraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TraceValidationError{}
+
+// Validate checks the field values on Metrics with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Metrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Metrics with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MetricsMultiError, or nil if none found.
+func (m *Metrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Metrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for MeterName
+
+	if len(errors) > 0 {
+		return MetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsMultiError is an error wrapping multiple validation errors returned
+// by Metrics.ValidateAll() if the designated constraints aren't met.
+type MetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsMultiError) AllErrors() []error { return m }
+
+// MetricsValidationError is the validation error returned by Metrics.Validate
+// if the designated constraints aren't met.
+type MetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsValidationError) ErrorName() string { return "MetricsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsValidationError{}
+
+// Validate checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_HTTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_HTTPMultiError, or
+// nil if none found.
+func (m *Server_HTTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_HTTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCors()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_HTTPValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_HTTPValidationError{
+				field:  "Cors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TrustForwardedHeaders
+
+	if len(errors) > 0 {
+		return Server_HTTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_HTTPMultiError is an error wrapping multiple validation errors
+// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
+type Server_HTTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_HTTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_HTTPMultiError) AllErrors() []error { return m }
+
+// Server_HTTPValidationError is the validation error returned by
+// Server_HTTP.Validate if the designated constraints aren't met.
+type Server_HTTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_HTTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_HTTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_HTTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_HTTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_HTTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_HTTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_HTTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_HTTPValidationError{}
+
+// Validate checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Server_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// nil if none found.
+func (m *Server_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Server_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_GRPCValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Server_GRPCValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Server_GRPCValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Server_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Server_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
+type Server_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_GRPCMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Server_GRPCMultiError) AllErrors() []error { return m }
+
+// Server_GRPCValidationError is the validation error returned by
+// Server_GRPC.Validate if the designated constraints aren't met.
+type Server_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_GRPCValidationError{}
+
+// Validate checks the field values on Client_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Client_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Client_GRPC with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Client_GRPCMultiError, or
+// nil if none found.
+func (m *Client_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Client_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Endpoint
+
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Client_GRPCValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Client_GRPCValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Client_GRPCValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Client_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Client_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Client_GRPC.ValidateAll() if the designated constraints aren't met.
+type Client_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Client_GRPCMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Client_GRPCMultiError) AllErrors() []error { return m }
+
+// Client_GRPCValidationError is the validation error returned by
+// Client_GRPC.Validate if the designated constraints aren't met.
+type Client_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Client_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Client_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Client_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Client_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Client_GRPCValidationError) ErrorName() string { return "Client_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Client_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClient_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Client_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Client_GRPCValidationError{}
+
+// Validate checks the field values on Data_Database with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Database) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Database with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
+// nil if none found.
+func (m *Data_Database) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Database) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Driver
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return Data_DatabaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_DatabaseMultiError is an error wrapping multiple validation errors
+// returned by Data_Database.ValidateAll() if the designated constraints
+// aren't met.
+type Data_DatabaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_DatabaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+
+// Data_DatabaseValidationError is the validation error returned by
+// Data_Database.Validate if the designated constraints aren't met.
+type Data_DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Database.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_DatabaseValidationError{}
+
+// Validate checks the field values on Data_Redis with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Redis) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Redis with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_RedisMultiError, or
+// nil if none found.
+func (m *Data_Redis) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Redis) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Network
+
+	// no validation rules for Addr
+
+	// no validation rules for UserName
+
+	// no validation rules for Password
+
+	// no validation rules for Db
+
+	if all {
+		switch v := interface{}(m.GetDialTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDialTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_RedisValidationError{
+				field:  "DialTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "ReadTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "ReadTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_RedisValidationError{
+				field:  "ReadTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWriteTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "WriteTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_RedisValidationError{
+					field:  "WriteTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWriteTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_RedisValidationError{
+				field:  "WriteTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_RedisMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_RedisMultiError is an error wrapping multiple validation errors
+// returned by Data_Redis.ValidateAll() if the designated constraints aren't met.
+type Data_RedisMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_RedisMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_RedisMultiError) AllErrors() []error { return m }
+
+// Data_RedisValidationError is the validation error returned by
+// Data_Redis.Validate if the designated constraints aren't met.
+type Data_RedisValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_RedisValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_RedisValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_RedisValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_RedisValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_RedisValidationError) ErrorName() string { return "Data_RedisValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_RedisValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Redis.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_RedisValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_RedisValidationError{}
+
+// Validate checks the field values on Data_Client with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_Client) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Client with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_ClientMultiError, or
+// nil if none found.
+func (m *Data_Client) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Client) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGrpc() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Data_ClientValidationError{
+						field:  fmt.Sprintf("Grpc[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Data_ClientValidationError{
+						field:  fmt.Sprintf("Grpc[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Data_ClientValidationError{
+					field:  fmt.Sprintf("Grpc[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHttp() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Data_ClientValidationError{
+						field:  fmt.Sprintf("Http[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Data_ClientValidationError{
+						field:  fmt.Sprintf("Http[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Data_ClientValidationError{
+					field:  fmt.Sprintf("Http[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return Data_ClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_ClientMultiError is an error wrapping multiple validation errors
+// returned by Data_Client.ValidateAll() if the designated constraints aren't met.
+type Data_ClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_ClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_ClientMultiError) AllErrors() []error { return m }
+
+// Data_ClientValidationError is the validation error returned by
+// Data_Client.Validate if the designated constraints aren't met.
+type Data_ClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_ClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_ClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_ClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_ClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_ClientValidationError) ErrorName() string { return "Data_ClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_ClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Client.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_ClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_ClientValidationError{}
+
+// Validate checks the field values on Data_EventBus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Data_EventBus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_EventBus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Data_EventBusMultiError, or
+// nil if none found.
+func (m *Data_EventBus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_EventBus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Driver
+
+	// no validation rules for StreamMaxLen
+
+	// no validation rules for MaxDeliveries
+
+	if all {
+		switch v := interface{}(m.GetRetryBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventBusValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventBusValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventBusValidationError{
+				field:  "RetryBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlockTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_EventBusValidationError{
+					field:  "BlockTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_EventBusValidationError{
+					field:  "BlockTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlockTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_EventBusValidationError{
+				field:  "BlockTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BatchSize
+
+	if len(errors) > 0 {
+		return Data_EventBusMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_EventBusMultiError is an error wrapping multiple validation errors
+// returned by Data_EventBus.ValidateAll() if the designated constraints
+// aren't met.
+type Data_EventBusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_EventBusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_EventBusMultiError) AllErrors() []error { return m }
+
+// Data_EventBusValidationError is the validation error returned by
+// Data_EventBus.Validate if the designated constraints aren't met.
+type Data_EventBusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_EventBusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_EventBusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_EventBusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_EventBusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_EventBusValidationError) ErrorName() string { return "Data_EventBusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_EventBusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_EventBus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_EventBusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_EventBusValidationError{}
+
+// Validate checks the field values on Data_Client_HTTP with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_Client_HTTP) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Client_HTTP with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_Client_HTTPMultiError, or nil if none found.
+func (m *Data_Client_HTTP) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Client_HTTP) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Endpoint
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_Client_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_Client_HTTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_Client_HTTPValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_Client_HTTPMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_Client_HTTPMultiError is an error wrapping multiple validation errors
+// returned by Data_Client_HTTP.ValidateAll() if the designated constraints
+// aren't met.
+type Data_Client_HTTPMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_Client_HTTPMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_Client_HTTPMultiError) AllErrors() []error { return m }
+
+// Data_Client_HTTPValidationError is the validation error returned by
+// Data_Client_HTTP.Validate if the designated constraints aren't met.
+type Data_Client_HTTPValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_Client_HTTPValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_Client_HTTPValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_Client_HTTPValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_Client_HTTPValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_Client_HTTPValidationError) ErrorName() string { return "Data_Client_HTTPValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_Client_HTTPValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Client_HTTP.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_Client_HTTPValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_Client_HTTPValidationError{}
+
+// Validate checks the field values on Data_Client_GRPC with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Data_Client_GRPC) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Data_Client_GRPC with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Data_Client_GRPCMultiError, or nil if none found.
+func (m *Data_Client_GRPC) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Data_Client_GRPC) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Endpoint
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Data_Client_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Data_Client_GRPCValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Data_Client_GRPCValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Data_Client_GRPCMultiError(errors)
+	}
+
+	return nil
+}
+
+// Data_Client_GRPCMultiError is an error wrapping multiple validation errors
+// returned by Data_Client_GRPC.ValidateAll() if the designated constraints
+// aren't met.
+type Data_Client_GRPCMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Data_Client_GRPCMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Data_Client_GRPCMultiError) AllErrors() []error { return m }
+
+// Data_Client_GRPCValidationError is the validation error returned by
+// Data_Client_GRPC.Validate if the designated constraints aren't met.
+type Data_Client_GRPCValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Data_Client_GRPCValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Data_Client_GRPCValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Data_Client_GRPCValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Data_Client_GRPCValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Data_Client_GRPCValidationError) ErrorName() string { return "Data_Client_GRPCValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Data_Client_GRPCValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sData_Client_GRPC.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Data_Client_GRPCValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Data_Client_GRPCValidationError{}
+
+// Validate checks the field values on App_Jwt with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Jwt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Jwt with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in App_JwtMultiError, or nil if none found.
+func (m *App_Jwt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Jwt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessSecret
+
+	// no validation rules for RefreshSecret
+
+	// no validation rules for AccessExpire
+
+	// no validation rules for RefreshExpire
+
+	// no validation rules for Issuer
+
+	// no validation rules for Audience
+
+	// no validation rules for RevokeAllOnReuse
+
+	for idx, item := range m.GetSigningKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, App_JwtValidationError{
+						field:  fmt.Sprintf("SigningKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Nacos",
+					errors = append(errors, App_JwtValidationError{
+						field:  fmt.Sprintf("SigningKeys[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return App_JwtValidationError{
+					field:  fmt.Sprintf("SigningKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return App_JwtMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_JwtMultiError is an error wrapping multiple validation errors returned
+// by App_Jwt.ValidateAll() if the designated constraints aren't met.
+type App_JwtMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_JwtMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_JwtMultiError) AllErrors() []error { return m }
+
+// App_JwtValidationError is the validation error returned by App_Jwt.Validate
+// if the designated constraints aren't met.
+type App_JwtValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_JwtValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_JwtValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_JwtValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_JwtValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_JwtValidationError) ErrorName() string { return "App_JwtValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_JwtValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Jwt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_JwtValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_JwtValidationError{}
+
+// Validate checks the field values on App_Log with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Log) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Log with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in App_LogMultiError, or nil if none found.
+func (m *App_Log) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Log) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	// no validation rules for Filename
+
+	// no validation rules for MaxSize
+
+	// no validation rules for MaxBackups
+
+	// no validation rules for MaxAge
+
+	// no validation rules for Compress
+
+	if len(errors) > 0 {
+		return App_LogMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_LogMultiError is an error wrapping multiple validation errors returned
+// by App_Log.ValidateAll() if the designated constraints aren't met.
+type App_LogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_LogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_LogMultiError) AllErrors() []error { return m }
+
+// App_LogValidationError is the validation error returned by App_Log.Validate
+// if the designated constraints aren't met.
+type App_LogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_LogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_LogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_LogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_LogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_LogValidationError) ErrorName() string { return "App_LogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_LogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Log.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_LogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_LogValidationError{}
+
+// Validate checks the field values on App_Mail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Mail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Mail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_MailMultiError, or nil
+// if none found.
+func (m *App_Mail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Mail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Driver
+
+	// no validation rules for From
+
+	if all {
+		switch v := interface{}(m.GetSmtp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_MailValidationError{
+					field:  "Smtp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_MailValidationError{
+					field:  "Smtp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSmtp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_MailValidationError{
+				field:  "Smtp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FileDir
+
+	// no validation rules for DefaultLocale
+
+	// no validation rules for TemplateDir
+
+	// no validation rules for Workers
+
+	// no validation rules for MaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetRetryBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_MailValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_MailValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_MailValidationError{
+				field:  "RetryBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return App_MailMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_MailMultiError is an error wrapping multiple validation errors returned
+// by App_Mail.ValidateAll() if the designated constraints aren't met.
+type App_MailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_MailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_MailMultiError) AllErrors() []error { return m }
+
+// App_MailValidationError is the validation error returned by
+// App_Mail.Validate if the designated constraints aren't met.
+type App_MailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_MailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_MailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_MailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_MailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_MailValidationError) ErrorName() string { return "App_MailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_MailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Mail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_MailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_MailValidationError{}
+
+// Validate checks the field values on App_Webhook with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Webhook with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_WebhookMultiError, or
+// nil if none found.
+func (m *App_Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetRetryBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_WebhookValidationError{
+				field:  "RetryBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_WebhookValidationError{
+				field:  "MaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_WebhookValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_WebhookValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_WebhookValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return App_WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_WebhookMultiError is an error wrapping multiple validation errors
+// returned by App_Webhook.ValidateAll() if the designated constraints aren't met.
+type App_WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_WebhookMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_WebhookMultiError) AllErrors() []error { return m }
+
+// App_WebhookValidationError is the validation error returned by
+// App_Webhook.Validate if the designated constraints aren't met.
+type App_WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_WebhookValidationError) ErrorName() string { return "App_WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Webhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_WebhookValidationError{}
+
+// Validate checks the field values on App_Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *App_Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Notification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_NotificationMultiError, or nil if none found.
+func (m *App_Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDigestInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_NotificationValidationError{
+					field:  "DigestInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_NotificationValidationError{
+					field:  "DigestInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDigestInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_NotificationValidationError{
+				field:  "DigestInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DefaultMode
+
+	if len(errors) > 0 {
+		return App_NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_NotificationMultiError is an error wrapping multiple validation errors
+// returned by App_Notification.ValidateAll() if the designated constraints
+// aren't met.
+type App_NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_NotificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_NotificationMultiError) AllErrors() []error { return m }
+
+// App_NotificationValidationError is the validation error returned by
+// App_Notification.Validate if the designated constraints aren't met.
+type App_NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_NotificationValidationError) ErrorName() string { return "App_NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Notification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_NotificationValidationError{}
+
+// Validate checks the field values on App_Outbox with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Outbox) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Outbox with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_OutboxMultiError, or
+// nil if none found.
+func (m *App_Outbox) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Outbox) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_OutboxValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "RetryBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_OutboxValidationError{
+				field:  "RetryBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_OutboxValidationError{
+					field:  "MaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_OutboxValidationError{
+				field:  "MaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BatchSize
+
+	if len(errors) > 0 {
+		return App_OutboxMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_OutboxMultiError is an error wrapping multiple validation errors
+// returned by App_Outbox.ValidateAll() if the designated constraints aren't met.
+type App_OutboxMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_OutboxMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_OutboxMultiError) AllErrors() []error { return m }
+
+// App_OutboxValidationError is the validation error returned by
+// App_Outbox.Validate if the designated constraints aren't met.
+type App_OutboxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_OutboxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_OutboxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_OutboxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_OutboxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_OutboxValidationError) ErrorName() string { return "App_OutboxValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_OutboxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Outbox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_OutboxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_OutboxValidationError{}
+
+// Validate checks the field values on App_Mfa with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Mfa) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Mfa with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in App_MfaMultiError, or nil if none found.
+func (m *App_Mfa) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Mfa) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Issuer
+
+	if all {
+		switch v := interface{}(m.GetChallengeTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_MfaValidationError{
+					field:  "ChallengeTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RegistryValidationError{
-					field:  "Nacos",
+				errors = append(errors, App_MfaValidationError{
+					field:  "ChallengeTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Registry_Kubernetes:
-		if v == nil {
-			err := RegistryValidationError{
-				field:  "Registry",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetChallengeTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_MfaValidationError{
+				field:  "ChallengeTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetKubernetes()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RegistryValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RegistryValidationError{
-					field:  "Kubernetes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for MaxAttempts
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for RecoveryCodes
 
 	if len(errors) > 0 {
-		return RegistryMultiError(errors)
+		return App_MfaMultiError(errors)
 	}
 
 	return nil
 }
 
-// RegistryMultiError is an error wrapping multiple validation errors returned
-// by Registry.ValidateAll() if the designated constraints aren't met.
-type RegistryMultiError []error
+// App_MfaMultiError is an error wrapping multiple validation errors returned
+// by App_Mfa.ValidateAll() if the designated constraints aren't met.
+type App_MfaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RegistryMultiError) Error() string {
+func (m App_MfaMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2012,11 +5650,11 @@ func (m RegistryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RegistryMultiError) AllErrors() []error { return m }
+func (m App_MfaMultiError) AllErrors() []error { return m }
 
-// RegistryValidationError is the validation error returned by
-// Registry.Validate if the designated constraints aren't met.
-type RegistryValidationError struct {
+// App_MfaValidationError is the validation error returned by App_Mfa.Validate
+// if the designated constraints aren't met.
+type App_MfaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2024,22 +5662,22 @@ type RegistryValidationError struct {
 }
 
 // Field function returns field value.
-func (e RegistryValidationError) Field() string { return e.field }
+func (e App_MfaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RegistryValidationError) Reason() string { return e.reason }
+func (e App_MfaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RegistryValidationError) Cause() error { return e.cause }
+func (e App_MfaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RegistryValidationError) Key() bool { return e.key }
+func (e App_MfaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RegistryValidationError) ErrorName() string { return "RegistryValidationError" }
+func (e App_MfaValidationError) ErrorName() string { return "App_MfaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RegistryValidationError) Error() string {
+func (e App_MfaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2051,14 +5689,14 @@ func (e RegistryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRegistry.%s: %s%s",
+		"invalid %sApp_Mfa.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RegistryValidationError{}
+var _ error = App_MfaValidationError{}
 
 var _ interface {
 	Field() string
@@ -2066,212 +5704,331 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RegistryValidationError{}
+} = App_MfaValidationError{}
 
-// Validate checks the field values on Discovery with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Account with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Discovery) Validate() error {
+func (m *App_Account) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Discovery with the rules defined in
+// ValidateAll checks the field values on App_Account with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DiscoveryMultiError, or nil
-// if none found.
-func (m *Discovery) ValidateAll() error {
+// result is a list of violation errors wrapped in App_AccountMultiError, or
+// nil if none found.
+func (m *App_Account) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Discovery) validate(all bool) error {
+func (m *App_Account) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Discovery.(type) {
-	case *Discovery_Consul:
-		if v == nil {
-			err := DiscoveryValidationError{
-				field:  "Discovery",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for RequireEmailVerification
+
+	if all {
+		switch v := interface{}(m.GetVerifyEmailTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "VerifyEmailTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "VerifyEmailTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVerifyEmailTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_AccountValidationError{
+				field:  "VerifyEmailTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetConsul()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetPasswordResetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "PasswordResetTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return DiscoveryValidationError{
-					field:  "Consul",
+				errors = append(errors, App_AccountValidationError{
+					field:  "PasswordResetTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPasswordResetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_AccountValidationError{
+				field:  "PasswordResetTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for VerifyEmailUrl
+
+	// no validation rules for PasswordResetUrl
+
+	if all {
+		switch v := interface{}(m.GetDeletionGracePeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "DeletionGracePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "DeletionGracePeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionGracePeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_AccountValidationError{
+				field:  "DeletionGracePeriod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPurgeInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "PurgeInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_AccountValidationError{
+					field:  "PurgeInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPurgeInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_AccountValidationError{
+				field:  "PurgeInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
+
+	if len(errors) > 0 {
+		return App_AccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_AccountMultiError is an error wrapping multiple validation errors
+// returned by App_Account.ValidateAll() if the designated constraints aren't met.
+type App_AccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_AccountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_AccountMultiError) AllErrors() []error { return m }
+
+// App_AccountValidationError is the validation error returned by
+// App_Account.Validate if the designated constraints aren't met.
+type App_AccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_AccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_AccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_AccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_AccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_AccountValidationError) ErrorName() string { return "App_AccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_AccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Account.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_AccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_AccountValidationError{}
 
-	case *Discovery_Etcd:
-		if v == nil {
-			err := DiscoveryValidationError{
-				field:  "Discovery",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Validate checks the field values on App_Oidc with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *App_Oidc) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Oidc with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_OidcMultiError, or nil
+// if none found.
+func (m *App_Oidc) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Oidc) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProviders() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetEtcd()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Etcd",
+					errors = append(errors, App_OidcValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Etcd",
+					errors = append(errors, App_OidcValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DiscoveryValidationError{
-					field:  "Etcd",
+				return App_OidcValidationError{
+					field:  fmt.Sprintf("Providers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *Discovery_Nacos:
-		if v == nil {
-			err := DiscoveryValidationError{
-				field:  "Discovery",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetNacos()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Nacos",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Nacos",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetStateTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_OidcValidationError{
+					field:  "StateTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return DiscoveryValidationError{
-					field:  "Nacos",
+				errors = append(errors, App_OidcValidationError{
+					field:  "StateTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Discovery_Kubernetes:
-		if v == nil {
-			err := DiscoveryValidationError{
-				field:  "Discovery",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetStateTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_OidcValidationError{
+				field:  "StateTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetKubernetes()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DiscoveryValidationError{
-						field:  "Kubernetes",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DiscoveryValidationError{
-					field:  "Kubernetes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AutoSignup
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for LinkByEmail
 
 	if len(errors) > 0 {
-		return DiscoveryMultiError(errors)
+		return App_OidcMultiError(errors)
 	}
 
 	return nil
 }
 
-// DiscoveryMultiError is an error wrapping multiple validation errors returned
-// by Discovery.ValidateAll() if the designated constraints aren't met.
-type DiscoveryMultiError []error
+// App_OidcMultiError is an error wrapping multiple validation errors returned
+// by App_Oidc.ValidateAll() if the designated constraints aren't met.
+type App_OidcMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DiscoveryMultiError) Error() string {
+func (m App_OidcMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2280,11 +6037,11 @@ func (m DiscoveryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DiscoveryMultiError) AllErrors() []error { return m }
+func (m App_OidcMultiError) AllErrors() []error { return m }
 
-// DiscoveryValidationError is the validation error returned by
-// Discovery.Validate if the designated constraints aren't met.
-type DiscoveryValidationError struct {
+// App_OidcValidationError is the validation error returned by
+// App_Oidc.Validate if the designated constraints aren't met.
+type App_OidcValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2292,22 +6049,22 @@ type DiscoveryValidationError struct {
 }
 
 // Field function returns field value.
-func (e DiscoveryValidationError) Field() string { return e.field }
+func (e App_OidcValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DiscoveryValidationError) Reason() string { return e.reason }
+func (e App_OidcValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DiscoveryValidationError) Cause() error { return e.cause }
+func (e App_OidcValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DiscoveryValidationError) Key() bool { return e.key }
+func (e App_OidcValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DiscoveryValidationError) ErrorName() string { return "DiscoveryValidationError" }
+func (e App_OidcValidationError) ErrorName() string { return "App_OidcValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DiscoveryValidationError) Error() string {
+func (e App_OidcValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2319,14 +6076,14 @@ func (e DiscoveryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDiscovery.%s: %s%s",
+		"invalid %sApp_Oidc.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DiscoveryValidationError{}
+var _ error = App_OidcValidationError{}
 
 var _ interface {
 	Field() string
@@ -2334,170 +6091,167 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DiscoveryValidationError{}
+} = App_OidcValidationError{}
 
-// Validate checks the field values on Config with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Lockout with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Config) Validate() error {
+func (m *App_Lockout) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Config with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ConfigMultiError, or nil if none found.
-func (m *Config) ValidateAll() error {
+// ValidateAll checks the field values on App_Lockout with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_LockoutMultiError, or
+// nil if none found.
+func (m *App_Lockout) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Config) validate(all bool) error {
+func (m *App_Lockout) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Config.(type) {
-	case *Config_Consul:
-		if v == nil {
-			err := ConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
+	if all {
+		switch v := interface{}(m.GetWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "Window",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_LockoutValidationError{
+				field:  "Window",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetConsul()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Consul",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for MaxAccountFailures
+
+	// no validation rules for MaxIpFailures
+
+	if all {
+		switch v := interface{}(m.GetLockoutDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "LockoutDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetConsul()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ConfigValidationError{
-					field:  "Consul",
+				errors = append(errors, App_LockoutValidationError{
+					field:  "LockoutDuration",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Config_Etcd:
-		if v == nil {
-			err := ConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetLockoutDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_LockoutValidationError{
+				field:  "LockoutDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetEtcd()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Etcd",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Etcd",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for DelayAfter
+
+	if all {
+		switch v := interface{}(m.GetDelayBase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "DelayBase",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ConfigValidationError{
-					field:  "Etcd",
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "DelayBase",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *Config_Nacos:
-		if v == nil {
-			err := ConfigValidationError{
-				field:  "Config",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetDelayBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_LockoutValidationError{
+				field:  "DelayBase",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetNacos()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Nacos",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ConfigValidationError{
-						field:  "Nacos",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetMaxDelay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_LockoutValidationError{
+					field:  "MaxDelay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetNacos()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ConfigValidationError{
-					field:  "Nacos",
+				errors = append(errors, App_LockoutValidationError{
+					field:  "MaxDelay",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxDelay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_LockoutValidationError{
+				field:  "MaxDelay",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for Disabled
+
 	if len(errors) > 0 {
-		return ConfigMultiError(errors)
+		return App_LockoutMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConfigMultiError is an error wrapping multiple validation errors returned by
-// Config.ValidateAll() if the designated constraints aren't met.
-type ConfigMultiError []error
+// App_LockoutMultiError is an error wrapping multiple validation errors
+// returned by App_Lockout.ValidateAll() if the designated constraints aren't met.
+type App_LockoutMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfigMultiError) Error() string {
+func (m App_LockoutMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2506,11 +6260,11 @@ func (m ConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfigMultiError) AllErrors() []error { return m }
+func (m App_LockoutMultiError) AllErrors() []error { return m }
 
-// ConfigValidationError is the validation error returned by Config.Validate if
-// the designated constraints aren't met.
-type ConfigValidationError struct {
+// App_LockoutValidationError is the validation error returned by
+// App_Lockout.Validate if the designated constraints aren't met.
+type App_LockoutValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2518,22 +6272,22 @@ type ConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfigValidationError) Field() string { return e.field }
+func (e App_LockoutValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfigValidationError) Reason() string { return e.reason }
+func (e App_LockoutValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfigValidationError) Cause() error { return e.cause }
+func (e App_LockoutValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfigValidationError) Key() bool { return e.key }
+func (e App_LockoutValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+func (e App_LockoutValidationError) ErrorName() string { return "App_LockoutValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ConfigValidationError) Error() string {
+func (e App_LockoutValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2545,14 +6299,14 @@ func (e ConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfig.%s: %s%s",
+		"invalid %sApp_Lockout.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfigValidationError{}
+var _ error = App_LockoutValidationError{}
 
 var _ interface {
 	Field() string
@@ -2560,44 +6314,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfigValidationError{}
+} = App_LockoutValidationError{}
 
-// Validate checks the field values on Trace with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Trace) Validate() error {
+// Validate checks the field values on App_PasswordPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *App_PasswordPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Trace with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TraceMultiError, or nil if none found.
-func (m *Trace) ValidateAll() error {
+// ValidateAll checks the field values on App_PasswordPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_PasswordPolicyMultiError, or nil if none found.
+func (m *App_PasswordPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Trace) validate(all bool) error {
+func (m *App_PasswordPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Endpoint
+	// no validation rules for MinLength
+
+	// no validation rules for MaxLength
+
+	// no validation rules for MinCharacterClasses
+
+	// no validation rules for BreachedPasswordsFile
+
+	// no validation rules for Algorithm
+
+	if all {
+		switch v := interface{}(m.GetArgon2Id()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_PasswordPolicyValidationError{
+					field:  "Argon2Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_PasswordPolicyValidationError{
+					field:  "Argon2Id",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArgon2Id()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_PasswordPolicyValidationError{
+				field:  "Argon2Id",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BcryptCost
 
 	if len(errors) > 0 {
-		return TraceMultiError(errors)
+		return App_PasswordPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TraceMultiError is an error wrapping multiple validation errors returned by
-// Trace.ValidateAll() if the designated constraints aren't met.
-type TraceMultiError []error
+// App_PasswordPolicyMultiError is an error wrapping multiple validation errors
+// returned by App_PasswordPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type App_PasswordPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TraceMultiError) Error() string {
+func (m App_PasswordPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2606,11 +6401,11 @@ func (m TraceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TraceMultiError) AllErrors() []error { return m }
+func (m App_PasswordPolicyMultiError) AllErrors() []error { return m }
 
-// TraceValidationError is the validation error returned by Trace.Validate if
-// the designated constraints aren't met.
-type TraceValidationError struct {
+// App_PasswordPolicyValidationError is the validation error returned by
+// App_PasswordPolicy.Validate if the designated constraints aren't met.
+type App_PasswordPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2618,22 +6413,24 @@ type TraceValidationError struct {
 }
 
 // Field function returns field value.
-func (e TraceValidationError) Field() string { return e.field }
+func (e App_PasswordPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TraceValidationError) Reason() string { return e.reason }
+func (e App_PasswordPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TraceValidationError) Cause() error { return e.cause }
+func (e App_PasswordPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TraceValidationError) Key() bool { return e.key }
+func (e App_PasswordPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TraceValidationError) ErrorName() string { return "TraceValidationError" }
+func (e App_PasswordPolicyValidationError) ErrorName() string {
+	return "App_PasswordPolicyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TraceValidationError) Error() string {
+func (e App_PasswordPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2645,14 +6442,14 @@ func (e TraceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTrace.%s: %s%s",
+		"invalid %sApp_PasswordPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TraceValidationError{}
+var _ error = App_PasswordPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2660,46 +6457,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TraceValidationError{}
+} = App_PasswordPolicyValidationError{}
 
-// Validate checks the field values on Metrics with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Metrics) Validate() error {
+// Validate checks the field values on App_PersonalAccessToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *App_PersonalAccessToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Metrics with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MetricsMultiError, or nil if none found.
-func (m *Metrics) ValidateAll() error {
+// ValidateAll checks the field values on App_PersonalAccessToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_PersonalAccessTokenMultiError, or nil if none found.
+func (m *App_PersonalAccessToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Metrics) validate(all bool) error {
+func (m *App_PersonalAccessToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Enable
+	// no validation rules for MaxPerUser
 
-	// no validation rules for MeterName
+	if all {
+		switch v := interface{}(m.GetMaxLifetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_PersonalAccessTokenValidationError{
+					field:  "MaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_PersonalAccessTokenValidationError{
+					field:  "MaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxLifetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_PersonalAccessTokenValidationError{
+				field:  "MaxLifetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MetricsMultiError(errors)
+		return App_PersonalAccessTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsMultiError is an error wrapping multiple validation errors returned
-// by Metrics.ValidateAll() if the designated constraints aren't met.
-type MetricsMultiError []error
+// App_PersonalAccessTokenMultiError is an error wrapping multiple validation
+// errors returned by App_PersonalAccessToken.ValidateAll() if the designated
+// constraints aren't met.
+type App_PersonalAccessTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsMultiError) Error() string {
+func (m App_PersonalAccessTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2708,11 +6534,11 @@ func (m MetricsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsMultiError) AllErrors() []error { return m }
+func (m App_PersonalAccessTokenMultiError) AllErrors() []error { return m }
 
-// MetricsValidationError is the validation error returned by Metrics.Validate
-// if the designated constraints aren't met.
-type MetricsValidationError struct {
+// App_PersonalAccessTokenValidationError is the validation error returned by
+// App_PersonalAccessToken.Validate if the designated constraints aren't met.
+type App_PersonalAccessTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2720,22 +6546,24 @@ type MetricsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsValidationError) Field() string { return e.field }
+func (e App_PersonalAccessTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsValidationError) Reason() string { return e.reason }
+func (e App_PersonalAccessTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsValidationError) Cause() error { return e.cause }
+func (e App_PersonalAccessTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsValidationError) Key() bool { return e.key }
+func (e App_PersonalAccessTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsValidationError) ErrorName() string { return "MetricsValidationError" }
+func (e App_PersonalAccessTokenValidationError) ErrorName() string {
+	return "App_PersonalAccessTokenValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsValidationError) Error() string {
+func (e App_PersonalAccessTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2747,14 +6575,14 @@ func (e MetricsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetrics.%s: %s%s",
+		"invalid %sApp_PersonalAccessToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsValidationError{}
+var _ error = App_PersonalAccessTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -2762,115 +6590,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsValidationError{}
+} = App_PersonalAccessTokenValidationError{}
 
-// Validate checks the field values on Server_HTTP with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Rbac with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_HTTP) Validate() error {
+func (m *App_Rbac) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_HTTP with the rules defined in
+// ValidateAll checks the field values on App_Rbac with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_HTTPMultiError, or
-// nil if none found.
-func (m *Server_HTTP) ValidateAll() error {
+// result is a list of violation errors wrapped in App_RbacMultiError, or nil
+// if none found.
+func (m *App_Rbac) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_HTTP) validate(all bool) error {
+func (m *App_Rbac) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
-
-	// no validation rules for Addr
-
-	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Timeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetTls()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Tls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, App_RbacValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, App_RbacValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Tls",
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return App_RbacValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Tls",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if all {
-		switch v := interface{}(m.GetCors()).(type) {
+		switch v := interface{}(m.GetReloadInterval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Cors",
+				errors = append(errors, App_RbacValidationError{
+					field:  "ReloadInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_HTTPValidationError{
-					field:  "Cors",
+				errors = append(errors, App_RbacValidationError{
+					field:  "ReloadInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReloadInterval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Server_HTTPValidationError{
-				field:  "Cors",
+			return App_RbacValidationError{
+				field:  "ReloadInterval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2878,18 +6678,18 @@ func (m *Server_HTTP) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return Server_HTTPMultiError(errors)
+		return App_RbacMultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_HTTPMultiError is an error wrapping multiple validation errors
-// returned by Server_HTTP.ValidateAll() if the designated constraints aren't met.
-type Server_HTTPMultiError []error
+// App_RbacMultiError is an error wrapping multiple validation errors returned
+// by App_Rbac.ValidateAll() if the designated constraints aren't met.
+type App_RbacMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_HTTPMultiError) Error() string {
+func (m App_RbacMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2898,11 +6698,11 @@ func (m Server_HTTPMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_HTTPMultiError) AllErrors() []error { return m }
+func (m App_RbacMultiError) AllErrors() []error { return m }
 
-// Server_HTTPValidationError is the validation error returned by
-// Server_HTTP.Validate if the designated constraints aren't met.
-type Server_HTTPValidationError struct {
+// App_RbacValidationError is the validation error returned by
+// App_Rbac.Validate if the designated constraints aren't met.
+type App_RbacValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2910,22 +6710,22 @@ type Server_HTTPValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_HTTPValidationError) Field() string { return e.field }
+func (e App_RbacValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_HTTPValidationError) Reason() string { return e.reason }
+func (e App_RbacValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_HTTPValidationError) Cause() error { return e.cause }
+func (e App_RbacValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_HTTPValidationError) Key() bool { return e.key }
+func (e App_RbacValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_HTTPValidationError) ErrorName() string { return "Server_HTTPValidationError" }
+func (e App_RbacValidationError) ErrorName() string { return "App_RbacValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_HTTPValidationError) Error() string {
+func (e App_RbacValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2937,14 +6737,14 @@ func (e Server_HTTPValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_HTTP.%s: %s%s",
+		"invalid %sApp_Rbac.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_HTTPValidationError{}
+var _ error = App_RbacValidationError{}
 
 var _ interface {
 	Field() string
@@ -2952,105 +6752,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_HTTPValidationError{}
+} = App_RbacValidationError{}
 
-// Validate checks the field values on Server_GRPC with the rules defined in
+// Validate checks the field values on App_Workspace with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Server_GRPC) Validate() error {
+func (m *App_Workspace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Server_GRPC with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Server_GRPCMultiError, or
+// ValidateAll checks the field values on App_Workspace with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_WorkspaceMultiError, or
 // nil if none found.
-func (m *Server_GRPC) ValidateAll() error {
+func (m *App_Workspace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Server_GRPC) validate(all bool) error {
+func (m *App_Workspace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
-
-	// no validation rules for Addr
-
 	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
+		switch v := interface{}(m.GetInvitationTtl()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
+				errors = append(errors, App_WorkspaceValidationError{
+					field:  "InvitationTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Timeout",
+				errors = append(errors, App_WorkspaceValidationError{
+					field:  "InvitationTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInvitationTtl()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Server_GRPCValidationError{
-				field:  "Timeout",
+			return App_WorkspaceValidationError{
+				field:  "InvitationTtl",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetTls()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Tls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Server_GRPCValidationError{
-					field:  "Tls",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Server_GRPCValidationError{
-				field:  "Tls",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for InvitationUrl
 
 	if len(errors) > 0 {
-		return Server_GRPCMultiError(errors)
+		return App_WorkspaceMultiError(errors)
 	}
 
 	return nil
 }
 
-// Server_GRPCMultiError is an error wrapping multiple validation errors
-// returned by Server_GRPC.ValidateAll() if the designated constraints aren't met.
-type Server_GRPCMultiError []error
+// App_WorkspaceMultiError is an error wrapping multiple validation errors
+// returned by App_Workspace.ValidateAll() if the designated constraints
+// aren't met.
+type App_WorkspaceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Server_GRPCMultiError) Error() string {
+func (m App_WorkspaceMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3059,11 +6829,11 @@ func (m Server_GRPCMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Server_GRPCMultiError) AllErrors() []error { return m }
+func (m App_WorkspaceMultiError) AllErrors() []error { return m }
 
-// Server_GRPCValidationError is the validation error returned by
-// Server_GRPC.Validate if the designated constraints aren't met.
-type Server_GRPCValidationError struct {
+// App_WorkspaceValidationError is the validation error returned by
+// App_Workspace.Validate if the designated constraints aren't met.
+type App_WorkspaceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3071,22 +6841,22 @@ type Server_GRPCValidationError struct {
 }
 
 // Field function returns field value.
-func (e Server_GRPCValidationError) Field() string { return e.field }
+func (e App_WorkspaceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Server_GRPCValidationError) Reason() string { return e.reason }
+func (e App_WorkspaceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Server_GRPCValidationError) Cause() error { return e.cause }
+func (e App_WorkspaceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Server_GRPCValidationError) Key() bool { return e.key }
+func (e App_WorkspaceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Server_GRPCValidationError) ErrorName() string { return "Server_GRPCValidationError" }
+func (e App_WorkspaceValidationError) ErrorName() string { return "App_WorkspaceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Server_GRPCValidationError) Error() string {
+func (e App_WorkspaceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3098,14 +6868,14 @@ func (e Server_GRPCValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServer_GRPC.%s: %s%s",
+		"invalid %sApp_Workspace.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Server_GRPCValidationError{}
+var _ error = App_WorkspaceValidationError{}
 
 var _ interface {
 	Field() string
@@ -3113,55 +6883,154 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Server_GRPCValidationError{}
+} = App_WorkspaceValidationError{}
 
-// Validate checks the field values on Client_GRPC with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Signup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Client_GRPC) Validate() error {
+func (m *App_Signup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Client_GRPC with the rules defined in
+// ValidateAll checks the field values on App_Signup with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Client_GRPCMultiError, or
+// result is a list of violation errors wrapped in App_SignupMultiError, or
 // nil if none found.
-func (m *Client_GRPC) ValidateAll() error {
+func (m *App_Signup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Client_GRPC) validate(all bool) error {
+func (m *App_Signup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Endpoint
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return App_SignupMultiError(errors)
+	}
+
+	return nil
+}
+
+// App_SignupMultiError is an error wrapping multiple validation errors
+// returned by App_Signup.ValidateAll() if the designated constraints aren't met.
+type App_SignupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m App_SignupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m App_SignupMultiError) AllErrors() []error { return m }
+
+// App_SignupValidationError is the validation error returned by
+// App_Signup.Validate if the designated constraints aren't met.
+type App_SignupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e App_SignupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e App_SignupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e App_SignupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e App_SignupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e App_SignupValidationError) ErrorName() string { return "App_SignupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e App_SignupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp_Signup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = App_SignupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = App_SignupValidationError{}
+
+// Validate checks the field values on App_Impersonation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *App_Impersonation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App_Impersonation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_ImpersonationMultiError, or nil if none found.
+func (m *App_Impersonation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App_Impersonation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTls()).(type) {
+		switch v := interface{}(m.GetTokenTtl()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Client_GRPCValidationError{
-					field:  "Tls",
+				errors = append(errors, App_ImpersonationValidationError{
+					field:  "TokenTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Client_GRPCValidationError{
-					field:  "Tls",
+				errors = append(errors, App_ImpersonationValidationError{
+					field:  "TokenTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTokenTtl()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Client_GRPCValidationError{
-				field:  "Tls",
+			return App_ImpersonationValidationError{
+				field:  "TokenTtl",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3169,18 +7038,19 @@ func (m *Client_GRPC) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return Client_GRPCMultiError(errors)
+		return App_ImpersonationMultiError(errors)
 	}
 
 	return nil
 }
 
-// Client_GRPCMultiError is an error wrapping multiple validation errors
-// returned by Client_GRPC.ValidateAll() if the designated constraints aren't met.
-type Client_GRPCMultiError []error
+// App_ImpersonationMultiError is an error wrapping multiple validation errors
+// returned by App_Impersonation.ValidateAll() if the designated constraints
+// aren't met.
+type App_ImpersonationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Client_GRPCMultiError) Error() string {
+func (m App_ImpersonationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3189,11 +7059,11 @@ func (m Client_GRPCMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Client_GRPCMultiError) AllErrors() []error { return m }
+func (m App_ImpersonationMultiError) AllErrors() []error { return m }
 
-// Client_GRPCValidationError is the validation error returned by
-// Client_GRPC.Validate if the designated constraints aren't met.
-type Client_GRPCValidationError struct {
+// App_ImpersonationValidationError is the validation error returned by
+// App_Impersonation.Validate if the designated constraints aren't met.
+type App_ImpersonationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3201,22 +7071,24 @@ type Client_GRPCValidationError struct {
 }
 
 // Field function returns field value.
-func (e Client_GRPCValidationError) Field() string { return e.field }
+func (e App_ImpersonationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Client_GRPCValidationError) Reason() string { return e.reason }
+func (e App_ImpersonationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Client_GRPCValidationError) Cause() error { return e.cause }
+func (e App_ImpersonationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Client_GRPCValidationError) Key() bool { return e.key }
+func (e App_ImpersonationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Client_GRPCValidationError) ErrorName() string { return "Client_GRPCValidationError" }
+func (e App_ImpersonationValidationError) ErrorName() string {
+	return "App_ImpersonationValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Client_GRPCValidationError) Error() string {
+func (e App_ImpersonationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3228,14 +7100,14 @@ func (e Client_GRPCValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClient_GRPC.%s: %s%s",
+		"invalid %sApp_Impersonation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Client_GRPCValidationError{}
+var _ error = App_ImpersonationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3243,48 +7115,130 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Client_GRPCValidationError{}
+} = App_ImpersonationValidationError{}
 
-// Validate checks the field values on Data_Database with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Ldap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Data_Database) Validate() error {
+func (m *App_Ldap) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Database with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_DatabaseMultiError, or
-// nil if none found.
-func (m *Data_Database) ValidateAll() error {
+// ValidateAll checks the field values on App_Ldap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_LdapMultiError, or nil
+// if none found.
+func (m *App_Ldap) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Database) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *App_Ldap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for StartTls
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for BindDn
+
+	// no validation rules for BindPassword
+
+	// no validation rules for BaseDn
+
+	// no validation rules for UserFilter
+
+	// no validation rules for IdAttribute
+
+	// no validation rules for NameAttribute
+
+	// no validation rules for EmailAttribute
+
+	// no validation rules for GroupAttribute
+
+	for idx, item := range m.GetGroupRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, App_LdapValidationError{
+						field:  fmt.Sprintf("GroupRoles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, App_LdapValidationError{
+						field:  fmt.Sprintf("GroupRoles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return App_LdapValidationError{
+					field:  fmt.Sprintf("GroupRoles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for Driver
+	// no validation rules for LinkByEmail
 
-	// no validation rules for Source
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_LdapValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, App_LdapValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_LdapValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return Data_DatabaseMultiError(errors)
+		return App_LdapMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_DatabaseMultiError is an error wrapping multiple validation errors
-// returned by Data_Database.ValidateAll() if the designated constraints
-// aren't met.
-type Data_DatabaseMultiError []error
+// App_LdapMultiError is an error wrapping multiple validation errors returned
+// by App_Ldap.ValidateAll() if the designated constraints aren't met.
+type App_LdapMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_DatabaseMultiError) Error() string {
+func (m App_LdapMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3293,11 +7247,11 @@ func (m Data_DatabaseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_DatabaseMultiError) AllErrors() []error { return m }
+func (m App_LdapMultiError) AllErrors() []error { return m }
 
-// Data_DatabaseValidationError is the validation error returned by
-// Data_Database.Validate if the designated constraints aren't met.
-type Data_DatabaseValidationError struct {
+// App_LdapValidationError is the validation error returned by
+// App_Ldap.Validate if the designated constraints aren't met.
+type App_LdapValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3305,22 +7259,22 @@ type Data_DatabaseValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_DatabaseValidationError) Field() string { return e.field }
+func (e App_LdapValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_DatabaseValidationError) Reason() string { return e.reason }
+func (e App_LdapValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_DatabaseValidationError) Cause() error { return e.cause }
+func (e App_LdapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_DatabaseValidationError) Key() bool { return e.key }
+func (e App_LdapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_DatabaseValidationError) ErrorName() string { return "Data_DatabaseValidationError" }
+func (e App_LdapValidationError) ErrorName() string { return "App_LdapValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Data_DatabaseValidationError) Error() string {
+func (e App_LdapValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3332,14 +7286,14 @@ func (e Data_DatabaseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Database.%s: %s%s",
+		"invalid %sApp_Ldap.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_DatabaseValidationError{}
+var _ error = App_LdapValidationError{}
 
 var _ interface {
 	Field() string
@@ -3347,140 +7301,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_DatabaseValidationError{}
+} = App_LdapValidationError{}
 
-// Validate checks the field values on Data_Redis with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Data_Redis) Validate() error {
+// Validate checks the field values on App_Jwt_SigningKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *App_Jwt_SigningKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Redis with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_RedisMultiError, or
-// nil if none found.
-func (m *Data_Redis) ValidateAll() error {
+// ValidateAll checks the field values on App_Jwt_SigningKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_Jwt_SigningKeyMultiError, or nil if none found.
+func (m *App_Jwt_SigningKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Redis) validate(all bool) error {
+func (m *App_Jwt_SigningKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Network
-
-	// no validation rules for Addr
-
-	// no validation rules for UserName
-
-	// no validation rules for Password
-
-	// no validation rules for Db
-
-	if all {
-		switch v := interface{}(m.GetDialTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "DialTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "DialTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDialTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_RedisValidationError{
-				field:  "DialTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Kid
 
-	if all {
-		switch v := interface{}(m.GetReadTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "ReadTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "ReadTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetReadTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_RedisValidationError{
-				field:  "ReadTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for KeyFile
 
-	if all {
-		switch v := interface{}(m.GetWriteTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "WriteTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_RedisValidationError{
-					field:  "WriteTimeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWriteTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_RedisValidationError{
-				field:  "WriteTimeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Key
 
 	if len(errors) > 0 {
-		return Data_RedisMultiError(errors)
+		return App_Jwt_SigningKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_RedisMultiError is an error wrapping multiple validation errors
-// returned by Data_Redis.ValidateAll() if the designated constraints aren't met.
-type Data_RedisMultiError []error
+// App_Jwt_SigningKeyMultiError is an error wrapping multiple validation errors
+// returned by App_Jwt_SigningKey.ValidateAll() if the designated constraints
+// aren't met.
+type App_Jwt_SigningKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_RedisMultiError) Error() string {
+func (m App_Jwt_SigningKeyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3489,11 +7353,11 @@ func (m Data_RedisMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_RedisMultiError) AllErrors() []error { return m }
+func (m App_Jwt_SigningKeyMultiError) AllErrors() []error { return m }
 
-// Data_RedisValidationError is the validation error returned by
-// Data_Redis.Validate if the designated constraints aren't met.
-type Data_RedisValidationError struct {
+// App_Jwt_SigningKeyValidationError is the validation error returned by
+// App_Jwt_SigningKey.Validate if the designated constraints aren't met.
+type App_Jwt_SigningKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3501,22 +7365,24 @@ type Data_RedisValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_RedisValidationError) Field() string { return e.field }
+func (e App_Jwt_SigningKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_RedisValidationError) Reason() string { return e.reason }
+func (e App_Jwt_SigningKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_RedisValidationError) Cause() error { return e.cause }
+func (e App_Jwt_SigningKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_RedisValidationError) Key() bool { return e.key }
+func (e App_Jwt_SigningKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_RedisValidationError) ErrorName() string { return "Data_RedisValidationError" }
+func (e App_Jwt_SigningKeyValidationError) ErrorName() string {
+	return "App_Jwt_SigningKeyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Data_RedisValidationError) Error() string {
+func (e App_Jwt_SigningKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3528,14 +7394,14 @@ func (e Data_RedisValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Redis.%s: %s%s",
+		"invalid %sApp_Jwt_SigningKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_RedisValidationError{}
+var _ error = App_Jwt_SigningKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3543,111 +7409,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_RedisValidationError{}
+} = App_Jwt_SigningKeyValidationError{}
 
-// Validate checks the field values on Data_Client with the rules defined in
+// Validate checks the field values on App_Mail_SMTP with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Data_Client) Validate() error {
+func (m *App_Mail_SMTP) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Client with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Data_ClientMultiError, or
+// ValidateAll checks the field values on App_Mail_SMTP with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_Mail_SMTPMultiError, or
 // nil if none found.
-func (m *Data_Client) ValidateAll() error {
+func (m *App_Mail_SMTP) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Client) validate(all bool) error {
+func (m *App_Mail_SMTP) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetGrpc() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, Data_ClientValidationError{
-						field:  fmt.Sprintf("Grpc[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, Data_ClientValidationError{
-						field:  fmt.Sprintf("Grpc[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return Data_ClientValidationError{
-					field:  fmt.Sprintf("Grpc[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetHttp() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, Data_ClientValidationError{
-						field:  fmt.Sprintf("Http[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, Data_ClientValidationError{
-						field:  fmt.Sprintf("Http[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Host
+
+	// no validation rules for Port
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for ImplicitTls
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, App_Mail_SMTPValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return Data_ClientValidationError{
-					field:  fmt.Sprintf("Http[%v]", idx),
+				errors = append(errors, App_Mail_SMTPValidationError{
+					field:  "Timeout",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return App_Mail_SMTPValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return Data_ClientMultiError(errors)
+		return App_Mail_SMTPMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_ClientMultiError is an error wrapping multiple validation errors
-// returned by Data_Client.ValidateAll() if the designated constraints aren't met.
-type Data_ClientMultiError []error
+// App_Mail_SMTPMultiError is an error wrapping multiple validation errors
+// returned by App_Mail_SMTP.ValidateAll() if the designated constraints
+// aren't met.
+type App_Mail_SMTPMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_ClientMultiError) Error() string {
+func (m App_Mail_SMTPMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3656,11 +7494,11 @@ func (m Data_ClientMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_ClientMultiError) AllErrors() []error { return m }
+func (m App_Mail_SMTPMultiError) AllErrors() []error { return m }
 
-// Data_ClientValidationError is the validation error returned by
-// Data_Client.Validate if the designated constraints aren't met.
-type Data_ClientValidationError struct {
+// App_Mail_SMTPValidationError is the validation error returned by
+// App_Mail_SMTP.Validate if the designated constraints aren't met.
+type App_Mail_SMTPValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3668,22 +7506,22 @@ type Data_ClientValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_ClientValidationError) Field() string { return e.field }
+func (e App_Mail_SMTPValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_ClientValidationError) Reason() string { return e.reason }
+func (e App_Mail_SMTPValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_ClientValidationError) Cause() error { return e.cause }
+func (e App_Mail_SMTPValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_ClientValidationError) Key() bool { return e.key }
+func (e App_Mail_SMTPValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_ClientValidationError) ErrorName() string { return "Data_ClientValidationError" }
+func (e App_Mail_SMTPValidationError) ErrorName() string { return "App_Mail_SMTPValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Data_ClientValidationError) Error() string {
+func (e App_Mail_SMTPValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3695,14 +7533,14 @@ func (e Data_ClientValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Client.%s: %s%s",
+		"invalid %sApp_Mail_SMTP.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_ClientValidationError{}
+var _ error = App_Mail_SMTPValidationError{}
 
 var _ interface {
 	Field() string
@@ -3710,77 +7548,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_ClientValidationError{}
+} = App_Mail_SMTPValidationError{}
 
-// Validate checks the field values on Data_Client_HTTP with the rules defined
+// Validate checks the field values on App_Oidc_Provider with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *Data_Client_HTTP) Validate() error {
+func (m *App_Oidc_Provider) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Client_HTTP with the rules
+// ValidateAll checks the field values on App_Oidc_Provider with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Data_Client_HTTPMultiError, or nil if none found.
-func (m *Data_Client_HTTP) ValidateAll() error {
+// App_Oidc_ProviderMultiError, or nil if none found.
+func (m *App_Oidc_Provider) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Client_HTTP) validate(all bool) error {
+func (m *App_Oidc_Provider) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceName
+	// no validation rules for Name
 
-	// no validation rules for Endpoint
+	// no validation rules for DisplayName
 
-	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_Client_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_Client_HTTPValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_Client_HTTPValidationError{
-				field:  "Timeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Issuer
+
+	// no validation rules for ClientId
+
+	// no validation rules for ClientSecret
+
+	// no validation rules for RedirectUrl
 
 	if len(errors) > 0 {
-		return Data_Client_HTTPMultiError(errors)
+		return App_Oidc_ProviderMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_Client_HTTPMultiError is an error wrapping multiple validation errors
-// returned by Data_Client_HTTP.ValidateAll() if the designated constraints
+// App_Oidc_ProviderMultiError is an error wrapping multiple validation errors
+// returned by App_Oidc_Provider.ValidateAll() if the designated constraints
 // aren't met.
-type Data_Client_HTTPMultiError []error
+type App_Oidc_ProviderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_Client_HTTPMultiError) Error() string {
+func (m App_Oidc_ProviderMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3789,11 +7606,11 @@ func (m Data_Client_HTTPMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_Client_HTTPMultiError) AllErrors() []error { return m }
+func (m App_Oidc_ProviderMultiError) AllErrors() []error { return m }
 
-// Data_Client_HTTPValidationError is the validation error returned by
-// Data_Client_HTTP.Validate if the designated constraints aren't met.
-type Data_Client_HTTPValidationError struct {
+// App_Oidc_ProviderValidationError is the validation error returned by
+// App_Oidc_Provider.Validate if the designated constraints aren't met.
+type App_Oidc_ProviderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3801,22 +7618,24 @@ type Data_Client_HTTPValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_Client_HTTPValidationError) Field() string { return e.field }
+func (e App_Oidc_ProviderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_Client_HTTPValidationError) Reason() string { return e.reason }
+func (e App_Oidc_ProviderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_Client_HTTPValidationError) Cause() error { return e.cause }
+func (e App_Oidc_ProviderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_Client_HTTPValidationError) Key() bool { return e.key }
+func (e App_Oidc_ProviderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_Client_HTTPValidationError) ErrorName() string { return "Data_Client_HTTPValidationError" }
+func (e App_Oidc_ProviderValidationError) ErrorName() string {
+	return "App_Oidc_ProviderValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Data_Client_HTTPValidationError) Error() string {
+func (e App_Oidc_ProviderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3828,14 +7647,14 @@ func (e Data_Client_HTTPValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Client_HTTP.%s: %s%s",
+		"invalid %sApp_Oidc_Provider.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_Client_HTTPValidationError{}
+var _ error = App_Oidc_ProviderValidationError{}
 
 var _ interface {
 	Field() string
@@ -3843,77 +7662,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_Client_HTTPValidationError{}
+} = App_Oidc_ProviderValidationError{}
 
-// Validate checks the field values on Data_Client_GRPC with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *Data_Client_GRPC) Validate() error {
+// Validate checks the field values on App_PasswordPolicy_Argon2Id with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *App_PasswordPolicy_Argon2Id) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Data_Client_GRPC with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on App_PasswordPolicy_Argon2Id with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Data_Client_GRPCMultiError, or nil if none found.
-func (m *Data_Client_GRPC) ValidateAll() error {
+// App_PasswordPolicy_Argon2IdMultiError, or nil if none found.
+func (m *App_PasswordPolicy_Argon2Id) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Data_Client_GRPC) validate(all bool) error {
+func (m *App_PasswordPolicy_Argon2Id) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceName
+	// no validation rules for Memory
 
-	// no validation rules for Endpoint
+	// no validation rules for Iterations
 
-	if all {
-		switch v := interface{}(m.GetTimeout()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Data_Client_GRPCValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Data_Client_GRPCValidationError{
-					field:  "Timeout",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Data_Client_GRPCValidationError{
-				field:  "Timeout",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Parallelism
 
 	if len(errors) > 0 {
-		return Data_Client_GRPCMultiError(errors)
+		return App_PasswordPolicy_Argon2IdMultiError(errors)
 	}
 
 	return nil
 }
 
-// Data_Client_GRPCMultiError is an error wrapping multiple validation errors
-// returned by Data_Client_GRPC.ValidateAll() if the designated constraints
-// aren't met.
-type Data_Client_GRPCMultiError []error
+// App_PasswordPolicy_Argon2IdMultiError is an error wrapping multiple
+// validation errors returned by App_PasswordPolicy_Argon2Id.ValidateAll() if
+// the designated constraints aren't met.
+type App_PasswordPolicy_Argon2IdMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Data_Client_GRPCMultiError) Error() string {
+func (m App_PasswordPolicy_Argon2IdMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3922,11 +7714,12 @@ func (m Data_Client_GRPCMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Data_Client_GRPCMultiError) AllErrors() []error { return m }
+func (m App_PasswordPolicy_Argon2IdMultiError) AllErrors() []error { return m }
 
-// Data_Client_GRPCValidationError is the validation error returned by
-// Data_Client_GRPC.Validate if the designated constraints aren't met.
-type Data_Client_GRPCValidationError struct {
+// App_PasswordPolicy_Argon2IdValidationError is the validation error returned
+// by App_PasswordPolicy_Argon2Id.Validate if the designated constraints
+// aren't met.
+type App_PasswordPolicy_Argon2IdValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3934,22 +7727,24 @@ type Data_Client_GRPCValidationError struct {
 }
 
 // Field function returns field value.
-func (e Data_Client_GRPCValidationError) Field() string { return e.field }
+func (e App_PasswordPolicy_Argon2IdValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Data_Client_GRPCValidationError) Reason() string { return e.reason }
+func (e App_PasswordPolicy_Argon2IdValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Data_Client_GRPCValidationError) Cause() error { return e.cause }
+func (e App_PasswordPolicy_Argon2IdValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Data_Client_GRPCValidationError) Key() bool { return e.key }
+func (e App_PasswordPolicy_Argon2IdValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Data_Client_GRPCValidationError) ErrorName() string { return "Data_Client_GRPCValidationError" }
+func (e App_PasswordPolicy_Argon2IdValidationError) ErrorName() string {
+	return "App_PasswordPolicy_Argon2IdValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Data_Client_GRPCValidationError) Error() string {
+func (e App_PasswordPolicy_Argon2IdValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3961,14 +7756,14 @@ func (e Data_Client_GRPCValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sData_Client_GRPC.%s: %s%s",
+		"invalid %sApp_PasswordPolicy_Argon2Id.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Data_Client_GRPCValidationError{}
+var _ error = App_PasswordPolicy_Argon2IdValidationError{}
 
 var _ interface {
 	Field() string
@@ -3976,54 +7771,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Data_Client_GRPCValidationError{}
+} = App_PasswordPolicy_Argon2IdValidationError{}
 
-// Validate checks the field values on App_Jwt with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on App_Rbac_Role with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *App_Jwt) Validate() error {
+func (m *App_Rbac_Role) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on App_Jwt with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in App_JwtMultiError, or nil if none found.
-func (m *App_Jwt) ValidateAll() error {
+// ValidateAll checks the field values on App_Rbac_Role with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in App_Rbac_RoleMultiError, or
+// nil if none found.
+func (m *App_Rbac_Role) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *App_Jwt) validate(all bool) error {
+func (m *App_Rbac_Role) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccessSecret
-
-	// no validation rules for RefreshSecret
-
-	// no validation rules for AccessExpire
-
-	// no validation rules for RefreshExpire
-
-	// no validation rules for Issuer
-
-	// no validation rules for Audience
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return App_JwtMultiError(errors)
+		return App_Rbac_RoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// App_JwtMultiError is an error wrapping multiple validation errors returned
-// by App_Jwt.ValidateAll() if the designated constraints aren't met.
-type App_JwtMultiError []error
+// App_Rbac_RoleMultiError is an error wrapping multiple validation errors
+// returned by App_Rbac_Role.ValidateAll() if the designated constraints
+// aren't met.
+type App_Rbac_RoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m App_JwtMultiError) Error() string {
+func (m App_Rbac_RoleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4032,11 +7819,11 @@ func (m App_JwtMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m App_JwtMultiError) AllErrors() []error { return m }
+func (m App_Rbac_RoleMultiError) AllErrors() []error { return m }
 
-// App_JwtValidationError is the validation error returned by App_Jwt.Validate
-// if the designated constraints aren't met.
-type App_JwtValidationError struct {
+// App_Rbac_RoleValidationError is the validation error returned by
+// App_Rbac_Role.Validate if the designated constraints aren't met.
+type App_Rbac_RoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4044,22 +7831,22 @@ type App_JwtValidationError struct {
 }
 
 // Field function returns field value.
-func (e App_JwtValidationError) Field() string { return e.field }
+func (e App_Rbac_RoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e App_JwtValidationError) Reason() string { return e.reason }
+func (e App_Rbac_RoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e App_JwtValidationError) Cause() error { return e.cause }
+func (e App_Rbac_RoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e App_JwtValidationError) Key() bool { return e.key }
+func (e App_Rbac_RoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e App_JwtValidationError) ErrorName() string { return "App_JwtValidationError" }
+func (e App_Rbac_RoleValidationError) ErrorName() string { return "App_Rbac_RoleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e App_JwtValidationError) Error() string {
+func (e App_Rbac_RoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4071,14 +7858,14 @@ func (e App_JwtValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApp_Jwt.%s: %s%s",
+		"invalid %sApp_Rbac_Role.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = App_JwtValidationError{}
+var _ error = App_Rbac_RoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -4086,54 +7873,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = App_JwtValidationError{}
+} = App_Rbac_RoleValidationError{}
 
-// Validate checks the field values on App_Log with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *App_Log) Validate() error {
+// Validate checks the field values on App_Ldap_GroupRole with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *App_Ldap_GroupRole) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on App_Log with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in App_LogMultiError, or nil if none found.
-func (m *App_Log) ValidateAll() error {
+// ValidateAll checks the field values on App_Ldap_GroupRole with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// App_Ldap_GroupRoleMultiError, or nil if none found.
+func (m *App_Ldap_GroupRole) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *App_Log) validate(all bool) error {
+func (m *App_Ldap_GroupRole) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Level
-
-	// no validation rules for Filename
-
-	// no validation rules for MaxSize
-
-	// no validation rules for MaxBackups
-
-	// no validation rules for MaxAge
+	// no validation rules for Group
 
-	// no validation rules for Compress
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return App_LogMultiError(errors)
+		return App_Ldap_GroupRoleMultiError(errors)
 	}
 
 	return nil
 }
 
-// App_LogMultiError is an error wrapping multiple validation errors returned
-// by App_Log.ValidateAll() if the designated constraints aren't met.
-type App_LogMultiError []error
+// App_Ldap_GroupRoleMultiError is an error wrapping multiple validation errors
+// returned by App_Ldap_GroupRole.ValidateAll() if the designated constraints
+// aren't met.
+type App_Ldap_GroupRoleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m App_LogMultiError) Error() string {
+func (m App_Ldap_GroupRoleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4142,11 +7923,11 @@ func (m App_LogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m App_LogMultiError) AllErrors() []error { return m }
+func (m App_Ldap_GroupRoleMultiError) AllErrors() []error { return m }
 
-// App_LogValidationError is the validation error returned by App_Log.Validate
-// if the designated constraints aren't met.
-type App_LogValidationError struct {
+// App_Ldap_GroupRoleValidationError is the validation error returned by
+// App_Ldap_GroupRole.Validate if the designated constraints aren't met.
+type App_Ldap_GroupRoleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4154,22 +7935,24 @@ type App_LogValidationError struct {
 }
 
 // Field function returns field value.
-func (e App_LogValidationError) Field() string { return e.field }
+func (e App_Ldap_GroupRoleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e App_LogValidationError) Reason() string { return e.reason }
+func (e App_Ldap_GroupRoleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e App_LogValidationError) Cause() error { return e.cause }
+func (e App_Ldap_GroupRoleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e App_LogValidationError) Key() bool { return e.key }
+func (e App_Ldap_GroupRoleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e App_LogValidationError) ErrorName() string { return "App_LogValidationError" }
+func (e App_Ldap_GroupRoleValidationError) ErrorName() string {
+	return "App_Ldap_GroupRoleValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e App_LogValidationError) Error() string {
+func (e App_Ldap_GroupRoleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4181,14 +7964,14 @@ func (e App_LogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApp_Log.%s: %s%s",
+		"invalid %sApp_Ldap_GroupRole.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = App_LogValidationError{}
+var _ error = App_Ldap_GroupRoleValidationError{}
 
 var _ interface {
 	Field() string
@@ -4196,4 +7979,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = App_LogValidationError{}
+} = App_Ldap_GroupRoleValidationError{}
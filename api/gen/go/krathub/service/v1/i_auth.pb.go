@@ -26,39 +26,133 @@ var File_krathub_service_v1_i_auth_proto protoreflect.FileDescriptor
 
 const file_krathub_service_v1_i_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x1fkrathub/service/v1/i_auth.proto\x12\x12krathub.service.v1\x1a\x1aauth/service/v1/auth.proto\x1a\x1cgoogle/api/annotations.proto2\x9d\x04\n" +
+	"\x1fkrathub/service/v1/i_auth.proto\x12\x12krathub.service.v1\x1a\x1aauth/service/v1/auth.proto\x1a\x1cgoogle/api/annotations.proto2\xd5\x18\n" +
 	"\vAuthService\x12\x86\x01\n" +
 	"\rSignupByEmail\x12%.auth.service.v1.SignupByEmailRequest\x1a&.auth.service.v1.SignupByEmailResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/auth/signup/using-email\x12\x9d\x01\n" +
 	"\x14LoginByEmailPassword\x12,.auth.service.v1.LoginByEmailPasswordRequest\x1a-.auth.service.v1.LoginByEmailPasswordResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/auth/login/email-password\x12~\n" +
 	"\fRefreshToken\x12$.auth.service.v1.RefreshTokenRequest\x1a%.auth.service.v1.RefreshTokenResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/refresh-token\x12e\n" +
-	"\x06Logout\x12\x1e.auth.service.v1.LogoutRequest\x1a\x1f.auth.service.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logoutB\xd7\x01\n" +
+	"\x06Logout\x12\x1e.auth.service.v1.LogoutRequest\x1a\x1f.auth.service.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12v\n" +
+	"\fListSessions\x12$.auth.service.v1.ListSessionsRequest\x1a%.auth.service.v1.ListSessionsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/auth/sessions\x12\x86\x01\n" +
+	"\rRevokeSession\x12%.auth.service.v1.RevokeSessionRequest\x1a&.auth.service.v1.RevokeSessionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/auth/sessions/{session_id}\x12\x9c\x01\n" +
+	"\x13RevokeOtherSessions\x12+.auth.service.v1.RevokeOtherSessionsRequest\x1a,.auth.service.v1.RevokeOtherSessionsResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/auth/sessions/revoke-others\x12r\n" +
+	"\tVerifyMFA\x12!.auth.service.v1.VerifyMFARequest\x1a\".auth.service.v1.VerifyMFAResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/mfa/verify\x12z\n" +
+	"\n" +
+	"EnrollTOTP\x12\".auth.service.v1.EnrollTOTPRequest\x1a#.auth.service.v1.EnrollTOTPResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/mfa/totp/enroll\x12~\n" +
+	"\vConfirmTOTP\x12#.auth.service.v1.ConfirmTOTPRequest\x1a$.auth.service.v1.ConfirmTOTPResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/auth/mfa/totp/confirm\x12~\n" +
+	"\vDisableTOTP\x12#.auth.service.v1.DisableTOTPRequest\x1a$.auth.service.v1.DisableTOTPResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/auth/mfa/totp/disable\x12z\n" +
+	"\vVerifyEmail\x12#.auth.service.v1.VerifyEmailRequest\x1a$.auth.service.v1.VerifyEmailResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/verify\x12\xab\x01\n" +
+	"\x17ResendVerificationEmail\x12/.auth.service.v1.ResendVerificationEmailRequest\x1a0.auth.service.v1.ResendVerificationEmailResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/auth/email/resend-verification\x12\x98\x01\n" +
+	"\x14RequestPasswordReset\x12,.auth.service.v1.RequestPasswordResetRequest\x1a-.auth.service.v1.RequestPasswordResetResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/password/forgot\x12\x82\x01\n" +
+	"\rResetPassword\x12%.auth.service.v1.ResetPasswordRequest\x1a&.auth.service.v1.ResetPasswordResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/password/reset\x12\x8b\x01\n" +
+	"\x11ListOIDCProviders\x12).auth.service.v1.ListOIDCProvidersRequest\x1a*.auth.service.v1.ListOIDCProvidersResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/auth/oidc/providers\x12\x90\x01\n" +
+	"\x0eStartOIDCLogin\x12&.auth.service.v1.StartOIDCLoginRequest\x1a'.auth.service.v1.StartOIDCLoginResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/auth/oidc/{provider}/authorize\x12\x89\x01\n" +
+	"\fOIDCCallback\x12$.auth.service.v1.OIDCCallbackRequest\x1a%.auth.service.v1.OIDCCallbackResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/auth/oidc/{provider}/callback\x12\x8a\x01\n" +
+	"\rStartOIDCLink\x12&.auth.service.v1.StartOIDCLoginRequest\x1a'.auth.service.v1.StartOIDCLoginResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/auth/oidc/{provider}/link\x12~\n" +
+	"\x0eListIdentities\x12&.auth.service.v1.ListIdentitiesRequest\x1a'.auth.service.v1.ListIdentitiesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/auth/identities\x12\x89\x01\n" +
+	"\x0eUnlinkIdentity\x12&.auth.service.v1.UnlinkIdentityRequest\x1a'.auth.service.v1.UnlinkIdentityResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/auth/identities/{provider}\x12\x84\x01\n" +
+	"\vImpersonate\x12#.auth.service.v1.ImpersonateRequest\x1a$.auth.service.v1.ImpersonateResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{user_id}/impersonate\x12\x8e\x01\n" +
+	"\x10EndImpersonation\x12(.auth.service.v1.EndImpersonationRequest\x1a).auth.service.v1.EndImpersonationResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/auth/impersonation/endB\xd7\x01\n" +
 	"\x16com.krathub.service.v1B\n" +
 	"IAuthProtoP\x01ZGgithub.com/ToAtlas/AtlasBackend/api/gen/go/krathub/service/v1;krathubpb\xa2\x02\x03KSX\xaa\x02\x12Krathub.Service.V1\xca\x02\x12Krathub\\Service\\V1\xe2\x02\x1eKrathub\\Service\\V1\\GPBMetadata\xea\x02\x14Krathub::Service::V1b\x06proto3"
 
 var file_krathub_service_v1_i_auth_proto_goTypes = []any{
-	(*v1.SignupByEmailRequest)(nil),         // 0: auth.service.v1.SignupByEmailRequest
-	(*v1.LoginByEmailPasswordRequest)(nil),  // 1: auth.service.v1.LoginByEmailPasswordRequest
-	(*v1.RefreshTokenRequest)(nil),          // 2: auth.service.v1.RefreshTokenRequest
-	(*v1.LogoutRequest)(nil),                // 3: auth.service.v1.LogoutRequest
-	(*v1.SignupByEmailResponse)(nil),        // 4: auth.service.v1.SignupByEmailResponse
-	(*v1.LoginByEmailPasswordResponse)(nil), // 5: auth.service.v1.LoginByEmailPasswordResponse
-	(*v1.RefreshTokenResponse)(nil),         // 6: auth.service.v1.RefreshTokenResponse
-	(*v1.LogoutResponse)(nil),               // 7: auth.service.v1.LogoutResponse
+	(*v1.SignupByEmailRequest)(nil),            // 0: auth.service.v1.SignupByEmailRequest
+	(*v1.LoginByEmailPasswordRequest)(nil),     // 1: auth.service.v1.LoginByEmailPasswordRequest
+	(*v1.RefreshTokenRequest)(nil),             // 2: auth.service.v1.RefreshTokenRequest
+	(*v1.LogoutRequest)(nil),                   // 3: auth.service.v1.LogoutRequest
+	(*v1.ListSessionsRequest)(nil),             // 4: auth.service.v1.ListSessionsRequest
+	(*v1.RevokeSessionRequest)(nil),            // 5: auth.service.v1.RevokeSessionRequest
+	(*v1.RevokeOtherSessionsRequest)(nil),      // 6: auth.service.v1.RevokeOtherSessionsRequest
+	(*v1.VerifyMFARequest)(nil),                // 7: auth.service.v1.VerifyMFARequest
+	(*v1.EnrollTOTPRequest)(nil),               // 8: auth.service.v1.EnrollTOTPRequest
+	(*v1.ConfirmTOTPRequest)(nil),              // 9: auth.service.v1.ConfirmTOTPRequest
+	(*v1.DisableTOTPRequest)(nil),              // 10: auth.service.v1.DisableTOTPRequest
+	(*v1.VerifyEmailRequest)(nil),              // 11: auth.service.v1.VerifyEmailRequest
+	(*v1.ResendVerificationEmailRequest)(nil),  // 12: auth.service.v1.ResendVerificationEmailRequest
+	(*v1.RequestPasswordResetRequest)(nil),     // 13: auth.service.v1.RequestPasswordResetRequest
+	(*v1.ResetPasswordRequest)(nil),            // 14: auth.service.v1.ResetPasswordRequest
+	(*v1.ListOIDCProvidersRequest)(nil),        // 15: auth.service.v1.ListOIDCProvidersRequest
+	(*v1.StartOIDCLoginRequest)(nil),           // 16: auth.service.v1.StartOIDCLoginRequest
+	(*v1.OIDCCallbackRequest)(nil),             // 17: auth.service.v1.OIDCCallbackRequest
+	(*v1.ListIdentitiesRequest)(nil),           // 18: auth.service.v1.ListIdentitiesRequest
+	(*v1.UnlinkIdentityRequest)(nil),           // 19: auth.service.v1.UnlinkIdentityRequest
+	(*v1.ImpersonateRequest)(nil),              // 20: auth.service.v1.ImpersonateRequest
+	(*v1.EndImpersonationRequest)(nil),         // 21: auth.service.v1.EndImpersonationRequest
+	(*v1.SignupByEmailResponse)(nil),           // 22: auth.service.v1.SignupByEmailResponse
+	(*v1.LoginByEmailPasswordResponse)(nil),    // 23: auth.service.v1.LoginByEmailPasswordResponse
+	(*v1.RefreshTokenResponse)(nil),            // 24: auth.service.v1.RefreshTokenResponse
+	(*v1.LogoutResponse)(nil),                  // 25: auth.service.v1.LogoutResponse
+	(*v1.ListSessionsResponse)(nil),            // 26: auth.service.v1.ListSessionsResponse
+	(*v1.RevokeSessionResponse)(nil),           // 27: auth.service.v1.RevokeSessionResponse
+	(*v1.RevokeOtherSessionsResponse)(nil),     // 28: auth.service.v1.RevokeOtherSessionsResponse
+	(*v1.VerifyMFAResponse)(nil),               // 29: auth.service.v1.VerifyMFAResponse
+	(*v1.EnrollTOTPResponse)(nil),              // 30: auth.service.v1.EnrollTOTPResponse
+	(*v1.ConfirmTOTPResponse)(nil),             // 31: auth.service.v1.ConfirmTOTPResponse
+	(*v1.DisableTOTPResponse)(nil),             // 32: auth.service.v1.DisableTOTPResponse
+	(*v1.VerifyEmailResponse)(nil),             // 33: auth.service.v1.VerifyEmailResponse
+	(*v1.ResendVerificationEmailResponse)(nil), // 34: auth.service.v1.ResendVerificationEmailResponse
+	(*v1.RequestPasswordResetResponse)(nil),    // 35: auth.service.v1.RequestPasswordResetResponse
+	(*v1.ResetPasswordResponse)(nil),           // 36: auth.service.v1.ResetPasswordResponse
+	(*v1.ListOIDCProvidersResponse)(nil),       // 37: auth.service.v1.ListOIDCProvidersResponse
+	(*v1.StartOIDCLoginResponse)(nil),          // 38: auth.service.v1.StartOIDCLoginResponse
+	(*v1.OIDCCallbackResponse)(nil),            // 39: auth.service.v1.OIDCCallbackResponse
+	(*v1.ListIdentitiesResponse)(nil),          // 40: auth.service.v1.ListIdentitiesResponse
+	(*v1.UnlinkIdentityResponse)(nil),          // 41: auth.service.v1.UnlinkIdentityResponse
+	(*v1.ImpersonateResponse)(nil),             // 42: auth.service.v1.ImpersonateResponse
+	(*v1.EndImpersonationResponse)(nil),        // 43: auth.service.v1.EndImpersonationResponse
 }
 var file_krathub_service_v1_i_auth_proto_depIdxs = []int32{
-	0, // 0: krathub.service.v1.AuthService.SignupByEmail:input_type -> auth.service.v1.SignupByEmailRequest
-	1, // 1: krathub.service.v1.AuthService.LoginByEmailPassword:input_type -> auth.service.v1.LoginByEmailPasswordRequest
-	2, // 2: krathub.service.v1.AuthService.RefreshToken:input_type -> auth.service.v1.RefreshTokenRequest
-	3, // 3: krathub.service.v1.AuthService.Logout:input_type -> auth.service.v1.LogoutRequest
-	4, // 4: krathub.service.v1.AuthService.SignupByEmail:output_type -> auth.service.v1.SignupByEmailResponse
-	5, // 5: krathub.service.v1.AuthService.LoginByEmailPassword:output_type -> auth.service.v1.LoginByEmailPasswordResponse
-	6, // 6: krathub.service.v1.AuthService.RefreshToken:output_type -> auth.service.v1.RefreshTokenResponse
-	7, // 7: krathub.service.v1.AuthService.Logout:output_type -> auth.service.v1.LogoutResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: krathub.service.v1.AuthService.SignupByEmail:input_type -> auth.service.v1.SignupByEmailRequest
+	1,  // 1: krathub.service.v1.AuthService.LoginByEmailPassword:input_type -> auth.service.v1.LoginByEmailPasswordRequest
+	2,  // 2: krathub.service.v1.AuthService.RefreshToken:input_type -> auth.service.v1.RefreshTokenRequest
+	3,  // 3: krathub.service.v1.AuthService.Logout:input_type -> auth.service.v1.LogoutRequest
+	4,  // 4: krathub.service.v1.AuthService.ListSessions:input_type -> auth.service.v1.ListSessionsRequest
+	5,  // 5: krathub.service.v1.AuthService.RevokeSession:input_type -> auth.service.v1.RevokeSessionRequest
+	6,  // 6: krathub.service.v1.AuthService.RevokeOtherSessions:input_type -> auth.service.v1.RevokeOtherSessionsRequest
+	7,  // 7: krathub.service.v1.AuthService.VerifyMFA:input_type -> auth.service.v1.VerifyMFARequest
+	8,  // 8: krathub.service.v1.AuthService.EnrollTOTP:input_type -> auth.service.v1.EnrollTOTPRequest
+	9,  // 9: krathub.service.v1.AuthService.ConfirmTOTP:input_type -> auth.service.v1.ConfirmTOTPRequest
+	10, // 10: krathub.service.v1.AuthService.DisableTOTP:input_type -> auth.service.v1.DisableTOTPRequest
+	11, // 11: krathub.service.v1.AuthService.VerifyEmail:input_type -> auth.service.v1.VerifyEmailRequest
+	12, // 12: krathub.service.v1.AuthService.ResendVerificationEmail:input_type -> auth.service.v1.ResendVerificationEmailRequest
+	13, // 13: krathub.service.v1.AuthService.RequestPasswordReset:input_type -> auth.service.v1.RequestPasswordResetRequest
+	14, // 14: krathub.service.v1.AuthService.ResetPassword:input_type -> auth.service.v1.ResetPasswordRequest
+	15, // 15: krathub.service.v1.AuthService.ListOIDCProviders:input_type -> auth.service.v1.ListOIDCProvidersRequest
+	16, // 16: krathub.service.v1.AuthService.StartOIDCLogin:input_type -> auth.service.v1.StartOIDCLoginRequest
+	17, // 17: krathub.service.v1.AuthService.OIDCCallback:input_type -> auth.service.v1.OIDCCallbackRequest
+	16, // 18: krathub.service.v1.AuthService.StartOIDCLink:input_type -> auth.service.v1.StartOIDCLoginRequest
+	18, // 19: krathub.service.v1.AuthService.ListIdentities:input_type -> auth.service.v1.ListIdentitiesRequest
+	19, // 20: krathub.service.v1.AuthService.UnlinkIdentity:input_type -> auth.service.v1.UnlinkIdentityRequest
+	20, // 21: krathub.service.v1.AuthService.Impersonate:input_type -> auth.service.v1.ImpersonateRequest
+	21, // 22: krathub.service.v1.AuthService.EndImpersonation:input_type -> auth.service.v1.EndImpersonationRequest
+	22, // 23: krathub.service.v1.AuthService.SignupByEmail:output_type -> auth.service.v1.SignupByEmailResponse
+	23, // 24: krathub.service.v1.AuthService.LoginByEmailPassword:output_type -> auth.service.v1.LoginByEmailPasswordResponse
+	24, // 25: krathub.service.v1.AuthService.RefreshToken:output_type -> auth.service.v1.RefreshTokenResponse
+	25, // 26: krathub.service.v1.AuthService.Logout:output_type -> auth.service.v1.LogoutResponse
+	26, // 27: krathub.service.v1.AuthService.ListSessions:output_type -> auth.service.v1.ListSessionsResponse
+	27, // 28: krathub.service.v1.AuthService.RevokeSession:output_type -> auth.service.v1.RevokeSessionResponse
+	28, // 29: krathub.service.v1.AuthService.RevokeOtherSessions:output_type -> auth.service.v1.RevokeOtherSessionsResponse
+	29, // 30: krathub.service.v1.AuthService.VerifyMFA:output_type -> auth.service.v1.VerifyMFAResponse
+	30, // 31: krathub.service.v1.AuthService.EnrollTOTP:output_type -> auth.service.v1.EnrollTOTPResponse
+	31, // 32: krathub.service.v1.AuthService.ConfirmTOTP:output_type -> auth.service.v1.ConfirmTOTPResponse
+	32, // 33: krathub.service.v1.AuthService.DisableTOTP:output_type -> auth.service.v1.DisableTOTPResponse
+	33, // 34: krathub.service.v1.AuthService.VerifyEmail:output_type -> auth.service.v1.VerifyEmailResponse
+	34, // 35: krathub.service.v1.AuthService.ResendVerificationEmail:output_type -> auth.service.v1.ResendVerificationEmailResponse
+	35, // 36: krathub.service.v1.AuthService.RequestPasswordReset:output_type -> auth.service.v1.RequestPasswordResetResponse
+	36, // 37: krathub.service.v1.AuthService.ResetPassword:output_type -> auth.service.v1.ResetPasswordResponse
+	37, // 38: krathub.service.v1.AuthService.ListOIDCProviders:output_type -> auth.service.v1.ListOIDCProvidersResponse
+	38, // 39: krathub.service.v1.AuthService.StartOIDCLogin:output_type -> auth.service.v1.StartOIDCLoginResponse
+	39, // 40: krathub.service.v1.AuthService.OIDCCallback:output_type -> auth.service.v1.OIDCCallbackResponse
+	38, // 41: krathub.service.v1.AuthService.StartOIDCLink:output_type -> auth.service.v1.StartOIDCLoginResponse
+	40, // 42: krathub.service.v1.AuthService.ListIdentities:output_type -> auth.service.v1.ListIdentitiesResponse
+	41, // 43: krathub.service.v1.AuthService.UnlinkIdentity:output_type -> auth.service.v1.UnlinkIdentityResponse
+	42, // 44: krathub.service.v1.AuthService.Impersonate:output_type -> auth.service.v1.ImpersonateResponse
+	43, // 45: krathub.service.v1.AuthService.EndImpersonation:output_type -> auth.service.v1.EndImpersonationResponse
+	23, // [23:46] is the sub-list for method output_type
+	0,  // [0:23] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_krathub_service_v1_i_auth_proto_init() }
@@ -20,16 +20,54 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceConfirmTOTP = "/krathub.service.v1.AuthService/ConfirmTOTP"
+const OperationAuthServiceDisableTOTP = "/krathub.service.v1.AuthService/DisableTOTP"
+const OperationAuthServiceEndImpersonation = "/krathub.service.v1.AuthService/EndImpersonation"
+const OperationAuthServiceEnrollTOTP = "/krathub.service.v1.AuthService/EnrollTOTP"
+const OperationAuthServiceImpersonate = "/krathub.service.v1.AuthService/Impersonate"
+const OperationAuthServiceListIdentities = "/krathub.service.v1.AuthService/ListIdentities"
+const OperationAuthServiceListOIDCProviders = "/krathub.service.v1.AuthService/ListOIDCProviders"
+const OperationAuthServiceListSessions = "/krathub.service.v1.AuthService/ListSessions"
 const OperationAuthServiceLoginByEmailPassword = "/krathub.service.v1.AuthService/LoginByEmailPassword"
 const OperationAuthServiceLogout = "/krathub.service.v1.AuthService/Logout"
+const OperationAuthServiceOIDCCallback = "/krathub.service.v1.AuthService/OIDCCallback"
 const OperationAuthServiceRefreshToken = "/krathub.service.v1.AuthService/RefreshToken"
+const OperationAuthServiceRequestPasswordReset = "/krathub.service.v1.AuthService/RequestPasswordReset"
+const OperationAuthServiceResendVerificationEmail = "/krathub.service.v1.AuthService/ResendVerificationEmail"
+const OperationAuthServiceResetPassword = "/krathub.service.v1.AuthService/ResetPassword"
+const OperationAuthServiceRevokeOtherSessions = "/krathub.service.v1.AuthService/RevokeOtherSessions"
+const OperationAuthServiceRevokeSession = "/krathub.service.v1.AuthService/RevokeSession"
 const OperationAuthServiceSignupByEmail = "/krathub.service.v1.AuthService/SignupByEmail"
+const OperationAuthServiceStartOIDCLink = "/krathub.service.v1.AuthService/StartOIDCLink"
+const OperationAuthServiceStartOIDCLogin = "/krathub.service.v1.AuthService/StartOIDCLogin"
+const OperationAuthServiceUnlinkIdentity = "/krathub.service.v1.AuthService/UnlinkIdentity"
+const OperationAuthServiceVerifyEmail = "/krathub.service.v1.AuthService/VerifyEmail"
+const OperationAuthServiceVerifyMFA = "/krathub.service.v1.AuthService/VerifyMFA"
 
 type AuthServiceHTTPServer interface {
+	ConfirmTOTP(context.Context, *v1.ConfirmTOTPRequest) (*v1.ConfirmTOTPResponse, error)
+	DisableTOTP(context.Context, *v1.DisableTOTPRequest) (*v1.DisableTOTPResponse, error)
+	EndImpersonation(context.Context, *v1.EndImpersonationRequest) (*v1.EndImpersonationResponse, error)
+	EnrollTOTP(context.Context, *v1.EnrollTOTPRequest) (*v1.EnrollTOTPResponse, error)
+	Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.ImpersonateResponse, error)
+	ListIdentities(context.Context, *v1.ListIdentitiesRequest) (*v1.ListIdentitiesResponse, error)
+	ListOIDCProviders(context.Context, *v1.ListOIDCProvidersRequest) (*v1.ListOIDCProvidersResponse, error)
+	ListSessions(context.Context, *v1.ListSessionsRequest) (*v1.ListSessionsResponse, error)
 	LoginByEmailPassword(context.Context, *v1.LoginByEmailPasswordRequest) (*v1.LoginByEmailPasswordResponse, error)
 	Logout(context.Context, *v1.LogoutRequest) (*v1.LogoutResponse, error)
+	OIDCCallback(context.Context, *v1.OIDCCallbackRequest) (*v1.OIDCCallbackResponse, error)
 	RefreshToken(context.Context, *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error)
+	RequestPasswordReset(context.Context, *v1.RequestPasswordResetRequest) (*v1.RequestPasswordResetResponse, error)
+	ResendVerificationEmail(context.Context, *v1.ResendVerificationEmailRequest) (*v1.ResendVerificationEmailResponse, error)
+	ResetPassword(context.Context, *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error)
+	RevokeOtherSessions(context.Context, *v1.RevokeOtherSessionsRequest) (*v1.RevokeOtherSessionsResponse, error)
+	RevokeSession(context.Context, *v1.RevokeSessionRequest) (*v1.RevokeSessionResponse, error)
 	SignupByEmail(context.Context, *v1.SignupByEmailRequest) (*v1.SignupByEmailResponse, error)
+	StartOIDCLink(context.Context, *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginResponse, error)
+	StartOIDCLogin(context.Context, *v1.StartOIDCLoginRequest) (*v1.StartOIDCLoginResponse, error)
+	UnlinkIdentity(context.Context, *v1.UnlinkIdentityRequest) (*v1.UnlinkIdentityResponse, error)
+	VerifyEmail(context.Context, *v1.VerifyEmailRequest) (*v1.VerifyEmailResponse, error)
+	VerifyMFA(context.Context, *v1.VerifyMFARequest) (*v1.VerifyMFAResponse, error)
 }
 
 func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
@@ -38,6 +76,25 @@ func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r.POST("/v1/auth/login/email-password", _AuthService_LoginByEmailPassword0_HTTP_Handler(srv))
 	r.POST("/v1/auth/refresh-token", _AuthService_RefreshToken0_HTTP_Handler(srv))
 	r.POST("/v1/auth/logout", _AuthService_Logout0_HTTP_Handler(srv))
+	r.GET("/v1/auth/sessions", _AuthService_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/v1/auth/sessions/{session_id}", _AuthService_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/v1/auth/sessions/revoke-others", _AuthService_RevokeOtherSessions0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/verify", _AuthService_VerifyMFA0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/totp/enroll", _AuthService_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/totp/confirm", _AuthService_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/totp/disable", _AuthService_DisableTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/auth/email/verify", _AuthService_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/v1/auth/email/resend-verification", _AuthService_ResendVerificationEmail0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/forgot", _AuthService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/reset", _AuthService_ResetPassword0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/providers", _AuthService_ListOIDCProviders0_HTTP_Handler(srv))
+	r.POST("/v1/auth/oidc/{provider}/authorize", _AuthService_StartOIDCLogin0_HTTP_Handler(srv))
+	r.POST("/v1/auth/oidc/{provider}/callback", _AuthService_OIDCCallback0_HTTP_Handler(srv))
+	r.POST("/v1/auth/oidc/{provider}/link", _AuthService_StartOIDCLink0_HTTP_Handler(srv))
+	r.GET("/v1/auth/identities", _AuthService_ListIdentities0_HTTP_Handler(srv))
+	r.DELETE("/v1/auth/identities/{provider}", _AuthService_UnlinkIdentity0_HTTP_Handler(srv))
+	r.POST("/v1/users/{user_id}/impersonate", _AuthService_Impersonate0_HTTP_Handler(srv))
+	r.POST("/v1/auth/impersonation/end", _AuthService_EndImpersonation0_HTTP_Handler(srv))
 }
 
 func _AuthService_SignupByEmail0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
@@ -128,11 +185,451 @@ func _AuthService_Logout0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.
 	}
 }
 
+func _AuthService_ListSessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*v1.ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeSession0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*v1.RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeOtherSessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeOtherSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeOtherSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeOtherSessions(ctx, req.(*v1.RevokeOtherSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeOtherSessionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_VerifyMFA0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*v1.VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.VerifyMFAResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_EnrollTOTP0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*v1.EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.EnrollTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ConfirmTOTP0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*v1.ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ConfirmTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DisableTOTP0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.DisableTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDisableTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTOTP(ctx, req.(*v1.DisableTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.DisableTOTPResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_VerifyEmail0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*v1.VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.VerifyEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResendVerificationEmail0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ResendVerificationEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResendVerificationEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendVerificationEmail(ctx, req.(*v1.ResendVerificationEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ResendVerificationEmailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RequestPasswordReset0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*v1.RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RequestPasswordResetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResetPassword0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*v1.ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ResetPasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListOIDCProviders0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListOIDCProvidersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListOIDCProviders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOIDCProviders(ctx, req.(*v1.ListOIDCProvidersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListOIDCProvidersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_StartOIDCLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.StartOIDCLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceStartOIDCLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartOIDCLogin(ctx, req.(*v1.StartOIDCLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.StartOIDCLoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_OIDCCallback0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.OIDCCallbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceOIDCCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OIDCCallback(ctx, req.(*v1.OIDCCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.OIDCCallbackResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_StartOIDCLink0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.StartOIDCLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceStartOIDCLink)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartOIDCLink(ctx, req.(*v1.StartOIDCLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.StartOIDCLoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListIdentities0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListIdentitiesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListIdentities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIdentities(ctx, req.(*v1.ListIdentitiesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListIdentitiesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_UnlinkIdentity0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.UnlinkIdentityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceUnlinkIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlinkIdentity(ctx, req.(*v1.UnlinkIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.UnlinkIdentityResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Impersonate0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ImpersonateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceImpersonate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Impersonate(ctx, req.(*v1.ImpersonateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ImpersonateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_EndImpersonation0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.EndImpersonationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceEndImpersonation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EndImpersonation(ctx, req.(*v1.EndImpersonationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.EndImpersonationResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthServiceHTTPClient interface {
+	ConfirmTOTP(ctx context.Context, req *v1.ConfirmTOTPRequest, opts ...http.CallOption) (rsp *v1.ConfirmTOTPResponse, err error)
+	DisableTOTP(ctx context.Context, req *v1.DisableTOTPRequest, opts ...http.CallOption) (rsp *v1.DisableTOTPResponse, err error)
+	EndImpersonation(ctx context.Context, req *v1.EndImpersonationRequest, opts ...http.CallOption) (rsp *v1.EndImpersonationResponse, err error)
+	EnrollTOTP(ctx context.Context, req *v1.EnrollTOTPRequest, opts ...http.CallOption) (rsp *v1.EnrollTOTPResponse, err error)
+	Impersonate(ctx context.Context, req *v1.ImpersonateRequest, opts ...http.CallOption) (rsp *v1.ImpersonateResponse, err error)
+	ListIdentities(ctx context.Context, req *v1.ListIdentitiesRequest, opts ...http.CallOption) (rsp *v1.ListIdentitiesResponse, err error)
+	ListOIDCProviders(ctx context.Context, req *v1.ListOIDCProvidersRequest, opts ...http.CallOption) (rsp *v1.ListOIDCProvidersResponse, err error)
+	ListSessions(ctx context.Context, req *v1.ListSessionsRequest, opts ...http.CallOption) (rsp *v1.ListSessionsResponse, err error)
 	LoginByEmailPassword(ctx context.Context, req *v1.LoginByEmailPasswordRequest, opts ...http.CallOption) (rsp *v1.LoginByEmailPasswordResponse, err error)
 	Logout(ctx context.Context, req *v1.LogoutRequest, opts ...http.CallOption) (rsp *v1.LogoutResponse, err error)
+	OIDCCallback(ctx context.Context, req *v1.OIDCCallbackRequest, opts ...http.CallOption) (rsp *v1.OIDCCallbackResponse, err error)
 	RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest, opts ...http.CallOption) (rsp *v1.RefreshTokenResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *v1.RequestPasswordResetRequest, opts ...http.CallOption) (rsp *v1.RequestPasswordResetResponse, err error)
+	ResendVerificationEmail(ctx context.Context, req *v1.ResendVerificationEmailRequest, opts ...http.CallOption) (rsp *v1.ResendVerificationEmailResponse, err error)
+	ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest, opts ...http.CallOption) (rsp *v1.ResetPasswordResponse, err error)
+	RevokeOtherSessions(ctx context.Context, req *v1.RevokeOtherSessionsRequest, opts ...http.CallOption) (rsp *v1.RevokeOtherSessionsResponse, err error)
+	RevokeSession(ctx context.Context, req *v1.RevokeSessionRequest, opts ...http.CallOption) (rsp *v1.RevokeSessionResponse, err error)
 	SignupByEmail(ctx context.Context, req *v1.SignupByEmailRequest, opts ...http.CallOption) (rsp *v1.SignupByEmailResponse, err error)
+	StartOIDCLink(ctx context.Context, req *v1.StartOIDCLoginRequest, opts ...http.CallOption) (rsp *v1.StartOIDCLoginResponse, err error)
+	StartOIDCLogin(ctx context.Context, req *v1.StartOIDCLoginRequest, opts ...http.CallOption) (rsp *v1.StartOIDCLoginResponse, err error)
+	UnlinkIdentity(ctx context.Context, req *v1.UnlinkIdentityRequest, opts ...http.CallOption) (rsp *v1.UnlinkIdentityResponse, err error)
+	VerifyEmail(ctx context.Context, req *v1.VerifyEmailRequest, opts ...http.CallOption) (rsp *v1.VerifyEmailResponse, err error)
+	VerifyMFA(ctx context.Context, req *v1.VerifyMFARequest, opts ...http.CallOption) (rsp *v1.VerifyMFAResponse, err error)
 }
 
 type AuthServiceHTTPClientImpl struct {
@@ -143,6 +640,110 @@ func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
 	return &AuthServiceHTTPClientImpl{client}
 }
 
+func (c *AuthServiceHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *v1.ConfirmTOTPRequest, opts ...http.CallOption) (*v1.ConfirmTOTPResponse, error) {
+	var out v1.ConfirmTOTPResponse
+	pattern := "/v1/auth/mfa/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) DisableTOTP(ctx context.Context, in *v1.DisableTOTPRequest, opts ...http.CallOption) (*v1.DisableTOTPResponse, error) {
+	var out v1.DisableTOTPResponse
+	pattern := "/v1/auth/mfa/totp/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceDisableTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) EndImpersonation(ctx context.Context, in *v1.EndImpersonationRequest, opts ...http.CallOption) (*v1.EndImpersonationResponse, error) {
+	var out v1.EndImpersonationResponse
+	pattern := "/v1/auth/impersonation/end"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceEndImpersonation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) EnrollTOTP(ctx context.Context, in *v1.EnrollTOTPRequest, opts ...http.CallOption) (*v1.EnrollTOTPResponse, error) {
+	var out v1.EnrollTOTPResponse
+	pattern := "/v1/auth/mfa/totp/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...http.CallOption) (*v1.ImpersonateResponse, error) {
+	var out v1.ImpersonateResponse
+	pattern := "/v1/users/{user_id}/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceImpersonate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListIdentities(ctx context.Context, in *v1.ListIdentitiesRequest, opts ...http.CallOption) (*v1.ListIdentitiesResponse, error) {
+	var out v1.ListIdentitiesResponse
+	pattern := "/v1/auth/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListIdentities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListOIDCProviders(ctx context.Context, in *v1.ListOIDCProvidersRequest, opts ...http.CallOption) (*v1.ListOIDCProvidersResponse, error) {
+	var out v1.ListOIDCProvidersResponse
+	pattern := "/v1/auth/oidc/providers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListOIDCProviders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListSessions(ctx context.Context, in *v1.ListSessionsRequest, opts ...http.CallOption) (*v1.ListSessionsResponse, error) {
+	var out v1.ListSessionsResponse
+	pattern := "/v1/auth/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) LoginByEmailPassword(ctx context.Context, in *v1.LoginByEmailPasswordRequest, opts ...http.CallOption) (*v1.LoginByEmailPasswordResponse, error) {
 	var out v1.LoginByEmailPasswordResponse
 	pattern := "/v1/auth/login/email-password"
@@ -169,6 +770,19 @@ func (c *AuthServiceHTTPClientImpl) Logout(ctx context.Context, in *v1.LogoutReq
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) OIDCCallback(ctx context.Context, in *v1.OIDCCallbackRequest, opts ...http.CallOption) (*v1.OIDCCallbackResponse, error) {
+	var out v1.OIDCCallbackResponse
+	pattern := "/v1/auth/oidc/{provider}/callback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceOIDCCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest, opts ...http.CallOption) (*v1.RefreshTokenResponse, error) {
 	var out v1.RefreshTokenResponse
 	pattern := "/v1/auth/refresh-token"
@@ -182,6 +796,71 @@ func (c *AuthServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *v1.Ref
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest, opts ...http.CallOption) (*v1.RequestPasswordResetResponse, error) {
+	var out v1.RequestPasswordResetResponse
+	pattern := "/v1/auth/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ResendVerificationEmail(ctx context.Context, in *v1.ResendVerificationEmailRequest, opts ...http.CallOption) (*v1.ResendVerificationEmailResponse, error) {
+	var out v1.ResendVerificationEmailResponse
+	pattern := "/v1/auth/email/resend-verification"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResendVerificationEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *v1.ResetPasswordRequest, opts ...http.CallOption) (*v1.ResetPasswordResponse, error) {
+	var out v1.ResetPasswordResponse
+	pattern := "/v1/auth/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RevokeOtherSessions(ctx context.Context, in *v1.RevokeOtherSessionsRequest, opts ...http.CallOption) (*v1.RevokeOtherSessionsResponse, error) {
+	var out v1.RevokeOtherSessionsResponse
+	pattern := "/v1/auth/sessions/revoke-others"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeOtherSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) RevokeSession(ctx context.Context, in *v1.RevokeSessionRequest, opts ...http.CallOption) (*v1.RevokeSessionResponse, error) {
+	var out v1.RevokeSessionResponse
+	pattern := "/v1/auth/sessions/{session_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) SignupByEmail(ctx context.Context, in *v1.SignupByEmailRequest, opts ...http.CallOption) (*v1.SignupByEmailResponse, error) {
 	var out v1.SignupByEmailResponse
 	pattern := "/v1/auth/signup/using-email"
@@ -194,3 +873,68 @@ func (c *AuthServiceHTTPClientImpl) SignupByEmail(ctx context.Context, in *v1.Si
 	}
 	return &out, nil
 }
+
+func (c *AuthServiceHTTPClientImpl) StartOIDCLink(ctx context.Context, in *v1.StartOIDCLoginRequest, opts ...http.CallOption) (*v1.StartOIDCLoginResponse, error) {
+	var out v1.StartOIDCLoginResponse
+	pattern := "/v1/auth/oidc/{provider}/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceStartOIDCLink))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) StartOIDCLogin(ctx context.Context, in *v1.StartOIDCLoginRequest, opts ...http.CallOption) (*v1.StartOIDCLoginResponse, error) {
+	var out v1.StartOIDCLoginResponse
+	pattern := "/v1/auth/oidc/{provider}/authorize"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceStartOIDCLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) UnlinkIdentity(ctx context.Context, in *v1.UnlinkIdentityRequest, opts ...http.CallOption) (*v1.UnlinkIdentityResponse, error) {
+	var out v1.UnlinkIdentityResponse
+	pattern := "/v1/auth/identities/{provider}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceUnlinkIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) VerifyEmail(ctx context.Context, in *v1.VerifyEmailRequest, opts ...http.CallOption) (*v1.VerifyEmailResponse, error) {
+	var out v1.VerifyEmailResponse
+	pattern := "/v1/auth/email/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) VerifyMFA(ctx context.Context, in *v1.VerifyMFARequest, opts ...http.CallOption) (*v1.VerifyMFAResponse, error) {
+	var out v1.VerifyMFAResponse
+	pattern := "/v1/auth/mfa/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -27,7 +27,7 @@ var File_krathub_service_v1_i_user_proto protoreflect.FileDescriptor
 
 const file_krathub_service_v1_i_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1fkrathub/service/v1/i_user.proto\x12\x12krathub.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1auser/service/v1/user.proto2\xb4\x04\n" +
+	"\x1fkrathub/service/v1/i_user.proto\x12\x12krathub.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1auser/service/v1/user.proto2\x9b\x12\n" +
 	"\vUserService\x12\x90\x01\n" +
 	"\x0fCurrentUserInfo\x12'.user.service.v1.CurrentUserInfoRequest\x1a(.user.service.v1.CurrentUserInfoResponse\"*\xbaG\x12Z\x10\n" +
 	"\x0e\n" +
@@ -46,34 +46,129 @@ const file_krathub_service_v1_i_user_proto_rawDesc = "" +
 	"DeleteUser\x12\".user.service.v1.DeleteUserRequest\x1a#.user.service.v1.DeleteUserResponse\"1\xbaG\x12Z\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16*\x14/v1/user/delete/{id}B\xd7\x01\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16*\x14/v1/user/delete/{id}\x12\x94\x01\n" +
+	"\x0eGetPreferences\x12&.user.service.v1.GetPreferencesRequest\x1a'.user.service.v1.GetPreferencesResponse\"1\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/user/preferences\x12\xa0\x01\n" +
+	"\x11UpdatePreferences\x12).user.service.v1.UpdatePreferencesRequest\x1a*.user.service.v1.UpdatePreferencesResponse\"4\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/user/preferences\x12\x8b\x01\n" +
+	"\n" +
+	"UnlockUser\x12\".user.service.v1.UnlockUserRequest\x1a#.user.service.v1.UnlockUserResponse\"4\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/user/{id}/unlock\x12z\n" +
+	"\tListUsers\x12!.user.service.v1.ListUsersRequest\x1a\".user.service.v1.ListUsersResponse\"&\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12\x90\x01\n" +
+	"\x0eBulkUpdateRole\x12&.user.service.v1.BulkUpdateRoleRequest\x1a!.user.service.v1.BulkUserResponse\"3\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/users/bulk/role\x12\x8f\x01\n" +
+	"\fDisableUsers\x12$.user.service.v1.DisableUsersRequest\x1a!.user.service.v1.BulkUserResponse\"6\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/users/bulk/disable\x12\x8c\x01\n" +
+	"\vEnableUsers\x12#.user.service.v1.EnableUsersRequest\x1a!.user.service.v1.BulkUserResponse\"5\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/users/bulk/enable\x12\x90\x01\n" +
+	"\vRestoreUser\x12#.user.service.v1.RestoreUserRequest\x1a$.user.service.v1.RestoreUserResponse\"6\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/users/{id}/restore\x12\x89\x01\n" +
+	"\fExportMyData\x12$.user.service.v1.ExportMyDataRequest\x1a%.user.service.v1.ExportMyDataResponse\",\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/user/export\x12\x99\x01\n" +
+	"\x10CreateInviteCode\x12(.user.service.v1.CreateInviteCodeRequest\x1a).user.service.v1.CreateInviteCodeResponse\"0\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/invite-codes\x12\x93\x01\n" +
+	"\x0fListInviteCodes\x12'.user.service.v1.ListInviteCodesRequest\x1a(.user.service.v1.ListInviteCodesResponse\"-\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/invite-codes\x12\x9b\x01\n" +
+	"\x10RevokeInviteCode\x12(.user.service.v1.RevokeInviteCodeRequest\x1a).user.service.v1.RevokeInviteCodeResponse\"2\xbaG\x12Z\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17*\x15/v1/invite-codes/{id}B\xd7\x01\n" +
 	"\x16com.krathub.service.v1B\n" +
 	"IUserProtoP\x01ZGgithub.com/ToAtlas/AtlasBackend/api/gen/go/krathub/service/v1;krathubpb\xa2\x02\x03KSX\xaa\x02\x12Krathub.Service.V1\xca\x02\x12Krathub\\Service\\V1\xe2\x02\x1eKrathub\\Service\\V1\\GPBMetadata\xea\x02\x14Krathub::Service::V1b\x06proto3"
 
 var file_krathub_service_v1_i_user_proto_goTypes = []any{
-	(*v1.CurrentUserInfoRequest)(nil),  // 0: user.service.v1.CurrentUserInfoRequest
-	(*v1.UpdateUserRequest)(nil),       // 1: user.service.v1.UpdateUserRequest
-	(*v1.SaveUserRequest)(nil),         // 2: user.service.v1.SaveUserRequest
-	(*v1.DeleteUserRequest)(nil),       // 3: user.service.v1.DeleteUserRequest
-	(*v1.CurrentUserInfoResponse)(nil), // 4: user.service.v1.CurrentUserInfoResponse
-	(*v1.UpdateUserResponse)(nil),      // 5: user.service.v1.UpdateUserResponse
-	(*v1.SaveUserResponse)(nil),        // 6: user.service.v1.SaveUserResponse
-	(*v1.DeleteUserResponse)(nil),      // 7: user.service.v1.DeleteUserResponse
+	(*v1.CurrentUserInfoRequest)(nil),    // 0: user.service.v1.CurrentUserInfoRequest
+	(*v1.UpdateUserRequest)(nil),         // 1: user.service.v1.UpdateUserRequest
+	(*v1.SaveUserRequest)(nil),           // 2: user.service.v1.SaveUserRequest
+	(*v1.DeleteUserRequest)(nil),         // 3: user.service.v1.DeleteUserRequest
+	(*v1.GetPreferencesRequest)(nil),     // 4: user.service.v1.GetPreferencesRequest
+	(*v1.UpdatePreferencesRequest)(nil),  // 5: user.service.v1.UpdatePreferencesRequest
+	(*v1.UnlockUserRequest)(nil),         // 6: user.service.v1.UnlockUserRequest
+	(*v1.ListUsersRequest)(nil),          // 7: user.service.v1.ListUsersRequest
+	(*v1.BulkUpdateRoleRequest)(nil),     // 8: user.service.v1.BulkUpdateRoleRequest
+	(*v1.DisableUsersRequest)(nil),       // 9: user.service.v1.DisableUsersRequest
+	(*v1.EnableUsersRequest)(nil),        // 10: user.service.v1.EnableUsersRequest
+	(*v1.RestoreUserRequest)(nil),        // 11: user.service.v1.RestoreUserRequest
+	(*v1.ExportMyDataRequest)(nil),       // 12: user.service.v1.ExportMyDataRequest
+	(*v1.CreateInviteCodeRequest)(nil),   // 13: user.service.v1.CreateInviteCodeRequest
+	(*v1.ListInviteCodesRequest)(nil),    // 14: user.service.v1.ListInviteCodesRequest
+	(*v1.RevokeInviteCodeRequest)(nil),   // 15: user.service.v1.RevokeInviteCodeRequest
+	(*v1.CurrentUserInfoResponse)(nil),   // 16: user.service.v1.CurrentUserInfoResponse
+	(*v1.UpdateUserResponse)(nil),        // 17: user.service.v1.UpdateUserResponse
+	(*v1.SaveUserResponse)(nil),          // 18: user.service.v1.SaveUserResponse
+	(*v1.DeleteUserResponse)(nil),        // 19: user.service.v1.DeleteUserResponse
+	(*v1.GetPreferencesResponse)(nil),    // 20: user.service.v1.GetPreferencesResponse
+	(*v1.UpdatePreferencesResponse)(nil), // 21: user.service.v1.UpdatePreferencesResponse
+	(*v1.UnlockUserResponse)(nil),        // 22: user.service.v1.UnlockUserResponse
+	(*v1.ListUsersResponse)(nil),         // 23: user.service.v1.ListUsersResponse
+	(*v1.BulkUserResponse)(nil),          // 24: user.service.v1.BulkUserResponse
+	(*v1.RestoreUserResponse)(nil),       // 25: user.service.v1.RestoreUserResponse
+	(*v1.ExportMyDataResponse)(nil),      // 26: user.service.v1.ExportMyDataResponse
+	(*v1.CreateInviteCodeResponse)(nil),  // 27: user.service.v1.CreateInviteCodeResponse
+	(*v1.ListInviteCodesResponse)(nil),   // 28: user.service.v1.ListInviteCodesResponse
+	(*v1.RevokeInviteCodeResponse)(nil),  // 29: user.service.v1.RevokeInviteCodeResponse
 }
 var file_krathub_service_v1_i_user_proto_depIdxs = []int32{
-	0, // 0: krathub.service.v1.UserService.CurrentUserInfo:input_type -> user.service.v1.CurrentUserInfoRequest
-	1, // 1: krathub.service.v1.UserService.UpdateUser:input_type -> user.service.v1.UpdateUserRequest
-	2, // 2: krathub.service.v1.UserService.SaveUser:input_type -> user.service.v1.SaveUserRequest
-	3, // 3: krathub.service.v1.UserService.DeleteUser:input_type -> user.service.v1.DeleteUserRequest
-	4, // 4: krathub.service.v1.UserService.CurrentUserInfo:output_type -> user.service.v1.CurrentUserInfoResponse
-	5, // 5: krathub.service.v1.UserService.UpdateUser:output_type -> user.service.v1.UpdateUserResponse
-	6, // 6: krathub.service.v1.UserService.SaveUser:output_type -> user.service.v1.SaveUserResponse
-	7, // 7: krathub.service.v1.UserService.DeleteUser:output_type -> user.service.v1.DeleteUserResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: krathub.service.v1.UserService.CurrentUserInfo:input_type -> user.service.v1.CurrentUserInfoRequest
+	1,  // 1: krathub.service.v1.UserService.UpdateUser:input_type -> user.service.v1.UpdateUserRequest
+	2,  // 2: krathub.service.v1.UserService.SaveUser:input_type -> user.service.v1.SaveUserRequest
+	3,  // 3: krathub.service.v1.UserService.DeleteUser:input_type -> user.service.v1.DeleteUserRequest
+	4,  // 4: krathub.service.v1.UserService.GetPreferences:input_type -> user.service.v1.GetPreferencesRequest
+	5,  // 5: krathub.service.v1.UserService.UpdatePreferences:input_type -> user.service.v1.UpdatePreferencesRequest
+	6,  // 6: krathub.service.v1.UserService.UnlockUser:input_type -> user.service.v1.UnlockUserRequest
+	7,  // 7: krathub.service.v1.UserService.ListUsers:input_type -> user.service.v1.ListUsersRequest
+	8,  // 8: krathub.service.v1.UserService.BulkUpdateRole:input_type -> user.service.v1.BulkUpdateRoleRequest
+	9,  // 9: krathub.service.v1.UserService.DisableUsers:input_type -> user.service.v1.DisableUsersRequest
+	10, // 10: krathub.service.v1.UserService.EnableUsers:input_type -> user.service.v1.EnableUsersRequest
+	11, // 11: krathub.service.v1.UserService.RestoreUser:input_type -> user.service.v1.RestoreUserRequest
+	12, // 12: krathub.service.v1.UserService.ExportMyData:input_type -> user.service.v1.ExportMyDataRequest
+	13, // 13: krathub.service.v1.UserService.CreateInviteCode:input_type -> user.service.v1.CreateInviteCodeRequest
+	14, // 14: krathub.service.v1.UserService.ListInviteCodes:input_type -> user.service.v1.ListInviteCodesRequest
+	15, // 15: krathub.service.v1.UserService.RevokeInviteCode:input_type -> user.service.v1.RevokeInviteCodeRequest
+	16, // 16: krathub.service.v1.UserService.CurrentUserInfo:output_type -> user.service.v1.CurrentUserInfoResponse
+	17, // 17: krathub.service.v1.UserService.UpdateUser:output_type -> user.service.v1.UpdateUserResponse
+	18, // 18: krathub.service.v1.UserService.SaveUser:output_type -> user.service.v1.SaveUserResponse
+	19, // 19: krathub.service.v1.UserService.DeleteUser:output_type -> user.service.v1.DeleteUserResponse
+	20, // 20: krathub.service.v1.UserService.GetPreferences:output_type -> user.service.v1.GetPreferencesResponse
+	21, // 21: krathub.service.v1.UserService.UpdatePreferences:output_type -> user.service.v1.UpdatePreferencesResponse
+	22, // 22: krathub.service.v1.UserService.UnlockUser:output_type -> user.service.v1.UnlockUserResponse
+	23, // 23: krathub.service.v1.UserService.ListUsers:output_type -> user.service.v1.ListUsersResponse
+	24, // 24: krathub.service.v1.UserService.BulkUpdateRole:output_type -> user.service.v1.BulkUserResponse
+	24, // 25: krathub.service.v1.UserService.DisableUsers:output_type -> user.service.v1.BulkUserResponse
+	24, // 26: krathub.service.v1.UserService.EnableUsers:output_type -> user.service.v1.BulkUserResponse
+	25, // 27: krathub.service.v1.UserService.RestoreUser:output_type -> user.service.v1.RestoreUserResponse
+	26, // 28: krathub.service.v1.UserService.ExportMyData:output_type -> user.service.v1.ExportMyDataResponse
+	27, // 29: krathub.service.v1.UserService.CreateInviteCode:output_type -> user.service.v1.CreateInviteCodeResponse
+	28, // 30: krathub.service.v1.UserService.ListInviteCodes:output_type -> user.service.v1.ListInviteCodesResponse
+	29, // 31: krathub.service.v1.UserService.RevokeInviteCode:output_type -> user.service.v1.RevokeInviteCodeResponse
+	16, // [16:32] is the sub-list for method output_type
+	0,  // [0:16] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_krathub_service_v1_i_user_proto_init() }
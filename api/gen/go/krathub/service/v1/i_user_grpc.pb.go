@@ -20,10 +20,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CurrentUserInfo_FullMethodName = "/krathub.service.v1.UserService/CurrentUserInfo"
-	UserService_UpdateUser_FullMethodName      = "/krathub.service.v1.UserService/UpdateUser"
-	UserService_SaveUser_FullMethodName        = "/krathub.service.v1.UserService/SaveUser"
-	UserService_DeleteUser_FullMethodName      = "/krathub.service.v1.UserService/DeleteUser"
+	UserService_CurrentUserInfo_FullMethodName   = "/krathub.service.v1.UserService/CurrentUserInfo"
+	UserService_UpdateUser_FullMethodName        = "/krathub.service.v1.UserService/UpdateUser"
+	UserService_SaveUser_FullMethodName          = "/krathub.service.v1.UserService/SaveUser"
+	UserService_DeleteUser_FullMethodName        = "/krathub.service.v1.UserService/DeleteUser"
+	UserService_GetPreferences_FullMethodName    = "/krathub.service.v1.UserService/GetPreferences"
+	UserService_UpdatePreferences_FullMethodName = "/krathub.service.v1.UserService/UpdatePreferences"
+	UserService_UnlockUser_FullMethodName        = "/krathub.service.v1.UserService/UnlockUser"
+	UserService_ListUsers_FullMethodName         = "/krathub.service.v1.UserService/ListUsers"
+	UserService_BulkUpdateRole_FullMethodName    = "/krathub.service.v1.UserService/BulkUpdateRole"
+	UserService_DisableUsers_FullMethodName      = "/krathub.service.v1.UserService/DisableUsers"
+	UserService_EnableUsers_FullMethodName       = "/krathub.service.v1.UserService/EnableUsers"
+	UserService_RestoreUser_FullMethodName       = "/krathub.service.v1.UserService/RestoreUser"
+	UserService_ExportMyData_FullMethodName      = "/krathub.service.v1.UserService/ExportMyData"
+	UserService_CreateInviteCode_FullMethodName  = "/krathub.service.v1.UserService/CreateInviteCode"
+	UserService_ListInviteCodes_FullMethodName   = "/krathub.service.v1.UserService/ListInviteCodes"
+	UserService_RevokeInviteCode_FullMethodName  = "/krathub.service.v1.UserService/RevokeInviteCode"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -36,6 +48,18 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *v1.UpdateUserRequest, opts ...grpc.CallOption) (*v1.UpdateUserResponse, error)
 	SaveUser(ctx context.Context, in *v1.SaveUserRequest, opts ...grpc.CallOption) (*v1.SaveUserResponse, error)
 	DeleteUser(ctx context.Context, in *v1.DeleteUserRequest, opts ...grpc.CallOption) (*v1.DeleteUserResponse, error)
+	GetPreferences(ctx context.Context, in *v1.GetPreferencesRequest, opts ...grpc.CallOption) (*v1.GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *v1.UpdatePreferencesRequest, opts ...grpc.CallOption) (*v1.UpdatePreferencesResponse, error)
+	UnlockUser(ctx context.Context, in *v1.UnlockUserRequest, opts ...grpc.CallOption) (*v1.UnlockUserResponse, error)
+	ListUsers(ctx context.Context, in *v1.ListUsersRequest, opts ...grpc.CallOption) (*v1.ListUsersResponse, error)
+	BulkUpdateRole(ctx context.Context, in *v1.BulkUpdateRoleRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error)
+	DisableUsers(ctx context.Context, in *v1.DisableUsersRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error)
+	EnableUsers(ctx context.Context, in *v1.EnableUsersRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error)
+	RestoreUser(ctx context.Context, in *v1.RestoreUserRequest, opts ...grpc.CallOption) (*v1.RestoreUserResponse, error)
+	ExportMyData(ctx context.Context, in *v1.ExportMyDataRequest, opts ...grpc.CallOption) (*v1.ExportMyDataResponse, error)
+	CreateInviteCode(ctx context.Context, in *v1.CreateInviteCodeRequest, opts ...grpc.CallOption) (*v1.CreateInviteCodeResponse, error)
+	ListInviteCodes(ctx context.Context, in *v1.ListInviteCodesRequest, opts ...grpc.CallOption) (*v1.ListInviteCodesResponse, error)
+	RevokeInviteCode(ctx context.Context, in *v1.RevokeInviteCodeRequest, opts ...grpc.CallOption) (*v1.RevokeInviteCodeResponse, error)
 }
 
 type userServiceClient struct {
@@ -86,6 +110,126 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *v1.DeleteUserReq
 	return out, nil
 }
 
+func (c *userServiceClient) GetPreferences(ctx context.Context, in *v1.GetPreferencesRequest, opts ...grpc.CallOption) (*v1.GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePreferences(ctx context.Context, in *v1.UpdatePreferencesRequest, opts ...grpc.CallOption) (*v1.UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *v1.UnlockUserRequest, opts ...grpc.CallOption) (*v1.UnlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.UnlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUsers(ctx context.Context, in *v1.ListUsersRequest, opts ...grpc.CallOption) (*v1.ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BulkUpdateRole(ctx context.Context, in *v1.BulkUpdateRoleRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.BulkUserResponse)
+	err := c.cc.Invoke(ctx, UserService_BulkUpdateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableUsers(ctx context.Context, in *v1.DisableUsersRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.BulkUserResponse)
+	err := c.cc.Invoke(ctx, UserService_DisableUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EnableUsers(ctx context.Context, in *v1.EnableUsersRequest, opts ...grpc.CallOption) (*v1.BulkUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.BulkUserResponse)
+	err := c.cc.Invoke(ctx, UserService_EnableUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RestoreUser(ctx context.Context, in *v1.RestoreUserRequest, opts ...grpc.CallOption) (*v1.RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RestoreUserResponse)
+	err := c.cc.Invoke(ctx, UserService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportMyData(ctx context.Context, in *v1.ExportMyDataRequest, opts ...grpc.CallOption) (*v1.ExportMyDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateInviteCode(ctx context.Context, in *v1.CreateInviteCodeRequest, opts ...grpc.CallOption) (*v1.CreateInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.CreateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInviteCodes(ctx context.Context, in *v1.ListInviteCodesRequest, opts ...grpc.CallOption) (*v1.ListInviteCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListInviteCodesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInviteCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeInviteCode(ctx context.Context, in *v1.RevokeInviteCodeRequest, opts ...grpc.CallOption) (*v1.RevokeInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.RevokeInviteCodeResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -96,6 +240,18 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
 	SaveUser(context.Context, *v1.SaveUserRequest) (*v1.SaveUserResponse, error)
 	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
+	GetPreferences(context.Context, *v1.GetPreferencesRequest) (*v1.GetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *v1.UpdatePreferencesRequest) (*v1.UpdatePreferencesResponse, error)
+	UnlockUser(context.Context, *v1.UnlockUserRequest) (*v1.UnlockUserResponse, error)
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
+	BulkUpdateRole(context.Context, *v1.BulkUpdateRoleRequest) (*v1.BulkUserResponse, error)
+	DisableUsers(context.Context, *v1.DisableUsersRequest) (*v1.BulkUserResponse, error)
+	EnableUsers(context.Context, *v1.EnableUsersRequest) (*v1.BulkUserResponse, error)
+	RestoreUser(context.Context, *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error)
+	ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error)
+	CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error)
+	ListInviteCodes(context.Context, *v1.ListInviteCodesRequest) (*v1.ListInviteCodesResponse, error)
+	RevokeInviteCode(context.Context, *v1.RevokeInviteCodeRequest) (*v1.RevokeInviteCodeResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -118,6 +274,42 @@ func (UnimplementedUserServiceServer) SaveUser(context.Context, *v1.SaveUserRequ
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) GetPreferences(context.Context, *v1.GetPreferencesRequest) (*v1.GetPreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePreferences(context.Context, *v1.UpdatePreferencesRequest) (*v1.UpdatePreferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *v1.UnlockUserRequest) (*v1.UnlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) BulkUpdateRole(context.Context, *v1.BulkUpdateRoleRequest) (*v1.BulkUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpdateRole not implemented")
+}
+func (UnimplementedUserServiceServer) DisableUsers(context.Context, *v1.DisableUsersRequest) (*v1.BulkUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisableUsers not implemented")
+}
+func (UnimplementedUserServiceServer) EnableUsers(context.Context, *v1.EnableUsersRequest) (*v1.BulkUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnableUsers not implemented")
+}
+func (UnimplementedUserServiceServer) RestoreUser(context.Context, *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedUserServiceServer) ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedUserServiceServer) CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (UnimplementedUserServiceServer) ListInviteCodes(context.Context, *v1.ListInviteCodesRequest) (*v1.ListInviteCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInviteCodes not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeInviteCode(context.Context, *v1.RevokeInviteCodeRequest) (*v1.RevokeInviteCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInviteCode not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -211,6 +403,222 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPreferences(ctx, req.(*v1.GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePreferences(ctx, req.(*v1.UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*v1.UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*v1.ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BulkUpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.BulkUpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BulkUpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BulkUpdateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BulkUpdateRole(ctx, req.(*v1.BulkUpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DisableUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableUsers(ctx, req.(*v1.DisableUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnableUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.EnableUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnableUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnableUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnableUsers(ctx, req.(*v1.EnableUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreUser(ctx, req.(*v1.RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportMyData(ctx, req.(*v1.ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateInviteCode(ctx, req.(*v1.CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInviteCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ListInviteCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInviteCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInviteCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInviteCodes(ctx, req.(*v1.ListInviteCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.RevokeInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeInviteCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeInviteCode(ctx, req.(*v1.RevokeInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +642,54 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _UserService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _UserService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "BulkUpdateRole",
+			Handler:    _UserService_BulkUpdateRole_Handler,
+		},
+		{
+			MethodName: "DisableUsers",
+			Handler:    _UserService_DisableUsers_Handler,
+		},
+		{
+			MethodName: "EnableUsers",
+			Handler:    _UserService_EnableUsers_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserService_RestoreUser_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _UserService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _UserService_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "ListInviteCodes",
+			Handler:    _UserService_ListInviteCodes_Handler,
+		},
+		{
+			MethodName: "RevokeInviteCode",
+			Handler:    _UserService_RevokeInviteCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "krathub/service/v1/i_user.proto",
@@ -20,15 +20,39 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserServiceBulkUpdateRole = "/krathub.service.v1.UserService/BulkUpdateRole"
+const OperationUserServiceCreateInviteCode = "/krathub.service.v1.UserService/CreateInviteCode"
 const OperationUserServiceCurrentUserInfo = "/krathub.service.v1.UserService/CurrentUserInfo"
 const OperationUserServiceDeleteUser = "/krathub.service.v1.UserService/DeleteUser"
+const OperationUserServiceDisableUsers = "/krathub.service.v1.UserService/DisableUsers"
+const OperationUserServiceEnableUsers = "/krathub.service.v1.UserService/EnableUsers"
+const OperationUserServiceExportMyData = "/krathub.service.v1.UserService/ExportMyData"
+const OperationUserServiceGetPreferences = "/krathub.service.v1.UserService/GetPreferences"
+const OperationUserServiceListInviteCodes = "/krathub.service.v1.UserService/ListInviteCodes"
+const OperationUserServiceListUsers = "/krathub.service.v1.UserService/ListUsers"
+const OperationUserServiceRestoreUser = "/krathub.service.v1.UserService/RestoreUser"
+const OperationUserServiceRevokeInviteCode = "/krathub.service.v1.UserService/RevokeInviteCode"
 const OperationUserServiceSaveUser = "/krathub.service.v1.UserService/SaveUser"
+const OperationUserServiceUnlockUser = "/krathub.service.v1.UserService/UnlockUser"
+const OperationUserServiceUpdatePreferences = "/krathub.service.v1.UserService/UpdatePreferences"
 const OperationUserServiceUpdateUser = "/krathub.service.v1.UserService/UpdateUser"
 
 type UserServiceHTTPServer interface {
+	BulkUpdateRole(context.Context, *v1.BulkUpdateRoleRequest) (*v1.BulkUserResponse, error)
+	CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error)
 	CurrentUserInfo(context.Context, *v1.CurrentUserInfoRequest) (*v1.CurrentUserInfoResponse, error)
 	DeleteUser(context.Context, *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
+	DisableUsers(context.Context, *v1.DisableUsersRequest) (*v1.BulkUserResponse, error)
+	EnableUsers(context.Context, *v1.EnableUsersRequest) (*v1.BulkUserResponse, error)
+	ExportMyData(context.Context, *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error)
+	GetPreferences(context.Context, *v1.GetPreferencesRequest) (*v1.GetPreferencesResponse, error)
+	ListInviteCodes(context.Context, *v1.ListInviteCodesRequest) (*v1.ListInviteCodesResponse, error)
+	ListUsers(context.Context, *v1.ListUsersRequest) (*v1.ListUsersResponse, error)
+	RestoreUser(context.Context, *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error)
+	RevokeInviteCode(context.Context, *v1.RevokeInviteCodeRequest) (*v1.RevokeInviteCodeResponse, error)
 	SaveUser(context.Context, *v1.SaveUserRequest) (*v1.SaveUserResponse, error)
+	UnlockUser(context.Context, *v1.UnlockUserRequest) (*v1.UnlockUserResponse, error)
+	UpdatePreferences(context.Context, *v1.UpdatePreferencesRequest) (*v1.UpdatePreferencesResponse, error)
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
 }
 
@@ -38,6 +62,18 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.POST("/v1/user/update", _UserService_UpdateUser0_HTTP_Handler(srv))
 	r.POST("/v1/user/save", _UserService_SaveUser0_HTTP_Handler(srv))
 	r.DELETE("/v1/user/delete/{id}", _UserService_DeleteUser0_HTTP_Handler(srv))
+	r.GET("/v1/user/preferences", _UserService_GetPreferences0_HTTP_Handler(srv))
+	r.POST("/v1/user/preferences", _UserService_UpdatePreferences0_HTTP_Handler(srv))
+	r.POST("/v1/user/{id}/unlock", _UserService_UnlockUser0_HTTP_Handler(srv))
+	r.GET("/v1/users", _UserService_ListUsers0_HTTP_Handler(srv))
+	r.POST("/v1/users/bulk/role", _UserService_BulkUpdateRole0_HTTP_Handler(srv))
+	r.POST("/v1/users/bulk/disable", _UserService_DisableUsers0_HTTP_Handler(srv))
+	r.POST("/v1/users/bulk/enable", _UserService_EnableUsers0_HTTP_Handler(srv))
+	r.POST("/v1/users/{id}/restore", _UserService_RestoreUser0_HTTP_Handler(srv))
+	r.GET("/v1/user/export", _UserService_ExportMyData0_HTTP_Handler(srv))
+	r.POST("/v1/invite-codes", _UserService_CreateInviteCode0_HTTP_Handler(srv))
+	r.GET("/v1/invite-codes", _UserService_ListInviteCodes0_HTTP_Handler(srv))
+	r.DELETE("/v1/invite-codes/{id}", _UserService_RevokeInviteCode0_HTTP_Handler(srv))
 }
 
 func _UserService_CurrentUserInfo0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
@@ -125,10 +161,280 @@ func _UserService_DeleteUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx h
 	}
 }
 
+func _UserService_GetPreferences0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.GetPreferencesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetPreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPreferences(ctx, req.(*v1.GetPreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.GetPreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UpdatePreferences0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.UpdatePreferencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUpdatePreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePreferences(ctx, req.(*v1.UpdatePreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.UpdatePreferencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_UnlockUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*v1.UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.UnlockUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ListUsers0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUsers(ctx, req.(*v1.ListUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListUsersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_BulkUpdateRole0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.BulkUpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceBulkUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkUpdateRole(ctx, req.(*v1.BulkUpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.BulkUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_DisableUsers0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.DisableUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceDisableUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableUsers(ctx, req.(*v1.DisableUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.BulkUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_EnableUsers0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.EnableUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceEnableUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnableUsers(ctx, req.(*v1.EnableUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.BulkUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RestoreUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RestoreUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRestoreUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreUser(ctx, req.(*v1.RestoreUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RestoreUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ExportMyData0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ExportMyDataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceExportMyData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportMyData(ctx, req.(*v1.ExportMyDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ExportMyDataResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_CreateInviteCode0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.CreateInviteCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceCreateInviteCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateInviteCode(ctx, req.(*v1.CreateInviteCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.CreateInviteCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_ListInviteCodes0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ListInviteCodesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceListInviteCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListInviteCodes(ctx, req.(*v1.ListInviteCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListInviteCodesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserService_RevokeInviteCode0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.RevokeInviteCodeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceRevokeInviteCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeInviteCode(ctx, req.(*v1.RevokeInviteCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.RevokeInviteCodeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserServiceHTTPClient interface {
+	BulkUpdateRole(ctx context.Context, req *v1.BulkUpdateRoleRequest, opts ...http.CallOption) (rsp *v1.BulkUserResponse, err error)
+	CreateInviteCode(ctx context.Context, req *v1.CreateInviteCodeRequest, opts ...http.CallOption) (rsp *v1.CreateInviteCodeResponse, err error)
 	CurrentUserInfo(ctx context.Context, req *v1.CurrentUserInfoRequest, opts ...http.CallOption) (rsp *v1.CurrentUserInfoResponse, err error)
 	DeleteUser(ctx context.Context, req *v1.DeleteUserRequest, opts ...http.CallOption) (rsp *v1.DeleteUserResponse, err error)
+	DisableUsers(ctx context.Context, req *v1.DisableUsersRequest, opts ...http.CallOption) (rsp *v1.BulkUserResponse, err error)
+	EnableUsers(ctx context.Context, req *v1.EnableUsersRequest, opts ...http.CallOption) (rsp *v1.BulkUserResponse, err error)
+	ExportMyData(ctx context.Context, req *v1.ExportMyDataRequest, opts ...http.CallOption) (rsp *v1.ExportMyDataResponse, err error)
+	GetPreferences(ctx context.Context, req *v1.GetPreferencesRequest, opts ...http.CallOption) (rsp *v1.GetPreferencesResponse, err error)
+	ListInviteCodes(ctx context.Context, req *v1.ListInviteCodesRequest, opts ...http.CallOption) (rsp *v1.ListInviteCodesResponse, err error)
+	ListUsers(ctx context.Context, req *v1.ListUsersRequest, opts ...http.CallOption) (rsp *v1.ListUsersResponse, err error)
+	RestoreUser(ctx context.Context, req *v1.RestoreUserRequest, opts ...http.CallOption) (rsp *v1.RestoreUserResponse, err error)
+	RevokeInviteCode(ctx context.Context, req *v1.RevokeInviteCodeRequest, opts ...http.CallOption) (rsp *v1.RevokeInviteCodeResponse, err error)
 	SaveUser(ctx context.Context, req *v1.SaveUserRequest, opts ...http.CallOption) (rsp *v1.SaveUserResponse, err error)
+	UnlockUser(ctx context.Context, req *v1.UnlockUserRequest, opts ...http.CallOption) (rsp *v1.UnlockUserResponse, err error)
+	UpdatePreferences(ctx context.Context, req *v1.UpdatePreferencesRequest, opts ...http.CallOption) (rsp *v1.UpdatePreferencesResponse, err error)
 	UpdateUser(ctx context.Context, req *v1.UpdateUserRequest, opts ...http.CallOption) (rsp *v1.UpdateUserResponse, err error)
 }
 
@@ -140,6 +446,32 @@ func NewUserServiceHTTPClient(client *http.Client) UserServiceHTTPClient {
 	return &UserServiceHTTPClientImpl{client}
 }
 
+func (c *UserServiceHTTPClientImpl) BulkUpdateRole(ctx context.Context, in *v1.BulkUpdateRoleRequest, opts ...http.CallOption) (*v1.BulkUserResponse, error) {
+	var out v1.BulkUserResponse
+	pattern := "/v1/users/bulk/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceBulkUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) CreateInviteCode(ctx context.Context, in *v1.CreateInviteCodeRequest, opts ...http.CallOption) (*v1.CreateInviteCodeResponse, error) {
+	var out v1.CreateInviteCodeResponse
+	pattern := "/v1/invite-codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceCreateInviteCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) CurrentUserInfo(ctx context.Context, in *v1.CurrentUserInfoRequest, opts ...http.CallOption) (*v1.CurrentUserInfoResponse, error) {
 	var out v1.CurrentUserInfoResponse
 	pattern := "/v1/user/info"
@@ -166,6 +498,110 @@ func (c *UserServiceHTTPClientImpl) DeleteUser(ctx context.Context, in *v1.Delet
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) DisableUsers(ctx context.Context, in *v1.DisableUsersRequest, opts ...http.CallOption) (*v1.BulkUserResponse, error) {
+	var out v1.BulkUserResponse
+	pattern := "/v1/users/bulk/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceDisableUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) EnableUsers(ctx context.Context, in *v1.EnableUsersRequest, opts ...http.CallOption) (*v1.BulkUserResponse, error) {
+	var out v1.BulkUserResponse
+	pattern := "/v1/users/bulk/enable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceEnableUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ExportMyData(ctx context.Context, in *v1.ExportMyDataRequest, opts ...http.CallOption) (*v1.ExportMyDataResponse, error) {
+	var out v1.ExportMyDataResponse
+	pattern := "/v1/user/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceExportMyData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) GetPreferences(ctx context.Context, in *v1.GetPreferencesRequest, opts ...http.CallOption) (*v1.GetPreferencesResponse, error) {
+	var out v1.GetPreferencesResponse
+	pattern := "/v1/user/preferences"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetPreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ListInviteCodes(ctx context.Context, in *v1.ListInviteCodesRequest, opts ...http.CallOption) (*v1.ListInviteCodesResponse, error) {
+	var out v1.ListInviteCodesResponse
+	pattern := "/v1/invite-codes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListInviteCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) ListUsers(ctx context.Context, in *v1.ListUsersRequest, opts ...http.CallOption) (*v1.ListUsersResponse, error) {
+	var out v1.ListUsersResponse
+	pattern := "/v1/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceListUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RestoreUser(ctx context.Context, in *v1.RestoreUserRequest, opts ...http.CallOption) (*v1.RestoreUserResponse, error) {
+	var out v1.RestoreUserResponse
+	pattern := "/v1/users/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceRestoreUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) RevokeInviteCode(ctx context.Context, in *v1.RevokeInviteCodeRequest, opts ...http.CallOption) (*v1.RevokeInviteCodeResponse, error) {
+	var out v1.RevokeInviteCodeResponse
+	pattern := "/v1/invite-codes/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceRevokeInviteCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) SaveUser(ctx context.Context, in *v1.SaveUserRequest, opts ...http.CallOption) (*v1.SaveUserResponse, error) {
 	var out v1.SaveUserResponse
 	pattern := "/v1/user/save"
@@ -179,6 +615,32 @@ func (c *UserServiceHTTPClientImpl) SaveUser(ctx context.Context, in *v1.SaveUse
 	return &out, nil
 }
 
+func (c *UserServiceHTTPClientImpl) UnlockUser(ctx context.Context, in *v1.UnlockUserRequest, opts ...http.CallOption) (*v1.UnlockUserResponse, error) {
+	var out v1.UnlockUserResponse
+	pattern := "/v1/user/{id}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserServiceHTTPClientImpl) UpdatePreferences(ctx context.Context, in *v1.UpdatePreferencesRequest, opts ...http.CallOption) (*v1.UpdatePreferencesResponse, error) {
+	var out v1.UpdatePreferencesResponse
+	pattern := "/v1/user/preferences"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceUpdatePreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest, opts ...http.CallOption) (*v1.UpdateUserResponse, error) {
 	var out v1.UpdateUserResponse
 	pattern := "/v1/user/update"
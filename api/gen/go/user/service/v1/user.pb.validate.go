@@ -165,6 +165,35 @@ func (m *CurrentUserInfoResponse) validate(all bool) error {
 
 	// no validation rules for Role
 
+	if all {
+		switch v := interface{}(m.GetImpersonator()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CurrentUserInfoResponseValidationError{
+					field:  "Impersonator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CurrentUserInfoResponseValidationError{
+					field:  "Impersonator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImpersonator()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CurrentUserInfoResponseValidationError{
+				field:  "Impersonator",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CurrentUserInfoResponseMultiError(errors)
 	}
@@ -245,6 +274,109 @@ var _ interface {
 	ErrorName() string
 } = CurrentUserInfoResponseValidationError{}
 
+// Validate checks the field values on Impersonator with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Impersonator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Impersonator with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImpersonatorMultiError, or
+// nil if none found.
+func (m *Impersonator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Impersonator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ImpersonatorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpersonatorMultiError is an error wrapping multiple validation errors
+// returned by Impersonator.ValidateAll() if the designated constraints aren't met.
+type ImpersonatorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonatorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonatorMultiError) AllErrors() []error { return m }
+
+// ImpersonatorValidationError is the validation error returned by
+// Impersonator.Validate if the designated constraints aren't met.
+type ImpersonatorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpersonatorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpersonatorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpersonatorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpersonatorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpersonatorValidationError) ErrorName() string { return "ImpersonatorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImpersonatorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpersonator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpersonatorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonatorValidationError{}
+
 // Validate checks the field values on DeleteUserRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -719,6 +851,8 @@ func (m *SaveUserRequest) validate(all bool) error {
 
 	// no validation rules for Role
 
+	// no validation rules for PasswordHashed
+
 	if len(errors) > 0 {
 		return SaveUserRequestMultiError(errors)
 	}
@@ -898,3 +1032,3754 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SaveUserResponseValidationError{}
+
+// Validate checks the field values on NotificationPreference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPreference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPreference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPreferenceMultiError, or nil if none found.
+func (m *NotificationPreference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPreference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventType
+
+	// no validation rules for Channel
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return NotificationPreferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPreferenceMultiError is an error wrapping multiple validation
+// errors returned by NotificationPreference.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPreferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPreferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPreferenceMultiError) AllErrors() []error { return m }
+
+// NotificationPreferenceValidationError is the validation error returned by
+// NotificationPreference.Validate if the designated constraints aren't met.
+type NotificationPreferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferenceValidationError) ErrorName() string {
+	return "NotificationPreferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferenceValidationError{}
+
+// Validate checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreferencesRequestMultiError, or nil if none found.
+func (m *GetPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetPreferencesRequestValidationError is the validation error returned by
+// GetPreferencesRequest.Validate if the designated constraints aren't met.
+type GetPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreferencesRequestValidationError) ErrorName() string {
+	return "GetPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreferencesRequestValidationError{}
+
+// Validate checks the field values on GetPreferencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreferencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreferencesResponseMultiError, or nil if none found.
+func (m *GetPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPreferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPreferencesResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPreferencesResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPreferencesResponseValidationError{
+					field:  fmt.Sprintf("Preferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDefaults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPreferencesResponseValidationError{
+						field:  fmt.Sprintf("Defaults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPreferencesResponseValidationError{
+						field:  fmt.Sprintf("Defaults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPreferencesResponseValidationError{
+					field:  fmt.Sprintf("Defaults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreferencesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPreferencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreferencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// GetPreferencesResponseValidationError is the validation error returned by
+// GetPreferencesResponse.Validate if the designated constraints aren't met.
+type GetPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreferencesResponseValidationError) ErrorName() string {
+	return "GetPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreferencesResponseValidationError{}
+
+// Validate checks the field values on UpdatePreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePreferencesRequestMultiError, or nil if none found.
+func (m *UpdatePreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPreferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdatePreferencesRequestValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdatePreferencesRequestValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdatePreferencesRequestValidationError{
+					field:  fmt.Sprintf("Preferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePreferencesRequestValidationError is the validation error returned by
+// UpdatePreferencesRequest.Validate if the designated constraints aren't met.
+type UpdatePreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePreferencesRequestValidationError) ErrorName() string {
+	return "UpdatePreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePreferencesRequestValidationError{}
+
+// Validate checks the field values on UpdatePreferencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePreferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePreferencesResponseMultiError, or nil if none found.
+func (m *UpdatePreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPreferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdatePreferencesResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdatePreferencesResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdatePreferencesResponseValidationError{
+					field:  fmt.Sprintf("Preferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePreferencesResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePreferencesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdatePreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePreferencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePreferencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePreferencesResponseValidationError is the validation error returned by
+// UpdatePreferencesResponse.Validate if the designated constraints aren't
+// met.
+type UpdatePreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePreferencesResponseValidationError) ErrorName() string {
+	return "UpdatePreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePreferencesResponseValidationError{}
+
+// Validate checks the field values on UnlockUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserRequestMultiError, or nil if none found.
+func (m *UnlockUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UnlockUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserRequestMultiError) AllErrors() []error { return m }
+
+// UnlockUserRequestValidationError is the validation error returned by
+// UnlockUserRequest.Validate if the designated constraints aren't met.
+type UnlockUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserRequestValidationError) ErrorName() string {
+	return "UnlockUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserRequestValidationError{}
+
+// Validate checks the field values on UnlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlockUserResponseMultiError, or nil if none found.
+func (m *UnlockUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return UnlockUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserResponseMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserResponseMultiError) AllErrors() []error { return m }
+
+// UnlockUserResponseValidationError is the validation error returned by
+// UnlockUserResponse.Validate if the designated constraints aren't met.
+type UnlockUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserResponseValidationError) ErrorName() string {
+	return "UnlockUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlockUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserResponseValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	// no validation rules for EmailVerified
+
+	// no validation rules for MfaEnabled
+
+	// no validation rules for Disabled
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on ListUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersRequestMultiError, or nil if none found.
+func (m *ListUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for Query
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Deleted
+
+	if m.Disabled != nil {
+
+		// no validation rules for Disabled
+
+	}
+
+	if len(errors) > 0 {
+		return ListUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsersRequestValidationError is the validation error returned by
+// ListUsersRequest.Validate if the designated constraints aren't met.
+type ListUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersRequestValidationError{}
+
+// Validate checks the field values on ListUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersResponseMultiError, or nil if none found.
+func (m *ListUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsersResponseValidationError is the validation error returned by
+// ListUsersResponse.Validate if the designated constraints aren't met.
+type ListUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResponseValidationError) ErrorName() string {
+	return "ListUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResponseValidationError{}
+
+// Validate checks the field values on BulkUpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUpdateRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUpdateRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUpdateRoleRequestMultiError, or nil if none found.
+func (m *BulkUpdateRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUpdateRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return BulkUpdateRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUpdateRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkUpdateRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulkUpdateRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUpdateRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUpdateRoleRequestMultiError) AllErrors() []error { return m }
+
+// BulkUpdateRoleRequestValidationError is the validation error returned by
+// BulkUpdateRoleRequest.Validate if the designated constraints aren't met.
+type BulkUpdateRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUpdateRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUpdateRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUpdateRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUpdateRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUpdateRoleRequestValidationError) ErrorName() string {
+	return "BulkUpdateRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUpdateRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUpdateRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUpdateRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUpdateRoleRequestValidationError{}
+
+// Validate checks the field values on DisableUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableUsersRequestMultiError, or nil if none found.
+func (m *DisableUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableUsersRequestMultiError) AllErrors() []error { return m }
+
+// DisableUsersRequestValidationError is the validation error returned by
+// DisableUsersRequest.Validate if the designated constraints aren't met.
+type DisableUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableUsersRequestValidationError) ErrorName() string {
+	return "DisableUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableUsersRequestValidationError{}
+
+// Validate checks the field values on EnableUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableUsersRequestMultiError, or nil if none found.
+func (m *EnableUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnableUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by EnableUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnableUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableUsersRequestMultiError) AllErrors() []error { return m }
+
+// EnableUsersRequestValidationError is the validation error returned by
+// EnableUsersRequest.Validate if the designated constraints aren't met.
+type EnableUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableUsersRequestValidationError) ErrorName() string {
+	return "EnableUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableUsersRequestValidationError{}
+
+// Validate checks the field values on BulkUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BulkUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUserResponseMultiError, or nil if none found.
+func (m *BulkUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFailed() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkUserResponseValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkUserResponseValidationError{
+						field:  fmt.Sprintf("Failed[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkUserResponseValidationError{
+					field:  fmt.Sprintf("Failed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BulkUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BulkUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUserResponseMultiError) AllErrors() []error { return m }
+
+// BulkUserResponseValidationError is the validation error returned by
+// BulkUserResponse.Validate if the designated constraints aren't met.
+type BulkUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUserResponseValidationError) ErrorName() string { return "BulkUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BulkUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUserResponseValidationError{}
+
+// Validate checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserRequestMultiError, or nil if none found.
+func (m *RestoreUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserRequestMultiError) AllErrors() []error { return m }
+
+// RestoreUserRequestValidationError is the validation error returned by
+// RestoreUserRequest.Validate if the designated constraints aren't met.
+type RestoreUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserRequestValidationError) ErrorName() string {
+	return "RestoreUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserRequestValidationError{}
+
+// Validate checks the field values on RestoreUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserResponseMultiError, or nil if none found.
+func (m *RestoreUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreUserResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserResponseMultiError) AllErrors() []error { return m }
+
+// RestoreUserResponseValidationError is the validation error returned by
+// RestoreUserResponse.Validate if the designated constraints aren't met.
+type RestoreUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreUserResponseValidationError) ErrorName() string {
+	return "RestoreUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreUserResponseValidationError{}
+
+// Validate checks the field values on ExportMyDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataRequestMultiError, or nil if none found.
+func (m *ExportMyDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportMyDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMyDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportMyDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMyDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportMyDataRequestValidationError is the validation error returned by
+// ExportMyDataRequest.Validate if the designated constraints aren't met.
+type ExportMyDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataRequestValidationError) ErrorName() string {
+	return "ExportMyDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataRequestValidationError{}
+
+// Validate checks the field values on ExportMyDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataResponseMultiError, or nil if none found.
+func (m *ExportMyDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponseValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponseValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponseValidationError{
+				field:  "ExportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPreferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportMyDataResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportMyDataResponseValidationError{
+						field:  fmt.Sprintf("Preferences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportMyDataResponseValidationError{
+					field:  fmt.Sprintf("Preferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportMyDataResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportMyDataResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportMyDataResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportMyDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMyDataResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportMyDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMyDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataResponseMultiError) AllErrors() []error { return m }
+
+// ExportMyDataResponseValidationError is the validation error returned by
+// ExportMyDataResponse.Validate if the designated constraints aren't met.
+type ExportMyDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataResponseValidationError) ErrorName() string {
+	return "ExportMyDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataResponseValidationError{}
+
+// Validate checks the field values on InviteCode with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InviteCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteCode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InviteCodeMultiError, or
+// nil if none found.
+func (m *InviteCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Hint
+
+	// no validation rules for MaxUses
+
+	// no validation rules for UsedCount
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteCodeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteCodeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteCodeValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteCodeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteCodeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteCodeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InviteCodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteCodeMultiError is an error wrapping multiple validation errors
+// returned by InviteCode.ValidateAll() if the designated constraints aren't met.
+type InviteCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteCodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteCodeMultiError) AllErrors() []error { return m }
+
+// InviteCodeValidationError is the validation error returned by
+// InviteCode.Validate if the designated constraints aren't met.
+type InviteCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteCodeValidationError) ErrorName() string { return "InviteCodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteCodeValidationError{}
+
+// Validate checks the field values on CreateInviteCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteCodeRequestMultiError, or nil if none found.
+func (m *CreateInviteCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxUses
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInviteCodeRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInviteCodeRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInviteCodeRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return CreateInviteCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInviteCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateInviteCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInviteCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteCodeRequestMultiError) AllErrors() []error { return m }
+
+// CreateInviteCodeRequestValidationError is the validation error returned by
+// CreateInviteCodeRequest.Validate if the designated constraints aren't met.
+type CreateInviteCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteCodeRequestValidationError) ErrorName() string {
+	return "CreateInviteCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInviteCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteCodeRequestValidationError{}
+
+// Validate checks the field values on CreateInviteCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteCodeResponseMultiError, or nil if none found.
+func (m *CreateInviteCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInviteCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInviteCodeResponseValidationError{
+					field:  "InviteCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInviteCodeResponseValidationError{
+					field:  "InviteCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInviteCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInviteCodeResponseValidationError{
+				field:  "InviteCode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return CreateInviteCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInviteCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInviteCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInviteCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteCodeResponseMultiError) AllErrors() []error { return m }
+
+// CreateInviteCodeResponseValidationError is the validation error returned by
+// CreateInviteCodeResponse.Validate if the designated constraints aren't met.
+type CreateInviteCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteCodeResponseValidationError) ErrorName() string {
+	return "CreateInviteCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInviteCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteCodeResponseValidationError{}
+
+// Validate checks the field values on ListInviteCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInviteCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInviteCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInviteCodesRequestMultiError, or nil if none found.
+func (m *ListInviteCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInviteCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeInactive
+
+	if len(errors) > 0 {
+		return ListInviteCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInviteCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInviteCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInviteCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInviteCodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInviteCodesRequestMultiError) AllErrors() []error { return m }
+
+// ListInviteCodesRequestValidationError is the validation error returned by
+// ListInviteCodesRequest.Validate if the designated constraints aren't met.
+type ListInviteCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInviteCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInviteCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInviteCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInviteCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInviteCodesRequestValidationError) ErrorName() string {
+	return "ListInviteCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInviteCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInviteCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInviteCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInviteCodesRequestValidationError{}
+
+// Validate checks the field values on ListInviteCodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInviteCodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInviteCodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInviteCodesResponseMultiError, or nil if none found.
+func (m *ListInviteCodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInviteCodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInviteCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInviteCodesResponseValidationError{
+						field:  fmt.Sprintf("InviteCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInviteCodesResponseValidationError{
+						field:  fmt.Sprintf("InviteCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInviteCodesResponseValidationError{
+					field:  fmt.Sprintf("InviteCodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInviteCodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInviteCodesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInviteCodesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInviteCodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInviteCodesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInviteCodesResponseMultiError) AllErrors() []error { return m }
+
+// ListInviteCodesResponseValidationError is the validation error returned by
+// ListInviteCodesResponse.Validate if the designated constraints aren't met.
+type ListInviteCodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInviteCodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInviteCodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInviteCodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInviteCodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInviteCodesResponseValidationError) ErrorName() string {
+	return "ListInviteCodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInviteCodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInviteCodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInviteCodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInviteCodesResponseValidationError{}
+
+// Validate checks the field values on RevokeInviteCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInviteCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInviteCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInviteCodeRequestMultiError, or nil if none found.
+func (m *RevokeInviteCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInviteCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RevokeInviteCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeInviteCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeInviteCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInviteCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInviteCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInviteCodeRequestMultiError) AllErrors() []error { return m }
+
+// RevokeInviteCodeRequestValidationError is the validation error returned by
+// RevokeInviteCodeRequest.Validate if the designated constraints aren't met.
+type RevokeInviteCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInviteCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInviteCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInviteCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInviteCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInviteCodeRequestValidationError) ErrorName() string {
+	return "RevokeInviteCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeInviteCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInviteCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInviteCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInviteCodeRequestValidationError{}
+
+// Validate checks the field values on RevokeInviteCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInviteCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInviteCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInviteCodeResponseMultiError, or nil if none found.
+func (m *RevokeInviteCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInviteCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RevokeInviteCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeInviteCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeInviteCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInviteCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInviteCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInviteCodeResponseMultiError) AllErrors() []error { return m }
+
+// RevokeInviteCodeResponseValidationError is the validation error returned by
+// RevokeInviteCodeResponse.Validate if the designated constraints aren't met.
+type RevokeInviteCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInviteCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInviteCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInviteCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInviteCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInviteCodeResponseValidationError) ErrorName() string {
+	return "RevokeInviteCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeInviteCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInviteCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInviteCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInviteCodeResponseValidationError{}
+
+// Validate checks the field values on BulkUserResponse_Failure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUserResponse_Failure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUserResponse_Failure with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUserResponse_FailureMultiError, or nil if none found.
+func (m *BulkUserResponse_Failure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUserResponse_Failure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return BulkUserResponse_FailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUserResponse_FailureMultiError is an error wrapping multiple validation
+// errors returned by BulkUserResponse_Failure.ValidateAll() if the designated
+// constraints aren't met.
+type BulkUserResponse_FailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUserResponse_FailureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUserResponse_FailureMultiError) AllErrors() []error { return m }
+
+// BulkUserResponse_FailureValidationError is the validation error returned by
+// BulkUserResponse_Failure.Validate if the designated constraints aren't met.
+type BulkUserResponse_FailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUserResponse_FailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUserResponse_FailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUserResponse_FailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUserResponse_FailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUserResponse_FailureValidationError) ErrorName() string {
+	return "BulkUserResponse_FailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUserResponse_FailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUserResponse_Failure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUserResponse_FailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUserResponse_FailureValidationError{}
+
+// Validate checks the field values on ExportMyDataResponse_Profile with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataResponse_Profile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataResponse_Profile with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataResponse_ProfileMultiError, or nil if none found.
+func (m *ExportMyDataResponse_Profile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataResponse_Profile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Phone
+
+	// no validation rules for Avatar
+
+	// no validation rules for Bio
+
+	// no validation rules for Location
+
+	// no validation rules for Website
+
+	// no validation rules for Role
+
+	// no validation rules for EmailVerified
+
+	// no validation rules for MfaEnabled
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponse_ProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponse_ProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponse_ProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponse_ProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponse_ProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponse_ProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportMyDataResponse_ProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMyDataResponse_ProfileMultiError is an error wrapping multiple
+// validation errors returned by ExportMyDataResponse_Profile.ValidateAll() if
+// the designated constraints aren't met.
+type ExportMyDataResponse_ProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataResponse_ProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataResponse_ProfileMultiError) AllErrors() []error { return m }
+
+// ExportMyDataResponse_ProfileValidationError is the validation error returned
+// by ExportMyDataResponse_Profile.Validate if the designated constraints
+// aren't met.
+type ExportMyDataResponse_ProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataResponse_ProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataResponse_ProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataResponse_ProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataResponse_ProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataResponse_ProfileValidationError) ErrorName() string {
+	return "ExportMyDataResponse_ProfileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataResponse_ProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataResponse_Profile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataResponse_ProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataResponse_ProfileValidationError{}
+
+// Validate checks the field values on ExportMyDataResponse_Session with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMyDataResponse_Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMyDataResponse_Session with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMyDataResponse_SessionMultiError, or nil if none found.
+func (m *ExportMyDataResponse_Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMyDataResponse_Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserAgent
+
+	// no validation rules for Ip
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponse_SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponse_SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponse_SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMyDataResponse_SessionValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMyDataResponse_SessionValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMyDataResponse_SessionValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportMyDataResponse_SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMyDataResponse_SessionMultiError is an error wrapping multiple
+// validation errors returned by ExportMyDataResponse_Session.ValidateAll() if
+// the designated constraints aren't met.
+type ExportMyDataResponse_SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMyDataResponse_SessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMyDataResponse_SessionMultiError) AllErrors() []error { return m }
+
+// ExportMyDataResponse_SessionValidationError is the validation error returned
+// by ExportMyDataResponse_Session.Validate if the designated constraints
+// aren't met.
+type ExportMyDataResponse_SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMyDataResponse_SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMyDataResponse_SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMyDataResponse_SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMyDataResponse_SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMyDataResponse_SessionValidationError) ErrorName() string {
+	return "ExportMyDataResponse_SessionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMyDataResponse_SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMyDataResponse_Session.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMyDataResponse_SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMyDataResponse_SessionValidationError{}
@@ -66,3 +66,129 @@ func IsSaveUserFailed(err error) bool {
 func ErrorSaveUserFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, ErrorReason_SAVE_USER_FAILED.String(), fmt.Sprintf(format, args...))
 }
+
+// 通知偏好设置不合法
+func IsInvalidPreferences(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_PREFERENCES.String() && e.Code == 400
+}
+
+// 通知偏好设置不合法
+func ErrorInvalidPreferences(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_PREFERENCES.String(), fmt.Sprintf(format, args...))
+}
+
+// 解除锁定失败
+func IsUnlockUserFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UNLOCK_USER_FAILED.String() && e.Code == 500
+}
+
+// 解除锁定失败
+func ErrorUnlockUserFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_UNLOCK_USER_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色不存在
+func IsInvalidRole(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_ROLE.String() && e.Code == 400
+}
+
+// 角色不存在
+func ErrorInvalidRole(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_ROLE.String(), fmt.Sprintf(format, args...))
+}
+
+// 列表查询参数无效（分页 token 与排序不匹配等）
+func IsInvalidListRequest(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_LIST_REQUEST.String() && e.Code == 400
+}
+
+// 列表查询参数无效（分页 token 与排序不匹配等）
+func ErrorInvalidListRequest(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_LIST_REQUEST.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户未删除或已超过恢复宽限期
+func IsUserNotRestorable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_NOT_RESTORABLE.String() && e.Code == 409
+}
+
+// 用户未删除或已超过恢复宽限期
+func ErrorUserNotRestorable(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_USER_NOT_RESTORABLE.String(), fmt.Sprintf(format, args...))
+}
+
+// 导出用户数据失败
+func IsExportDataFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EXPORT_DATA_FAILED.String() && e.Code == 500
+}
+
+// 导出用户数据失败
+func ErrorExportDataFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_EXPORT_DATA_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邀请码不存在
+func IsInviteCodeNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVITE_CODE_NOT_FOUND.String() && e.Code == 404
+}
+
+// 邀请码不存在
+func ErrorInviteCodeNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_INVITE_CODE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// 保存邀请码失败
+func IsSaveInviteCodeFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SAVE_INVITE_CODE_FAILED.String() && e.Code == 500
+}
+
+// 保存邀请码失败
+func ErrorSaveInviteCodeFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SAVE_INVITE_CODE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+// 邀请码参数无效（过期时间早于当前时间等）
+func IsInvalidInviteRequest(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_INVITE_REQUEST.String() && e.Code == 400
+}
+
+// 邀请码参数无效（过期时间早于当前时间等）
+func ErrorInvalidInviteRequest(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_INVITE_REQUEST.String(), fmt.Sprintf(format, args...))
+}
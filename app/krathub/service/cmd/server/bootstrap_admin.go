@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// adminPasswordEnv 未指定 -password-file 时从该环境变量读取初始管理员密码
+const adminPasswordEnv = "BOOTSTRAP_ADMIN_PASSWORD"
+
+// runBootstrapAdmin 执行 bootstrap-admin 子命令：创建初始管理员，已创建时使用 -reset 重置其密码。
+// 密码从文件或环境变量读取，不出现在命令行参数中，便于部署脚本非交互地执行
+//
+//	server -conf ./configs bootstrap-admin -email admin@example.com -password-file /run/secrets/admin_password
+func runBootstrapAdmin(bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("bootstrap-admin", flag.ContinueOnError)
+	name := fs.String("name", biz.InitialAdminName, "username of the initial admin")
+	email := fs.String("email", "", "email of the initial admin, required when it is created")
+	passwordFile := fs.String("password-file", "", "read the password from this file, - for stdin (default $"+adminPasswordEnv+")")
+	reset := fs.Bool("reset", false, "reset the password of the initial admin if it already exists")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	password, err := readAdminPassword(*passwordFile)
+	if err != nil {
+		return err
+	}
+
+	bootstrap, cleanup, err := wireAdminBootstrap(bc.Discovery, bc.Data, bc.App, bc.Trace, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	user, created, err := bootstrap.EnsureAdmin(context.Background(), *name, *email, password, *reset)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Printf("initial admin %s (id %d) created\n", user.Name, user.ID)
+	} else {
+		fmt.Printf("initial admin %s (id %d) reset\n", user.Name, user.ID)
+	}
+	return nil
+}
+
+func readAdminPassword(file string) (string, error) {
+	var password string
+	switch file {
+	case "":
+		password = os.Getenv(adminPasswordEnv)
+	case "-":
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("read password from stdin: %w", err)
+		}
+		password = string(b)
+	default:
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read password file: %w", err)
+		}
+		password = string(b)
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return "", errors.New("password is required, set -password-file or $" + adminPasswordEnv)
+	}
+	return password, nil
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
 
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/server"
 	"github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -43,14 +45,14 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "./configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, reg registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, reg registry.Registrar, gs *grpc.Server, hs *http.Server, mq *mail.Queue, wd *server.WebhookDispatcher, dw *server.DigestWorker, pw *server.PurgeWorker, ow *server.OutboxWorker, ec *server.EventConsumer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
 		kratos.Metadata(Metadata),
 		kratos.Logger(logger),
-		kratos.Server(gs, hs),
+		kratos.Server(gs, hs, mq, wd, dw, pw, ow, ec),
 		kratos.Registrar(reg),
 	)
 }
@@ -122,6 +124,16 @@ func main() {
 		Compress:   bc.App.Log.Compress,
 	})
 
+	// 子命令：创建或重置初始管理员后退出
+	if flag.Arg(0) == "bootstrap-admin" {
+		if err := runBootstrapAdmin(bc, log, flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "bootstrap-admin: %v\n", err)
+			c.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// 初始化链路追踪
 	if err := initTracerProvider(bc.Trace, bc.App.Env); err != nil {
 		panic(err)
@@ -11,6 +11,7 @@ import (
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/server"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
+	"github.com/ToAtlas/AtlasBackend/pkg/eventbus"
 	"github.com/ToAtlas/AtlasBackend/pkg/transport/client"
 
 	"github.com/go-kratos/kratos/v2"
@@ -20,5 +21,10 @@ import (
 
 // wireApp init kratos application.
 func wireApp(*conf.Server, *conf.Discovery, *conf.Registry, *conf.Data, *conf.App, *conf.Trace, *conf.Metrics, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, client.ProviderSet, newApp))
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, client.ProviderSet, eventbus.ProviderSet, newApp))
+}
+
+// wireAdminBootstrap init the initial admin bootstrap for the bootstrap-admin command.
+func wireAdminBootstrap(*conf.Discovery, *conf.Data, *conf.App, *conf.Trace, log.Logger) (*biz.AdminBootstrap, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet, client.ProviderSet))
 }
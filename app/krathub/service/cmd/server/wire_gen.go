@@ -13,6 +13,7 @@ import (
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/server"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/server/middleware"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
+	"github.com/ToAtlas/AtlasBackend/pkg/eventbus"
 	"github.com/ToAtlas/AtlasBackend/pkg/transport/client"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -34,8 +35,6 @@ func wireApp(confServer *conf.Server, discovery *conf.Discovery, registry *conf.
 	}
 	grpcMiddleware := server.NewGRPCMiddleware(trace, serverMetrics, logger)
 	grpcServer := server.NewGRPCServer(confServer, grpcMiddleware, logger)
-	authJWT := middleware.NewAuthMiddleware(app)
-	httpMiddleware := server.NewHTTPMiddleware(trace, serverMetrics, logger, authJWT)
 	db, err := data.NewDB(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -54,18 +53,149 @@ func wireApp(confServer *conf.Server, discovery *conf.Discovery, registry *conf.
 		cleanup()
 		return nil, nil, err
 	}
+	tokenRevocationRepo := data.NewTokenRevocationRepo(dataData, logger)
+	tokenRevoker := biz.NewTokenRevoker(tokenRevocationRepo, logger, app)
+	jwt, err := biz.NewAccessTokenJWT(app)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenRepo := data.NewTokenRepo(dataData, logger)
 	authRepo := data.NewAuthRepo(dataData, logger)
-	authUsecase := biz.NewAuthUsecase(authRepo, logger, app)
-	authService := service.NewAuthService(authUsecase)
+	tokenUsecase := biz.NewTokenUsecase(tokenRepo, authRepo, logger, app)
+	roleRepo := data.NewRoleRepo(dataData, logger)
+	authorizer, err := biz.NewAuthorizer(roleRepo, logger, app)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authJWT := middleware.NewAuthMiddleware(jwt, tokenRevoker, tokenUsecase, authorizer)
+	httpMiddleware := server.NewHTTPMiddleware(confServer, trace, serverMetrics, logger, authJWT)
+	mfaRepo := data.NewMFARepo(dataData, logger)
+	identityRepo := data.NewIdentityRepo(dataData, logger)
+	loginThrottleRepo := data.NewLoginThrottleRepo(dataData, logger)
+	loginThrottler := biz.NewLoginThrottler(loginThrottleRepo, logger, app)
+	queue, err := data.NewMailQueue(app, redisClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mailer, err := data.NewMailer(app, queue)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	oidcProviders, err := biz.NewOIDCProviders(app)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordPolicy, err := biz.NewPasswordPolicy(app, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookRepo := data.NewWebhookRepo(dataData, logger)
+	webhookUsecase := biz.NewWebhookUsecase(webhookRepo, logger, app)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger, app, authRepo)
-	userService := service.NewUserService(userUsecase)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, userRepo, logger, app, mailer, webhookUsecase)
+	workspaceRepo := data.NewWorkspaceRepo(dataData, logger)
+	auditRepo := data.NewAuditRepo(dataData, logger)
+	auditUsecase := biz.NewAuditUsecase(auditRepo, authorizer, logger)
+	signupInviteRepo := data.NewSignupInviteRepo(dataData, logger)
+	signupUsecase, err := biz.NewSignupUsecase(signupInviteRepo, authorizer, auditUsecase, logger, app)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bootstrapRepo := data.NewBootstrapRepo(dataData, logger)
+	adminBootstrap := biz.NewAdminBootstrap(bootstrapRepo, authRepo, passwordPolicy, tokenRevoker, loginThrottler, logger)
+	authProviders, err := biz.NewAuthProviders(authRepo, userRepo, identityRepo, passwordPolicy, authorizer, tokenRevoker, webhookUsecase, auditUsecase, logger, app)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authUsecase := biz.NewAuthUsecase(authRepo, mfaRepo, identityRepo, logger, app, jwt, oidcProviders, mailer, webhookUsecase, notificationUsecase, tokenRevoker, loginThrottler, passwordPolicy, workspaceRepo, auditUsecase, signupUsecase, adminBootstrap, authProviders)
+	impersonationUsecase := biz.NewImpersonationUsecase(authRepo, jwt, authorizer, auditUsecase, tokenRevoker, logger, app)
+	authService := service.NewAuthService(authUsecase, impersonationUsecase)
+	userUsecase := biz.NewUserUsecase(userRepo, logger, app, authRepo, webhookUsecase, notificationUsecase, tokenRevoker, loginThrottler, passwordPolicy, authorizer, auditUsecase, signupUsecase)
+	userService := service.NewUserService(userUsecase, notificationUsecase, signupUsecase)
 	testRepo := data.NewTestRepo(dataData, logger)
 	testUsecase := biz.NewTestUsecase(testRepo, logger)
 	testService := service.NewTestService(testUsecase)
-	httpServer := server.NewHTTPServer(confServer, httpMiddleware, serverMetrics, logger, authService, userService, testService)
-	kratosApp := newApp(logger, registrar, grpcServer, httpServer)
+	webhookService := service.NewWebhookService(webhookUsecase)
+	tokenService := service.NewTokenService(tokenUsecase)
+	workspaceUsecase := biz.NewWorkspaceUsecase(workspaceRepo, authRepo, mailer, logger, app)
+	workspaceService := service.NewWorkspaceService(workspaceUsecase, authUsecase)
+	auditService := service.NewAuditService(auditUsecase)
+	scimUsecase := biz.NewSCIMUsecase(userUsecase, userRepo, authRepo, identityRepo, passwordPolicy, authorizer, logger)
+	scimService := service.NewSCIMService(scimUsecase)
+	httpServer := server.NewHTTPServer(confServer, httpMiddleware, serverMetrics, logger, authService, userService, testService, webhookService, tokenService, workspaceService, auditService, scimService)
+	webhookDispatcher := server.NewWebhookDispatcher(webhookUsecase, logger)
+	digestWorker := server.NewDigestWorker(notificationUsecase, logger)
+	purgeWorker := server.NewPurgeWorker(userUsecase, logger)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	bus, cleanup3, err := eventbus.NewBus(confData, redisClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizEventBus := data.NewDomainEventBus(bus)
+	outboxRelay := biz.NewOutboxRelay(outboxRepo, bizEventBus, logger, app)
+	outboxWorker := server.NewOutboxWorker(outboxRelay, logger)
+	eventConsumer := server.NewEventConsumer(bus, webhookUsecase, logger)
+	kratosApp := newApp(logger, registrar, grpcServer, httpServer, queue, webhookDispatcher, digestWorker, purgeWorker, outboxWorker, eventConsumer)
 	return kratosApp, func() {
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireAdminBootstrap init the initial admin bootstrap for the bootstrap-admin command.
+func wireAdminBootstrap(discovery *conf.Discovery, confData *conf.Data, app *conf.App, trace *conf.Trace, logger log.Logger) (*biz.AdminBootstrap, func(), error) {
+	db, err := data.NewDB(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	registryDiscovery := data.NewDiscovery(discovery)
+	clientClient, err := client.NewClient(confData, trace, registryDiscovery, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	redisClient, cleanup, err := data.NewRedis(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataData, cleanup2, err := data.NewData(db, confData, logger, clientClient, redisClient)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	bootstrapRepo := data.NewBootstrapRepo(dataData, logger)
+	authRepo := data.NewAuthRepo(dataData, logger)
+	passwordPolicy, err := biz.NewPasswordPolicy(app, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenRevocationRepo := data.NewTokenRevocationRepo(dataData, logger)
+	tokenRevoker := biz.NewTokenRevoker(tokenRevocationRepo, logger, app)
+	loginThrottleRepo := data.NewLoginThrottleRepo(dataData, logger)
+	loginThrottler := biz.NewLoginThrottler(loginThrottleRepo, logger, app)
+	adminBootstrap := biz.NewAdminBootstrap(bootstrapRepo, authRepo, passwordPolicy, tokenRevoker, loginThrottler, logger)
+	return adminBootstrap, func() {
 		cleanup2()
 		cleanup()
 	}, nil
@@ -0,0 +1,226 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strconv"
+	"time"
+
+	auditpb "github.com/ToAtlas/AtlasBackend/api/gen/go/audit/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+)
+
+// 审计操作
+const (
+	AuditActionSignup           = "auth.signup"
+	AuditActionLogin            = "auth.login" // 密码或 OIDC 登录，需要两步验证时以 auth.mfa_verify 为准
+	AuditActionMFAVerify        = "auth.mfa_verify"
+	AuditActionLogout           = "auth.logout"
+	AuditActionPasswordReset    = "auth.password_reset"
+	AuditActionTokenReuse       = "auth.token_reuse"
+	AuditActionImpersonateStart = "auth.impersonate_start"
+	AuditActionImpersonateEnd   = "auth.impersonate_end"
+	AuditActionMFAEnable        = "auth.mfa_enable"
+	AuditActionMFADisable       = "auth.mfa_disable"
+	AuditActionUserCreate       = "user.create"
+	AuditActionUserUpdate       = "user.update"
+	AuditActionRoleChange       = "user.role_change"
+	AuditActionUserDelete       = "user.delete"
+	AuditActionUserRestore      = "user.restore"
+	AuditActionUserDisable      = "user.disable"
+	AuditActionUserEnable       = "user.enable"
+	AuditActionUserUnlock       = "user.unlock"
+	AuditActionInviteCreate     = "invite_code.create"
+	AuditActionInviteRevoke     = "invite_code.revoke"
+)
+
+// 审计结果
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// 审计操作对象类型
+const (
+	AuditTargetUser       = "user"
+	AuditTargetInviteCode = "invite_code"
+)
+
+const (
+	defaultAuditPageSize    = 50
+	maxAuditPageSize        = 200
+	defaultAuditExportLimit = 10000
+	auditExportBatch        = 500
+	auditUserAgentMaxLen    = 512
+	auditFieldMaxLen        = 255
+)
+
+// AuditRepo 审计日志仓库，只提供追加和查询
+type AuditRepo interface {
+	CreateAuditEvent(context.Context, *po.AuditEvent) error
+	// ListAuditEvents 按 id 倒序查询，beforeID 为 0 表示从最新的记录开始
+	ListAuditEvents(ctx context.Context, filter *AuditFilter, beforeID int64, limit int) ([]*po.AuditEvent, error)
+}
+
+// AuditFilter 审计日志的过滤条件，零值表示不过滤
+type AuditFilter struct {
+	ActorID    int64
+	Action     string
+	TargetType string
+	TargetID   string
+	Result     string
+	Since      *time.Time
+	Until      *time.Time
+}
+
+// AuditEntry 一条待写入的审计事件。操作者为空时取 context 中的当前用户，代登录时为实际操作的管理员，
+// 被模拟的用户记录在 metadata 的 impersonated_user_id 中。IP、User-Agent 和链路追踪ID 由 Record 从 context 中补全
+type AuditEntry struct {
+	Action     string
+	ActorID    int64
+	ActorName  string
+	TargetType string
+	TargetID   int64
+	Err        error // 非空时结果为 failure，原因取错误的 reason
+	Metadata   map[string]any
+}
+
+// AuditUsecase 记录和查询安全审计日志
+type AuditUsecase struct {
+	repo  AuditRepo
+	authz *Authorizer
+	log   *log.Helper
+}
+
+// NewAuditUsecase new an audit usecase.
+func NewAuditUsecase(repo AuditRepo, authz *Authorizer, logger log.Logger) *AuditUsecase {
+	return &AuditUsecase{
+		repo:  repo,
+		authz: authz,
+		log:   log.NewHelper(pkglogger.WithModule(logger, "audit/biz/krathub-service")),
+	}
+}
+
+// Record 写入审计事件。写入失败只记录日志，不影响业务操作的结果；
+// 请求被取消时仍然写入
+func (uc *AuditUsecase) Record(ctx context.Context, entry AuditEntry) {
+	event := &po.AuditEvent{
+		Action:    entry.Action,
+		Result:    AuditResultSuccess,
+		CreatedAt: time.Now(),
+	}
+	if entry.ActorID == 0 {
+		if claims, ok := jwt.FromContext[UserClaims](ctx); ok {
+			entry.ActorID, entry.ActorName = claims.ID, claims.Name
+			if claims.Impersonated() {
+				entry.ActorID, entry.ActorName = claims.Act.ID, claims.Act.Name
+				entry.Metadata = maps.Clone(entry.Metadata)
+				if entry.Metadata == nil {
+					entry.Metadata = make(map[string]any, 1)
+				}
+				entry.Metadata["impersonated_user_id"] = claims.ID
+			}
+		}
+	}
+	if entry.ActorID != 0 {
+		event.ActorID = &entry.ActorID
+	}
+	event.ActorName = optionalString(truncate(entry.ActorName, auditFieldMaxLen))
+	if entry.TargetType != "" {
+		event.TargetType = &entry.TargetType
+		event.TargetID = optionalString(strconv.FormatInt(entry.TargetID, 10))
+	}
+	if entry.Err != nil {
+		event.Result = AuditResultFailure
+		e := errors.FromError(entry.Err)
+		reason := e.Reason
+		if reason == "" {
+			reason = e.Message
+		}
+		event.Reason = optionalString(truncate(reason, auditFieldMaxLen))
+	}
+	client := clientinfo.FromContext(ctx)
+	event.IP = optionalString(client.IP)
+	event.UserAgent = optionalString(truncate(client.UserAgent, auditUserAgentMaxLen))
+	if traceID, _ := tracing.TraceID()(ctx).(string); traceID != "" {
+		event.TraceID = &traceID
+	}
+	if len(entry.Metadata) > 0 {
+		if b, err := json.Marshal(entry.Metadata); err == nil {
+			event.Metadata = optionalString(string(b))
+		}
+	}
+
+	if err := uc.repo.CreateAuditEvent(context.WithoutCancel(ctx), event); err != nil {
+		uc.log.Errorf("write audit event %s failed: %v", entry.Action, err)
+	}
+}
+
+// ListAuditEvents 分页查询审计日志，按发生时间倒序
+func (uc *AuditUsecase) ListAuditEvents(ctx context.Context, filter *AuditFilter, pageToken string, pageSize int) ([]*po.AuditEvent, string, error) {
+	if err := uc.authz.Authorize(ctx, PermAuditRead); err != nil {
+		return nil, "", err
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	pageSize = min(pageSize, maxAuditPageSize)
+	var beforeID int64
+	if pageToken != "" {
+		id, err := strconv.ParseInt(pageToken, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, "", auditpb.ErrorInvalidAuditRequest("invalid page_token")
+		}
+		beforeID = id
+	}
+	events, err := uc.repo.ListAuditEvents(ctx, filter, beforeID, pageSize+1)
+	if err != nil {
+		return nil, "", auditpb.ErrorListAuditEventsFailed("failed to list audit events: %v", err)
+	}
+	if len(events) <= pageSize {
+		return events, "", nil
+	}
+	events = events[:pageSize]
+	return events, strconv.FormatInt(events[len(events)-1].ID, 10), nil
+}
+
+// ExportAuditEvents 按条件查询最多 limit 条审计日志用于导出，按发生时间倒序
+func (uc *AuditUsecase) ExportAuditEvents(ctx context.Context, filter *AuditFilter, limit int) ([]*po.AuditEvent, error) {
+	if err := uc.authz.Authorize(ctx, PermAuditRead); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultAuditExportLimit
+	}
+	var (
+		all      []*po.AuditEvent
+		beforeID int64
+	)
+	for len(all) < limit {
+		events, err := uc.repo.ListAuditEvents(ctx, filter, beforeID, min(auditExportBatch, limit-len(all)))
+		if err != nil {
+			return nil, auditpb.ErrorListAuditEventsFailed("failed to export audit events: %v", err)
+		}
+		all = append(all, events...)
+		if len(events) < auditExportBatch {
+			break
+		}
+		beforeID = events[len(events)-1].ID
+	}
+	return all, nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
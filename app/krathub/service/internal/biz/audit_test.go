@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	auditpb "github.com/ToAtlas/AtlasBackend/api/gen/go/audit/service/v1"
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAudit(t *testing.T) (*AuditUsecase, *memStore) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	return e.audit, e.store
+}
+
+func TestAuditUsecase_Record(t *testing.T) {
+	audit, store := newTestAudit(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	ctx := clientinfo.NewContext(asUser(admin), clientinfo.Info{IP: "203.0.113.7", UserAgent: strings.Repeat("a", 600)})
+
+	// 操作者取当前用户，请求方信息从 context 补全
+	audit.Record(ctx, AuditEntry{Action: AuditActionUserDisable, TargetType: AuditTargetUser, TargetID: 42,
+		Metadata: map[string]any{"role": RoleUser}})
+	event, metadata := lastAudit(t, store)
+	assert.Equal(t, AuditActionUserDisable, event.Action)
+	assert.Equal(t, AuditResultSuccess, event.Result)
+	assert.Equal(t, admin.ID, *event.ActorID)
+	assert.Equal(t, admin.Name, *event.ActorName)
+	assert.Equal(t, AuditTargetUser, *event.TargetType)
+	assert.Equal(t, "42", *event.TargetID)
+	assert.Nil(t, event.Reason)
+	assert.Equal(t, "203.0.113.7", *event.IP)
+	assert.Len(t, *event.UserAgent, auditUserAgentMaxLen)
+	assert.Nil(t, event.TraceID)
+	assert.Equal(t, map[string]any{"role": RoleUser}, metadata)
+	assert.False(t, event.CreatedAt.IsZero())
+
+	// 失败时记录错误的 reason，普通错误记录错误信息
+	audit.Record(ctx, AuditEntry{Action: AuditActionLogin, Err: authpb.ErrorIncorrectPassword("wrong password")})
+	event, metadata = lastAudit(t, store)
+	assert.Equal(t, AuditResultFailure, event.Result)
+	assert.Equal(t, authpb.ErrorReason_INCORRECT_PASSWORD.String(), *event.Reason)
+	assert.Nil(t, event.TargetType)
+	assert.Nil(t, event.TargetID)
+	assert.Nil(t, metadata)
+	audit.Record(ctx, AuditEntry{Action: AuditActionLogin, Err: errors.New("connection refused")})
+	event, _ = lastAudit(t, store)
+	assert.Equal(t, "connection refused", *event.Reason)
+
+	// 显式指定的操作者优先于当前用户，未登录时没有操作者
+	audit.Record(ctx, AuditEntry{Action: AuditActionLogin, ActorID: 7, ActorName: strings.Repeat("n", 300)})
+	event, _ = lastAudit(t, store)
+	assert.Equal(t, int64(7), *event.ActorID)
+	assert.Len(t, *event.ActorName, auditFieldMaxLen)
+	audit.Record(context.Background(), AuditEntry{Action: AuditActionSignup})
+	event, _ = lastAudit(t, store)
+	assert.Nil(t, event.ActorID)
+	assert.Nil(t, event.ActorName)
+	assert.Nil(t, event.IP)
+}
+
+func TestAuditUsecase_RecordImpersonated(t *testing.T) {
+	audit, store := newTestAudit(t)
+	claims := &UserClaims{ID: 5, Name: "alice", Role: RoleUser, Act: &ActorClaims{ID: 1, Name: "admin"}}
+	ctx := jwt.NewContext(context.Background(), claims)
+
+	// 代登录期间的操作记在管理员名下，被模拟的用户写入 metadata，不修改调用方的 metadata
+	metadata := map[string]any{"field": "email"}
+	audit.Record(ctx, AuditEntry{Action: AuditActionUserUpdate, TargetType: AuditTargetUser, TargetID: 5, Metadata: metadata})
+	event, recorded := lastAudit(t, store)
+	assert.Equal(t, int64(1), *event.ActorID)
+	assert.Equal(t, "admin", *event.ActorName)
+	assert.Equal(t, strconv.FormatInt(claims.ID, 10), *event.TargetID)
+	assert.Equal(t, map[string]any{"field": "email", "impersonated_user_id": float64(5)}, recorded)
+	assert.Equal(t, map[string]any{"field": "email"}, metadata)
+
+	audit.Record(ctx, AuditEntry{Action: AuditActionLogout})
+	_, recorded = lastAudit(t, store)
+	assert.Equal(t, map[string]any{"impersonated_user_id": float64(5)}, recorded)
+}
+
+func TestAuditUsecase_ListAuditEvents(t *testing.T) {
+	audit, store := newTestAudit(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	for range 5 {
+		audit.Record(asUser(alice), AuditEntry{Action: AuditActionLogin})
+	}
+	audit.Record(asUser(admin), AuditEntry{Action: AuditActionUserDisable, TargetType: AuditTargetUser, TargetID: alice.ID})
+
+	// 没有 audit:read 权限时不能查询和导出
+	_, _, err := audit.ListAuditEvents(asUser(alice), nil, "", 10)
+	assert.True(t, authpb.IsUnauthorized(err))
+	_, err = audit.ExportAuditEvents(asUser(alice), nil, 10)
+	assert.True(t, authpb.IsUnauthorized(err))
+
+	// 按 id 倒序分页，最后一页没有 next token
+	events, next, err := audit.ListAuditEvents(asUser(admin), nil, "", 4)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, AuditActionUserDisable, events[0].Action)
+	assert.Equal(t, strconv.FormatInt(events[3].ID, 10), next)
+	events, next, err = audit.ListAuditEvents(asUser(admin), nil, next, 4)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Empty(t, next)
+
+	events, _, err = audit.ListAuditEvents(asUser(admin), &AuditFilter{ActorID: admin.ID}, "", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, strconv.FormatInt(alice.ID, 10), *events[0].TargetID)
+
+	for _, token := range []string{"abc", "0", "-1"} {
+		_, _, err = audit.ListAuditEvents(asUser(admin), nil, token, 10)
+		assert.True(t, auditpb.IsInvalidAuditRequest(err), token)
+	}
+
+	exported, err := audit.ExportAuditEvents(asUser(admin), &AuditFilter{Action: AuditActionLogin}, 3)
+	require.NoError(t, err)
+	assert.Len(t, exported, 3)
+}
@@ -4,15 +4,16 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	stderrors "errors"
 	"time"
 
-	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
-
 	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
 	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
 	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
 	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
@@ -20,36 +21,66 @@ import (
 
 // AuthUsecase is a Auth usecase.
 type AuthUsecase struct {
-	repo            AuthRepo
-	log             *log.Helper
-	cfg             *conf.App
-	adminRegistered bool                    // 是否已经注册了 admin 用户
-	accessJWT       *jwtpkg.JWT[UserClaims] // Access Token JWT service
-	refreshJWT      *jwtpkg.JWT[UserClaims] // Refresh Token JWT service (for validation only)
+	repo         AuthRepo
+	mfaRepo      MFARepo
+	identityRepo IdentityRepo
+	log          *log.Helper
+	cfg          *conf.App
+	accessJWT    *jwtpkg.JWT[UserClaims] // Access Token JWT service
+	refreshJWT   *jwtpkg.JWT[UserClaims] // Refresh Token JWT service (for validation only)
+	oidc         *OIDCProviders          // 第三方登录身份提供方
+	mailer       *mail.Mailer            // 邮件通知
+	events       EventPublisher          // 业务事件发布（webhook）
+	notifier     *NotificationUsecase    // 按用户偏好发送通知
+	revoker      *TokenRevoker           // Access Token 吊销
+	throttler    *LoginThrottler         // 登录失败限制
+	passwords    *PasswordPolicy         // 密码策略与密码哈希
+	workspaces   WorkspaceRepo           // 校验活动工作空间的成员身份
+	audit        *AuditUsecase           // 安全审计日志
+	signup       *SignupUsecase          // 注册方式与邀请码
+	bootstrap    *AdminBootstrap         // 初始管理员
+	providers    AuthProviders           // 邮箱密码登录的认证方式
 }
 
-// NewAuthUsecase new an auth usecase.
-func NewAuthUsecase(repo AuthRepo, logger log.Logger, cfg *conf.App) *AuthUsecase {
-	accessJWTService := jwtpkg.NewJWT[UserClaims](&jwtpkg.Config{
-		SecretKey: cfg.Jwt.AccessSecret,
-	})
+// NewAccessTokenJWT 创建签发和验证 Access Token 的 JWT 服务，配置了 signing_keys 时使用非对称签名
+func NewAccessTokenJWT(cfg *conf.App) (*jwtpkg.JWT[UserClaims], error) {
+	keys, err := jwtpkg.NewKeySetFromConfig(cfg.GetJwt().GetSigningKeys())
+	if err != nil {
+		return nil, err
+	}
+	return jwtpkg.NewJWT[UserClaims](&jwtpkg.Config{
+		SecretKey: cfg.GetJwt().GetAccessSecret(),
+		KeySet:    keys,
+	}), nil
+}
 
+// NewAuthUsecase new an auth usecase.
+func NewAuthUsecase(repo AuthRepo, mfaRepo MFARepo, identityRepo IdentityRepo, logger log.Logger, cfg *conf.App, accessJWT *jwtpkg.JWT[UserClaims], oidcProviders *OIDCProviders, mailer *mail.Mailer, events EventPublisher, notifier *NotificationUsecase, revoker *TokenRevoker, throttler *LoginThrottler, passwords *PasswordPolicy, workspaces WorkspaceRepo, audit *AuditUsecase, signup *SignupUsecase, bootstrap *AdminBootstrap, providers AuthProviders) *AuthUsecase {
 	refreshJWTService := jwtpkg.NewJWT[UserClaims](&jwtpkg.Config{
 		SecretKey: cfg.Jwt.RefreshSecret,
 	})
 
-	uc := &AuthUsecase{
-		repo:       repo,
-		log:        log.NewHelper(pkglogger.WithModule(logger, "auth/biz/krathub-service")),
-		cfg:        cfg,
-		accessJWT:  accessJWTService,
-		refreshJWT: refreshJWTService,
+	return &AuthUsecase{
+		repo:         repo,
+		mfaRepo:      mfaRepo,
+		identityRepo: identityRepo,
+		log:          log.NewHelper(pkglogger.WithModule(logger, "auth/biz/krathub-service")),
+		cfg:          cfg,
+		accessJWT:    accessJWT,
+		refreshJWT:   refreshJWTService,
+		oidc:         oidcProviders,
+		mailer:       mailer,
+		events:       events,
+		notifier:     notifier,
+		revoker:      revoker,
+		throttler:    throttler,
+		passwords:    passwords,
+		workspaces:   workspaces,
+		audit:        audit,
+		signup:       signup,
+		bootstrap:    bootstrap,
+		providers:    providers,
 	}
-	admin, err := repo.GetUserByUserName(context.Background(), "admin")
-	if err == nil && admin != nil {
-		uc.adminRegistered = true
-	}
-	return uc
 }
 
 // UserClaims defines the custom claims for the JWT.
@@ -59,9 +90,30 @@ type UserClaims struct {
 	Name  string `json:"name"`
 	Role  string `json:"role"`
 	Nonce string `json:"nonce"` // Random nonce to ensure token uniqueness
+	// SessionID 登录会话ID（即Refresh Token族ID），用于识别当前会话和按会话吊销
+	SessionID string `json:"sid,omitempty"`
+	// WorkspaceID 当前会话的活动工作空间，0 表示未选择
+	WorkspaceID int64 `json:"wid,omitempty"`
+	// Act 代登录时实际操作的管理员，为空表示不是代登录
+	Act *ActorClaims `json:"act,omitempty"`
+	// IssuedAtMs 签发时间（Unix 毫秒）。iat 只精确到秒，吊销水位线按毫秒比较
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
+	// PATID 使用个人访问令牌认证时为令牌 ID，只在服务端设置，不出现在 JWT 中
+	PATID int64 `json:"-"`
 	jwt.RegisteredClaims
 }
 
+// issuedAt 返回 token 的签发时间，旧 token 没有毫秒签发时间时退回 iat
+func (c *UserClaims) issuedAt() time.Time {
+	if c.IssuedAtMs > 0 {
+		return time.UnixMilli(c.IssuedAtMs)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
 // TokenPair represents a pair of access and refresh tokens
 type TokenPair struct {
 	AccessToken  string
@@ -69,6 +121,9 @@ type TokenPair struct {
 	ExpiresIn    int64 // Access token expiration time in seconds
 }
 
+// refreshReuseGrace 刚轮换的Refresh Token在这段时间内再次使用不视为重放，而是返回同一对新token
+const refreshReuseGrace = 5 * time.Second
+
 // TokenStore Refresh Token存储接口
 type TokenStore interface {
 	// SaveRefreshToken 保存Refresh Token
@@ -80,8 +135,41 @@ type TokenStore interface {
 	// DeleteRefreshToken 删除Refresh Token
 	DeleteRefreshToken(ctx context.Context, token string) error
 
-	// DeleteUserRefreshTokens 删除用户所有Refresh Token
+	// DeleteUserRefreshTokens 删除用户所有Refresh Token及其token族
 	DeleteUserRefreshTokens(ctx context.Context, userID int64) error
+
+	// ConsumeRefreshToken 原子地取出并删除Refresh Token，同一个token只能成功使用一次
+	ConsumeRefreshToken(ctx context.Context, token string) (int64, error)
+
+	// SaveTokenFamily 把Refresh Token登记到token族，token被轮换后族信息仍保留，用于重放检测
+	SaveTokenFamily(ctx context.Context, family *TokenFamily, token string, expiration time.Duration) error
+
+	// GetTokenFamily 查询token所属的族，不属于任何族时返回nil
+	GetTokenFamily(ctx context.Context, token string) (*TokenFamily, error)
+
+	// SaveRotatedTokenPair 记录Refresh Token轮换得到的新token对，在宽限期内重复使用旧token时原样返回
+	SaveRotatedTokenPair(ctx context.Context, token string, pair *TokenPair, expiration time.Duration) error
+
+	// GetRotatedTokenPair 查询旧token轮换得到的新token对，宽限期已过或族已注销时返回nil
+	GetRotatedTokenPair(ctx context.Context, token string) (*TokenPair, error)
+
+	// RevokeTokenFamily 注销族内所有Refresh Token并删除族信息和会话信息
+	RevokeTokenFamily(ctx context.Context, family *TokenFamily) error
+
+	// SaveSession 保存会话信息，过期时间随每次刷新延长
+	SaveSession(ctx context.Context, session *Session, expiration time.Duration) error
+
+	// GetSession 查询会话，不存在时返回nil
+	GetSession(ctx context.Context, sessionID string) (*Session, error)
+
+	// ListUserSessions 查询用户所有未过期的会话
+	ListUserSessions(ctx context.Context, userID int64) ([]*Session, error)
+}
+
+// TokenFamily 一次登录产生的Refresh Token族，轮换得到的新token继承同一个族
+type TokenFamily struct {
+	ID     string
+	UserID int64
 }
 
 // AuthRepo 统一的认证仓库接口，包含数据库和 grpc 操作
@@ -91,53 +179,157 @@ type AuthRepo interface {
 	GetUserByEmail(context.Context, string) (*po.User, error)
 	GetUserByUserName(context.Context, string) (*po.User, error)
 	GetUserByID(context.Context, int64) (*po.User, error)
+	// IsUserNameTaken、IsEmailTaken 检查用户名和邮箱是否已被占用。GetUserByUserName 等查询不返回已删除的用户，
+	// 但宽限期内删除的用户仍占用唯一索引，创建用户和修改用户名、邮箱前需要用这两个方法检查
+	IsUserNameTaken(ctx context.Context, name string) (bool, error)
+	IsEmailTaken(ctx context.Context, email string) (bool, error)
+	// SetEmailVerified 更新邮箱验证状态
+	SetEmailVerified(ctx context.Context, userID int64, verified bool) error
+	// UpdatePassword 更新密码，password 必须是已经按密码策略生成的哈希
+	UpdatePassword(ctx context.Context, userID int64, password string) error
 	// Token存储方法
 	TokenStore
+	VerificationTokenStore
 }
 
-// SignupByEmail 使用邮件注册
-func (uc *AuthUsecase) SignupByEmail(ctx context.Context, user *po.User) (*po.User, error) {
-	// 检查 admin 用户是否已存在
-	if !uc.adminRegistered {
+// SignupByEmail 使用邮件注册，是否需要邀请码由注册方式决定；第一个用户（admin）不受注册方式限制
+func (uc *AuthUsecase) SignupByEmail(ctx context.Context, user *po.User, inviteCode string) (*po.User, error) {
+	// 检查初始管理员是否已创建
+	bootstrapped, err := uc.bootstrap.Bootstrapped(ctx)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("failed to check initial admin: %v", err)
+	}
+	if !bootstrapped {
 		// 第一次注册，用户名必须为 admin
-		if user.Name != "admin" {
-			return nil, authpb.ErrorInvalidCredentials("the first user must be named admin")
+		if user.Name != InitialAdminName {
+			return nil, authpb.ErrorInvalidCredentials("the first user must be named %s", InitialAdminName)
 		}
-		user.Role = "admin"
 	} else {
 		// 后续注册，用户名可以任意，但角色为 user
 		// 检查用户名是否已存在
-		existingUser, err := uc.repo.GetUserByUserName(ctx, user.Name)
+		taken, err := uc.repo.IsUserNameTaken(ctx, user.Name)
 		if err != nil {
 			return nil, authpb.ErrorUserNotFound("failed to check username: %v", err)
 		}
-		if existingUser != nil {
+		if taken {
 			return nil, authpb.ErrorUserAlreadyExists("username already exists")
 		}
-		user.Role = "user"
+		user.Role = RoleUser
 	}
 
 	// 检查邮箱是否已存在
-	existingEmail, err := uc.repo.GetUserByEmail(ctx, user.Email)
+	taken, err := uc.repo.IsEmailTaken(ctx, user.Email)
 	if err != nil {
 		return nil, authpb.ErrorUserNotFound("failed to check email: %v", err)
 	}
-	if existingEmail != nil {
+	if taken {
 		return nil, authpb.ErrorUserAlreadyExists("email already exists")
 	}
 
-	createdUser, err := uc.repo.SaveUser(ctx, user)
-	if err == nil && !uc.adminRegistered && user.Name == "admin" {
-		uc.adminRegistered = true // 注册成功后更新状态
+	hashed, err := uc.passwords.HashNew(user.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = hashed
+
+	var createdUser *po.User
+	if bootstrapped {
+		var release func()
+		if release, err = uc.signup.Admit(ctx, user.Email, inviteCode); err != nil {
+			return nil, err
+		}
+		if createdUser, err = uc.repo.SaveUser(ctx, user); err != nil {
+			release()
+		}
+	} else {
+		// 多个实例同时注册时只有一个能创建成功，其他返回 USER_ALREADY_EXISTS
+		createdUser, err = uc.bootstrap.CreateInitialAdmin(ctx, user)
+	}
+	if err == nil {
+		// user.signup 事件与用户在同一事务中写入 outbox
+		uc.audit.Record(ctx, AuditEntry{
+			Action: AuditActionSignup, ActorID: createdUser.ID, ActorName: createdUser.Name,
+			TargetType: AuditTargetUser, TargetID: createdUser.ID,
+		})
+		uc.sendWelcomeMail(ctx, createdUser)
+		if err := uc.sendVerificationEmail(ctx, createdUser); err != nil {
+			uc.log.Warnf("send verification email to user %d failed: %v", createdUser.ID, err)
+		}
 	}
 	return createdUser, err
 }
 
+// sendWelcomeMail 发送欢迎邮件，只负责入队，失败不影响注册结果
+func (uc *AuthUsecase) sendWelcomeMail(ctx context.Context, user *po.User) {
+	if uc.mailer == nil {
+		return
+	}
+	err := uc.mailer.Notify(ctx, user.Email, mail.KindWelcome, "", map[string]any{
+		"AppName": uc.appName(),
+		"Name":    user.Name,
+	})
+	if err != nil {
+		uc.log.Warnf("enqueue welcome mail for user %d failed: %v", user.ID, err)
+	}
+}
+
+// appName 邮件中展示的应用名称
+func (uc *AuthUsecase) appName() string {
+	return appDisplayName(uc.cfg)
+}
+
+func appDisplayName(cfg *conf.App) string {
+	if name := cfg.GetName(); name != "" {
+		return name
+	}
+	return "Krathub"
+}
+
+// JWKS 返回验证 Access Token 的公钥集合，使用 HS256 时为空
+func (uc *AuthUsecase) JWKS() jwtpkg.JWKS {
+	keys := uc.accessJWT.KeySet()
+	if keys == nil {
+		return jwtpkg.JWKS{Keys: []jwtpkg.JWK{}}
+	}
+	return keys.JWKS()
+}
+
 // generateAccessToken 签发 Access Token
 func (uc *AuthUsecase) generateAccessToken(claims *UserClaims) (string, error) {
 	return uc.accessJWT.GenerateToken(claims)
 }
 
+// newAccessToken 为会话签发 Access Token
+func (uc *AuthUsecase) newAccessToken(user *po.User, sessionID string, workspaceID int64) (string, error) {
+	// Generate a random nonce to ensure token uniqueness
+	nonce, err := uc.generateRefreshToken()
+	if err != nil {
+		return "", authpb.ErrorTokenGenerationFailed("failed to generate nonce: %v", err)
+	}
+	now := time.Now()
+	claims := &UserClaims{
+		ID:          user.ID,
+		Name:        user.Name,
+		Role:        user.Role,
+		Nonce:       nonce,
+		SessionID:   sessionID,
+		WorkspaceID: workspaceID,
+		IssuedAtMs:  now.UnixMilli(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{uc.cfg.Jwt.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(uc.cfg.Jwt.AccessExpire) * time.Second)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    uc.cfg.Jwt.Issuer,
+			ID:        nonce, // jti，用于吊销单个 token
+		},
+	}
+	accessToken, err := uc.generateAccessToken(claims)
+	if err != nil {
+		return "", authpb.ErrorTokenGenerationFailed("failed to generate access token: %v", err)
+	}
+	return accessToken, nil
+}
+
 // generateRefreshToken 生成 Refresh Token (UUID-like string)
 func (uc *AuthUsecase) generateRefreshToken() (string, error) {
 	bytes := make([]byte, 32)
@@ -147,45 +339,77 @@ func (uc *AuthUsecase) generateRefreshToken() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-// LoginByEmailPassword 邮箱密码登录 - 返回Token Pair
-func (uc *AuthUsecase) LoginByEmailPassword(ctx context.Context, user *po.User) (*TokenPair, error) {
-	foundUser, err := uc.repo.GetUserByEmail(ctx, user.Email)
-	if err != nil {
-		return nil, authpb.ErrorUserNotFound("failed to get user: %v", err)
+// LoginByEmailPassword 邮箱密码登录 - 返回Token Pair；启用两步验证时返回登录挑战，由 VerifyMFA 换取Token Pair
+func (uc *AuthUsecase) LoginByEmailPassword(ctx context.Context, user *po.User) (pair *TokenPair, challenge *MFAChallenge, err error) {
+	// 成功和失败都写入审计日志，账号不存在时记录登录输入的邮箱
+	entry := AuditEntry{Action: AuditActionLogin, ActorName: user.Email, Metadata: map[string]any{"method": "password"}}
+	defer func() {
+		entry.Err = err
+		if challenge != nil {
+			entry.Metadata["mfa_required"] = true
+		}
+		uc.audit.Record(ctx, entry)
+	}()
+
+	ip := clientinfo.FromContext(ctx).IP
+	if err := uc.throttler.Check(ctx, user.Email, ip); err != nil {
+		return nil, nil, err
+	}
+	foundUser, provider, err := uc.providers.authenticate(ctx, user.Email, user.Password)
+	if foundUser != nil {
+		entry.ActorID, entry.ActorName = foundUser.ID, foundUser.Name
 	}
-	if foundUser == nil {
+	if provider != "" {
+		entry.Metadata["provider"] = provider
+	}
+	switch {
+	case stderrors.Is(err, ErrAccountNotFound):
 		uc.log.Warnf("user %s does not exist", user.Email)
-		return nil, authpb.ErrorUserNotFound("user %s does not exist", user.Email)
+		// 不存在的账号同样计数，避免通过是否被锁定判断账号是否存在
+		uc.throttler.RecordFailure(ctx, user.Email, ip)
+		return nil, nil, authpb.ErrorUserNotFound("user %s does not exist", user.Email)
+	case stderrors.Is(err, ErrIncorrectPassword):
+		if uc.throttler.RecordFailure(ctx, user.Email, ip) && foundUser != nil {
+			uc.sendSecurityAlert(ctx, foundUser, "Your account was temporarily locked after too many failed sign-in attempts.")
+		}
+		return nil, nil, authpb.ErrorIncorrectPassword("incorrect password for user: %s", user.Email)
+	case err != nil:
+		return nil, nil, err
+	}
+	uc.throttler.RecordSuccess(ctx, user.Email)
+	if !foundUser.EmailVerified && (uc.cfg.GetAccount().GetRequireEmailVerification() || uc.signup.RequiresVerification(foundUser.Email)) {
+		return nil, nil, authpb.ErrorEmailNotVerified("email %s is not verified", user.Email)
 	}
-	if !hash.BcryptCheck(user.Password, foundUser.Password) {
-		return nil, authpb.ErrorIncorrectPassword("incorrect password for user: %s", user.Email)
+	if err := checkUserEnabled(foundUser); err != nil {
+		return nil, nil, err
+	}
+
+	if mfaEnabled(foundUser) {
+		challenge, err = uc.createMFAChallenge(ctx, foundUser)
+		return nil, challenge, err
 	}
 
 	// 登录成功，生成Token Pair
+	pair, err = uc.issueTokenPair(ctx, foundUser)
+	return pair, nil, err
+}
 
-	// Generate a random nonce to ensure token uniqueness
-	nonce, err := uc.generateRefreshToken()
-	if err != nil {
-		return nil, authpb.ErrorTokenGenerationFailed("failed to generate nonce: %v", err)
+// issueTokenPair 为完成全部认证步骤的用户开启新会话并签发Token Pair
+func (uc *AuthUsecase) issueTokenPair(ctx context.Context, foundUser *po.User) (*TokenPair, error) {
+	if err := checkUserEnabled(foundUser); err != nil {
+		return nil, err
 	}
-
-	// 生成Access Token
-	accessClaims := &UserClaims{
-		ID:    foundUser.ID,
-		Name:  foundUser.Name,
-		Role:  foundUser.Role,
-		Nonce: nonce,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Audience:  jwt.ClaimStrings{uc.cfg.Jwt.Audience},
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(uc.cfg.Jwt.AccessExpire) * time.Second)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    uc.cfg.Jwt.Issuer,
-		},
+	// 每次登录开启一个新的会话（token族）
+	familyID, err := uc.generateRefreshToken()
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate token family: %v", err)
 	}
+	family := &TokenFamily{ID: familyID, UserID: foundUser.ID}
 
-	accessToken, err := uc.generateAccessToken(accessClaims)
+	// 生成Access Token，新会话未选择工作空间
+	accessToken, err := uc.newAccessToken(foundUser, family.ID, 0)
 	if err != nil {
-		return nil, authpb.ErrorTokenGenerationFailed("failed to generate access token: %v", err)
+		return nil, err
 	}
 
 	// 生成Refresh Token
@@ -194,11 +418,13 @@ func (uc *AuthUsecase) LoginByEmailPassword(ctx context.Context, user *po.User)
 		return nil, authpb.ErrorTokenGenerationFailed("failed to generate refresh token: %v", err)
 	}
 
-	// 保存Refresh Token到Redis
-	refreshExpirationTime := time.Duration(uc.cfg.Jwt.RefreshExpire) * time.Second
-	if err := uc.repo.SaveRefreshToken(ctx, foundUser.ID, refreshToken, refreshExpirationTime); err != nil {
+	if err := uc.saveRefreshToken(ctx, family, refreshToken); err != nil {
 		return nil, authpb.ErrorTokenGenerationFailed("failed to save refresh token: %v", err)
 	}
+	uc.touchSession(ctx, family)
+
+	uc.events.Publish(ctx, EventUserLogin, UserEventData(foundUser))
+	uc.notifier.Notify(ctx, foundUser, EventUserLogin, UserEventData(foundUser))
 
 	return &TokenPair{
 		AccessToken:  accessToken,
@@ -209,45 +435,49 @@ func (uc *AuthUsecase) LoginByEmailPassword(ctx context.Context, user *po.User)
 
 // RefreshToken 刷新Access Token
 func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
-	// 从Redis获取Refresh Token关联的用户ID
-	userID, err := uc.repo.GetRefreshToken(ctx, refreshToken)
+	// 取出并删除Refresh Token，并发使用同一个token时只有一个请求能成功
+	userID, err := uc.repo.ConsumeRefreshToken(ctx, refreshToken)
 	if err != nil {
+		// token已失效但仍属于某个族，说明是已轮换的旧token被重放
+		if family, ferr := uc.repo.GetTokenFamily(ctx, refreshToken); ferr == nil && family != nil {
+			// 刚轮换的token在宽限期内再次使用（例如客户端没收到响应后重试），返回同一对新token
+			if pair, perr := uc.repo.GetRotatedTokenPair(ctx, refreshToken); perr == nil && pair != nil {
+				return pair, nil
+			}
+			uc.handleRefreshTokenReuse(ctx, family)
+			return nil, authpb.ErrorInvalidRefreshToken("refresh token has already been used")
+		}
 		uc.log.Warnf("Invalid refresh token: %v", err)
 		return nil, authpb.ErrorInvalidRefreshToken("invalid or expired refresh token")
 	}
 
+	family, err := uc.repo.GetTokenFamily(ctx, refreshToken)
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to get token family: %v", err)
+	}
+	if family == nil {
+		// 引入token族之前签发的token，从本次轮换开始新建族
+		familyID, err := uc.generateRefreshToken()
+		if err != nil {
+			return nil, authpb.ErrorTokenGenerationFailed("failed to generate token family: %v", err)
+		}
+		family = &TokenFamily{ID: familyID, UserID: userID}
+	}
+
 	// 获取用户信息
 	user, err := uc.repo.GetUserByID(ctx, userID)
 	if err != nil {
 		uc.log.Errorf("Failed to get user by ID: %v", err)
 		return nil, authpb.ErrorUserNotFound("user not found: %v", err)
 	}
-
-	// 生成新的Access Token
-	accessExpirationTime := time.Duration(uc.cfg.Jwt.AccessExpire) * time.Second
-
-	// Generate a random nonce to ensure token uniqueness
-	nonce, err := uc.generateRefreshToken() // Reuse the random generation logic
-	if err != nil {
-		return nil, authpb.ErrorTokenGenerationFailed("failed to generate nonce: %v", err)
-	}
-
-	accessClaims := &UserClaims{
-		ID:    user.ID,
-		Name:  user.Name,
-		Role:  user.Role,
-		Nonce: nonce,
-		RegisteredClaims: jwt.RegisteredClaims{
-			Audience:  jwt.ClaimStrings{uc.cfg.Jwt.Audience},
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessExpirationTime)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    uc.cfg.Jwt.Issuer,
-		},
+	if err := checkUserEnabled(user); err != nil {
+		return nil, err
 	}
 
-	accessToken, err := uc.generateAccessToken(accessClaims)
+	// 生成新的Access Token，沿用会话的活动工作空间
+	accessToken, err := uc.newAccessToken(user, family.ID, uc.sessionWorkspace(ctx, family))
 	if err != nil {
-		return nil, authpb.ErrorTokenGenerationFailed("failed to generate access token: %v", err)
+		return nil, err
 	}
 
 	// 可选：轮换Refresh Token
@@ -257,27 +487,99 @@ func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*
 		return nil, authpb.ErrorTokenGenerationFailed("failed to generate refresh token: %v", err)
 	}
 
-	// 删除旧的Refresh Token
-	if err := uc.repo.DeleteRefreshToken(ctx, refreshToken); err != nil {
-		uc.log.Warnf("Failed to delete old refresh token: %v", err)
-		// 不返回错误，继续执行
-	}
-
-	// 保存新的Refresh Token
-	refreshExpirationTime := time.Duration(uc.cfg.Jwt.RefreshExpire) * time.Second
-	if err := uc.repo.SaveRefreshToken(ctx, user.ID, newRefreshToken, refreshExpirationTime); err != nil {
+	// 保存新的Refresh Token，旧token已在上面被删除，但仍留在族中用于重放检测
+	if err := uc.saveRefreshToken(ctx, family, newRefreshToken); err != nil {
 		return nil, authpb.ErrorTokenGenerationFailed("failed to save refresh token: %v", err)
 	}
+	uc.touchSession(ctx, family)
 
-	return &TokenPair{
+	pair := &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
 		ExpiresIn:    int64(uc.cfg.Jwt.AccessExpire),
-	}, nil
+	}
+	if err := uc.repo.SaveRotatedTokenPair(ctx, refreshToken, pair, refreshReuseGrace); err != nil {
+		uc.log.Warnf("save rotated token pair failed: %v", err)
+	}
+	return pair, nil
+}
+
+// saveRefreshToken 保存Refresh Token并登记到token族
+func (uc *AuthUsecase) saveRefreshToken(ctx context.Context, family *TokenFamily, token string) error {
+	expiration := time.Duration(uc.cfg.Jwt.RefreshExpire) * time.Second
+	if err := uc.repo.SaveRefreshToken(ctx, family.UserID, token, expiration); err != nil {
+		return err
+	}
+	return uc.repo.SaveTokenFamily(ctx, family, token, expiration)
+}
+
+// handleRefreshTokenReuse 已轮换的Refresh Token被再次使用，token可能已经泄露：
+// 注销整个token族（按配置注销用户全部会话），并通过webhook和安全提醒邮件发出安全事件
+func (uc *AuthUsecase) handleRefreshTokenReuse(ctx context.Context, family *TokenFamily) {
+	revokeAll := uc.cfg.GetJwt().GetRevokeAllOnReuse()
+	uc.log.Warnf("refresh token reuse detected for user %d, family %s, revoke all sessions: %v", family.UserID, family.ID, revokeAll)
+
+	if err := uc.revokeSession(ctx, family); err != nil {
+		uc.log.Errorf("revoke token family %s failed: %v", family.ID, err)
+	}
+	if revokeAll {
+		if err := uc.repo.DeleteUserRefreshTokens(ctx, family.UserID); err != nil {
+			uc.log.Errorf("revoke refresh tokens of user %d failed: %v", family.UserID, err)
+		}
+		if err := uc.revoker.RevokeUserTokens(ctx, family.UserID); err != nil {
+			uc.log.Errorf("revoke access tokens of user %d failed: %v", family.UserID, err)
+		}
+	}
+
+	user, err := uc.repo.GetUserByID(ctx, family.UserID)
+	if err != nil {
+		uc.log.Warnf("get user %d for token reuse event failed: %v", family.UserID, err)
+		return
+	}
+	data := UserEventData(user)
+	data["token_family"] = family.ID
+	data["revoked_all_sessions"] = revokeAll
+	uc.events.Publish(ctx, EventUserTokenReuse, data)
+	uc.audit.Record(ctx, AuditEntry{
+		Action: AuditActionTokenReuse, ActorID: user.ID, ActorName: user.Name,
+		Err:      authpb.ErrorInvalidRefreshToken("refresh token has already been used"),
+		Metadata: map[string]any{"session_id": family.ID, "revoked_all_sessions": revokeAll},
+	})
+
+	uc.sendSecurityAlert(ctx, user, "A previously used sign-in token was presented again; the affected sessions have been signed out.")
+}
+
+// sendSecurityAlert 发送账号安全提醒邮件，不受通知偏好影响，只负责入队
+func (uc *AuthUsecase) sendSecurityAlert(ctx context.Context, user *po.User, event string) {
+	if uc.mailer == nil {
+		return
+	}
+	err := uc.mailer.Notify(ctx, user.Email, mail.KindSecurityAlert, "", map[string]any{
+		"AppName": uc.appName(),
+		"Name":    user.Name,
+		"Event":   event,
+		"Time":    time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		uc.log.Warnf("enqueue security alert for user %d failed: %v", user.ID, err)
+	}
 }
 
-// Logout 登出，使Refresh Token失效
+// Logout 登出，吊销当前Access Token并使该次登录的整个token族失效
 func (uc *AuthUsecase) Logout(ctx context.Context, refreshToken string) error {
+	defer uc.audit.Record(ctx, AuditEntry{Action: AuditActionLogout})
+	if claims, ok := jwtpkg.FromContext[UserClaims](ctx); ok {
+		if err := uc.revoker.RevokeToken(ctx, claims); err != nil {
+			uc.log.Warnf("Failed to revoke access token during logout: %v", err)
+		}
+	}
+	family, err := uc.repo.GetTokenFamily(ctx, refreshToken)
+	if err == nil && family != nil {
+		if err := uc.revokeSession(ctx, family); err != nil {
+			uc.log.Warnf("Failed to revoke session during logout: %v", err)
+		}
+		return nil
+	}
 	// 删除Refresh Token
 	if err := uc.repo.DeleteRefreshToken(ctx, refreshToken); err != nil {
 		uc.log.Warnf("Failed to delete refresh token during logout: %v", err)
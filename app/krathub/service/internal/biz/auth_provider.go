@@ -0,0 +1,430 @@
+package biz
+
+import (
+	"context"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+	"github.com/ToAtlas/AtlasBackend/pkg/ldap"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 认证方式名称，写入登录审计日志的 provider 字段
+const (
+	AuthProviderLocal = "local"
+	// AuthProviderLDAP 同时作为目录用户外部身份的 provider
+	AuthProviderLDAP = "ldap"
+)
+
+// LDAP 属性的默认值
+const (
+	defaultLDAPNameAttribute  = "uid"
+	defaultLDAPEmailAttribute = "mail"
+	defaultLDAPGroupAttribute = "memberOf"
+)
+
+var (
+	// ErrAccountNotFound 认证方式中没有该账号，交给下一个认证方式
+	ErrAccountNotFound = stderrors.New("account not found")
+	// ErrIncorrectPassword 账号存在但密码错误，不再尝试其他认证方式
+	ErrIncorrectPassword = stderrors.New("incorrect password")
+)
+
+// AuthProvider 邮箱密码登录的认证方式
+type AuthProvider interface {
+	// Name 认证方式名称
+	Name() string
+	// Authenticate 校验邮箱和密码，返回对应的本地用户。账号不存在时返回 ErrAccountNotFound；
+	// 密码错误时返回 ErrIncorrectPassword，已知对应的本地用户时一并返回，用于审计和账号锁定提醒
+	Authenticate(ctx context.Context, email, password string) (*po.User, error)
+}
+
+// AuthProviders 按顺序尝试的认证方式。配置了 LDAP 时先查目录，目录中没有的账号、
+// 以及未关联目录身份的本地账号再查本地数据库，初始管理员等本地账号不受目录影响
+type AuthProviders []AuthProvider
+
+// NewAuthProviders 根据配置创建认证方式，LDAP 配置无效时返回错误
+func NewAuthProviders(repo AuthRepo, userRepo UserRepo, identityRepo IdentityRepo, passwords *PasswordPolicy, authz *Authorizer, revoker *TokenRevoker, events EventPublisher, audit *AuditUsecase, logger log.Logger, cfg *conf.App) (AuthProviders, error) {
+	local := &localAuthProvider{
+		repo:       repo,
+		identities: identityRepo,
+		passwords:  passwords,
+		log:        log.NewHelper(pkglogger.WithModule(logger, "auth-provider/biz/krathub-service")),
+	}
+	c := cfg.GetLdap()
+	if c.GetUrl() == "" {
+		return AuthProviders{local}, nil
+	}
+	for _, p := range cfg.GetOidc().GetProviders() {
+		if p.GetName() == AuthProviderLDAP {
+			return nil, fmt.Errorf("oidc: provider name %q is reserved for ldap", AuthProviderLDAP)
+		}
+	}
+	p, err := newLDAPAuthProvider(c, repo, userRepo, identityRepo, passwords, authz, revoker, events, audit, logger)
+	if err != nil {
+		return nil, err
+	}
+	local.directoryOnly = true
+	return AuthProviders{p, local}, nil
+}
+
+// authenticate 按顺序尝试认证方式，返回得出结果的认证方式名称
+func (ps AuthProviders) authenticate(ctx context.Context, email, password string) (*po.User, string, error) {
+	for _, p := range ps {
+		user, err := p.Authenticate(ctx, email, password)
+		if stderrors.Is(err, ErrAccountNotFound) {
+			continue
+		}
+		return user, p.Name(), err
+	}
+	return nil, "", ErrAccountNotFound
+}
+
+// localAuthProvider 校验数据库中的密码哈希
+type localAuthProvider struct {
+	repo       AuthRepo
+	identities IdentityRepo
+	passwords  *PasswordPolicy
+	log        *log.Helper
+
+	// directoryOnly 为 true 时关联了目录身份的用户只能通过目录认证，
+	// 避免目录中已删除或目录暂时不可用时使用本地密码登录
+	directoryOnly bool
+}
+
+func (p *localAuthProvider) Name() string {
+	return AuthProviderLocal
+}
+
+func (p *localAuthProvider) Authenticate(ctx context.Context, email, password string) (*po.User, error) {
+	user, err := p.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, ErrAccountNotFound
+	}
+	if !hash.Check(password, user.Password) {
+		return user, ErrIncorrectPassword
+	}
+	if p.directoryOnly {
+		linked, err := hasDirectoryIdentity(ctx, p.identities, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if linked {
+			p.log.Warnf("user %d is managed by the directory, local password rejected", user.ID)
+			return user, ErrIncorrectPassword
+		}
+	}
+	p.rehash(ctx, user, password)
+	return user, nil
+}
+
+// hasDirectoryIdentity 判断用户是否关联了目录身份
+func hasDirectoryIdentity(ctx context.Context, identities IdentityRepo, userID int64) (bool, error) {
+	list, err := identities.ListUserIdentities(ctx, userID)
+	if err != nil {
+		return false, authpb.ErrorUserNotFound("failed to list identities: %v", err)
+	}
+	for _, identity := range list {
+		if identity.Provider == AuthProviderLDAP {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rehash 密码验证成功后，旧算法或旧参数的哈希按当前配置重新生成，失败不影响登录
+func (p *localAuthProvider) rehash(ctx context.Context, user *po.User, password string) {
+	if !p.passwords.NeedsRehash(user.Password) {
+		return
+	}
+	hashed, err := p.passwords.Hash(password)
+	if err != nil {
+		p.log.Errorf("rehash password of user %d failed: %v", user.ID, err)
+		return
+	}
+	if err := p.repo.UpdatePassword(ctx, user.ID, hashed); err != nil {
+		p.log.Errorf("save rehashed password of user %d failed: %v", user.ID, err)
+		return
+	}
+	user.Password = hashed
+}
+
+// ldapAuthProvider 通过 LDAP 绑定校验密码。目录用户第一次登录时自动创建本地用户并关联目录身份，
+// 配置了组与角色的映射时每次登录按所属组同步角色
+type ldapAuthProvider struct {
+	client     *ldap.Client
+	repo       AuthRepo
+	users      UserRepo
+	identities IdentityRepo
+	passwords  *PasswordPolicy
+	authz      *Authorizer
+	revoker    *TokenRevoker
+	events     EventPublisher
+	audit      *AuditUsecase
+	log        *log.Helper
+
+	idAttr      string // 为空时使用 DN
+	nameAttr    string
+	emailAttr   string
+	groupAttr   string
+	groupRoles  []*conf.App_Ldap_GroupRole
+	linkByEmail bool
+}
+
+func newLDAPAuthProvider(c *conf.App_Ldap, repo AuthRepo, userRepo UserRepo, identityRepo IdentityRepo, passwords *PasswordPolicy, authz *Authorizer, revoker *TokenRevoker, events EventPublisher, audit *AuditUsecase, logger log.Logger) (*ldapAuthProvider, error) {
+	p := &ldapAuthProvider{
+		repo:        repo,
+		users:       userRepo,
+		identities:  identityRepo,
+		passwords:   passwords,
+		authz:       authz,
+		revoker:     revoker,
+		events:      events,
+		audit:       audit,
+		log:         log.NewHelper(pkglogger.WithModule(logger, "ldap/biz/krathub-service")),
+		idAttr:      c.GetIdAttribute(),
+		nameAttr:    orDefault(c.GetNameAttribute(), defaultLDAPNameAttribute),
+		emailAttr:   orDefault(c.GetEmailAttribute(), defaultLDAPEmailAttribute),
+		groupAttr:   orDefault(c.GetGroupAttribute(), defaultLDAPGroupAttribute),
+		groupRoles:  c.GetGroupRoles(),
+		linkByEmail: c.GetLinkByEmail(),
+	}
+	for _, gr := range p.groupRoles {
+		if gr.GetGroup() == "" || gr.GetRole() == "" {
+			return nil, fmt.Errorf("ldap: group and role are required in group_roles")
+		}
+	}
+	attrs := []string{p.nameAttr, p.emailAttr}
+	if p.idAttr != "" {
+		attrs = append(attrs, p.idAttr)
+	}
+	if len(p.groupRoles) > 0 {
+		attrs = append(attrs, p.groupAttr)
+	}
+	client, err := ldap.NewClient(ldap.Config{
+		URL:                c.GetUrl(),
+		StartTLS:           c.GetStartTls(),
+		InsecureSkipVerify: c.GetInsecureSkipVerify(),
+		BindDN:             c.GetBindDn(),
+		BindPassword:       c.GetBindPassword(),
+		BaseDN:             c.GetBaseDn(),
+		UserFilter:         c.GetUserFilter(),
+		Attributes:         attrs,
+		Timeout:            c.GetTimeout().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.client = client
+	return p, nil
+}
+
+func (p *ldapAuthProvider) Name() string {
+	return AuthProviderLDAP
+}
+
+// Authenticate 目录中没有该账号或目录服务器不可用时返回 ErrAccountNotFound，交给本地认证；
+// 目录密码错误但邮箱属于未关联目录身份的本地账号时同样交给本地认证
+func (p *ldapAuthProvider) Authenticate(ctx context.Context, email, password string) (*po.User, error) {
+	entry, err := p.client.Authenticate(ctx, email, password)
+	switch {
+	case stderrors.Is(err, ldap.ErrUserNotFound):
+		return nil, ErrAccountNotFound
+	case stderrors.Is(err, ldap.ErrInvalidCredentials):
+		user, err := p.repo.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, authpb.ErrorUserNotFound("failed to get user: %v", err)
+		}
+		if user != nil {
+			linked, err := hasDirectoryIdentity(ctx, p.identities, user.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !linked {
+				return nil, ErrAccountNotFound
+			}
+		}
+		return user, ErrIncorrectPassword
+	case err != nil:
+		p.log.Errorf("ldap authentication of %s failed: %v", email, err)
+		return nil, ErrAccountNotFound
+	}
+
+	subject, err := p.subject(entry)
+	if err != nil {
+		return nil, authpb.ErrorIdentityNotLinked("%v", err)
+	}
+	user, err := p.resolveUser(ctx, entry, subject, email)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.syncRole(ctx, user, entry); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// subject 目录身份的唯一标识。DN 在用户改名或移动时会变化，生产环境建议配置 entryUUID 或 objectGUID
+func (p *ldapAuthProvider) subject(entry *ldap.Entry) (string, error) {
+	if p.idAttr == "" {
+		return strings.ToLower(entry.DN), nil
+	}
+	raw := entry.Raw(p.idAttr)
+	if len(raw) == 0 {
+		return "", fmt.Errorf("directory entry %s has no %s attribute", entry.DN, p.idAttr)
+	}
+	// objectGUID 等二进制属性使用十六进制
+	if !utf8.Valid(raw) {
+		return hex.EncodeToString(raw), nil
+	}
+	return string(raw), nil
+}
+
+// resolveUser 查找目录身份对应的本地用户：已关联的用户、邮箱相同的用户（link_by_email），或新建用户
+func (p *ldapAuthProvider) resolveUser(ctx context.Context, entry *ldap.Entry, subject, loginEmail string) (*po.User, error) {
+	identity, err := p.identities.GetIdentity(ctx, AuthProviderLDAP, subject)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("failed to get identity: %v", err)
+	}
+	if identity != nil {
+		user, err := p.repo.GetUserByID(ctx, identity.UserID)
+		if err != nil || user == nil {
+			return nil, authpb.ErrorUserNotFound("user %d not found", identity.UserID)
+		}
+		return user, nil
+	}
+
+	email := entry.Get(p.emailAttr)
+	if email == "" {
+		email = loginEmail
+	}
+	existing, err := p.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("failed to get user: %v", err)
+	}
+	if existing != nil {
+		if !p.linkByEmail {
+			return nil, authpb.ErrorIdentityNotLinked("an account with email %s already exists and is not linked to the directory", email)
+		}
+		if err := p.identities.CreateIdentity(ctx, newLDAPIdentity(existing.ID, subject, email)); err != nil {
+			return nil, authpb.ErrorIdentityAlreadyLinked("failed to link directory account: %v", err)
+		}
+		p.log.Infof("directory account %s linked to user %d", entry.DN, existing.ID)
+		return existing, nil
+	}
+	return p.createUser(ctx, entry, subject, email)
+}
+
+// createUser 为目录用户创建本地用户。目录由管理员维护，不受注册方式限制，邮箱视为已验证；
+// 本地密码随机生成且不会使用
+func (p *ldapAuthProvider) createUser(ctx context.Context, entry *ldap.Entry, subject, email string) (*po.User, error) {
+	base := entry.Get(p.nameAttr)
+	if base == "" {
+		base, _, _ = strings.Cut(email, "@")
+	}
+	name, err := uniqueUserName(ctx, p.repo, truncate(base, maxUserNameLength))
+	if err != nil {
+		return nil, err
+	}
+	password, err := p.passwords.RandomHash()
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate password: %v", err)
+	}
+	role := RoleUser
+	if mapped, ok := p.mappedRole(ctx, entry); ok {
+		role = mapped
+	}
+	user := &po.User{
+		Name:          name,
+		Email:         email,
+		Password:      password,
+		Role:          role,
+		EmailVerified: true,
+	}
+	created, err := p.identities.CreateUserWithIdentity(ctx, user, newLDAPIdentity(0, subject, email))
+	if err != nil {
+		return nil, authpb.ErrorUserAlreadyExists("failed to create user: %v", err)
+	}
+	p.log.Infof("user %d (%s) provisioned from directory account %s", created.ID, created.Name, entry.DN)
+	return created, nil
+}
+
+// mappedRole 按配置顺序返回第一个匹配的组对应的角色，未配置映射时返回 false；
+// 配置了映射但不属于任何组时为 user
+func (p *ldapAuthProvider) mappedRole(ctx context.Context, entry *ldap.Entry) (string, bool) {
+	if len(p.groupRoles) == 0 {
+		return "", false
+	}
+	groups := entry.Values(p.groupAttr)
+	for _, gr := range p.groupRoles {
+		for _, g := range groups {
+			if !strings.EqualFold(strings.TrimSpace(g), strings.TrimSpace(gr.GetGroup())) {
+				continue
+			}
+			if !p.authz.RoleExists(ctx, gr.GetRole()) {
+				p.log.Errorf("role %s mapped from group %s does not exist", gr.GetRole(), gr.GetGroup())
+				continue
+			}
+			return gr.GetRole(), true
+		}
+	}
+	return RoleUser, true
+}
+
+// syncRole 角色与目录中的组不一致时更新角色，之前签发的 Access Token 随即失效
+func (p *ldapAuthProvider) syncRole(ctx context.Context, user *po.User, entry *ldap.Entry) (err error) {
+	role, ok := p.mappedRole(ctx, entry)
+	if !ok || role == user.Role {
+		return nil
+	}
+	from := user.Role
+	defer func() {
+		p.audit.Record(ctx, AuditEntry{
+			Action: AuditActionRoleChange, ActorName: AuthProviderLDAP, TargetType: AuditTargetUser, TargetID: user.ID, Err: err,
+			Metadata: map[string]any{"from": from, "to": role, "source": AuthProviderLDAP},
+		})
+	}()
+	if err := p.users.UpdateRole(ctx, user.ID, role); err != nil {
+		return authpb.ErrorUserNotFound("failed to update role: %v", err)
+	}
+	if err := p.revoker.RevokeUserTokens(ctx, user.ID); err != nil {
+		p.log.Errorf("revoke access tokens of user %d failed: %v", user.ID, err)
+	}
+	user.Role = role
+	p.events.Publish(ctx, EventUserUpdated, UserEventData(user))
+	p.log.Infof("role of user %d changed from %s to %s by directory groups", user.ID, from, role)
+	return nil
+}
+
+func newLDAPIdentity(userID int64, subject, email string) *po.UserIdentity {
+	identity := &po.UserIdentity{
+		UserID:    userID,
+		Provider:  AuthProviderLDAP,
+		Subject:   subject,
+		CreatedAt: time.Now(),
+	}
+	if email != "" {
+		identity.Email = &email
+	}
+	return identity
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
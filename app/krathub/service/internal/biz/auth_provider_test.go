@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/ldap/ldaptest"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAdminsGroup = "cn=admins,ou=groups,dc=example,dc=com"
+	testAliceDN     = "uid=alice,ou=people,dc=example,dc=com"
+)
+
+func newTestDirectory(t *testing.T) *ldaptest.Server {
+	t.Helper()
+	srv := ldaptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddEntry(ldaptest.Entry{DN: "cn=svc,dc=example,dc=com", Password: "svc-secret"})
+	srv.AddEntry(ldaptest.Entry{DN: "ou=people,dc=example,dc=com"})
+	srv.AddEntry(ldaptest.Entry{
+		DN:       testAliceDN,
+		Password: "alice-secret",
+		Attributes: map[string][]string{
+			"uid":       {"alice"},
+			"mail":      {"alice@example.com"},
+			"entryUUID": {"5c3b6a7e-0000-4000-8000-000000000001"},
+			"memberOf":  {testAdminsGroup},
+		},
+	})
+	return srv
+}
+
+func newTestAuthProviders(t *testing.T, srv *ldaptest.Server, mutate func(*conf.App_Ldap)) (AuthProviders, *memStore) {
+	t.Helper()
+	ldapCfg := &conf.App_Ldap{
+		Url:          srv.URL,
+		BindDn:       "cn=svc,dc=example,dc=com",
+		BindPassword: "svc-secret",
+		BaseDn:       "ou=people,dc=example,dc=com",
+		IdAttribute:  "entryUUID",
+		GroupRoles:   []*conf.App_Ldap_GroupRole{{Group: testAdminsGroup, Role: RoleAdmin}},
+	}
+	if mutate != nil {
+		mutate(ldapCfg)
+	}
+	e := newTestEnv(t, &conf.App{Ldap: ldapCfg})
+	providers, err := NewAuthProviders(e.authRepo(), e.userRepo(), e.identityRepo, e.passwords, e.authz, e.revoker,
+		nopPublisher{}, e.audit, e.logger, e.cfg)
+	require.NoError(t, err)
+	return providers, e.store
+}
+
+func TestLDAPAuthProvider_ProvisionsAndSyncsRole(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestDirectory(t)
+	providers, store := newTestAuthProviders(t, srv, nil)
+
+	user, provider, err := providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, AuthProviderLDAP, provider)
+	assert.Equal(t, "alice", user.Name)
+	assert.Equal(t, "alice@example.com", user.Email)
+	assert.Equal(t, RoleAdmin, user.Role)
+	assert.True(t, user.EmailVerified)
+	require.Len(t, store.identities, 1)
+	assert.Equal(t, "5c3b6a7e-0000-4000-8000-000000000001", store.identities[0].Subject)
+
+	// 再次登录使用同一个本地用户
+	again, _, err := providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, again.ID)
+	assert.Len(t, store.users, 1)
+
+	// 移出管理员组后降为普通用户，并写入审计日志
+	srv.SetAttribute(testAliceDN, "memberOf")
+	again, _, err = providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, RoleUser, again.Role)
+	assert.Equal(t, RoleUser, store.users[user.ID].Role)
+	require.Len(t, store.audits, 1)
+	assert.Equal(t, AuditActionRoleChange, store.audits[0].Action)
+}
+
+func TestLDAPAuthProvider_IncorrectPassword(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestDirectory(t)
+	providers, store := newTestAuthProviders(t, srv, func(c *conf.App_Ldap) { c.LinkByEmail = true })
+
+	// 目录中也有同一邮箱时，未关联目录身份的本地账号仍然可以使用本地密码
+	passwords, err := NewPasswordPolicy(&conf.App{}, log.DefaultLogger)
+	require.NoError(t, err)
+	hashed, err := passwords.Hash("local-secret")
+	require.NoError(t, err)
+	store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Password: hashed, Role: RoleUser})
+
+	user, provider, err := providers.authenticate(ctx, "alice@example.com", "local-secret")
+	require.NoError(t, err)
+	assert.Equal(t, AuthProviderLocal, provider)
+	assert.Equal(t, "alice", user.Name)
+	_, provider, err = providers.authenticate(ctx, "alice@example.com", "wrong-secret")
+	assert.ErrorIs(t, err, ErrIncorrectPassword)
+	assert.Equal(t, AuthProviderLocal, provider)
+
+	// 关联目录身份后只校验目录密码，不再尝试本地密码
+	_, _, err = providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	user, provider, err = providers.authenticate(ctx, "alice@example.com", "local-secret")
+	assert.ErrorIs(t, err, ErrIncorrectPassword)
+	assert.Equal(t, AuthProviderLDAP, provider)
+	require.NotNil(t, user)
+	assert.Equal(t, "alice", user.Name)
+}
+
+func TestLDAPAuthProvider_FallsBackToLocal(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestDirectory(t)
+	providers, store := newTestAuthProviders(t, srv, nil)
+
+	passwords, err := NewPasswordPolicy(&conf.App{}, log.DefaultLogger)
+	require.NoError(t, err)
+	hashed, err := passwords.Hash("admin-secret")
+	require.NoError(t, err)
+	store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Password: hashed, Role: RoleAdmin})
+
+	// 目录中没有的账号使用本地密码
+	user, provider, err := providers.authenticate(ctx, "admin@example.com", "admin-secret")
+	require.NoError(t, err)
+	assert.Equal(t, AuthProviderLocal, provider)
+	assert.Equal(t, "admin", user.Name)
+
+	_, _, err = providers.authenticate(ctx, "nobody@example.com", "secret")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// 目录用户从目录中删除后，不能再用本地密码登录
+	alice, _, err := providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	hashed, err = passwords.Hash("alice-secret")
+	require.NoError(t, err)
+	require.NoError(t, memAuthRepo{s: store}.UpdatePassword(ctx, alice.ID, hashed))
+	srv.DeleteEntry(testAliceDN)
+	_, provider, err = providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	assert.ErrorIs(t, err, ErrIncorrectPassword)
+	assert.Equal(t, AuthProviderLocal, provider)
+}
+
+func TestLDAPAuthProvider_LinkByEmail(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestDirectory(t)
+	existing := &po.User{Name: "alice-local", Email: "alice@example.com", Password: "x", Role: RoleUser, CreatedAt: time.Now()}
+
+	providers, store := newTestAuthProviders(t, srv, nil)
+	store.addUser(existing)
+	_, _, err := providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	assert.True(t, authpb.IsIdentityNotLinked(err))
+	assert.Empty(t, store.identities)
+
+	providers, store = newTestAuthProviders(t, srv, func(c *conf.App_Ldap) { c.LinkByEmail = true })
+	store.addUser(existing)
+	user, _, err := providers.authenticate(ctx, "alice@example.com", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "alice-local", user.Name)
+	assert.Len(t, store.users, 1)
+	require.Len(t, store.identities, 1)
+	assert.Equal(t, user.ID, store.identities[0].UserID)
+}
+
+func TestNewAuthProviders_LocalOnly(t *testing.T) {
+	cfg := &conf.App{}
+	providers, err := NewAuthProviders(nil, nil, nil, nil, nil, nil, nil, nil, log.DefaultLogger, cfg)
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	assert.Equal(t, AuthProviderLocal, providers[0].Name())
+}
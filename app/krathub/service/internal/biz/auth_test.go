@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestAuthUsecase_SignupRejectsNameAndEmailOfDeletedUser(t *testing.T) {
+	ctx := context.Background()
+	uc, store := newTestAuth(t, nil)
+
+	_, err := uc.SignupByEmail(ctx, &po.User{Name: InitialAdminName, Email: "admin@example.com", Password: "Admin-Secret-1"}, "")
+	require.NoError(t, err)
+	bob, err := uc.SignupByEmail(ctx, &po.User{Name: "bob", Email: "bob@example.com", Password: "Bob-Secret-1"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, RoleUser, bob.Role)
+
+	// 宽限期内删除的用户仍占用唯一索引，注册返回 USER_ALREADY_EXISTS 而不是数据库错误
+	store.mu.Lock()
+	store.users[bob.ID].DeletedAt = gorm.DeletedAt{Valid: true}
+	store.mu.Unlock()
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "bob", Email: "bob2@example.com", Password: "Bob-Secret-1"}, "")
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "bob2", Email: "bob@example.com", Password: "Bob-Secret-1"}, "")
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+}
+
+func TestAuthUsecase_RefreshTokenRotates(t *testing.T) {
+	ctx := context.Background()
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+
+	pair, err := uc.issueTokenPair(ctx, alice)
+	require.NoError(t, err)
+	login, err := uc.accessJWT.ParseToken(pair.AccessToken)
+	require.NoError(t, err)
+
+	// 刷新后换发新的 Refresh Token，沿用同一个会话
+	rotated, err := uc.RefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, pair.RefreshToken, rotated.RefreshToken)
+	claims, err := uc.accessJWT.ParseToken(rotated.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, claims.ID)
+	assert.Equal(t, login.SessionID, claims.SessionID)
+
+	family, err := memAuthRepo{s: store}.GetTokenFamily(ctx, rotated.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, &TokenFamily{ID: login.SessionID, UserID: alice.ID}, family)
+	assert.NotContains(t, store.refreshTokens, pair.RefreshToken)
+
+	// 新 token 可以继续轮换
+	_, err = uc.RefreshToken(ctx, rotated.RefreshToken)
+	require.NoError(t, err)
+
+	_, err = uc.RefreshToken(ctx, "unknown-token")
+	assert.True(t, authpb.IsInvalidRefreshToken(err))
+}
+
+func TestAuthUsecase_RefreshTokenReuseGrace(t *testing.T) {
+	ctx := context.Background()
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+
+	pair, err := uc.issueTokenPair(ctx, alice)
+	require.NoError(t, err)
+	rotated, err := uc.RefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err)
+
+	// 宽限期内重复使用刚轮换的 token 返回同一对新 token，不视为重放
+	retried, err := uc.RefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, rotated, retried)
+	assert.NotContains(t, auditActions(store), AuditActionTokenReuse)
+	_, err = uc.RefreshToken(ctx, rotated.RefreshToken)
+	require.NoError(t, err)
+
+	// 族被注销后宽限期内也不再返回 token
+	pair, err = uc.issueTokenPair(ctx, alice)
+	require.NoError(t, err)
+	_, err = uc.RefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err)
+	require.NoError(t, uc.repo.DeleteUserRefreshTokens(ctx, alice.ID))
+	_, err = uc.RefreshToken(ctx, pair.RefreshToken)
+	assert.True(t, authpb.IsInvalidRefreshToken(err))
+}
+
+func TestAuthUsecase_RefreshTokenReuseRevokesFamily(t *testing.T) {
+	for _, revokeAll := range []bool{false, true} {
+		ctx := context.Background()
+		uc, store := newTestAuth(t, &conf.App{Jwt: &conf.App_Jwt{
+			AccessSecret: "test-access-secret", RefreshSecret: "test-refresh-secret",
+			AccessExpire: 900, RefreshExpire: 3600, RevokeAllOnReuse: revokeAll,
+		}})
+		alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+
+		stolen, err := uc.issueTokenPair(ctx, alice)
+		require.NoError(t, err)
+		other, err := uc.issueTokenPair(ctx, alice)
+		require.NoError(t, err)
+		rotated, err := uc.RefreshToken(ctx, stolen.RefreshToken)
+		require.NoError(t, err)
+		session, err := uc.accessJWT.ParseToken(rotated.AccessToken)
+		require.NoError(t, err)
+
+		// 宽限期过后已轮换的 token 被重放时拒绝，并注销整个族，包括合法持有者手中的最新 token
+		store.expireRotatedPairs()
+		_, err = uc.RefreshToken(ctx, stolen.RefreshToken)
+		assert.True(t, authpb.IsInvalidRefreshToken(err), revokeAll)
+		_, err = uc.RefreshToken(ctx, rotated.RefreshToken)
+		assert.True(t, authpb.IsInvalidRefreshToken(err), revokeAll)
+		assert.True(t, revocations(uc.revoker).sessions[session.SessionID], revokeAll)
+		assert.True(t, authpb.IsUnauthorized(uc.revoker.Check(ctx, session)), revokeAll)
+		assert.Contains(t, auditActions(store), AuditActionTokenReuse)
+
+		// 其他会话只在配置了 revoke_all_on_reuse 时一并注销
+		_, err = uc.RefreshToken(ctx, other.RefreshToken)
+		assert.Equal(t, revokeAll, authpb.IsInvalidRefreshToken(err), revokeAll)
+		assert.Equal(t, revokeAll, !revocations(uc.revoker).watermarks[alice.ID].IsZero(), revokeAll)
+	}
+}
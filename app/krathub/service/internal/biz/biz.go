@@ -3,4 +3,7 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewAuthUsecase, NewUserUsecase, NewTestUsecase)
+var ProviderSet = wire.NewSet(
+	NewAccessTokenJWT, NewOIDCProviders, NewAuthUsecase, NewUserUsecase, NewTestUsecase, NewWebhookUsecase, NewNotificationUsecase, NewOutboxRelay, NewTokenRevoker, NewLoginThrottler, NewPasswordPolicy, NewTokenUsecase, NewAuthorizer, NewWorkspaceUsecase, NewAuditUsecase, NewSignupUsecase, NewAdminBootstrap, NewImpersonationUsecase, NewAuthProviders, NewSCIMUsecase,
+	wire.Bind(new(EventPublisher), new(*WebhookUsecase)),
+)
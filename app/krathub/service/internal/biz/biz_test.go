@@ -0,0 +1,791 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// memStore 业务用例测试共用的内存存储，memAuthRepo、memUserRepo 等仓库都读写同一份数据
+type memStore struct {
+	mu         sync.Mutex
+	nextID     int64
+	users      map[int64]*po.User
+	identities []*po.UserIdentity
+	audits     []*po.AuditEvent
+
+	// refreshTokens 有效的 Refresh Token，tokenFamilies 记录 token 所属的族，轮换后仍保留
+	refreshTokens map[string]int64
+	tokenFamilies map[string]TokenFamily
+	rotatedPairs  map[string]memRotatedPair
+	sessions      map[string]*Session
+	// verifications 邮箱验证和密码重置 token，键为 用途:token
+	verifications map[string]memVerification
+
+	bootstrapAdminID int64
+}
+
+type memRotatedPair struct {
+	pair      TokenPair
+	expiresAt time.Time
+}
+
+type memVerification struct {
+	userID    int64
+	email     string
+	expiresAt time.Time
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		users:         map[int64]*po.User{},
+		refreshTokens: map[string]int64{},
+		tokenFamilies: map[string]TokenFamily{},
+		rotatedPairs:  map[string]memRotatedPair{},
+		sessions:      map[string]*Session{},
+		verifications: map[string]memVerification{},
+	}
+}
+
+// expireRotatedPairs 让已轮换 token 的宽限期提前结束
+func (s *memStore) expireRotatedPairs() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.rotatedPairs)
+}
+
+func (s *memStore) addUser(u *po.User) *po.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	u.ID = s.nextID
+	s.users[u.ID] = u
+	return u
+}
+
+// insertUser 与数据库的唯一索引一致，用户名和邮箱被占用（包括已删除的用户）时返回错误
+func (s *memStore) insertUser(u *po.User) (*po.User, error) {
+	if s.find(func(e *po.User) bool {
+		return e.Name == u.Name || (u.Email != "" && strings.EqualFold(e.Email, u.Email))
+	}) != nil {
+		return nil, errors.New("duplicate key value violates unique constraint")
+	}
+	return s.addUser(u), nil
+}
+
+func (s *memStore) find(match func(*po.User) bool) *po.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if match(u) {
+			clone := *u
+			return &clone
+		}
+	}
+	return nil
+}
+
+// user 按 ID 返回用户的快照，包括已删除的用户
+func (s *memStore) user(id int64) *po.User {
+	return s.find(func(u *po.User) bool { return u.ID == id })
+}
+
+// verificationToken 返回用户当前有效的验证 token，邮件中的链接即该 token
+func (s *memStore) verificationToken(purpose string, userID int64) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, v := range s.verifications {
+		if p, token, _ := strings.Cut(key, ":"); p == purpose && v.userID == userID {
+			return token
+		}
+	}
+	return ""
+}
+
+// expireVerificationTokens 让全部验证 token 过期
+func (s *memStore) expireVerificationTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, v := range s.verifications {
+		v.expiresAt = time.Now().Add(-time.Second)
+		s.verifications[key] = v
+	}
+}
+
+type memAuthRepo struct {
+	AuthRepo
+	s *memStore
+}
+
+func (r memAuthRepo) SaveUser(_ context.Context, user *po.User) (*po.User, error) {
+	return r.s.insertUser(user)
+}
+
+// 与 gorm 的软删除一致，查询不返回已删除的用户，但已删除的用户仍占用用户名和邮箱
+func (r memAuthRepo) GetUserByEmail(_ context.Context, email string) (*po.User, error) {
+	return r.s.find(func(u *po.User) bool { return !u.DeletedAt.Valid && strings.EqualFold(u.Email, email) }), nil
+}
+
+func (r memAuthRepo) GetUserByUserName(_ context.Context, name string) (*po.User, error) {
+	return r.s.find(func(u *po.User) bool { return !u.DeletedAt.Valid && u.Name == name }), nil
+}
+
+func (r memAuthRepo) GetUserByID(_ context.Context, id int64) (*po.User, error) {
+	return r.s.find(func(u *po.User) bool { return !u.DeletedAt.Valid && u.ID == id }), nil
+}
+
+func (r memAuthRepo) IsUserNameTaken(_ context.Context, name string) (bool, error) {
+	return r.s.find(func(u *po.User) bool { return u.Name == name }) != nil, nil
+}
+
+func (r memAuthRepo) IsEmailTaken(_ context.Context, email string) (bool, error) {
+	return r.s.find(func(u *po.User) bool { return strings.EqualFold(u.Email, email) }) != nil, nil
+}
+
+func (r memAuthRepo) SetEmailVerified(_ context.Context, id int64, verified bool) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[id].EmailVerified = verified
+	return nil
+}
+
+func (r memAuthRepo) UpdatePassword(_ context.Context, id int64, password string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[id].Password = password
+	return nil
+}
+
+func (r memAuthRepo) SaveRefreshToken(_ context.Context, userID int64, token string, _ time.Duration) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.refreshTokens[token] = userID
+	return nil
+}
+
+func (r memAuthRepo) ConsumeRefreshToken(_ context.Context, token string) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	userID, ok := r.s.refreshTokens[token]
+	if !ok {
+		return 0, errors.New("refresh token not found")
+	}
+	delete(r.s.refreshTokens, token)
+	return userID, nil
+}
+
+func (r memAuthRepo) SaveTokenFamily(_ context.Context, family *TokenFamily, token string, _ time.Duration) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.tokenFamilies[token] = *family
+	return nil
+}
+
+func (r memAuthRepo) GetTokenFamily(_ context.Context, token string) (*TokenFamily, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	family, ok := r.s.tokenFamilies[token]
+	if !ok {
+		return nil, nil
+	}
+	return &family, nil
+}
+
+func (r memAuthRepo) SaveRotatedTokenPair(_ context.Context, token string, pair *TokenPair, expiration time.Duration) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.rotatedPairs[token] = memRotatedPair{pair: *pair, expiresAt: time.Now().Add(expiration)}
+	return nil
+}
+
+func (r memAuthRepo) GetRotatedTokenPair(_ context.Context, token string) (*TokenPair, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	rp, ok := r.s.rotatedPairs[token]
+	if !ok || time.Now().After(rp.expiresAt) {
+		return nil, nil
+	}
+	pair := rp.pair
+	return &pair, nil
+}
+
+func (r memAuthRepo) RevokeTokenFamily(_ context.Context, family *TokenFamily) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for token, f := range r.s.tokenFamilies {
+		if f.ID == family.ID {
+			delete(r.s.tokenFamilies, token)
+			delete(r.s.refreshTokens, token)
+			delete(r.s.rotatedPairs, token)
+		}
+	}
+	delete(r.s.sessions, family.ID)
+	return nil
+}
+
+func (r memAuthRepo) DeleteUserRefreshTokens(ctx context.Context, userID int64) error {
+	r.s.mu.Lock()
+	var families []TokenFamily
+	for _, f := range r.s.tokenFamilies {
+		if f.UserID == userID {
+			families = append(families, f)
+		}
+	}
+	for token, id := range r.s.refreshTokens {
+		if id == userID {
+			delete(r.s.refreshTokens, token)
+		}
+	}
+	r.s.mu.Unlock()
+	for _, f := range families {
+		if err := r.RevokeTokenFamily(ctx, &f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r memAuthRepo) SaveSession(_ context.Context, session *Session, _ time.Duration) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	clone := *session
+	r.s.sessions[session.ID] = &clone
+	return nil
+}
+
+func (r memAuthRepo) GetSession(_ context.Context, sessionID string) (*Session, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	session, ok := r.s.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	clone := *session
+	return &clone, nil
+}
+
+func (r memAuthRepo) ListUserSessions(_ context.Context, userID int64) ([]*Session, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var out []*Session
+	for _, session := range r.s.sessions {
+		if session.UserID == userID {
+			clone := *session
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r memAuthRepo) SaveVerificationToken(ctx context.Context, purpose, token string, userID int64, email string, expiration time.Duration) error {
+	_ = r.DeleteVerificationToken(ctx, purpose, userID)
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.verifications[purpose+":"+token] = memVerification{userID: userID, email: email, expiresAt: time.Now().Add(expiration)}
+	return nil
+}
+
+func (r memAuthRepo) ConsumeVerificationToken(_ context.Context, purpose, token string) (int64, string, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	v, ok := r.s.verifications[purpose+":"+token]
+	delete(r.s.verifications, purpose+":"+token)
+	if !ok || !v.expiresAt.After(time.Now()) {
+		return 0, "", nil
+	}
+	return v.userID, v.email, nil
+}
+
+func (r memAuthRepo) DeleteVerificationToken(_ context.Context, purpose string, userID int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for key, v := range r.s.verifications {
+		if p, _, _ := strings.Cut(key, ":"); p == purpose && v.userID == userID {
+			delete(r.s.verifications, key)
+		}
+	}
+	return nil
+}
+
+type memUserRepo struct {
+	UserRepo
+	s *memStore
+}
+
+func (r memUserRepo) SaveUser(_ context.Context, user *po.User) (*po.User, error) {
+	clone := *user
+	clone.CreatedAt, clone.UpdatedAt = time.Now(), time.Now()
+	return r.s.insertUser(&clone)
+}
+
+func (r memUserRepo) GetUserById(_ context.Context, id int64) (*po.User, error) {
+	if user := r.s.find(func(u *po.User) bool { return !u.DeletedAt.Valid && u.ID == id }); user != nil {
+		return user, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+func (r memUserRepo) UpdateUser(_ context.Context, user *po.User) (*po.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	stored := r.s.users[user.ID]
+	if user.Name != "" {
+		stored.Name = user.Name
+	}
+	if user.Email != "" {
+		stored.Email = user.Email
+	}
+	if user.Password != "" {
+		stored.Password = user.Password
+	}
+	return user, nil
+}
+
+func (r memUserRepo) DeleteUser(_ context.Context, user *po.User) (*po.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[user.ID].DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return user, nil
+}
+
+func (r memUserRepo) UpdateRole(_ context.Context, id int64, role string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[id].Role = role
+	return nil
+}
+
+func (r memUserRepo) SetDisabledAt(_ context.Context, id int64, at *time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[id].DisabledAt = at
+	return nil
+}
+
+func (r memUserRepo) ListUsers(_ context.Context, opts *UserListOptions) ([]*po.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var out []*po.User
+	for _, u := range r.s.users {
+		if u.DeletedAt.Valid != opts.Filter.Deleted {
+			continue
+		}
+		if opts.Filter.Role != "" && u.Role != opts.Filter.Role {
+			continue
+		}
+		if opts.After != nil && u.ID <= opts.After.ID {
+			continue
+		}
+		clone := *u
+		out = append(out, &clone)
+	}
+	slices.SortFunc(out, func(a, b *po.User) int { return int(a.ID - b.ID) })
+	return out[:min(len(out), opts.Limit)], nil
+}
+
+func (r memUserRepo) ListPurgeableUsers(_ context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var ids []int64
+	for id, u := range r.s.users {
+		if u.DeletedAt.Valid && u.DeletedAt.Time.Before(deletedBefore) && u.AnonymizedAt == nil {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids[:min(len(ids), limit)], nil
+}
+
+func (r memUserRepo) AnonymizeUser(_ context.Context, id int64, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	u := r.s.users[id]
+	u.Name, u.Email, u.AnonymizedAt = fmt.Sprintf("deleted-user-%d", id), "", &at
+	return nil
+}
+
+type memIdentityRepo struct {
+	IdentityRepo
+	s *memStore
+
+	mu     sync.Mutex
+	states map[string]*OIDCState
+}
+
+func newMemIdentityRepo(s *memStore) *memIdentityRepo {
+	return &memIdentityRepo{s: s, states: map[string]*OIDCState{}}
+}
+
+func (r *memIdentityRepo) GetIdentity(_ context.Context, provider, subject string) (*po.UserIdentity, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, i := range r.s.identities {
+		if i.Provider == provider && i.Subject == subject {
+			return i, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memIdentityRepo) ListUserIdentities(_ context.Context, userID int64) ([]*po.UserIdentity, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var out []*po.UserIdentity
+	for _, i := range r.s.identities {
+		if i.UserID == userID {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+func (r *memIdentityRepo) ListProviderIdentities(_ context.Context, provider string, userIDs []int64) ([]*po.UserIdentity, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var out []*po.UserIdentity
+	for _, i := range r.s.identities {
+		if i.Provider == provider && slices.Contains(userIDs, i.UserID) {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+func (r *memIdentityRepo) CreateIdentity(_ context.Context, identity *po.UserIdentity) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.identities = append(r.s.identities, identity)
+	return nil
+}
+
+func (r *memIdentityRepo) CreateUserWithIdentity(ctx context.Context, user *po.User, identity *po.UserIdentity) (*po.User, error) {
+	created, err := r.s.insertUser(user)
+	if err != nil {
+		return nil, err
+	}
+	identity.UserID = created.ID
+	return created, r.CreateIdentity(ctx, identity)
+}
+
+func (r *memIdentityRepo) DeleteIdentity(_ context.Context, userID int64, provider string) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	n := len(r.s.identities)
+	r.s.identities = slices.DeleteFunc(r.s.identities, func(i *po.UserIdentity) bool {
+		return i.UserID == userID && i.Provider == provider
+	})
+	return len(r.s.identities) < n, nil
+}
+
+func (r *memIdentityRepo) SaveOIDCState(_ context.Context, state string, s *OIDCState, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[state] = s
+	return nil
+}
+
+func (r *memIdentityRepo) ConsumeOIDCState(_ context.Context, state string) (*OIDCState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := r.states[state]
+	delete(r.states, state)
+	return s, nil
+}
+
+type memRoleRepo struct{}
+
+func (memRoleRepo) ListRoles(context.Context) ([]*po.Role, error) { return nil, nil }
+
+type memAuditRepo struct {
+	AuditRepo
+	s *memStore
+}
+
+func (r memAuditRepo) CreateAuditEvent(_ context.Context, event *po.AuditEvent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	event.ID = int64(len(r.s.audits) + 1)
+	r.s.audits = append(r.s.audits, event)
+	return nil
+}
+
+// ListAuditEvents 按 id 倒序返回，只支持按操作者和操作过滤
+func (r memAuditRepo) ListAuditEvents(_ context.Context, filter *AuditFilter, beforeID int64, limit int) ([]*po.AuditEvent, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var out []*po.AuditEvent
+	for i := len(r.s.audits) - 1; i >= 0 && len(out) < limit; i-- {
+		e := r.s.audits[i]
+		if beforeID > 0 && e.ID >= beforeID {
+			continue
+		}
+		if filter != nil && filter.ActorID != 0 && (e.ActorID == nil || *e.ActorID != filter.ActorID) {
+			continue
+		}
+		if filter != nil && filter.Action != "" && e.Action != filter.Action {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+type memBootstrapRepo struct {
+	s *memStore
+}
+
+func (r memBootstrapRepo) GetBootstrapAdminID(context.Context) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	return r.s.bootstrapAdminID, nil
+}
+
+func (r memBootstrapRepo) CreateBootstrapAdmin(_ context.Context, user *po.User) (*po.User, error) {
+	if id, _ := r.GetBootstrapAdminID(context.Background()); id != 0 {
+		return nil, ErrAdminBootstrapped
+	}
+	created, err := r.s.insertUser(user)
+	if err != nil {
+		return nil, err
+	}
+	return created, r.AdoptBootstrapAdmin(context.Background(), created.ID)
+}
+
+func (r memBootstrapRepo) AdoptBootstrapAdmin(_ context.Context, userID int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if r.s.bootstrapAdminID == 0 {
+		r.s.bootstrapAdminID = userID
+	}
+	return nil
+}
+
+func (r memBootstrapRepo) ResetBootstrapAdmin(_ context.Context, userID int64, passwordHash string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	u := r.s.users[userID]
+	u.Password, u.Role, u.DisabledAt, u.DeletedAt = passwordHash, RoleAdmin, nil, gorm.DeletedAt{}
+	return nil
+}
+
+// memRevocationRepo 吊销记录的内存存储，只记录过期时间而不让记录过期
+type memRevocationRepo struct {
+	mu         sync.Mutex
+	tokens     map[string]bool
+	sessions   map[string]bool
+	watermarks map[int64]time.Time
+	ttls       map[string]time.Duration // 键为 watermark:用户ID 或 session:会话ID
+}
+
+func newMemRevocationRepo() *memRevocationRepo {
+	return &memRevocationRepo{
+		tokens:     map[string]bool{},
+		sessions:   map[string]bool{},
+		watermarks: map[int64]time.Time{},
+		ttls:       map[string]time.Duration{},
+	}
+}
+
+func (r *memRevocationRepo) DenyAccessToken(_ context.Context, jti string, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens[jti] = true
+	return nil
+}
+
+func (r *memRevocationRepo) IsAccessTokenDenied(_ context.Context, jti string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.tokens[jti], nil
+}
+
+func (r *memRevocationRepo) SetTokenWatermark(_ context.Context, userID int64, at time.Time, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watermarks[userID] = at
+	r.ttls[fmt.Sprintf("watermark:%d", userID)] = ttl
+	return nil
+}
+
+func (r *memRevocationRepo) GetTokenWatermark(_ context.Context, userID int64) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.watermarks[userID], nil
+}
+
+func (r *memRevocationRepo) DenySession(_ context.Context, sessionID string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[sessionID] = true
+	r.ttls["session:"+sessionID] = ttl
+	return nil
+}
+
+func (r *memRevocationRepo) IsSessionDenied(_ context.Context, sessionID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.sessions[sessionID], nil
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, string, any) {}
+
+// testEnv 业务用例测试的公共依赖，仓库都使用同一个 memStore，邮件只放入内存队列不发送
+type testEnv struct {
+	t      *testing.T
+	cfg    *conf.App
+	logger log.Logger
+	store  *memStore
+	mails  *mail.MemoryStore
+
+	identityRepo *memIdentityRepo
+	revocations  *memRevocationRepo
+	authz        *Authorizer
+	passwords    *PasswordPolicy
+	audit        *AuditUsecase
+	revoker      *TokenRevoker
+	throttler    *LoginThrottler
+	mailer       *mail.Mailer
+	notifier     *NotificationUsecase
+}
+
+// newTestEnv cfg 为空时使用默认配置；未配置 JWT 时使用测试密钥，未配置通知时关闭通知
+func newTestEnv(t *testing.T, cfg *conf.App) *testEnv {
+	t.Helper()
+	if cfg == nil {
+		cfg = &conf.App{}
+	}
+	if cfg.Jwt == nil {
+		cfg.Jwt = &conf.App_Jwt{AccessSecret: "test-access-secret", RefreshSecret: "test-refresh-secret", AccessExpire: 900, RefreshExpire: 3600}
+	}
+	if cfg.Notification == nil {
+		cfg.Notification = &conf.App_Notification{DefaultMode: NotifyOff}
+	}
+	e := &testEnv{t: t, cfg: cfg, logger: log.DefaultLogger, store: newMemStore(), mails: mail.NewMemoryStore()}
+	e.identityRepo = newMemIdentityRepo(e.store)
+	e.revocations = newMemRevocationRepo()
+
+	var err error
+	e.authz, err = NewAuthorizer(memRoleRepo{}, e.logger, cfg)
+	require.NoError(t, err)
+	e.passwords, err = NewPasswordPolicy(cfg, e.logger)
+	require.NoError(t, err)
+	renderer, err := mail.NewRenderer(nil, "")
+	require.NoError(t, err)
+	e.mailer = mail.NewMailer(renderer, mail.NewQueue(mail.QueueConfig{}, e.mails, mail.NewMemorySink(""), e.logger))
+	e.audit = NewAuditUsecase(memAuditRepo{s: e.store}, e.authz, e.logger)
+	e.revoker = NewTokenRevoker(e.revocations, e.logger, cfg)
+	e.throttler = NewLoginThrottler(nil, e.logger, cfg)
+	e.notifier = NewNotificationUsecase(nil, e.userRepo(), e.logger, cfg, e.mailer, nopPublisher{})
+	return e
+}
+
+func (e *testEnv) authRepo() memAuthRepo { return memAuthRepo{s: e.store} }
+
+func (e *testEnv) userRepo() memUserRepo { return memUserRepo{s: e.store} }
+
+// users 创建用户用例
+func (e *testEnv) users() *UserUsecase {
+	return NewUserUsecase(e.userRepo(), e.logger, e.cfg, e.authRepo(), nopPublisher{}, e.notifier, e.revoker,
+		e.throttler, e.passwords, e.authz, e.audit, e.signup())
+}
+
+// signup 创建注册用例，注册方式取决于配置，不使用邀请码仓库
+func (e *testEnv) signup() *SignupUsecase {
+	e.t.Helper()
+	signup, err := NewSignupUsecase(nil, e.authz, e.audit, e.logger, e.cfg)
+	require.NoError(e.t, err)
+	return signup
+}
+
+// auth 创建认证用例，注册方式和登录方式取决于配置
+func (e *testEnv) auth() *AuthUsecase {
+	e.t.Helper()
+	accessJWT, err := NewAccessTokenJWT(e.cfg)
+	require.NoError(e.t, err)
+	oidcProviders, err := NewOIDCProviders(e.cfg)
+	require.NoError(e.t, err)
+	bootstrap := NewAdminBootstrap(memBootstrapRepo{s: e.store}, e.authRepo(), e.passwords, e.revoker, e.throttler, e.logger)
+	providers, err := NewAuthProviders(e.authRepo(), e.userRepo(), e.identityRepo, e.passwords, e.authz, e.revoker,
+		nopPublisher{}, e.audit, e.logger, e.cfg)
+	require.NoError(e.t, err)
+	return NewAuthUsecase(e.authRepo(), nil, e.identityRepo, e.logger, e.cfg, accessJWT, oidcProviders, e.mailer, nopPublisher{},
+		e.notifier, e.revoker, e.throttler, e.passwords, nil, e.audit, e.signup(), bootstrap, providers)
+}
+
+// sentMails 取出已放入发送队列的邮件
+func (e *testEnv) sentMails() []*mail.Message {
+	var out []*mail.Message
+	for {
+		job, err := e.mails.Pop(context.Background(), 0)
+		if err != nil || job == nil {
+			return out
+		}
+		out = append(out, job.Message)
+	}
+}
+
+// asUser 返回以指定用户身份调用的 context
+func asUser(u *po.User) context.Context {
+	return jwt.NewContext(context.Background(), &UserClaims{ID: u.ID, Name: u.Name, Role: u.Role})
+}
+
+// auditActions 按记录顺序返回审计日志的操作类型
+func auditActions(store *memStore) []string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	actions := make([]string, 0, len(store.audits))
+	for _, e := range store.audits {
+		actions = append(actions, e.Action)
+	}
+	return actions
+}
+
+// lastAudit 返回最近一条审计日志和解析后的 metadata
+func lastAudit(t *testing.T, store *memStore) (*po.AuditEvent, map[string]any) {
+	t.Helper()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	require.NotEmpty(t, store.audits)
+	event := store.audits[len(store.audits)-1]
+	var metadata map[string]any
+	if event.Metadata != nil {
+		require.NoError(t, json.Unmarshal([]byte(*event.Metadata), &metadata))
+	}
+	return event, metadata
+}
+
+// newTestAuth 创建使用内存存储的认证用例，只启用本地密码登录
+func newTestAuth(t *testing.T, cfg *conf.App) (*AuthUsecase, *memStore) {
+	t.Helper()
+	e := newTestEnv(t, cfg)
+	return e.auth(), e.store
+}
+
+// newTestUsers 创建使用内存存储的用户用例
+func newTestUsers(t *testing.T, cfg *conf.App) (*UserUsecase, *memStore) {
+	t.Helper()
+	e := newTestEnv(t, cfg)
+	return e.users(), e.store
+}
+
+// revocations 返回用例使用的内存吊销记录
+func revocations(revoker *TokenRevoker) *memRevocationRepo {
+	return revoker.repo.(*memRevocationRepo)
+}
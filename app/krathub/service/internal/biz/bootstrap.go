@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	stderrors "errors"
+	"sync/atomic"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InitialAdminName 通过注册创建初始管理员时必须使用的用户名
+const InitialAdminName = "admin"
+
+// ErrAdminBootstrapped 初始管理员已经创建
+var ErrAdminBootstrapped = stderrors.New("initial admin already exists")
+
+// BootstrapRepo 记录初始管理员。记录只有一行，由主键保证多个实例并发时只会初始化一次
+type BootstrapRepo interface {
+	// GetBootstrapAdminID 未初始化时返回 0
+	GetBootstrapAdminID(ctx context.Context) (int64, error)
+	// CreateBootstrapAdmin 在同一事务中创建用户并记录为初始管理员，已初始化时返回 ErrAdminBootstrapped
+	CreateBootstrapAdmin(ctx context.Context, user *po.User) (*po.User, error)
+	// AdoptBootstrapAdmin 把已存在的用户记录为初始管理员，已初始化时不做修改
+	AdoptBootstrapAdmin(ctx context.Context, userID int64) error
+	// ResetBootstrapAdmin 设置初始管理员的密码和 admin 角色，并取消停用和删除
+	ResetBootstrapAdmin(ctx context.Context, userID int64, passwordHash string) error
+}
+
+// AdminBootstrap 创建和重置初始管理员。是否已初始化以数据库为准，
+// 查询失败时返回错误，不会因为数据库暂时不可用而再次开放初始管理员注册
+type AdminBootstrap struct {
+	repo      BootstrapRepo
+	authRepo  AuthRepo
+	passwords *PasswordPolicy
+	revoker   *TokenRevoker
+	throttler *LoginThrottler
+	log       *log.Helper
+
+	done atomic.Bool // 初始化后不会回退，确认后不再查询数据库
+}
+
+// NewAdminBootstrap new an admin bootstrap.
+func NewAdminBootstrap(repo BootstrapRepo, authRepo AuthRepo, passwords *PasswordPolicy, revoker *TokenRevoker, throttler *LoginThrottler, logger log.Logger) *AdminBootstrap {
+	return &AdminBootstrap{
+		repo:      repo,
+		authRepo:  authRepo,
+		passwords: passwords,
+		revoker:   revoker,
+		throttler: throttler,
+		log:       log.NewHelper(pkglogger.WithModule(logger, "bootstrap/biz/krathub-service")),
+	}
+}
+
+// Bootstrapped 判断初始管理员是否已经创建
+func (b *AdminBootstrap) Bootstrapped(ctx context.Context) (bool, error) {
+	if b.done.Load() {
+		return true, nil
+	}
+	id, err := b.adminID(ctx)
+	if err != nil {
+		return false, err
+	}
+	return id != 0, nil
+}
+
+// adminID 初始管理员的用户 ID，未初始化时返回 0。
+// 没有初始化记录但已存在 admin 用户时（升级前通过注册创建），把该用户记录为初始管理员
+func (b *AdminBootstrap) adminID(ctx context.Context) (int64, error) {
+	id, err := b.repo.GetBootstrapAdminID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		admin, err := b.authRepo.GetUserByUserName(ctx, InitialAdminName)
+		if err != nil {
+			return 0, err
+		}
+		if admin == nil {
+			return 0, nil
+		}
+		if err := b.repo.AdoptBootstrapAdmin(ctx, admin.ID); err != nil {
+			return 0, err
+		}
+		if id, err = b.repo.GetBootstrapAdminID(ctx); err != nil {
+			return 0, err
+		}
+	}
+	b.done.Store(true)
+	return id, nil
+}
+
+// CreateInitialAdmin 创建初始管理员，user 的密码需已生成哈希。已初始化时返回 USER_ALREADY_EXISTS
+func (b *AdminBootstrap) CreateInitialAdmin(ctx context.Context, user *po.User) (*po.User, error) {
+	user.Role = RoleAdmin
+	created, err := b.repo.CreateBootstrapAdmin(ctx, user)
+	if stderrors.Is(err, ErrAdminBootstrapped) {
+		b.done.Store(true)
+		return nil, authpb.ErrorUserAlreadyExists("the initial admin has already been created")
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.done.Store(true)
+	b.log.Infof("initial admin %d (%s) created", created.ID, created.Name)
+	return created, nil
+}
+
+// EnsureAdmin 供命令行使用：未初始化时创建初始管理员，邮箱视为已验证；
+// 已初始化且 reset 为 true 时重置初始管理员的密码，恢复 admin 角色、取消停用和删除，
+// 并注销其全部会话、解除登录锁定。返回初始管理员和是否新创建
+func (b *AdminBootstrap) EnsureAdmin(ctx context.Context, name, email, password string, reset bool) (*po.User, bool, error) {
+	id, err := b.adminID(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	hashed, err := b.passwords.HashNew(password)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if id == 0 {
+		if name == "" || email == "" {
+			return nil, false, authpb.ErrorInvalidCredentials("name and email are required to create the initial admin")
+		}
+		if taken, err := b.authRepo.IsUserNameTaken(ctx, name); err != nil {
+			return nil, false, err
+		} else if taken {
+			return nil, false, authpb.ErrorUserAlreadyExists("username %s already exists", name)
+		}
+		if taken, err := b.authRepo.IsEmailTaken(ctx, email); err != nil {
+			return nil, false, err
+		} else if taken {
+			return nil, false, authpb.ErrorUserAlreadyExists("email %s already exists", email)
+		}
+		user, err := b.CreateInitialAdmin(ctx, &po.User{
+			Name:          name,
+			Email:         email,
+			Password:      hashed,
+			EmailVerified: true,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		return user, true, nil
+	}
+
+	if !reset {
+		return nil, false, authpb.ErrorUserAlreadyExists("the initial admin (user %d) has already been created", id)
+	}
+	if err := b.repo.ResetBootstrapAdmin(ctx, id, hashed); err != nil {
+		return nil, false, err
+	}
+	user, err := b.authRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := b.revoker.RevokeUserTokens(ctx, id); err != nil {
+		b.log.Errorf("revoke access tokens of user %d failed: %v", id, err)
+	}
+	if err := b.authRepo.DeleteUserRefreshTokens(ctx, id); err != nil {
+		b.log.Errorf("revoke refresh tokens of user %d failed: %v", id, err)
+	}
+	if err := b.throttler.Unlock(ctx, user.Email); err != nil {
+		b.log.Errorf("unlock user %d failed: %v", id, err)
+	}
+	b.log.Infof("initial admin %d (%s) reset", user.ID, user.Name)
+	return user, false, nil
+}
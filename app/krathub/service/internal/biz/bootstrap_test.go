@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingBootstrapRepo 查询初始化记录失败，模拟数据库暂时不可用
+type failingBootstrapRepo struct {
+	memBootstrapRepo
+}
+
+func (failingBootstrapRepo) GetBootstrapAdminID(context.Context) (int64, error) {
+	return 0, errors.New("connection refused")
+}
+
+// newTestBootstrap 创建初始管理员用例，登录锁定使用 MaxAccountFailures 为 1 的内存仓库
+func newTestBootstrap(t *testing.T) (*AdminBootstrap, *memStore, *memRevocationRepo, *LoginThrottler) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	throttler, _ := newTestThrottler(&conf.App_Lockout{MaxAccountFailures: 1})
+	b := NewAdminBootstrap(memBootstrapRepo{s: e.store}, e.authRepo(), e.passwords, e.revoker, throttler, e.logger)
+	return b, e.store, e.revocations, throttler
+}
+
+func TestAdminBootstrap_CreateInitialAdmin(t *testing.T) {
+	ctx := context.Background()
+	b, store, _, _ := newTestBootstrap(t)
+
+	bootstrapped, err := b.Bootstrapped(ctx)
+	require.NoError(t, err)
+	assert.False(t, bootstrapped)
+
+	// 初始管理员总是 admin 角色
+	created, err := b.CreateInitialAdmin(ctx, &po.User{Name: InitialAdminName, Email: "admin@example.com", Role: RoleUser})
+	require.NoError(t, err)
+	assert.Equal(t, RoleAdmin, created.Role)
+	assert.Equal(t, created.ID, store.bootstrapAdminID)
+	bootstrapped, err = b.Bootstrapped(ctx)
+	require.NoError(t, err)
+	assert.True(t, bootstrapped)
+
+	// 已初始化后再次创建被拒绝，不会创建第二个管理员
+	_, err = b.CreateInitialAdmin(ctx, &po.User{Name: "second", Email: "second@example.com"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	assert.Len(t, store.users, 1)
+	assert.Equal(t, created.ID, store.bootstrapAdminID)
+
+	// 另一个实例未缓存初始化状态时，以数据库为准
+	other := NewAdminBootstrap(memBootstrapRepo{s: store}, memAuthRepo{s: store}, b.passwords, b.revoker, b.throttler, log.DefaultLogger)
+	_, err = other.CreateInitialAdmin(ctx, &po.User{Name: "third", Email: "third@example.com"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	assert.Len(t, store.users, 1)
+}
+
+func TestAdminBootstrap_AdoptsExistingAdmin(t *testing.T) {
+	ctx := context.Background()
+	b, store, _, _ := newTestBootstrap(t)
+
+	// 升级前通过注册创建的 admin 用户被记录为初始管理员
+	admin := store.addUser(&po.User{Name: InitialAdminName, Email: "admin@example.com", Role: RoleAdmin})
+	bootstrapped, err := b.Bootstrapped(ctx)
+	require.NoError(t, err)
+	assert.True(t, bootstrapped)
+	assert.Equal(t, admin.ID, store.bootstrapAdminID)
+
+	_, created, err := b.EnsureAdmin(ctx, "root", "root@example.com", "Root-Secret-1", false)
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	assert.False(t, created)
+	assert.Len(t, store.users, 1)
+}
+
+func TestAdminBootstrap_RefusesWhenCheckFails(t *testing.T) {
+	ctx := context.Background()
+	uc, store := newTestAuth(t, nil)
+	uc.bootstrap.repo = failingBootstrapRepo{memBootstrapRepo{s: store}}
+
+	// 查询失败时不能当作未初始化，否则会再次开放初始管理员注册
+	_, err := uc.bootstrap.Bootstrapped(ctx)
+	require.Error(t, err)
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: InitialAdminName, Email: "admin@example.com", Password: "Admin-Secret-1"}, "")
+	require.Error(t, err)
+	_, _, err = uc.bootstrap.EnsureAdmin(ctx, InitialAdminName, "admin@example.com", "Admin-Secret-1", false)
+	require.Error(t, err)
+	assert.Empty(t, store.users)
+}
+
+func TestAdminBootstrap_EnsureAdmin(t *testing.T) {
+	ctx := context.Background()
+	b, store, revocations, throttler := newTestBootstrap(t)
+	store.addUser(&po.User{Name: "taken", Email: "taken@example.com", Role: RoleUser})
+
+	tests := []struct {
+		name, email string
+		check       func(error) bool
+	}{
+		{name: "", email: "root@example.com", check: authpb.IsInvalidCredentials},
+		{name: "root", email: "", check: authpb.IsInvalidCredentials},
+		{name: "taken", email: "root@example.com", check: authpb.IsUserAlreadyExists},
+		{name: "root", email: "taken@example.com", check: authpb.IsUserAlreadyExists},
+	}
+	for _, tt := range tests {
+		_, _, err := b.EnsureAdmin(ctx, tt.name, tt.email, "Root-Secret-1", false)
+		assert.True(t, tt.check(err), "%s %s: %v", tt.name, tt.email, err)
+	}
+	_, _, err := b.EnsureAdmin(ctx, "root", "root@example.com", "short", false)
+	require.Error(t, err)
+	assert.Zero(t, store.bootstrapAdminID)
+
+	// 未初始化时创建初始管理员，邮箱视为已验证
+	admin, created, err := b.EnsureAdmin(ctx, "root", "root@example.com", "Root-Secret-1", false)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, RoleAdmin, admin.Role)
+	assert.True(t, admin.EmailVerified)
+	assert.True(t, hash.Check("Root-Secret-1", admin.Password))
+	assert.Equal(t, admin.ID, store.bootstrapAdminID)
+
+	// 再次执行不会重复创建，不指定 reset 时拒绝
+	_, created, err = b.EnsureAdmin(ctx, "root", "root@example.com", "Root-Secret-1", false)
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	assert.False(t, created)
+	_, created, err = b.EnsureAdmin(ctx, "other", "other@example.com", "Other-Secret-1", false)
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	assert.False(t, created)
+	assert.Len(t, store.users, 2)
+
+	// reset 恢复初始管理员：重置密码和角色，取消停用，注销会话并解除锁定
+	now := time.Now()
+	store.mu.Lock()
+	store.users[admin.ID].Role, store.users[admin.ID].DisabledAt = RoleUser, &now
+	store.mu.Unlock()
+	require.NoError(t, memAuthRepo{s: store}.SaveRefreshToken(ctx, admin.ID, "admin-refresh", time.Hour))
+	throttler.RecordFailure(ctx, admin.Email, "10.0.0.1")
+	require.True(t, authpb.IsAccountLocked(throttler.Check(ctx, admin.Email, "10.0.0.2")))
+
+	reset, created, err := b.EnsureAdmin(ctx, "ignored", "ignored@example.com", "Reset-Secret-2", true)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, admin.ID, reset.ID)
+	assert.Equal(t, "root", reset.Name)
+	assert.Equal(t, RoleAdmin, reset.Role)
+	assert.False(t, IsDisabled(reset))
+	assert.True(t, hash.Check("Reset-Secret-2", reset.Password))
+	assert.Contains(t, revocations.watermarks, admin.ID)
+	_, err = memAuthRepo{s: store}.ConsumeRefreshToken(ctx, "admin-refresh")
+	assert.Error(t, err)
+	assert.NoError(t, throttler.Check(ctx, admin.Email, "10.0.0.2"))
+	assert.Len(t, store.users, 2)
+}
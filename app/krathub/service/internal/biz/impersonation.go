@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultImpersonationTTL = 15 * time.Minute
+	maxImpersonationTTL     = time.Hour
+)
+
+// ActorClaims 代登录时实际操作的管理员，对应 RFC 8693 的 act 声明
+type ActorClaims struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Impersonated 判断 Access Token 是否由代登录签发。此时 ID、Name、Role 为被模拟的用户，Act 为实际操作的管理员
+func (c *UserClaims) Impersonated() bool {
+	return c.Act != nil
+}
+
+// ImpersonatorFromContext 返回代登录的管理员，当前请求不是代登录时返回 false
+func ImpersonatorFromContext(ctx context.Context) (*ActorClaims, bool) {
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok || !claims.Impersonated() {
+		return nil, false
+	}
+	return claims.Act, true
+}
+
+// rejectImpersonation 代登录期间拒绝修改密码、创建令牌等敏感操作，action 用于错误信息
+func rejectImpersonation(ctx context.Context, action string) error {
+	if act, ok := ImpersonatorFromContext(ctx); ok {
+		return authpb.ErrorImpersonationNotAllowed("%s is not allowed while impersonating (by user %d)", action, act.ID)
+	}
+	return nil
+}
+
+// ImpersonationUsecase 管理员以其他用户身份登录。代登录只签发短期 Access Token，没有 Refresh Token，
+// 开始和结束都写入审计日志
+type ImpersonationUsecase struct {
+	authRepo  AuthRepo
+	accessJWT *jwtpkg.JWT[UserClaims]
+	authz     *Authorizer
+	audit     *AuditUsecase
+	revoker   *TokenRevoker
+	cfg       *conf.App
+	log       *log.Helper
+
+	ttl time.Duration
+}
+
+// NewImpersonationUsecase new an impersonation usecase.
+func NewImpersonationUsecase(authRepo AuthRepo, accessJWT *jwtpkg.JWT[UserClaims], authz *Authorizer, audit *AuditUsecase, revoker *TokenRevoker, logger log.Logger, cfg *conf.App) *ImpersonationUsecase {
+	uc := &ImpersonationUsecase{
+		authRepo:  authRepo,
+		accessJWT: accessJWT,
+		authz:     authz,
+		audit:     audit,
+		revoker:   revoker,
+		cfg:       cfg,
+		log:       log.NewHelper(pkglogger.WithModule(logger, "impersonation/biz/krathub-service")),
+		ttl:       cfg.GetImpersonation().GetTokenTtl().AsDuration(),
+	}
+	if uc.ttl <= 0 {
+		uc.ttl = defaultImpersonationTTL
+	}
+	uc.ttl = min(uc.ttl, maxImpersonationTTL)
+	return uc
+}
+
+// Impersonate 签发以 userID 身份访问的 Access Token。不能模拟自己、已停用的用户、权限超过自己的用户
+// 和同样可以代登录的管理员，也不能在代登录期间再次代登录
+func (uc *ImpersonationUsecase) Impersonate(ctx context.Context, userID int64, reason string) (pair *TokenPair, err error) {
+	entry := AuditEntry{Action: AuditActionImpersonateStart, TargetType: AuditTargetUser, TargetID: userID, Metadata: map[string]any{"reason": reason}}
+	defer func() {
+		entry.Err = err
+		uc.audit.Record(ctx, entry)
+	}()
+
+	if err := rejectImpersonation(ctx, "impersonation"); err != nil {
+		return nil, err
+	}
+	if err := uc.authz.Authorize(ctx, PermUserImpersonate); err != nil {
+		return nil, err
+	}
+	claims, _ := jwtpkg.FromContext[UserClaims](ctx)
+	if userID == claims.ID {
+		return nil, authpb.ErrorImpersonationNotAllowed("cannot impersonate yourself")
+	}
+	user, err := uc.authRepo.GetUserByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, userpb.ErrorUserNotFound("user %d not found", userID)
+	}
+	if err := uc.authz.AuthorizeUserManagement(ctx, user.Role); err != nil {
+		return nil, err
+	}
+	// 管理员之间不能互相代登录，避免借他人身份执行管理操作
+	if uc.authz.Can(ctx, user.Role, PermUserImpersonate) {
+		return nil, authpb.ErrorImpersonationNotAllowed("cannot impersonate administrator %d", user.ID)
+	}
+	if err := checkUserEnabled(user); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate nonce: %v", err)
+	}
+	nonce := hex.EncodeToString(b)
+	now := time.Now()
+	expiresAt := now.Add(uc.ttl)
+	token, err := uc.accessJWT.GenerateToken(&UserClaims{
+		ID:         user.ID,
+		Name:       user.Name,
+		Role:       user.Role,
+		Nonce:      nonce,
+		Act:        &ActorClaims{ID: claims.ID, Name: claims.Name},
+		IssuedAtMs: now.UnixMilli(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{uc.cfg.GetJwt().GetAudience()},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    uc.cfg.GetJwt().GetIssuer(),
+			ID:        nonce,
+		},
+	})
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate access token: %v", err)
+	}
+	entry.Metadata["token_id"] = nonce
+	entry.Metadata["expires_at"] = expiresAt.UTC().Format(time.RFC3339)
+	uc.log.Infof("user %d started impersonating user %d", claims.ID, user.ID)
+	return &TokenPair{AccessToken: token, ExpiresIn: int64(uc.ttl / time.Second)}, nil
+}
+
+// EndImpersonation 吊销当前代登录的 Access Token。未主动结束的代登录在 Access Token 过期时结束
+func (uc *ImpersonationUsecase) EndImpersonation(ctx context.Context) (err error) {
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok {
+		return authpb.ErrorUnauthorized("user not authenticated")
+	}
+	if !claims.Impersonated() {
+		return authpb.ErrorInvalidTokenType("the current token is not an impersonation token")
+	}
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{
+			Action: AuditActionImpersonateEnd, TargetType: AuditTargetUser, TargetID: claims.ID, Err: err,
+			Metadata: map[string]any{"token_id": claims.RegisteredClaims.ID},
+		})
+	}()
+	if err := uc.revoker.RevokeToken(ctx, claims); err != nil {
+		return authpb.ErrorTokenGenerationFailed("failed to revoke impersonation token: %v", err)
+	}
+	uc.log.Infof("user %d stopped impersonating user %d", claims.Act.ID, claims.ID)
+	return nil
+}
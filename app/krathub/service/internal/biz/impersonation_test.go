@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestImpersonation(t *testing.T) (*ImpersonationUsecase, *memStore, *memRevocationRepo) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	accessJWT, err := NewAccessTokenJWT(e.cfg)
+	require.NoError(t, err)
+	uc := NewImpersonationUsecase(e.authRepo(), accessJWT, e.authz, e.audit, e.revoker, e.logger, e.cfg)
+	return uc, e.store, e.revocations
+}
+
+func TestImpersonationUsecase_Impersonate(t *testing.T) {
+	uc, store, revocations := newTestImpersonation(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+
+	pair, err := uc.Impersonate(asUser(admin), alice.ID, "ticket 42")
+	require.NoError(t, err)
+	assert.Empty(t, pair.RefreshToken)
+	assert.Equal(t, int64(defaultImpersonationTTL/time.Second), pair.ExpiresIn)
+
+	// token 以被模拟的用户身份访问，act 声明记录实际操作的管理员
+	claims, err := uc.accessJWT.ParseToken(pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, claims.ID)
+	assert.Equal(t, RoleUser, claims.Role)
+	require.True(t, claims.Impersonated())
+	assert.Equal(t, &ActorClaims{ID: admin.ID, Name: admin.Name}, claims.Act)
+	assert.WithinDuration(t, time.Now().Add(defaultImpersonationTTL), claims.ExpiresAt.Time, 5*time.Second)
+
+	event, metadata := lastAudit(t, store)
+	assert.Equal(t, AuditActionImpersonateStart, event.Action)
+	assert.Equal(t, AuditResultSuccess, event.Result)
+	assert.Equal(t, admin.ID, *event.ActorID)
+	assert.Equal(t, strconv.FormatInt(alice.ID, 10), *event.TargetID)
+	assert.Equal(t, "ticket 42", metadata["reason"])
+	assert.Equal(t, claims.RegisteredClaims.ID, metadata["token_id"])
+
+	// 代登录期间的操作记在管理员名下，并且不能再次代登录
+	ctx := jwt.NewContext(context.Background(), claims)
+	act, ok := ImpersonatorFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, admin.ID, act.ID)
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	_, err = uc.Impersonate(ctx, bob.ID, "again")
+	assert.True(t, authpb.IsImpersonationNotAllowed(err))
+	event, metadata = lastAudit(t, store)
+	assert.Equal(t, AuditResultFailure, event.Result)
+	assert.Equal(t, admin.ID, *event.ActorID)
+	assert.Equal(t, float64(alice.ID), metadata["impersonated_user_id"])
+
+	// 结束代登录后 token 立即失效，并写入审计日志
+	require.NoError(t, uc.EndImpersonation(ctx))
+	assert.True(t, revocations.tokens[claims.RegisteredClaims.ID])
+	assert.True(t, authpb.IsUnauthorized(uc.revoker.Check(ctx, claims)))
+	event, _ = lastAudit(t, store)
+	assert.Equal(t, AuditActionImpersonateEnd, event.Action)
+	assert.Equal(t, admin.ID, *event.ActorID)
+	assert.Equal(t, strconv.FormatInt(alice.ID, 10), *event.TargetID)
+
+	assert.True(t, authpb.IsInvalidTokenType(uc.EndImpersonation(asUser(admin))))
+}
+
+func TestImpersonationUsecase_Refusals(t *testing.T) {
+	uc, store, _ := newTestImpersonation(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	other := store.addUser(&po.User{Name: "other-admin", Email: "other@example.com", Role: RoleAdmin})
+	operator := store.addUser(&po.User{Name: "operator", Email: "operator@example.com", Role: RoleOperator})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	now := time.Now()
+	disabled := store.addUser(&po.User{Name: "disabled", Email: "disabled@example.com", Role: RoleUser, DisabledAt: &now})
+
+	tests := []struct {
+		name   string
+		actor  *po.User
+		target int64
+		check  func(error) bool
+	}{
+		{name: "yourself", actor: admin, target: admin.ID, check: authpb.IsImpersonationNotAllowed},
+		{name: "another admin", actor: admin, target: other.ID, check: authpb.IsImpersonationNotAllowed},
+		{name: "operator impersonating admin", actor: operator, target: admin.ID, check: authpb.IsImpersonationNotAllowed},
+		{name: "higher role", actor: admin, target: operator.ID, check: authpb.IsUnauthorized},
+		{name: "disabled user", actor: admin, target: disabled.ID, check: authpb.IsUserDisabled},
+		{name: "missing user", actor: admin, target: 999, check: userpb.IsUserNotFound},
+		{name: "without permission", actor: alice, target: disabled.ID, check: authpb.IsUnauthorized},
+	}
+	for _, tt := range tests {
+		_, err := uc.Impersonate(asUser(tt.actor), tt.target, tt.name)
+		assert.True(t, tt.check(err), "%s: %v", tt.name, err)
+
+		// 被拒绝的代登录同样写入审计日志
+		event, metadata := lastAudit(t, store)
+		assert.Equal(t, AuditActionImpersonateStart, event.Action, tt.name)
+		assert.Equal(t, AuditResultFailure, event.Result, tt.name)
+		assert.Equal(t, tt.actor.ID, *event.ActorID, tt.name)
+		assert.Equal(t, tt.name, metadata["reason"], tt.name)
+	}
+}
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultLockoutWindow      = 15 * time.Minute
+	defaultMaxAccountFailures = 10
+	defaultMaxIPFailures      = 50
+	defaultLockoutDuration    = 15 * time.Minute
+	defaultDelayAfter         = 3
+	defaultDelayBase          = time.Second
+	defaultMaxDelay           = 30 * time.Second
+)
+
+// 禁止登录的类型
+const (
+	LoginBlockLock  = "lock"  // 失败次数达到上限后的锁定
+	LoginBlockDelay = "delay" // 连续失败后的递增等待
+)
+
+// LoginThrottleRepo 登录失败计数和禁止登录状态的存储
+type LoginThrottleRepo interface {
+	// AddLoginFailure 记录一次登录失败并返回滑动窗口内的失败次数
+	AddLoginFailure(ctx context.Context, subject string, at time.Time, window time.Duration) (int64, error)
+	ClearLoginFailures(ctx context.Context, subject string) error
+	// BlockLogin 在 d 时间内禁止 subject 登录
+	BlockLogin(ctx context.Context, kind, subject string, d time.Duration) error
+	// LoginBlockRemaining 返回剩余的禁止登录时间，未被禁止时返回 0
+	LoginBlockRemaining(ctx context.Context, kind, subject string) (time.Duration, error)
+	ClearLoginBlock(ctx context.Context, kind, subject string) error
+}
+
+// LoginThrottler 按账号和 IP 统计登录失败次数，失败后要求递增等待，达到上限后暂时锁定
+type LoginThrottler struct {
+	repo LoginThrottleRepo
+	log  *log.Helper
+
+	disabled           bool
+	window             time.Duration
+	maxAccountFailures int64
+	maxIPFailures      int64
+	lockoutDuration    time.Duration
+	delayAfter         int64
+	delayBase          time.Duration
+	maxDelay           time.Duration
+}
+
+// NewLoginThrottler new a login throttler.
+func NewLoginThrottler(repo LoginThrottleRepo, logger log.Logger, cfg *conf.App) *LoginThrottler {
+	c := cfg.GetLockout()
+	t := &LoginThrottler{
+		repo:               repo,
+		log:                log.NewHelper(pkglogger.WithModule(logger, "lockout/biz/krathub-service")),
+		disabled:           c.GetDisabled(),
+		window:             c.GetWindow().AsDuration(),
+		maxAccountFailures: int64(c.GetMaxAccountFailures()),
+		maxIPFailures:      int64(c.GetMaxIpFailures()),
+		lockoutDuration:    c.GetLockoutDuration().AsDuration(),
+		delayAfter:         int64(c.GetDelayAfter()),
+		delayBase:          c.GetDelayBase().AsDuration(),
+		maxDelay:           c.GetMaxDelay().AsDuration(),
+	}
+	if t.window <= 0 {
+		t.window = defaultLockoutWindow
+	}
+	if t.maxAccountFailures <= 0 {
+		t.maxAccountFailures = defaultMaxAccountFailures
+	}
+	if t.maxIPFailures <= 0 {
+		t.maxIPFailures = defaultMaxIPFailures
+	}
+	if t.lockoutDuration <= 0 {
+		t.lockoutDuration = defaultLockoutDuration
+	}
+	if t.delayAfter <= 0 {
+		t.delayAfter = defaultDelayAfter
+	}
+	if t.delayBase <= 0 {
+		t.delayBase = defaultDelayBase
+	}
+	if t.maxDelay <= 0 {
+		t.maxDelay = defaultMaxDelay
+	}
+	return t
+}
+
+func accountSubject(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
+
+// Check 在校验密码之前调用，账号或 IP 被锁定、或仍在等待期内时返回带 retry_after 的错误。
+// 存储不可用时放行，避免 Redis 故障导致所有用户无法登录
+func (t *LoginThrottler) Check(ctx context.Context, email, ip string) error {
+	if t.disabled {
+		return nil
+	}
+	subjects := []string{accountSubject(email)}
+	if ip != "" {
+		subjects = append(subjects, ipSubject(ip))
+	}
+	for _, subject := range subjects {
+		remaining, err := t.repo.LoginBlockRemaining(ctx, LoginBlockLock, subject)
+		if err != nil {
+			t.log.Errorf("check login lock of %s failed: %v", subject, err)
+			continue
+		}
+		if remaining > 0 {
+			return withRetryAfter(authpb.ErrorAccountLocked("too many failed login attempts, try again later"), remaining)
+		}
+	}
+	remaining, err := t.repo.LoginBlockRemaining(ctx, LoginBlockDelay, subjects[0])
+	if err != nil {
+		t.log.Errorf("check login delay of %s failed: %v", subjects[0], err)
+		return nil
+	}
+	if remaining > 0 {
+		return withRetryAfter(authpb.ErrorTooManyLoginAttempts("login failed too many times, wait before retrying"), remaining)
+	}
+	return nil
+}
+
+// RecordFailure 记录一次密码错误（包括不存在的账号），返回账号是否因此被锁定
+func (t *LoginThrottler) RecordFailure(ctx context.Context, email, ip string) (locked bool) {
+	if t.disabled {
+		return false
+	}
+	now := time.Now()
+	account := accountSubject(email)
+	n, err := t.repo.AddLoginFailure(ctx, account, now, t.window)
+	if err != nil {
+		t.log.Errorf("record login failure of %s failed: %v", account, err)
+	} else if n >= t.maxAccountFailures {
+		locked = t.block(ctx, LoginBlockLock, account, t.lockoutDuration)
+		if locked {
+			t.log.Warnf("%s locked after %d failed login attempts", account, n)
+		}
+	} else if n >= t.delayAfter {
+		t.block(ctx, LoginBlockDelay, account, t.delay(n))
+	}
+
+	if ip == "" {
+		return locked
+	}
+	subject := ipSubject(ip)
+	n, err = t.repo.AddLoginFailure(ctx, subject, now, t.window)
+	if err != nil {
+		t.log.Errorf("record login failure of %s failed: %v", subject, err)
+	} else if n >= t.maxIPFailures && t.block(ctx, LoginBlockLock, subject, t.lockoutDuration) {
+		t.log.Warnf("%s locked after %d failed login attempts", subject, n)
+	}
+	return locked
+}
+
+// RecordSuccess 登录成功后清空账号的失败计数。IP 计数不清空，否则攻击者可以用自己的账号登录来重置计数
+func (t *LoginThrottler) RecordSuccess(ctx context.Context, email string) {
+	if t.disabled {
+		return
+	}
+	account := accountSubject(email)
+	if err := t.repo.ClearLoginFailures(ctx, account); err != nil {
+		t.log.Warnf("clear login failures of %s failed: %v", account, err)
+	}
+	if err := t.repo.ClearLoginBlock(ctx, LoginBlockDelay, account); err != nil {
+		t.log.Warnf("clear login delay of %s failed: %v", account, err)
+	}
+}
+
+// Unlock 解除账号锁定并清空失败计数，供管理员使用
+func (t *LoginThrottler) Unlock(ctx context.Context, email string) error {
+	account := accountSubject(email)
+	if err := t.repo.ClearLoginFailures(ctx, account); err != nil {
+		return err
+	}
+	if err := t.repo.ClearLoginBlock(ctx, LoginBlockDelay, account); err != nil {
+		return err
+	}
+	return t.repo.ClearLoginBlock(ctx, LoginBlockLock, account)
+}
+
+func (t *LoginThrottler) block(ctx context.Context, kind, subject string, d time.Duration) bool {
+	if err := t.repo.BlockLogin(ctx, kind, subject, d); err != nil {
+		t.log.Errorf("block login of %s failed: %v", subject, err)
+		return false
+	}
+	return true
+}
+
+// delay 第 n 次失败后的等待时间：delayBase * 2^(n-delayAfter)，不超过 maxDelay
+func (t *LoginThrottler) delay(n int64) time.Duration {
+	exp := float64(n - t.delayAfter)
+	d := time.Duration(float64(t.delayBase) * math.Pow(2, exp))
+	if d <= 0 || d > t.maxDelay {
+		return t.maxDelay
+	}
+	return d
+}
+
+// withRetryAfter 在错误 metadata 中写入剩余秒数（向上取整）
+func withRetryAfter(err *errors.Error, remaining time.Duration) *errors.Error {
+	seconds := int64(math.Ceil(remaining.Seconds()))
+	return err.WithMetadata(map[string]string{"retry_after": strconv.FormatInt(seconds, 10)})
+}
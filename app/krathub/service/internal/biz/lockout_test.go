@@ -0,0 +1,172 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memThrottleRepo 登录失败计数的内存存储，elapse 模拟时间流逝
+type memThrottleRepo struct {
+	mu       sync.Mutex
+	failures map[string][]time.Time
+	blocks   map[string]time.Time
+	elapsed  time.Duration
+}
+
+func newMemThrottleRepo() *memThrottleRepo {
+	return &memThrottleRepo{failures: map[string][]time.Time{}, blocks: map[string]time.Time{}}
+}
+
+func (r *memThrottleRepo) elapse(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.elapsed += d
+}
+
+func (r *memThrottleRepo) AddLoginFailure(_ context.Context, subject string, at time.Time, window time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	at = at.Add(r.elapsed)
+	var kept []time.Time
+	for _, f := range r.failures[subject] {
+		if f.After(at.Add(-window)) {
+			kept = append(kept, f)
+		}
+	}
+	r.failures[subject] = append(kept, at)
+	return int64(len(r.failures[subject])), nil
+}
+
+func (r *memThrottleRepo) ClearLoginFailures(_ context.Context, subject string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.failures, subject)
+	return nil
+}
+
+func (r *memThrottleRepo) BlockLogin(_ context.Context, kind, subject string, d time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blocks[kind+":"+subject] = time.Now().Add(r.elapsed + d)
+	return nil
+}
+
+func (r *memThrottleRepo) LoginBlockRemaining(_ context.Context, kind, subject string) (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	until, ok := r.blocks[kind+":"+subject]
+	if !ok {
+		return 0, nil
+	}
+	return max(until.Sub(time.Now().Add(r.elapsed)), 0), nil
+}
+
+func (r *memThrottleRepo) ClearLoginBlock(_ context.Context, kind, subject string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.blocks, kind+":"+subject)
+	return nil
+}
+
+func newTestThrottler(c *conf.App_Lockout) (*LoginThrottler, *memThrottleRepo) {
+	repo := newMemThrottleRepo()
+	return NewLoginThrottler(repo, log.DefaultLogger, &conf.App{Lockout: c}), repo
+}
+
+func TestLoginThrottler_Threshold(t *testing.T) {
+	ctx := context.Background()
+	throttler, _ := newTestThrottler(&conf.App_Lockout{MaxAccountFailures: 5, DelayAfter: 3})
+
+	tests := []struct {
+		failures int
+		locked   bool
+		check    func(error) bool
+	}{
+		{failures: 1, check: func(err error) bool { return err == nil }},
+		{failures: 2, check: func(err error) bool { return err == nil }},
+		{failures: 3, check: authpb.IsTooManyLoginAttempts},
+		{failures: 4, check: authpb.IsTooManyLoginAttempts},
+		{failures: 5, locked: true, check: authpb.IsAccountLocked},
+	}
+	for _, tt := range tests {
+		locked := throttler.RecordFailure(ctx, "Alice@Example.com", "10.0.0.1")
+		assert.Equal(t, tt.locked, locked, tt.failures)
+		// 账号按邮箱忽略大小写统计
+		err := throttler.Check(ctx, "alice@example.com", "10.0.0.2")
+		assert.True(t, tt.check(err), "after %d failures: %v", tt.failures, err)
+		if err != nil {
+			assert.NotEmpty(t, errors.FromError(err).Metadata["retry_after"])
+		}
+	}
+
+	// 锁定只影响该账号
+	assert.NoError(t, throttler.Check(ctx, "bob@example.com", "10.0.0.2"))
+}
+
+func TestLoginThrottler_IPThreshold(t *testing.T) {
+	ctx := context.Background()
+	throttler, _ := newTestThrottler(&conf.App_Lockout{MaxIpFailures: 3, DelayAfter: 10})
+
+	// 同一 IP 尝试不同账号，达到上限后锁定该 IP，登录成功也不会清空 IP 计数
+	throttler.RecordFailure(ctx, "a@example.com", "10.0.0.1")
+	throttler.RecordFailure(ctx, "b@example.com", "10.0.0.1")
+	throttler.RecordSuccess(ctx, "c@example.com")
+	throttler.RecordFailure(ctx, "c@example.com", "10.0.0.1")
+	assert.True(t, authpb.IsAccountLocked(throttler.Check(ctx, "d@example.com", "10.0.0.1")))
+	assert.NoError(t, throttler.Check(ctx, "d@example.com", "10.0.0.2"))
+}
+
+func TestLoginThrottler_WindowExpires(t *testing.T) {
+	ctx := context.Background()
+	throttler, repo := newTestThrottler(&conf.App_Lockout{
+		MaxAccountFailures: 5, DelayAfter: 3, Window: durationpb.New(10 * time.Minute), MaxDelay: durationpb.New(time.Minute),
+	})
+
+	for range 4 {
+		require.False(t, throttler.RecordFailure(ctx, "alice@example.com", ""))
+	}
+	require.True(t, authpb.IsTooManyLoginAttempts(throttler.Check(ctx, "alice@example.com", "")))
+
+	// 窗口过后之前的失败不再计数，等待期也已结束
+	repo.elapse(10*time.Minute + time.Second)
+	assert.NoError(t, throttler.Check(ctx, "alice@example.com", ""))
+	assert.False(t, throttler.RecordFailure(ctx, "alice@example.com", ""))
+	assert.NoError(t, throttler.Check(ctx, "alice@example.com", ""))
+
+	// 锁定在锁定时长后自动解除
+	for range 4 {
+		throttler.RecordFailure(ctx, "alice@example.com", "")
+	}
+	require.True(t, authpb.IsAccountLocked(throttler.Check(ctx, "alice@example.com", "")))
+	repo.elapse(defaultLockoutDuration + time.Second)
+	assert.NoError(t, throttler.Check(ctx, "alice@example.com", ""))
+}
+
+func TestLoginThrottler_SuccessResetsCounter(t *testing.T) {
+	ctx := context.Background()
+	throttler, _ := newTestThrottler(&conf.App_Lockout{MaxAccountFailures: 5, DelayAfter: 3})
+
+	for range 4 {
+		throttler.RecordFailure(ctx, "alice@example.com", "")
+	}
+	require.True(t, authpb.IsTooManyLoginAttempts(throttler.Check(ctx, "alice@example.com", "")))
+
+	// 登录成功清空失败计数和等待期，之后重新从零开始计数
+	throttler.RecordSuccess(ctx, "alice@example.com")
+	assert.NoError(t, throttler.Check(ctx, "alice@example.com", ""))
+	for range 4 {
+		assert.False(t, throttler.RecordFailure(ctx, "alice@example.com", ""))
+	}
+	assert.True(t, throttler.RecordFailure(ctx, "alice@example.com", ""))
+}
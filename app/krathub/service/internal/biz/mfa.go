@@ -0,0 +1,324 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/totp"
+)
+
+const (
+	defaultMFAChallengeTTL = 5 * time.Minute
+	defaultMFAMaxAttempts  = 5
+	defaultRecoveryCodes   = 10
+	recoveryCodeLength     = 10
+	// totpSkew 允许前后各一个时间步的时钟偏差
+	totpSkew = 1
+)
+
+// MFAChallenge 密码验证通过后等待两步验证的登录挑战
+type MFAChallenge struct {
+	Token     string
+	UserID    int64
+	ExpiresIn int64 // 秒
+}
+
+// MFARepo 两步验证数据仓库
+type MFARepo interface {
+	// SetTOTPSecret 登记尚未启用的 TOTP 密钥，覆盖之前未确认的密钥
+	SetTOTPSecret(ctx context.Context, userID int64, secret string) error
+	// EnableTOTP 启用 TOTP 并替换全部恢复码，在同一事务中完成
+	EnableTOTP(ctx context.Context, userID int64, enabledAt time.Time, recoveryCodeHashes []string) error
+	// DisableTOTP 清除 TOTP 密钥和恢复码
+	DisableTOTP(ctx context.Context, userID int64) error
+	// UseRecoveryCode 把未使用的恢复码标记为已使用，返回是否匹配
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string, usedAt time.Time) (bool, error)
+	// CountRecoveryCodes 统计未使用的恢复码数量
+	CountRecoveryCodes(ctx context.Context, userID int64) (int64, error)
+	// MarkTOTPCounterUsed 记录已使用的 TOTP 时间步，返回 false 表示该验证码已经用过
+	MarkTOTPCounterUsed(ctx context.Context, userID int64, counter uint64, ttl time.Duration) (bool, error)
+
+	SaveMFAChallenge(ctx context.Context, challenge *MFAChallenge, ttl time.Duration) error
+	// GetMFAChallenge 查询登录挑战，不存在或已过期时返回nil
+	GetMFAChallenge(ctx context.Context, token string) (*MFAChallenge, error)
+	// IncrMFAChallengeAttempts 增加验证码尝试次数并返回累计次数，计数最长保留 ttl
+	IncrMFAChallengeAttempts(ctx context.Context, token string, ttl time.Duration) (int64, error)
+	DeleteMFAChallenge(ctx context.Context, token string) error
+}
+
+func mfaEnabled(user *po.User) bool {
+	return user.TotpEnabledAt != nil && user.TotpSecret != nil
+}
+
+func (uc *AuthUsecase) mfaChallengeTTL() time.Duration {
+	if ttl := uc.cfg.GetMfa().GetChallengeTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultMFAChallengeTTL
+}
+
+func (uc *AuthUsecase) mfaMaxAttempts() int64 {
+	if n := int64(uc.cfg.GetMfa().GetMaxAttempts()); n > 0 {
+		return n
+	}
+	return defaultMFAMaxAttempts
+}
+
+// disableTOTPAttemptsKey 关闭两步验证的尝试次数按用户计数，复用登录挑战的计数，登录挑战 token 是十六进制不会冲突
+func disableTOTPAttemptsKey(userID int64) string {
+	return fmt.Sprintf("disable-totp:%d", userID)
+}
+
+func (uc *AuthUsecase) mfaIssuer() string {
+	if issuer := uc.cfg.GetMfa().GetIssuer(); issuer != "" {
+		return issuer
+	}
+	return uc.appName()
+}
+
+// createMFAChallenge 密码验证通过后创建短期有效的登录挑战
+func (uc *AuthUsecase) createMFAChallenge(ctx context.Context, user *po.User) (*MFAChallenge, error) {
+	token, err := uc.generateRefreshToken()
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate mfa token: %v", err)
+	}
+	ttl := uc.mfaChallengeTTL()
+	challenge := &MFAChallenge{Token: token, UserID: user.ID, ExpiresIn: int64(ttl / time.Second)}
+	if err := uc.mfaRepo.SaveMFAChallenge(ctx, challenge, ttl); err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to save mfa challenge: %v", err)
+	}
+	return challenge, nil
+}
+
+// VerifyMFA 使用 TOTP 验证码或恢复码完成登录，使用恢复码时同时返回剩余可用数量
+func (uc *AuthUsecase) VerifyMFA(ctx context.Context, token, code string) (pair *TokenPair, remaining int64, err error) {
+	challenge, err := uc.mfaRepo.GetMFAChallenge(ctx, token)
+	if err != nil || challenge == nil {
+		return nil, 0, authpb.ErrorInvalidMfaToken("invalid or expired mfa token")
+	}
+	entry := AuditEntry{Action: AuditActionMFAVerify, ActorID: challenge.UserID}
+	defer func() {
+		entry.Err = err
+		uc.audit.Record(ctx, entry)
+	}()
+
+	attempts, err := uc.mfaRepo.IncrMFAChallengeAttempts(ctx, token, uc.mfaChallengeTTL())
+	if err != nil {
+		return nil, 0, authpb.ErrorInvalidMfaToken("failed to verify mfa token: %v", err)
+	}
+	if attempts > uc.mfaMaxAttempts() {
+		// 超过尝试次数，必须重新输入密码
+		_ = uc.mfaRepo.DeleteMFAChallenge(ctx, token)
+		return nil, 0, authpb.ErrorInvalidMfaToken("too many attempts, please login again")
+	}
+
+	user, err := uc.repo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, 0, authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	entry.ActorName = user.Name
+	if !mfaEnabled(user) {
+		_ = uc.mfaRepo.DeleteMFAChallenge(ctx, token)
+		return nil, 0, authpb.ErrorMfaNotEnabled("two-factor authentication is not enabled, please login again")
+	}
+
+	usedRecovery, err := uc.checkMFACode(ctx, user, code)
+	if err != nil {
+		return nil, 0, err
+	}
+	entry.Metadata = map[string]any{"recovery_code": usedRecovery}
+	// 挑战只能兑换一次
+	if err := uc.mfaRepo.DeleteMFAChallenge(ctx, token); err != nil {
+		uc.log.Warnf("delete mfa challenge failed: %v", err)
+	}
+
+	pair, err = uc.issueTokenPair(ctx, user)
+	if err != nil {
+		return nil, 0, err
+	}
+	if usedRecovery {
+		if remaining, err = uc.mfaRepo.CountRecoveryCodes(ctx, user.ID); err != nil {
+			uc.log.Warnf("count recovery codes of user %d failed: %v", user.ID, err)
+		}
+		uc.sendSecurityAlert(ctx, user, "A recovery code was used to sign in.")
+	}
+	return pair, remaining, nil
+}
+
+// checkMFACode 校验 TOTP 验证码或恢复码，返回是否使用了恢复码
+func (uc *AuthUsecase) checkMFACode(ctx context.Context, user *po.User, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		if err := uc.checkTOTP(ctx, user.ID, *user.TotpSecret, code); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	ok, err := uc.mfaRepo.UseRecoveryCode(ctx, user.ID, hashRecoveryCode(code), time.Now())
+	if err != nil {
+		return false, authpb.ErrorInvalidMfaCode("failed to verify recovery code: %v", err)
+	}
+	if !ok {
+		return false, authpb.ErrorInvalidMfaCode("invalid verification code")
+	}
+	return true, nil
+}
+
+// checkTOTP 校验 TOTP 验证码，同一个验证码只能使用一次
+func (uc *AuthUsecase) checkTOTP(ctx context.Context, userID int64, secret, code string) error {
+	counter, ok := totp.Validate(secret, code, time.Now(), totpSkew)
+	if !ok {
+		return authpb.ErrorInvalidMfaCode("invalid verification code")
+	}
+	// 记录保留到该时间步离开容差窗口之后
+	ttl := time.Duration(2*totpSkew+1) * totp.Period
+	first, err := uc.mfaRepo.MarkTOTPCounterUsed(ctx, userID, counter, ttl)
+	if err != nil {
+		return authpb.ErrorInvalidMfaCode("failed to verify code: %v", err)
+	}
+	if !first {
+		return authpb.ErrorInvalidMfaCode("verification code has already been used")
+	}
+	return nil
+}
+
+// currentUser 查询当前登录用户的完整信息
+func (uc *AuthUsecase) currentUser(ctx context.Context) (*po.User, error) {
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	user, err := uc.repo.GetUserByID(ctx, claims.ID)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	return user, nil
+}
+
+// EnrollTOTP 为当前用户生成 TOTP 密钥，需要 ConfirmTOTP 校验验证码后才会启用
+func (uc *AuthUsecase) EnrollTOTP(ctx context.Context) (secret, uri string, err error) {
+	if err := rejectImpersonation(ctx, "changing two-factor authentication"); err != nil {
+		return "", "", err
+	}
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if mfaEnabled(user) {
+		return "", "", authpb.ErrorMfaAlreadyEnabled("two-factor authentication is already enabled")
+	}
+	secret, err = totp.GenerateSecret()
+	if err != nil {
+		return "", "", authpb.ErrorTokenGenerationFailed("failed to generate totp secret: %v", err)
+	}
+	if err := uc.mfaRepo.SetTOTPSecret(ctx, user.ID, secret); err != nil {
+		return "", "", authpb.ErrorTokenGenerationFailed("failed to save totp secret: %v", err)
+	}
+	return secret, totp.URI(uc.mfaIssuer(), user.Email, secret), nil
+}
+
+// ConfirmTOTP 校验验证器应用生成的验证码并启用两步验证，返回一次性恢复码明文
+func (uc *AuthUsecase) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	if err := rejectImpersonation(ctx, "changing two-factor authentication"); err != nil {
+		return nil, err
+	}
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mfaEnabled(user) {
+		return nil, authpb.ErrorMfaAlreadyEnabled("two-factor authentication is already enabled")
+	}
+	if user.TotpSecret == nil {
+		return nil, authpb.ErrorMfaNotEnabled("call EnrollTOTP first")
+	}
+	if err := uc.checkTOTP(ctx, user.ID, *user.TotpSecret, code); err != nil {
+		return nil, err
+	}
+
+	count := int(uc.cfg.GetMfa().GetRecoveryCodes())
+	if count <= 0 {
+		count = defaultRecoveryCodes
+	}
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+	for range count {
+		c, err := generateRecoveryCode()
+		if err != nil {
+			return nil, authpb.ErrorTokenGenerationFailed("failed to generate recovery code: %v", err)
+		}
+		codes = append(codes, c)
+		hashes = append(hashes, hashRecoveryCode(c))
+	}
+	if err := uc.mfaRepo.EnableTOTP(ctx, user.ID, time.Now(), hashes); err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to enable totp: %v", err)
+	}
+	uc.audit.Record(ctx, AuditEntry{Action: AuditActionMFAEnable, TargetType: AuditTargetUser, TargetID: user.ID})
+	uc.sendSecurityAlert(ctx, user, "Two-factor authentication was enabled.")
+	return codes, nil
+}
+
+// DisableTOTP 使用 TOTP 验证码或恢复码关闭两步验证
+func (uc *AuthUsecase) DisableTOTP(ctx context.Context, code string) error {
+	if err := rejectImpersonation(ctx, "changing two-factor authentication"); err != nil {
+		return err
+	}
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !mfaEnabled(user) {
+		return authpb.ErrorMfaNotEnabled("two-factor authentication is not enabled")
+	}
+	// 与登录时的两步验证一样限制尝试次数，避免持有被盗 Access Token 的人穷举验证码关闭两步验证
+	key := disableTOTPAttemptsKey(user.ID)
+	attempts, err := uc.mfaRepo.IncrMFAChallengeAttempts(ctx, key, uc.mfaChallengeTTL())
+	if err != nil {
+		return authpb.ErrorInvalidMfaCode("failed to verify code: %v", err)
+	}
+	if attempts > uc.mfaMaxAttempts() {
+		err := authpb.ErrorInvalidMfaCode("too many attempts, please try again later")
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionMFADisable, TargetType: AuditTargetUser, TargetID: user.ID, Err: err})
+		return err
+	}
+	if _, err := uc.checkMFACode(ctx, user, code); err != nil {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionMFADisable, TargetType: AuditTargetUser, TargetID: user.ID, Err: err})
+		return err
+	}
+	if err := uc.mfaRepo.DeleteMFAChallenge(ctx, key); err != nil {
+		uc.log.Warnf("reset disable totp attempts of user %d failed: %v", user.ID, err)
+	}
+	if err := uc.mfaRepo.DisableTOTP(ctx, user.ID); err != nil {
+		return authpb.ErrorTokenGenerationFailed("failed to disable totp: %v", err)
+	}
+	uc.audit.Record(ctx, AuditEntry{Action: AuditActionMFADisable, TargetType: AuditTargetUser, TargetID: user.ID})
+	uc.sendSecurityAlert(ctx, user, "Two-factor authentication was disabled.")
+	return nil
+}
+
+// generateRecoveryCode 生成形如 abcde-fghij 的恢复码（50 位熵）
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	s := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))[:recoveryCodeLength]
+	return s[:recoveryCodeLength/2] + "-" + s[recoveryCodeLength/2:], nil
+}
+
+// hashRecoveryCode 恢复码熵足够高，使用 SHA-256 存储即可；忽略大小写、空格和连字符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memMFARepo 两步验证测试用的内存仓库，TOTP 密钥保存在 memStore 的用户上
+type memMFARepo struct {
+	MFARepo
+	s *memStore
+
+	mu       sync.Mutex
+	attempts map[string]int64
+	counters map[uint64]bool
+}
+
+func newMemMFARepo(s *memStore) *memMFARepo {
+	return &memMFARepo{s: s, attempts: map[string]int64{}, counters: map[uint64]bool{}}
+}
+
+func (r *memMFARepo) IncrMFAChallengeAttempts(_ context.Context, token string, _ time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts[token]++
+	return r.attempts[token], nil
+}
+
+func (r *memMFARepo) DeleteMFAChallenge(_ context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.attempts, token)
+	return nil
+}
+
+func (r *memMFARepo) MarkTOTPCounterUsed(_ context.Context, _ int64, counter uint64, _ time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.counters[counter] {
+		return false, nil
+	}
+	r.counters[counter] = true
+	return true, nil
+}
+
+func (r *memMFARepo) UseRecoveryCode(context.Context, int64, string, time.Time) (bool, error) {
+	return false, nil
+}
+
+func (r *memMFARepo) DisableTOTP(_ context.Context, userID int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[userID].TotpSecret, r.s.users[userID].TotpEnabledAt = nil, nil
+	return nil
+}
+
+// wrongTOTPCode 返回在当前容差窗口内无效的验证码
+func wrongTOTPCode(t *testing.T, secret string) string {
+	t.Helper()
+	for _, code := range []string{"000000", "111111", "222222"} {
+		if _, ok := totp.Validate(secret, code, time.Now(), totpSkew); !ok {
+			return code
+		}
+	}
+	t.Fatal("no invalid code found")
+	return ""
+}
+
+func TestAuthUsecase_DisableTOTPLimitsAttempts(t *testing.T) {
+	uc, store := newTestAuth(t, nil)
+	mfa := newMemMFARepo(store)
+	uc.mfaRepo = mfa
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser, TotpSecret: &secret, TotpEnabledAt: &now})
+	ctx := asUser(alice)
+
+	wrong := wrongTOTPCode(t, secret)
+	for range defaultMFAMaxAttempts {
+		err := uc.DisableTOTP(ctx, wrong)
+		assert.True(t, authpb.IsInvalidMfaCode(err))
+	}
+
+	// 超过尝试次数后，正确的验证码也会被拒绝，两步验证保持开启
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	err = uc.DisableTOTP(ctx, code)
+	require.True(t, authpb.IsInvalidMfaCode(err))
+	assert.Contains(t, err.Error(), "too many attempts")
+	assert.True(t, mfaEnabled(store.find(func(u *po.User) bool { return u.ID == alice.ID })))
+	assert.Len(t, store.audits, defaultMFAMaxAttempts+1)
+
+	// 计数过期后可以再次尝试，成功后关闭两步验证
+	require.NoError(t, mfa.DeleteMFAChallenge(ctx, disableTOTPAttemptsKey(alice.ID)))
+	require.NoError(t, uc.DisableTOTP(ctx, code))
+	assert.False(t, mfaEnabled(store.find(func(u *po.User) bool { return u.ID == alice.ID })))
+}
@@ -0,0 +1,345 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 通知渠道
+const (
+	ChannelInApp   = "in_app"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+// 通知方式
+const (
+	NotifyImmediate = "immediate"
+	NotifyHourly    = "hourly"
+	NotifyDaily     = "daily"
+	NotifyOff       = "off"
+)
+
+// NotificationChannels 支持的全部通知渠道
+var NotificationChannels = []string{ChannelInApp, ChannelEmail, ChannelWebhook}
+
+// NotificationEvents 会通知到用户本人的事件类型
+var NotificationEvents = []string{EventUserLogin, EventUserUpdated}
+
+var notifyModes = []string{NotifyImmediate, NotifyHourly, NotifyDaily, NotifyOff}
+
+// defaultNotifyModes 用户和配置都未指定时各渠道的通知方式，webhook 渠道需要用户主动开启
+var defaultNotifyModes = map[string]string{
+	ChannelInApp:   NotifyImmediate,
+	ChannelEmail:   NotifyImmediate,
+	ChannelWebhook: NotifyOff,
+}
+
+const (
+	defaultDigestInterval = time.Minute
+	digestBatch           = 100
+)
+
+// NotificationPrefs 用户通知偏好：事件类型 -> 渠道 -> 通知方式，事件类型为 * 时作为该渠道的默认值。
+// 以 JSON 形式保存在 users.notification_prefs 中。
+type NotificationPrefs map[string]map[string]string
+
+// NotificationRepo 通知偏好与待汇总通知仓库
+type NotificationRepo interface {
+	UpdateNotificationPrefs(ctx context.Context, userID int64, prefs *string) error
+	CreatePendingNotification(context.Context, *po.PendingNotification) error
+	// ListDigestUsers 查询有到期待汇总通知的用户
+	ListDigestUsers(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	ListDuePendingNotifications(ctx context.Context, userID int64, now time.Time) ([]*po.PendingNotification, error)
+	// DeletePendingNotifications 删除并返回实际删除的条数，多实例部署时只有删除成功的实例负责发送
+	DeletePendingNotifications(ctx context.Context, ids []int64) (int64, error)
+}
+
+// NotificationUsecase 按用户偏好把事件通知到各渠道，并负责汇总通知的发送
+type NotificationUsecase struct {
+	repo     NotificationRepo
+	userRepo UserRepo
+	log      *log.Helper
+	cfg      *conf.App
+	mailer   *mail.Mailer
+	events   EventPublisher
+
+	defaultMode    string
+	digestInterval time.Duration
+}
+
+// NewNotificationUsecase new a notification usecase.
+func NewNotificationUsecase(repo NotificationRepo, userRepo UserRepo, logger log.Logger, cfg *conf.App, mailer *mail.Mailer, events EventPublisher) *NotificationUsecase {
+	nc := cfg.GetNotification()
+	uc := &NotificationUsecase{
+		repo:           repo,
+		userRepo:       userRepo,
+		log:            log.NewHelper(pkglogger.WithModule(logger, "notification/biz/krathub-service")),
+		cfg:            cfg,
+		mailer:         mailer,
+		events:         events,
+		digestInterval: nc.GetDigestInterval().AsDuration(),
+	}
+	if slices.Contains(notifyModes, nc.GetDefaultMode()) {
+		uc.defaultMode = nc.GetDefaultMode()
+	}
+	if uc.digestInterval <= 0 {
+		uc.digestInterval = defaultDigestInterval
+	}
+	return uc
+}
+
+// ParseNotificationPrefs 解析用户保存的通知偏好，内容无效时视为未设置
+func ParseNotificationPrefs(user *po.User) NotificationPrefs {
+	prefs := NotificationPrefs{}
+	if user == nil || user.NotificationPrefs == nil || *user.NotificationPrefs == "" {
+		return prefs
+	}
+	_ = json.Unmarshal([]byte(*user.NotificationPrefs), &prefs)
+	return prefs
+}
+
+// DefaultMode 用户未设置偏好时该渠道的通知方式
+func (uc *NotificationUsecase) DefaultMode(channel string) string {
+	if uc.defaultMode != "" {
+		return uc.defaultMode
+	}
+	if mode, ok := defaultNotifyModes[channel]; ok {
+		return mode
+	}
+	return NotifyOff
+}
+
+// ShouldDeliver 返回事件在该渠道上的通知方式。
+// 优先级：用户对该事件的设置 > 用户的 * 设置 > 配置的默认方式 > 渠道默认方式。
+func (uc *NotificationUsecase) ShouldDeliver(user *po.User, event, channel string) string {
+	prefs := ParseNotificationPrefs(user)
+	if mode, ok := prefs[event][channel]; ok {
+		return mode
+	}
+	if mode, ok := prefs[EventAll][channel]; ok {
+		return mode
+	}
+	return uc.DefaultMode(channel)
+}
+
+// GetPreferences 获取当前登录用户的通知偏好
+func (uc *NotificationUsecase) GetPreferences(ctx context.Context) (NotificationPrefs, error) {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationPrefs(user), nil
+}
+
+// UpdatePreferences 整体替换当前登录用户的通知偏好
+func (uc *NotificationUsecase) UpdatePreferences(ctx context.Context, prefs NotificationPrefs) (NotificationPrefs, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	for event, channels := range prefs {
+		if event != EventAll && !slices.Contains(NotificationEvents, event) {
+			return nil, userpb.ErrorInvalidPreferences("unsupported event type: %s", event)
+		}
+		for channel, mode := range channels {
+			if !slices.Contains(NotificationChannels, channel) {
+				return nil, userpb.ErrorInvalidPreferences("unsupported channel: %s", channel)
+			}
+			if !slices.Contains(notifyModes, mode) {
+				return nil, userpb.ErrorInvalidPreferences("unsupported mode: %s", mode)
+			}
+		}
+	}
+
+	var stored *string
+	if len(prefs) > 0 {
+		data, err := json.Marshal(prefs)
+		if err != nil {
+			return nil, userpb.ErrorInvalidPreferences("failed to encode preferences: %v", err)
+		}
+		stored = strPtr(string(data))
+	}
+	if err := uc.repo.UpdateNotificationPrefs(ctx, claims.ID, stored); err != nil {
+		return nil, userpb.ErrorUpdateUserFailed("failed to update preferences: %v", err)
+	}
+	return prefs, nil
+}
+
+// Notify 按用户偏好把事件通知到各渠道：立即发送、暂存等待汇总或忽略。
+// 只记录日志不返回错误，通知失败不能影响业务操作。
+func (uc *NotificationUsecase) Notify(ctx context.Context, user *po.User, event string, data map[string]any) {
+	now := time.Now()
+	for _, channel := range NotificationChannels {
+		switch mode := uc.ShouldDeliver(user, event, channel); mode {
+		case NotifyImmediate:
+			item := &po.PendingNotification{UserID: user.ID, EventType: event, Channel: channel, CreatedAt: now}
+			if err := uc.send(ctx, user, channel, []*po.PendingNotification{item}, []map[string]any{data}); err != nil {
+				uc.log.Warnf("notify user %d of %s via %s failed: %v", user.ID, event, channel, err)
+			}
+		case NotifyHourly, NotifyDaily:
+			payload, err := json.Marshal(data)
+			if err != nil {
+				uc.log.Errorf("notify user %d of %s: marshal payload failed: %v", user.ID, event, err)
+				continue
+			}
+			err = uc.repo.CreatePendingNotification(ctx, &po.PendingNotification{
+				UserID:    user.ID,
+				EventType: event,
+				Channel:   channel,
+				Payload:   string(payload),
+				SendAfter: digestSendAfter(mode, now),
+				CreatedAt: now,
+			})
+			if err != nil {
+				uc.log.Errorf("notify user %d of %s: save pending notification failed: %v", user.ID, event, err)
+			}
+		}
+	}
+}
+
+// DigestInterval 汇总任务的扫描间隔
+func (uc *NotificationUsecase) DigestInterval() time.Duration {
+	return uc.digestInterval
+}
+
+// SendDueDigests 把到期的待汇总通知按用户和渠道合并为一条消息发送，返回处理的用户数
+func (uc *NotificationUsecase) SendDueDigests(ctx context.Context) (int, error) {
+	now := time.Now()
+	userIDs, err := uc.repo.ListDigestUsers(ctx, now, digestBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range userIDs {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		uc.sendDigest(ctx, id, now)
+	}
+	return len(userIDs), nil
+}
+
+func (uc *NotificationUsecase) sendDigest(ctx context.Context, userID int64, now time.Time) {
+	items, err := uc.repo.ListDuePendingNotifications(ctx, userID, now)
+	if err != nil || len(items) == 0 {
+		if err != nil {
+			uc.log.Errorf("list pending notifications for user %d failed: %v", userID, err)
+		}
+		return
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	// 先删除再发送：删除条数不符说明已被其他实例处理，避免重复发送
+	n, err := uc.repo.DeletePendingNotifications(ctx, ids)
+	if err != nil || n != int64(len(ids)) {
+		if err != nil {
+			uc.log.Errorf("claim pending notifications for user %d failed: %v", userID, err)
+		}
+		return
+	}
+
+	user, err := uc.userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		// 用户已被删除，丢弃其通知
+		uc.log.Warnf("drop %d pending notifications of user %d: %v", len(items), userID, err)
+		return
+	}
+
+	byChannel := make(map[string][]*po.PendingNotification)
+	for _, item := range items {
+		byChannel[item.Channel] = append(byChannel[item.Channel], item)
+	}
+	for channel, group := range byChannel {
+		data := make([]map[string]any, 0, len(group))
+		for _, item := range group {
+			var d map[string]any
+			_ = json.Unmarshal([]byte(item.Payload), &d)
+			data = append(data, d)
+		}
+		if err := uc.send(ctx, user, channel, group, data); err != nil {
+			uc.log.Errorf("send %s digest to user %d failed: %v", channel, userID, err)
+		}
+	}
+}
+
+// send 通过指定渠道发送一条消息，items 多于一条时以汇总形式发送
+func (uc *NotificationUsecase) send(ctx context.Context, user *po.User, channel string, items []*po.PendingNotification, data []map[string]any) error {
+	switch channel {
+	case ChannelEmail:
+		if uc.mailer == nil {
+			return nil
+		}
+		if len(items) == 1 {
+			return uc.mailer.Notify(ctx, user.Email, mail.KindNotification, "", map[string]any{
+				"AppName": appDisplayName(uc.cfg),
+				"Name":    user.Name,
+				"Event":   items[0].EventType,
+				"Time":    items[0].CreatedAt.Format(time.RFC3339),
+			})
+		}
+		lines := make([]map[string]any, 0, len(items))
+		for _, item := range items {
+			lines = append(lines, map[string]any{
+				"Event": item.EventType,
+				"Time":  item.CreatedAt.Format(time.RFC3339),
+			})
+		}
+		return uc.mailer.Notify(ctx, user.Email, mail.KindDigest, "", map[string]any{
+			"AppName": appDisplayName(uc.cfg),
+			"Name":    user.Name,
+			"Items":   lines,
+		})
+	case ChannelWebhook:
+		events := make([]map[string]any, 0, len(items))
+		for i, item := range items {
+			events = append(events, map[string]any{
+				"type":       item.EventType,
+				"created_at": item.CreatedAt.UTC(),
+				"data":       data[i],
+			})
+		}
+		uc.events.Publish(ctx, EventUserNotification, map[string]any{
+			"user_id": user.ID,
+			"events":  events,
+		})
+		return nil
+	default:
+		// 站内信暂无服务端收件箱，偏好只保存供客户端参考
+		return nil
+	}
+}
+
+func (uc *NotificationUsecase) currentUser(ctx context.Context) (*po.User, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	user, err := uc.userRepo.GetUserById(ctx, claims.ID)
+	if err != nil {
+		return nil, userpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	return user, nil
+}
+
+// digestSendAfter 汇总通知的发送时间：hourly 为下一个整点，daily 为次日零点
+func digestSendAfter(mode string, now time.Time) time.Time {
+	if mode == NotifyDaily {
+		y, m, d := now.Date()
+		return time.Date(y, m, d+1, 0, 0, 0, 0, now.Location())
+	}
+	return now.Truncate(time.Hour).Add(time.Hour)
+}
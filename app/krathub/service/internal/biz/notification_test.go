@@ -0,0 +1,255 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memNotificationRepo 待汇总通知的内存仓库，通知偏好保存在 memStore 的用户上
+type memNotificationRepo struct {
+	s *memStore
+
+	mu      sync.Mutex
+	nextID  int64
+	pending []*po.PendingNotification
+	// stolen 为 true 时模拟其他实例已删除了待发送的通知
+	stolen bool
+}
+
+func (r *memNotificationRepo) UpdateNotificationPrefs(_ context.Context, userID int64, prefs *string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.users[userID].NotificationPrefs = prefs
+	return nil
+}
+
+func (r *memNotificationRepo) CreatePendingNotification(_ context.Context, n *po.PendingNotification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	n.ID = r.nextID
+	clone := *n
+	r.pending = append(r.pending, &clone)
+	return nil
+}
+
+func (r *memNotificationRepo) ListDigestUsers(_ context.Context, now time.Time, limit int) ([]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []int64
+	for _, n := range r.pending {
+		if !n.SendAfter.After(now) && len(ids) < limit && (len(ids) == 0 || ids[len(ids)-1] != n.UserID) {
+			ids = append(ids, n.UserID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memNotificationRepo) ListDuePendingNotifications(_ context.Context, userID int64, now time.Time) ([]*po.PendingNotification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.PendingNotification
+	for _, n := range r.pending {
+		if n.UserID == userID && !n.SendAfter.After(now) {
+			clone := *n
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memNotificationRepo) DeletePendingNotifications(_ context.Context, ids []int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stolen {
+		return 0, nil
+	}
+	var kept []*po.PendingNotification
+	var n int64
+	for _, p := range r.pending {
+		deleted := false
+		for _, id := range ids {
+			if p.ID == id {
+				deleted = true
+			}
+		}
+		if deleted {
+			n++
+		} else {
+			kept = append(kept, p)
+		}
+	}
+	r.pending = kept
+	return n, nil
+}
+
+// makeDue 让全部待汇总通知立即到期
+func (r *memNotificationRepo) makeDue() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range r.pending {
+		n.SendAfter = time.Now().Add(-time.Second)
+	}
+}
+
+// recordingPublisher 记录发布的业务事件
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []string
+	data   []any
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, eventType string, data any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, eventType)
+	p.data = append(p.data, data)
+}
+
+func newTestNotifications(t *testing.T, c *conf.App_Notification) (*NotificationUsecase, *memNotificationRepo, *recordingPublisher, *testEnv) {
+	t.Helper()
+	e := newTestEnv(t, &conf.App{Notification: c})
+	repo := &memNotificationRepo{s: e.store}
+	events := &recordingPublisher{}
+	return NewNotificationUsecase(repo, e.userRepo(), e.logger, e.cfg, e.mailer, events), repo, events, e
+}
+
+// mailKinds 返回邮件的通知类型
+func mailKinds(messages []*mail.Message) []string {
+	kinds := make([]string, 0, len(messages))
+	for _, m := range messages {
+		kinds = append(kinds, m.Headers["X-Notification-Kind"])
+	}
+	return kinds
+}
+
+func TestNotificationUsecase_ShouldDeliver(t *testing.T) {
+	prefs := `{"user.login": {"email": "daily"}, "*": {"email": "off", "webhook": "hourly"}}`
+	user := &po.User{NotificationPrefs: &prefs}
+
+	tests := []struct {
+		defaultMode    string
+		user           *po.User
+		event, channel string
+		want           string
+	}{
+		// 用户对事件的设置优先于 * 设置
+		{user: user, event: EventUserLogin, channel: ChannelEmail, want: NotifyDaily},
+		{user: user, event: EventUserUpdated, channel: ChannelEmail, want: NotifyOff},
+		{user: user, event: EventUserLogin, channel: ChannelWebhook, want: NotifyHourly},
+		// 用户未设置时使用配置的默认方式，未配置时使用渠道默认方式
+		{user: user, event: EventUserLogin, channel: ChannelInApp, want: NotifyImmediate},
+		{defaultMode: NotifyDaily, user: user, event: EventUserLogin, channel: ChannelInApp, want: NotifyDaily},
+		{user: &po.User{}, event: EventUserLogin, channel: ChannelWebhook, want: NotifyOff},
+		{defaultMode: "weekly", user: &po.User{}, event: EventUserLogin, channel: ChannelEmail, want: NotifyImmediate},
+	}
+	for _, tt := range tests {
+		uc, _, _, _ := newTestNotifications(t, &conf.App_Notification{DefaultMode: tt.defaultMode})
+		assert.Equal(t, tt.want, uc.ShouldDeliver(tt.user, tt.event, tt.channel), "%s %s %s", tt.defaultMode, tt.event, tt.channel)
+	}
+
+	// 保存的内容无效时视为未设置
+	invalid := "not json"
+	uc, _, _, _ := newTestNotifications(t, &conf.App_Notification{})
+	assert.Equal(t, NotifyImmediate, uc.ShouldDeliver(&po.User{NotificationPrefs: &invalid}, EventUserLogin, ChannelEmail))
+}
+
+func TestNotificationUsecase_UpdatePreferences(t *testing.T) {
+	uc, _, _, e := newTestNotifications(t, nil)
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	ctx := asUser(alice)
+
+	for _, prefs := range []NotificationPrefs{
+		{"user.deleted": {ChannelEmail: NotifyOff}},
+		{EventUserLogin: {"sms": NotifyOff}},
+		{EventUserLogin: {ChannelEmail: "weekly"}},
+	} {
+		_, err := uc.UpdatePreferences(ctx, prefs)
+		assert.True(t, userpb.IsInvalidPreferences(err), "%v: %v", prefs, err)
+	}
+
+	prefs := NotificationPrefs{EventAll: {ChannelEmail: NotifyDaily}, EventUserLogin: {ChannelWebhook: NotifyImmediate}}
+	_, err := uc.UpdatePreferences(ctx, prefs)
+	require.NoError(t, err)
+	got, err := uc.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, prefs, got)
+	assert.Equal(t, NotifyDaily, uc.ShouldDeliver(e.store.user(alice.ID), EventUserUpdated, ChannelEmail))
+
+	// 提交空偏好时清空设置，恢复默认方式
+	_, err = uc.UpdatePreferences(ctx, nil)
+	require.NoError(t, err)
+	assert.Nil(t, e.store.user(alice.ID).NotificationPrefs)
+}
+
+func TestNotificationUsecase_NotifyAndDigest(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, events, e := newTestNotifications(t, &conf.App_Notification{})
+	prefs := `{"*": {"email": "hourly", "webhook": "daily"}, "user.login": {"email": "immediate"}}`
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser, NotificationPrefs: &prefs})
+
+	// 立即通知直接发送，汇总通知暂存到下一个整点或次日零点
+	uc.Notify(ctx, alice, EventUserLogin, map[string]any{"ip": "203.0.113.7"})
+	uc.Notify(ctx, alice, EventUserUpdated, map[string]any{"field": "name"})
+	uc.Notify(ctx, alice, EventUserUpdated, map[string]any{"field": "email"})
+	assert.Equal(t, []string{mail.KindNotification}, mailKinds(e.sentMails()))
+	assert.Empty(t, events.events)
+	require.Len(t, repo.pending, 5)
+	now := time.Now()
+	for _, n := range repo.pending {
+		want := digestSendAfter(NotifyHourly, now)
+		if n.Channel == ChannelWebhook {
+			want = digestSendAfter(NotifyDaily, now)
+		}
+		assert.Equal(t, want, n.SendAfter, n.Channel)
+	}
+
+	// 未到期时不发送
+	sent, err := uc.SendDueDigests(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+
+	// 到期后每个渠道合并为一条消息，发送后删除
+	repo.makeDue()
+	sent, err = uc.SendDueDigests(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{mail.KindDigest}, mailKinds(e.sentMails()))
+	require.Equal(t, []string{EventUserNotification}, events.events)
+	assert.Len(t, events.data[0].(map[string]any)["events"], 3)
+	assert.Empty(t, repo.pending)
+}
+
+func TestNotificationUsecase_DigestClaimedOrUserDeleted(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _, e := newTestNotifications(t, &conf.App_Notification{DefaultMode: NotifyHourly})
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	uc.Notify(ctx, alice, EventUserLogin, nil)
+	repo.makeDue()
+
+	// 已被其他实例领取时不重复发送
+	repo.stolen = true
+	_, err := uc.SendDueDigests(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, e.sentMails())
+	repo.stolen = false
+
+	// 用户已删除时丢弃其通知
+	_, err = e.userRepo().DeleteUser(ctx, alice)
+	require.NoError(t, err)
+	sent, err := uc.SendDueDigests(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Empty(t, e.sentMails())
+	assert.Empty(t, repo.pending)
+}
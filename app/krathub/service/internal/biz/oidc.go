@@ -0,0 +1,444 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/oidc"
+)
+
+const (
+	defaultOIDCStateTTL = 10 * time.Minute
+	// maxUserNameLength 与 users.name 列长度一致
+	maxUserNameLength = 64
+)
+
+// OIDCProviderInfo 登录页展示的第三方登录方式
+type OIDCProviderInfo struct {
+	Name        string
+	DisplayName string
+}
+
+// OIDCProviders 已配置的 OIDC 身份提供方，按配置中的 name 索引
+type OIDCProviders struct {
+	providers map[string]*oidc.Provider
+	infos     []OIDCProviderInfo
+}
+
+// NewOIDCProviders 根据配置创建身份提供方，发现文档在第一次登录时才加载
+func NewOIDCProviders(cfg *conf.App) (*OIDCProviders, error) {
+	ps := &OIDCProviders{providers: map[string]*oidc.Provider{}}
+	for _, c := range cfg.GetOidc().GetProviders() {
+		if c.GetName() == "" {
+			return nil, fmt.Errorf("oidc: provider name is required")
+		}
+		if _, dup := ps.providers[c.GetName()]; dup {
+			return nil, fmt.Errorf("oidc: duplicate provider %q", c.GetName())
+		}
+		p, err := oidc.NewProvider(oidc.Config{
+			Issuer:       c.GetIssuer(),
+			ClientID:     c.GetClientId(),
+			ClientSecret: c.GetClientSecret(),
+			RedirectURL:  c.GetRedirectUrl(),
+			Scopes:       c.GetScopes(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("oidc provider %q: %w", c.GetName(), err)
+		}
+		displayName := c.GetDisplayName()
+		if displayName == "" {
+			displayName = c.GetName()
+		}
+		ps.providers[c.GetName()] = p
+		ps.infos = append(ps.infos, OIDCProviderInfo{Name: c.GetName(), DisplayName: displayName})
+	}
+	return ps, nil
+}
+
+// Get 按名称查找身份提供方
+func (ps *OIDCProviders) Get(name string) (*oidc.Provider, bool) {
+	if ps == nil {
+		return nil, false
+	}
+	p, ok := ps.providers[name]
+	return p, ok
+}
+
+// OIDCState 跳转到身份提供方之前保存的授权请求，回调时用 state 取回
+type OIDCState struct {
+	Provider string `json:"provider"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	// LinkUserID 非 0 表示为该用户关联外部身份，而不是登录
+	LinkUserID int64 `json:"link_user_id,omitempty"`
+}
+
+// OIDCLoginResult 第三方登录回调的结果
+type OIDCLoginResult struct {
+	Tokens    *TokenPair
+	Challenge *MFAChallenge // 启用两步验证时不直接签发 token
+	Linked    bool          // 外部身份被关联到了已有用户
+	Created   bool          // 创建了新用户
+}
+
+// IdentityRepo 外部身份数据仓库
+type IdentityRepo interface {
+	// GetIdentity 按身份提供方和 subject 查询关联，不存在时返回nil
+	GetIdentity(ctx context.Context, provider, subject string) (*po.UserIdentity, error)
+	ListUserIdentities(ctx context.Context, userID int64) ([]*po.UserIdentity, error)
+	// ListProviderIdentities 批量查询一组用户在 provider 下的关联
+	ListProviderIdentities(ctx context.Context, provider string, userIDs []int64) ([]*po.UserIdentity, error)
+	CreateIdentity(ctx context.Context, identity *po.UserIdentity) error
+	// CreateUserWithIdentity 在同一事务中创建用户、user.signup 事件和外部身份关联
+	CreateUserWithIdentity(ctx context.Context, user *po.User, identity *po.UserIdentity) (*po.User, error)
+	// DeleteIdentity 解除关联，返回是否存在该关联
+	DeleteIdentity(ctx context.Context, userID int64, provider string) (bool, error)
+
+	SaveOIDCState(ctx context.Context, state string, s *OIDCState, ttl time.Duration) error
+	// ConsumeOIDCState 原子地取出并删除授权请求，不存在或已过期时返回nil
+	ConsumeOIDCState(ctx context.Context, state string) (*OIDCState, error)
+}
+
+// ListOIDCProviders 返回已配置的第三方登录方式
+func (uc *AuthUsecase) ListOIDCProviders() []OIDCProviderInfo {
+	if uc.oidc == nil {
+		return nil
+	}
+	return uc.oidc.infos
+}
+
+func (uc *AuthUsecase) oidcStateTTL() time.Duration {
+	if ttl := uc.cfg.GetOidc().GetStateTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultOIDCStateTTL
+}
+
+// StartOIDCLogin 生成 state、nonce 和 PKCE verifier 并返回身份提供方的授权地址
+func (uc *AuthUsecase) StartOIDCLogin(ctx context.Context, provider string) (authURL, state string, expiresIn int64, err error) {
+	return uc.startOIDC(ctx, provider, 0)
+}
+
+// StartOIDCLink 为当前用户发起外部身份关联，回调同样由 OIDCCallback 处理
+func (uc *AuthUsecase) StartOIDCLink(ctx context.Context, provider string) (authURL, state string, expiresIn int64, err error) {
+	if err := rejectImpersonation(ctx, "linking identities"); err != nil {
+		return "", "", 0, err
+	}
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+	identities, err := uc.identityRepo.ListUserIdentities(ctx, user.ID)
+	if err != nil {
+		return "", "", 0, authpb.ErrorUserNotFound("failed to list identities: %v", err)
+	}
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return "", "", 0, authpb.ErrorIdentityAlreadyLinked("provider %s is already linked", provider)
+		}
+	}
+	return uc.startOIDC(ctx, provider, user.ID)
+}
+
+func (uc *AuthUsecase) startOIDC(ctx context.Context, provider string, linkUserID int64) (string, string, int64, error) {
+	p, ok := uc.oidc.Get(provider)
+	if !ok {
+		return "", "", 0, authpb.ErrorOidcProviderNotFound("oidc provider %s is not configured", provider)
+	}
+	var values [3]string
+	for i := range values {
+		v, err := oidc.RandomString()
+		if err != nil {
+			return "", "", 0, authpb.ErrorTokenGenerationFailed("failed to generate oidc state: %v", err)
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", 0, authpb.ErrorOidcLoginFailed("failed to build authorization url: %v", err)
+	}
+	ttl := uc.oidcStateTTL()
+	err = uc.identityRepo.SaveOIDCState(ctx, state, &OIDCState{
+		Provider:   provider,
+		Nonce:      nonce,
+		Verifier:   verifier,
+		LinkUserID: linkUserID,
+	}, ttl)
+	if err != nil {
+		return "", "", 0, authpb.ErrorTokenGenerationFailed("failed to save oidc state: %v", err)
+	}
+	return authURL, state, int64(ttl / time.Second), nil
+}
+
+// OIDCCallback 校验 state、用授权码换取并校验 ID Token，然后登录、关联或创建本地用户
+func (uc *AuthUsecase) OIDCCallback(ctx context.Context, provider, code, state string) (result *OIDCLoginResult, err error) {
+	// 登录结果写入审计日志，关联已登录用户的外部账号不算登录
+	linking := false
+	entry := AuditEntry{Action: AuditActionLogin, Metadata: map[string]any{"method": "oidc", "provider": provider}}
+	defer func() {
+		if linking {
+			return
+		}
+		entry.Err = err
+		if result != nil && result.Challenge != nil {
+			entry.Metadata["mfa_required"] = true
+		}
+		uc.audit.Record(ctx, entry)
+	}()
+
+	st, err := uc.identityRepo.ConsumeOIDCState(ctx, state)
+	if err != nil || st == nil || st.Provider != provider {
+		return nil, authpb.ErrorOidcLoginFailed("invalid or expired oidc state")
+	}
+	// 关联流程必须由发起关联的用户带着自己的 Access Token 完成回调，
+	// 否则他人可以诱导受害者的浏览器完成回调，把受害者的外部账号关联到自己的账号上
+	if st.LinkUserID != 0 {
+		linking = true
+		caller, ok := jwtpkg.FromContext[UserClaims](ctx)
+		if !ok || caller.ID != st.LinkUserID || caller.Act != nil {
+			return nil, authpb.ErrorOidcLoginFailed("oidc link must be completed by the user who started it")
+		}
+	}
+	p, ok := uc.oidc.Get(provider)
+	if !ok {
+		return nil, authpb.ErrorOidcProviderNotFound("oidc provider %s is not configured", provider)
+	}
+	token, err := p.Exchange(ctx, code, st.Verifier)
+	if err != nil {
+		return nil, authpb.ErrorOidcLoginFailed("failed to exchange authorization code: %v", err)
+	}
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, st.Nonce)
+	if err != nil {
+		return nil, authpb.ErrorOidcLoginFailed("failed to verify id token: %v", err)
+	}
+
+	if st.LinkUserID != 0 {
+		if err := uc.linkIdentity(ctx, st.LinkUserID, provider, claims); err != nil {
+			return nil, err
+		}
+		return &OIDCLoginResult{Linked: true}, nil
+	}
+
+	result = &OIDCLoginResult{}
+	user, err := uc.resolveOIDCUser(ctx, provider, claims, result)
+	if err != nil {
+		return nil, err
+	}
+	entry.ActorID, entry.ActorName = user.ID, user.Name
+	if mfaEnabled(user) {
+		result.Challenge, err = uc.createMFAChallenge(ctx, user)
+		return result, err
+	}
+	result.Tokens, err = uc.issueTokenPair(ctx, user)
+	return result, err
+}
+
+// resolveOIDCUser 查找外部身份对应的本地用户：已关联的用户、邮箱相同的用户（link_by_email），或新建用户（auto_signup）
+func (uc *AuthUsecase) resolveOIDCUser(ctx context.Context, provider string, claims *oidc.IDTokenClaims, result *OIDCLoginResult) (*po.User, error) {
+	identity, err := uc.identityRepo.GetIdentity(ctx, provider, claims.Subject)
+	if err != nil {
+		return nil, authpb.ErrorOidcLoginFailed("failed to get identity: %v", err)
+	}
+	if identity != nil {
+		user, err := uc.repo.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, authpb.ErrorUserNotFound("user not found: %v", err)
+		}
+		return user, nil
+	}
+
+	var existing *po.User
+	if claims.Email != "" {
+		if existing, err = uc.repo.GetUserByEmail(ctx, claims.Email); err != nil {
+			return nil, authpb.ErrorUserNotFound("failed to get user: %v", err)
+		}
+	}
+	// 只信任身份提供方确认过的邮箱，否则任何人都能用别人的邮箱注册外部账号接管本地用户。
+	// 本地账号的邮箱也必须已验证，否则可能是他人抢先用受害者的邮箱注册、预先设置了密码的账号
+	if existing != nil && uc.cfg.GetOidc().GetLinkByEmail() && claims.EmailVerified && existing.EmailVerified {
+		if err := uc.linkIdentity(ctx, existing.ID, provider, claims); err != nil {
+			return nil, err
+		}
+		result.Linked = true
+		return existing, nil
+	}
+	if existing != nil {
+		return nil, authpb.ErrorIdentityNotLinked("an account with email %s already exists, sign in and link %s first", claims.Email, provider)
+	}
+	if !uc.cfg.GetOidc().GetAutoSignup() {
+		return nil, authpb.ErrorIdentityNotLinked("%s account is not linked to any user", provider)
+	}
+	if claims.Email == "" {
+		return nil, authpb.ErrorIdentityNotLinked("%s did not return an email address", provider)
+	}
+	if err := uc.signup.AdmitExternal(claims.Email, claims.EmailVerified); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.createOIDCUser(ctx, provider, claims)
+	if err != nil {
+		return nil, err
+	}
+	result.Created = true
+	return user, nil
+}
+
+// createOIDCUser 为外部身份创建本地用户，密码随机生成，用户之后可以通过重置密码设置
+func (uc *AuthUsecase) createOIDCUser(ctx context.Context, provider string, claims *oidc.IDTokenClaims) (*po.User, error) {
+	name, err := uniqueUserName(ctx, uc.repo, oidcUserName(claims))
+	if err != nil {
+		return nil, err
+	}
+	password, err := uc.generateRefreshToken()
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to generate password: %v", err)
+	}
+	password, err = uc.passwords.Hash(password)
+	if err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to hash password: %v", err)
+	}
+	user := &po.User{
+		Name:          name,
+		Email:         claims.Email,
+		Password:      password,
+		Role:          RoleUser,
+		EmailVerified: claims.EmailVerified,
+	}
+	created, err := uc.identityRepo.CreateUserWithIdentity(ctx, user, newIdentity(0, provider, claims))
+	if err != nil {
+		return nil, authpb.ErrorUserAlreadyExists("failed to create user: %v", err)
+	}
+	uc.sendWelcomeMail(ctx, created)
+	return created, nil
+}
+
+// uniqueUserName 用户名被占用时追加随机后缀
+func uniqueUserName(ctx context.Context, repo AuthRepo, base string) (string, error) {
+	name := base
+	for range 5 {
+		taken, err := repo.IsUserNameTaken(ctx, name)
+		if err != nil {
+			return "", authpb.ErrorUserNotFound("failed to check username: %v", err)
+		}
+		if !taken {
+			return name, nil
+		}
+		b := make([]byte, 3)
+		if _, err := rand.Read(b); err != nil {
+			return "", authpb.ErrorTokenGenerationFailed("failed to generate username: %v", err)
+		}
+		name = truncate(base, maxUserNameLength-7) + "-" + hex.EncodeToString(b)
+	}
+	return "", authpb.ErrorUserAlreadyExists("failed to find an available username for %s", base)
+}
+
+// oidcUserName 依次使用 preferred_username、邮箱前缀和 sub 作为用户名
+func oidcUserName(claims *oidc.IDTokenClaims) string {
+	name := claims.PreferredUsername
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+	if name == "" {
+		name = claims.Subject
+	}
+	return truncate(name, maxUserNameLength)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func newIdentity(userID int64, provider string, claims *oidc.IDTokenClaims) *po.UserIdentity {
+	identity := &po.UserIdentity{
+		UserID:    userID,
+		Provider:  provider,
+		Subject:   claims.Subject,
+		CreatedAt: time.Now(),
+	}
+	if claims.Email != "" {
+		email := claims.Email
+		identity.Email = &email
+	}
+	return identity
+}
+
+// linkIdentity 把外部身份关联到用户，同一外部身份只能关联一个用户，每个用户在每个身份提供方只能关联一个身份
+func (uc *AuthUsecase) linkIdentity(ctx context.Context, userID int64, provider string, claims *oidc.IDTokenClaims) error {
+	existing, err := uc.identityRepo.GetIdentity(ctx, provider, claims.Subject)
+	if err != nil {
+		return authpb.ErrorOidcLoginFailed("failed to get identity: %v", err)
+	}
+	if existing != nil {
+		if existing.UserID == userID {
+			return nil
+		}
+		return authpb.ErrorIdentityAlreadyLinked("this %s account is already linked to another user", provider)
+	}
+	if err := uc.identityRepo.CreateIdentity(ctx, newIdentity(userID, provider, claims)); err != nil {
+		return authpb.ErrorIdentityAlreadyLinked("failed to link %s: %v", provider, err)
+	}
+	if user, err := uc.repo.GetUserByID(ctx, userID); err == nil {
+		uc.sendSecurityAlert(ctx, user, fmt.Sprintf("A %s account was linked to your account.", provider))
+	}
+	return nil
+}
+
+// ListIdentities 查询当前用户关联的外部身份
+func (uc *AuthUsecase) ListIdentities(ctx context.Context) ([]*po.UserIdentity, error) {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := uc.identityRepo.ListUserIdentities(ctx, user.ID)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("failed to list identities: %v", err)
+	}
+	return identities, nil
+}
+
+// UnlinkIdentity 解除当前用户与身份提供方的关联。
+// 通过第三方登录创建的用户不知道自己的密码，邮箱未验证时无法重置密码，因此不允许解除最后一个关联
+func (uc *AuthUsecase) UnlinkIdentity(ctx context.Context, provider string) error {
+	if err := rejectImpersonation(ctx, "unlinking identities"); err != nil {
+		return err
+	}
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	identities, err := uc.identityRepo.ListUserIdentities(ctx, user.ID)
+	if err != nil {
+		return authpb.ErrorUserNotFound("failed to list identities: %v", err)
+	}
+	found := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return authpb.ErrorIdentityNotFound("provider %s is not linked", provider)
+	}
+	if len(identities) == 1 && !user.EmailVerified {
+		return authpb.ErrorLastLoginMethod("verify your email before unlinking the last sign-in provider")
+	}
+	if _, err := uc.identityRepo.DeleteIdentity(ctx, user.ID, provider); err != nil {
+		return authpb.ErrorIdentityNotFound("failed to unlink %s: %v", provider, err)
+	}
+	uc.sendSecurityAlert(ctx, user, fmt.Sprintf("The %s account was unlinked from your account.", provider))
+	return nil
+}
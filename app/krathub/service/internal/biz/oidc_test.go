@@ -0,0 +1,178 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/oidc/oidctest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestOIDC 启动本地身份提供方 test，并创建配置了该身份提供方的认证用例
+func newTestOIDC(t *testing.T, linkByEmail, autoSignup bool) (*AuthUsecase, *testEnv, *oidctest.Server) {
+	t.Helper()
+	srv := oidctest.NewServer("client", "secret")
+	t.Cleanup(srv.Close)
+	e := newTestEnv(t, &conf.App{Oidc: &conf.App_Oidc{
+		Providers: []*conf.App_Oidc_Provider{{
+			Name: "test", Issuer: srv.URL, ClientId: "client", ClientSecret: "secret",
+			RedirectUrl: "https://app.example.com/oidc/callback",
+		}},
+		LinkByEmail: linkByEmail,
+		AutoSignup:  autoSignup,
+	}})
+	return e.auth(), e, srv
+}
+
+// oidcLogin 以 user 身份在身份提供方授权，并完成登录回调
+func oidcLogin(t *testing.T, uc *AuthUsecase, srv *oidctest.Server, user oidctest.User) (*OIDCLoginResult, error) {
+	t.Helper()
+	authURL, _, _, err := uc.StartOIDCLogin(context.Background(), "test")
+	require.NoError(t, err)
+	return oidcAuthorize(t, context.Background(), uc, srv, user, authURL)
+}
+
+func oidcAuthorize(t *testing.T, ctx context.Context, uc *AuthUsecase, srv *oidctest.Server, user oidctest.User, authURL string) (*OIDCLoginResult, error) {
+	t.Helper()
+	srv.SetUser(user)
+	code, state, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	return uc.OIDCCallback(ctx, "test", code, state)
+}
+
+func TestAuthUsecase_OIDCAutoSignup(t *testing.T) {
+	ctx := context.Background()
+	uc, e, srv := newTestOIDC(t, false, false)
+	user := oidctest.User{Subject: "sub-1", Email: "alice@example.com", EmailVerified: true, Name: "Alice"}
+
+	// 未开启自动注册时不创建用户
+	_, err := oidcLogin(t, uc, srv, user)
+	assert.True(t, authpb.IsIdentityNotLinked(err))
+	assert.Nil(t, e.store.find(func(u *po.User) bool { return u.Email == user.Email }))
+
+	uc, e, srv = newTestOIDC(t, false, true)
+	result, err := oidcLogin(t, uc, srv, user)
+	require.NoError(t, err)
+	assert.True(t, result.Created)
+	require.NotNil(t, result.Tokens)
+	created := e.store.find(func(u *po.User) bool { return u.Email == user.Email })
+	require.NotNil(t, created)
+	assert.Equal(t, "alice", created.Name)
+	assert.True(t, created.EmailVerified)
+
+	// 再次登录使用已关联的用户
+	result, err = oidcLogin(t, uc, srv, user)
+	require.NoError(t, err)
+	assert.False(t, result.Created)
+	claims, err := uc.accessJWT.ParseToken(result.Tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, claims.ID)
+	assert.Equal(t, []string{AuditActionLogin, AuditActionLogin}, auditActions(e.store))
+
+	// state 只能使用一次，且必须属于回调的身份提供方
+	authURL, _, _, err := uc.StartOIDCLogin(ctx, "test")
+	require.NoError(t, err)
+	code, state, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	_, err = uc.OIDCCallback(ctx, "other", code, state)
+	assert.True(t, authpb.IsOidcLoginFailed(err))
+	_, err = uc.OIDCCallback(ctx, "test", code, state)
+	assert.True(t, authpb.IsOidcLoginFailed(err))
+
+	_, _, _, err = uc.StartOIDCLogin(ctx, "other")
+	assert.True(t, authpb.IsOidcProviderNotFound(err))
+}
+
+func TestAuthUsecase_OIDCLinkByEmail(t *testing.T) {
+	uc, e, srv := newTestOIDC(t, true, false)
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser, EmailVerified: true})
+
+	// 身份提供方未确认邮箱时不自动关联
+	_, err := oidcLogin(t, uc, srv, oidctest.User{Subject: "sub-1", Email: alice.Email})
+	assert.True(t, authpb.IsIdentityNotLinked(err))
+
+	result, err := oidcLogin(t, uc, srv, oidctest.User{Subject: "sub-1", Email: alice.Email, EmailVerified: true})
+	require.NoError(t, err)
+	assert.True(t, result.Linked)
+	claims, err := uc.accessJWT.ParseToken(result.Tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, claims.ID)
+	identities, err := uc.ListIdentities(asUser(alice))
+	require.NoError(t, err)
+	require.Len(t, identities, 1)
+	assert.Equal(t, "sub-1", identities[0].Subject)
+
+	// 本地账号的邮箱未验证时不自动关联，避免他人抢注受害者的邮箱后接管其外部账号
+	carol := e.store.addUser(&po.User{Name: "carol", Email: "carol@example.com", Role: RoleUser})
+	_, err = oidcLogin(t, uc, srv, oidctest.User{Subject: "sub-2", Email: carol.Email, EmailVerified: true})
+	assert.True(t, authpb.IsIdentityNotLinked(err))
+	identities, err = uc.ListIdentities(asUser(carol))
+	require.NoError(t, err)
+	assert.Empty(t, identities)
+}
+
+func TestAuthUsecase_OIDCLinkAndUnlink(t *testing.T) {
+	ctx := context.Background()
+	uc, e, srv := newTestOIDC(t, false, false)
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := e.store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	external := oidctest.User{Subject: "sub-1", Email: "alice@elsewhere.example.com", EmailVerified: true}
+
+	authURL, _, _, err := uc.StartOIDCLink(asUser(alice), "test")
+	require.NoError(t, err)
+	result, err := oidcAuthorize(t, asUser(alice), uc, srv, external, authURL)
+	require.NoError(t, err)
+	assert.True(t, result.Linked)
+	assert.Nil(t, result.Tokens)
+	// 关联不是登录，不写登录审计日志
+	assert.Empty(t, auditActions(e.store))
+
+	// 关联后可以用外部身份登录
+	result, err = oidcLogin(t, uc, srv, external)
+	require.NoError(t, err)
+	claims, err := uc.accessJWT.ParseToken(result.Tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, claims.ID)
+
+	// 已关联的身份提供方不能重复关联，同一外部身份不能关联到其他用户
+	_, _, _, err = uc.StartOIDCLink(asUser(alice), "test")
+	assert.True(t, authpb.IsIdentityAlreadyLinked(err))
+	authURL, _, _, err = uc.StartOIDCLink(asUser(bob), "test")
+	require.NoError(t, err)
+	_, err = oidcAuthorize(t, asUser(bob), uc, srv, external, authURL)
+	assert.True(t, authpb.IsIdentityAlreadyLinked(err))
+
+	// 邮箱未验证时不能解除最后一个关联
+	err = uc.UnlinkIdentity(asUser(alice), "test")
+	assert.True(t, authpb.IsLastLoginMethod(err))
+	require.NoError(t, e.authRepo().SetEmailVerified(ctx, alice.ID, true))
+	require.NoError(t, uc.UnlinkIdentity(asUser(alice), "test"))
+	err = uc.UnlinkIdentity(asUser(alice), "test")
+	assert.True(t, authpb.IsIdentityNotFound(err))
+
+	_, err = oidcLogin(t, uc, srv, external)
+	assert.True(t, authpb.IsIdentityNotLinked(err))
+}
+
+func TestAuthUsecase_OIDCLinkRequiresInitiator(t *testing.T) {
+	uc, e, srv := newTestOIDC(t, false, false)
+	alice := e.store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := e.store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	external := oidctest.User{Subject: "sub-1", Email: "bob@elsewhere.example.com", EmailVerified: true}
+
+	// 关联回调必须由发起关联的用户完成，未登录或其他用户完成回调时不关联
+	for _, ctx := range []context.Context{context.Background(), asUser(bob)} {
+		authURL, _, _, err := uc.StartOIDCLink(asUser(alice), "test")
+		require.NoError(t, err)
+		_, err = oidcAuthorize(t, ctx, uc, srv, external, authURL)
+		assert.True(t, authpb.IsOidcLoginFailed(err))
+	}
+	identities, err := uc.ListIdentities(asUser(alice))
+	require.NoError(t, err)
+	assert.Empty(t, identities)
+}
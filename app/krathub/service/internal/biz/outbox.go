@@ -0,0 +1,179 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/webhook"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// outbox 事件状态
+const (
+	OutboxPending = "pending"
+	OutboxSent    = "sent"
+)
+
+// 聚合类型
+const (
+	AggregateUser = "user"
+)
+
+// DomainEventTopic 领域事件在事件总线上的 topic
+const DomainEventTopic = "krathub.domain_events"
+
+const (
+	defaultOutboxPollInterval = time.Second
+	defaultOutboxRetryBackoff = 5 * time.Second
+	defaultOutboxMaxBackoff   = 10 * time.Minute
+	defaultOutboxBatchSize    = 100
+	// 领取事件后的租约时长，超过后其他实例可以重新领取
+	outboxLease = time.Minute
+)
+
+// DomainEvent 从 outbox 转发到事件总线的领域事件
+type DomainEvent struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// EventBus 事件总线，返回错误时 outbox 会稍后重试，因此实现方需要容忍重复事件
+type EventBus interface {
+	PublishEvent(ctx context.Context, event *DomainEvent) error
+}
+
+// OutboxRepo outbox 事件仓库，写入由各业务仓库在自身事务中完成
+type OutboxRepo interface {
+	// ListDueOutboxEvents 按写入顺序查询已到期的待转发事件
+	ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*po.OutboxEvent, error)
+	// ClaimOutboxEvent 通过条件更新把 next_attempt_at 推迟到 leaseUntil，返回是否领取成功
+	ClaimOutboxEvent(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error)
+	MarkOutboxEventSent(ctx context.Context, id int64, sentAt time.Time) error
+	MarkOutboxEventFailed(ctx context.Context, id int64, attempts int32, lastError string, nextAttemptAt time.Time) error
+}
+
+// NewOutboxEvent 构造待写入 outbox 的事件，业务仓库在同一事务中保存
+func NewOutboxEvent(eventType, aggregateType string, aggregateID int64, data any) (*po.OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &po.OutboxEvent{
+		EventID:       uuid.NewString(),
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   strconv.FormatInt(aggregateID, 10),
+		Payload:       string(payload),
+		Status:        OutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
+
+// OutboxRelay 把 outbox 中的事件转发到事件总线，至少投递一次
+type OutboxRelay struct {
+	repo OutboxRepo
+	bus  EventBus
+	log  *log.Helper
+
+	pollInterval time.Duration
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	batchSize    int
+}
+
+// NewOutboxRelay new an outbox relay.
+func NewOutboxRelay(repo OutboxRepo, bus EventBus, logger log.Logger, cfg *conf.App) *OutboxRelay {
+	oc := cfg.GetOutbox()
+	r := &OutboxRelay{
+		repo:         repo,
+		bus:          bus,
+		log:          log.NewHelper(pkglogger.WithModule(logger, "outbox/biz/krathub-service")),
+		pollInterval: oc.GetPollInterval().AsDuration(),
+		retryBackoff: oc.GetRetryBackoff().AsDuration(),
+		maxBackoff:   oc.GetMaxBackoff().AsDuration(),
+		batchSize:    int(oc.GetBatchSize()),
+	}
+	if r.pollInterval <= 0 {
+		r.pollInterval = defaultOutboxPollInterval
+	}
+	if r.retryBackoff <= 0 {
+		r.retryBackoff = defaultOutboxRetryBackoff
+	}
+	if r.maxBackoff <= 0 {
+		r.maxBackoff = defaultOutboxMaxBackoff
+	}
+	if r.batchSize <= 0 {
+		r.batchSize = defaultOutboxBatchSize
+	}
+	return r
+}
+
+// PollInterval 扫描待转发事件的间隔
+func (r *OutboxRelay) PollInterval() time.Duration {
+	return r.pollInterval
+}
+
+// RelayDue 转发一批已到期的事件，返回本次处理的数量
+func (r *OutboxRelay) RelayDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := r.repo.ListDueOutboxEvents(ctx, now, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, e := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := r.repo.ClaimOutboxEvent(ctx, e.ID, now, now.Add(outboxLease))
+		if err != nil {
+			r.log.Warnf("claim outbox event %d failed: %v", e.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		r.relay(ctx, e)
+		n++
+	}
+	return n, nil
+}
+
+// relay 发布单个事件并记录结果，失败时按指数退避安排重试
+func (r *OutboxRelay) relay(ctx context.Context, e *po.OutboxEvent) {
+	err := r.bus.PublishEvent(ctx, &DomainEvent{
+		ID:            e.EventID,
+		Type:          e.EventType,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		OccurredAt:    e.CreatedAt,
+		Data:          json.RawMessage(e.Payload),
+	})
+	if err == nil {
+		if err := r.repo.MarkOutboxEventSent(ctx, e.ID, time.Now()); err != nil {
+			// 标记失败时租约到期后会再次发送，由消费方按事件 ID 去重
+			r.log.Errorf("mark outbox event %d sent failed: %v", e.ID, err)
+		}
+		return
+	}
+
+	attempts := e.Attempts + 1
+	next := time.Now().Add(webhook.Backoff(int(attempts), r.retryBackoff, r.maxBackoff))
+	r.log.Warnf("publish outbox event %d (%s) failed, attempt %d, retry at %s: %v", e.ID, e.EventType, attempts, next.Format(time.RFC3339), err)
+	if err := r.repo.MarkOutboxEventFailed(ctx, e.ID, attempts, err.Error(), next); err != nil {
+		r.log.Errorf("mark outbox event %d failed: %v", e.ID, err)
+	}
+}
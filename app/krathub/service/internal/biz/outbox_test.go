@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memOutboxRepo outbox 事件的内存仓库
+type memOutboxRepo struct {
+	mu     sync.Mutex
+	nextID int64
+	events []*po.OutboxEvent
+}
+
+func (r *memOutboxRepo) add(t *testing.T, eventType string, aggregateID int64, data any) *po.OutboxEvent {
+	t.Helper()
+	e, err := NewOutboxEvent(eventType, AggregateUser, aggregateID, data)
+	require.NoError(t, err)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	e.ID = r.nextID
+	r.events = append(r.events, e)
+	return e
+}
+
+func (r *memOutboxRepo) ListDueOutboxEvents(_ context.Context, now time.Time, limit int) ([]*po.OutboxEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.OutboxEvent
+	for _, e := range r.events {
+		if e.Status == OutboxPending && !e.NextAttemptAt.After(now) && len(out) < limit {
+			clone := *e
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memOutboxRepo) ClaimOutboxEvent(_ context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.ID == id && e.Status == OutboxPending && !e.NextAttemptAt.After(now) {
+			e.NextAttemptAt = leaseUntil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memOutboxRepo) MarkOutboxEventSent(_ context.Context, id int64, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.ID == id {
+			e.Status = OutboxSent
+			e.SentAt = &sentAt
+		}
+	}
+	return nil
+}
+
+func (r *memOutboxRepo) MarkOutboxEventFailed(_ context.Context, id int64, attempts int32, lastError string, nextAttemptAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		if e.ID == id {
+			e.Attempts = attempts
+			e.LastError = &lastError
+			e.NextAttemptAt = nextAttemptAt
+		}
+	}
+	return nil
+}
+
+// makeDue 让全部待转发事件立即到期
+func (r *memOutboxRepo) makeDue() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.events {
+		e.NextAttemptAt = time.Now().Add(-time.Second)
+	}
+}
+
+// memEventBus 记录发布的领域事件，err 非空时发布失败
+type memEventBus struct {
+	mu        sync.Mutex
+	err       error
+	published []*DomainEvent
+}
+
+func (b *memEventBus) PublishEvent(_ context.Context, event *DomainEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.err != nil {
+		return b.err
+	}
+	b.published = append(b.published, event)
+	return nil
+}
+
+func newTestOutbox(oc *conf.App_Outbox) (*OutboxRelay, *memOutboxRepo, *memEventBus) {
+	repo := &memOutboxRepo{}
+	bus := &memEventBus{}
+	return NewOutboxRelay(repo, bus, log.DefaultLogger, &conf.App{Outbox: oc}), repo, bus
+}
+
+func TestOutboxRelay_RelayDue(t *testing.T) {
+	ctx := context.Background()
+	relay, repo, bus := newTestOutbox(&conf.App_Outbox{BatchSize: 2})
+	first := repo.add(t, EventUserSignup, 1, map[string]any{"name": "alice"})
+	repo.add(t, EventUserUpdated, 1, map[string]any{"name": "alice2"})
+	repo.add(t, EventUserSignup, 2, map[string]any{"name": "bob"})
+
+	// 每批最多转发 BatchSize 个事件，按写入顺序发布
+	n, err := relay.RelayDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	require.Len(t, bus.published, 2)
+	got := bus.published[0]
+	assert.Equal(t, first.EventID, got.ID)
+	assert.Equal(t, EventUserSignup, got.Type)
+	assert.Equal(t, AggregateUser, got.AggregateType)
+	assert.Equal(t, "1", got.AggregateID)
+	assert.JSONEq(t, `{"name": "alice"}`, string(got.Data))
+	assert.Equal(t, OutboxSent, repo.events[0].Status)
+	assert.NotNil(t, repo.events[0].SentAt)
+
+	n, err = relay.RelayDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = relay.RelayDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Len(t, bus.published, 3)
+}
+
+func TestOutboxRelay_RetryWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	relay, repo, bus := newTestOutbox(&conf.App_Outbox{
+		RetryBackoff: durationpb.New(time.Minute),
+		MaxBackoff:   durationpb.New(3 * time.Minute),
+	})
+	repo.add(t, EventUserSignup, 1, nil)
+	bus.err = errors.New("broker unavailable")
+
+	// 失败后记录错误并按指数退避推迟，退避时间不超过上限
+	for attempt, backoff := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute} {
+		start := time.Now()
+		n, err := relay.RelayDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		e := repo.events[0]
+		assert.Equal(t, OutboxPending, e.Status)
+		assert.EqualValues(t, attempt+1, e.Attempts)
+		require.NotNil(t, e.LastError)
+		assert.Equal(t, "broker unavailable", *e.LastError)
+		assert.WithinRange(t, e.NextAttemptAt, start.Add(backoff), time.Now().Add(backoff))
+
+		// 未到重试时间时不会再次领取
+		n, err = relay.RelayDue(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		repo.makeDue()
+	}
+
+	// 恢复后转发成功
+	bus.err = nil
+	n, err := relay.RelayDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, OutboxSent, repo.events[0].Status)
+	assert.Len(t, bus.published, 1)
+}
@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPasswordMinLength = 8
+	defaultPasswordMaxLength = 128
+	bcryptMaxPasswordBytes   = 72 // bcrypt 只使用前 72 个字节
+)
+
+// PasswordPolicy 校验新密码是否符合配置的规则，并按配置的算法生成密码哈希
+type PasswordPolicy struct {
+	log *log.Helper
+
+	minLength     int
+	maxLength     int
+	minClasses    int
+	breached      map[string]struct{} // 已泄露密码的 SHA-1 摘要（大写十六进制）
+	hasher        *hash.Hasher
+	bcryptLimited bool
+}
+
+// NewPasswordPolicy new a password policy. 配置了已泄露密码列表时在启动时加载，文件无法读取时返回错误
+func NewPasswordPolicy(cfg *conf.App, logger log.Logger) (*PasswordPolicy, error) {
+	c := cfg.GetPasswordPolicy()
+	p := &PasswordPolicy{
+		log:        log.NewHelper(pkglogger.WithModule(logger, "password/biz/krathub-service")),
+		minLength:  int(c.GetMinLength()),
+		maxLength:  int(c.GetMaxLength()),
+		minClasses: int(c.GetMinCharacterClasses()),
+		hasher: &hash.Hasher{
+			Algorithm: c.GetAlgorithm(),
+			Argon2id: hash.Argon2idParams{
+				Memory:      c.GetArgon2Id().GetMemory(),
+				Iterations:  c.GetArgon2Id().GetIterations(),
+				Parallelism: uint8(min(c.GetArgon2Id().GetParallelism(), 255)),
+			},
+			BcryptCost: int(c.GetBcryptCost()),
+		},
+	}
+	switch p.hasher.Algorithm {
+	case "":
+		p.hasher.Algorithm = hash.AlgorithmArgon2id
+	case hash.AlgorithmArgon2id:
+	case hash.AlgorithmBcrypt:
+		p.bcryptLimited = true
+	default:
+		return nil, fmt.Errorf("password policy: unsupported hash algorithm %q", p.hasher.Algorithm)
+	}
+	if err := p.hasher.Argon2id.Validate(); err != nil {
+		return nil, fmt.Errorf("password policy: %w", err)
+	}
+	if p.minLength <= 0 {
+		p.minLength = defaultPasswordMinLength
+	}
+	if p.maxLength <= 0 {
+		p.maxLength = defaultPasswordMaxLength
+	}
+	if p.maxLength < p.minLength {
+		return nil, fmt.Errorf("password policy: max_length %d is less than min_length %d", p.maxLength, p.minLength)
+	}
+	if file := c.GetBreachedPasswordsFile(); file != "" {
+		breached, err := loadBreachedPasswords(file)
+		if err != nil {
+			return nil, fmt.Errorf("password policy: load breached passwords: %w", err)
+		}
+		p.breached = breached
+		p.log.Infof("loaded %d breached passwords from %s", len(breached), file)
+	}
+	return p, nil
+}
+
+// loadBreachedPasswords 读取已泄露密码列表。每行是明文密码或 SHA-1 十六进制摘要，
+// 摘要后可以带 ":次数"（HIBP 导出格式），空行忽略
+func loadBreachedPasswords(file string) (map[string]struct{}, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	breached := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if digest, _, _ := strings.Cut(line, ":"); isSHA1Hex(digest) {
+			breached[strings.ToUpper(digest)] = struct{}{}
+			continue
+		}
+		breached[sha1Hex(line)] = struct{}{}
+	}
+	return breached, scanner.Err()
+}
+
+func isSHA1Hex(s string) bool {
+	if len(s) != sha1.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// Validate 校验新密码，不符合规则时返回 WEAK_PASSWORD 错误
+func (p *PasswordPolicy) Validate(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < p.minLength {
+		return authpb.ErrorWeakPassword("password must be at least %d characters", p.minLength)
+	}
+	if n > p.maxLength {
+		return authpb.ErrorWeakPassword("password must be at most %d characters", p.maxLength)
+	}
+	if p.bcryptLimited && len(password) > bcryptMaxPasswordBytes {
+		return authpb.ErrorWeakPassword("password must be at most %d bytes", bcryptMaxPasswordBytes)
+	}
+	if p.minClasses > 0 && characterClasses(password) < p.minClasses {
+		return authpb.ErrorWeakPassword("password must contain at least %d of: uppercase letters, lowercase letters, digits, symbols", p.minClasses)
+	}
+	if _, ok := p.breached[sha1Hex(password)]; ok {
+		return authpb.ErrorWeakPassword("password has appeared in a data breach, choose a different one")
+	}
+	return nil
+}
+
+// characterClasses 统计密码包含的字符类别数：大写字母、小写字母、数字、其他符号
+func characterClasses(password string) int {
+	var upper, lower, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		case !unicode.IsSpace(r):
+			symbol = 1
+		}
+	}
+	return upper + lower + digit + symbol
+}
+
+// Hash 按配置的算法生成密码哈希，不做规则校验
+func (p *PasswordPolicy) Hash(password string) (string, error) {
+	return p.hasher.Hash(password)
+}
+
+// HashNew 校验新密码并生成哈希
+func (p *PasswordPolicy) HashNew(password string) (string, error) {
+	if err := p.Validate(password); err != nil {
+		return "", err
+	}
+	hashed, err := p.hasher.Hash(password)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return hashed, nil
+}
+
+// RandomHash 生成随机密码的哈希，用于由目录或身份提供方管理、不使用本地密码登录的用户
+func (p *PasswordPolicy) RandomHash() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate password: %w", err)
+	}
+	return p.Hash(hex.EncodeToString(b))
+}
+
+// NeedsRehash 判断已保存的哈希是否需要在下次登录成功后按当前配置重新生成
+func (p *PasswordPolicy) NeedsRehash(encoded string) bool {
+	return p.hasher.NeedsRehash(encoded)
+}
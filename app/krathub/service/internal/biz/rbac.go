@@ -0,0 +1,249 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 内置角色
+const (
+	RoleGuest    = "guest"
+	RoleUser     = "user"
+	RoleAdmin    = "admin"
+	RoleOperator = "operator"
+)
+
+// 权限名，格式为 资源:操作[:范围]
+const (
+	PermAccountManageSelf = "account:manage:self" // 管理自己的登录会话、两步验证和第三方账号关联
+	PermUserReadSelf      = "user:read:self"
+	PermUserUpdateSelf    = "user:update:self" // 修改自己的资料和通知偏好
+	PermUserReadAny       = "user:read:any"    // 查询用户列表
+	PermUserUpdateAny     = "user:update:any"  // 修改其他用户的资料
+	PermUserCreate        = "user:create"
+	PermUserDeleteAny     = "user:delete:any"
+	PermUserUnlock        = "user:unlock"      // 解除登录锁定
+	PermUserDisable       = "user:disable"     // 停用和重新启用账号
+	PermUserAssignRole    = "user:role:assign" // 修改用户角色，只能分配权限不超过自己的角色
+	PermUserInvite        = "user:invite"      // 创建和作废注册邀请码
+	PermUserImpersonate   = "user:impersonate" // 以其他用户身份登录，只能模拟权限不超过自己的用户
+	PermUserProvision     = "user:provision"   // 通过 SCIM 接口同步用户，具体操作还按上面的用户管理权限校验
+	PermUserImport        = "user:import"      // 创建用户时直接导入其他系统的密码哈希
+	PermTokenManageSelf   = "token:manage:self"
+	PermWebhookManage     = "webhook:manage"
+	PermWorkspaceUse      = "workspace:use" // 创建和加入工作空间，工作空间内的操作另按工作空间角色校验
+	PermAuditRead         = "audit:read"    // 查询和导出审计日志
+	PermTestPrivate       = "test:private"
+
+	// PermAll 全部权限
+	PermAll = "*"
+)
+
+var userPermissions = []string{PermAccountManageSelf, PermUserReadSelf, PermUserUpdateSelf, PermTokenManageSelf, PermWorkspaceUse, PermTestPrivate}
+
+// builtinRoles 内置角色定义，可以被配置或数据库中的同名角色替换
+var builtinRoles = map[string][]string{
+	RoleGuest: {PermUserReadSelf},
+	RoleUser:  userPermissions,
+	RoleAdmin: append(slices.Clone(userPermissions),
+		PermUserReadAny, PermUserUpdateAny, PermUserCreate, PermUserDeleteAny, PermUserUnlock, PermUserDisable, PermUserAssignRole, PermUserInvite, PermUserImpersonate, PermUserProvision, PermUserImport, PermWebhookManage, PermAuditRead),
+	RoleOperator: {PermAll},
+}
+
+const (
+	defaultRoleReloadInterval = time.Minute
+	rolePermissionSep         = ","
+)
+
+// RoleRepo 数据库中的角色定义
+type RoleRepo interface {
+	ListRoles(context.Context) ([]*po.Role, error)
+}
+
+// Authorizer 根据角色的权限判断当前用户能否执行操作。
+// 角色定义依次取内置角色、配置、数据库，后者覆盖前者的同名角色；数据库中的角色定期重新加载
+type Authorizer struct {
+	repo RoleRepo
+	log  *log.Helper
+
+	static         map[string][]string // 内置角色和配置合并后的结果
+	reloadInterval time.Duration
+
+	mu       sync.RWMutex
+	roles    map[string][]string
+	loadedAt time.Time
+}
+
+// NewAuthorizer new an authorizer.
+func NewAuthorizer(repo RoleRepo, logger log.Logger, cfg *conf.App) (*Authorizer, error) {
+	a := &Authorizer{
+		repo:           repo,
+		log:            log.NewHelper(pkglogger.WithModule(logger, "rbac/biz/krathub-service")),
+		static:         make(map[string][]string, len(builtinRoles)),
+		reloadInterval: cfg.GetRbac().GetReloadInterval().AsDuration(),
+	}
+	if a.reloadInterval <= 0 {
+		a.reloadInterval = defaultRoleReloadInterval
+	}
+	for name, perms := range builtinRoles {
+		a.static[name] = perms
+	}
+	for _, role := range cfg.GetRbac().GetRoles() {
+		if role.GetName() == "" {
+			return nil, fmt.Errorf("rbac: role name is required")
+		}
+		a.static[role.GetName()] = normalizePermissions(role.GetPermissions())
+	}
+	a.roles = a.static
+	return a, nil
+}
+
+func normalizePermissions(perms []string) []string {
+	out := make([]string, 0, len(perms))
+	for _, p := range perms {
+		if p = strings.TrimSpace(p); p != "" && !slices.Contains(out, p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// permissions 返回角色的权限，必要时从数据库重新加载角色定义。加载失败时继续使用上一次的结果
+func (a *Authorizer) permissions(ctx context.Context, role string) ([]string, bool) {
+	a.mu.RLock()
+	stale := time.Since(a.loadedAt) >= a.reloadInterval
+	perms, ok := a.roles[role]
+	a.mu.RUnlock()
+	if !stale {
+		return perms, ok
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if time.Since(a.loadedAt) >= a.reloadInterval {
+		a.reload(ctx)
+	}
+	perms, ok = a.roles[role]
+	return perms, ok
+}
+
+// reload 调用方需持有写锁
+func (a *Authorizer) reload(ctx context.Context) {
+	a.loadedAt = time.Now()
+	rows, err := a.repo.ListRoles(ctx)
+	if err != nil {
+		a.log.Warnf("load roles from database failed, keep using previous definitions: %v", err)
+		return
+	}
+	roles := make(map[string][]string, len(a.static)+len(rows))
+	for name, perms := range a.static {
+		roles[name] = perms
+	}
+	for _, row := range rows {
+		roles[row.Name] = normalizePermissions(strings.Split(row.Permissions, rolePermissionSep))
+	}
+	a.roles = roles
+}
+
+// grants 判断权限列表是否包含 required。支持 * 和 资源:* 形式的通配
+func grants(perms []string, required string) bool {
+	for _, p := range perms {
+		if p == PermAll || p == required {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasSuffix(prefix, ":") && strings.HasPrefix(required, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Can 判断角色是否拥有权限，不存在的角色没有任何权限
+func (a *Authorizer) Can(ctx context.Context, role, permission string) bool {
+	perms, _ := a.permissions(ctx, role)
+	return grants(perms, permission)
+}
+
+// RoleExists 判断角色是否已定义
+func (a *Authorizer) RoleExists(ctx context.Context, role string) bool {
+	_, ok := a.permissions(ctx, role)
+	return ok
+}
+
+// Roles 返回全部已定义的角色名，按名称排序
+func (a *Authorizer) Roles(ctx context.Context) []string {
+	a.permissions(ctx, "") // 必要时重新加载
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	roles := make([]string, 0, len(a.roles))
+	for name := range a.roles {
+		roles = append(roles, name)
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// Authorize 检查 context 中的当前用户是否拥有权限
+func (a *Authorizer) Authorize(ctx context.Context, permission string) error {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return authpb.ErrorUnauthorized("user not authenticated")
+	}
+	if !a.Can(ctx, claims.Role, permission) {
+		return authpb.ErrorUnauthorized("permission denied, %s is required", permission)
+	}
+	return nil
+}
+
+// AuthorizeRoleAssignment 检查当前用户能否把 role 分配给用户：
+// 需要 user:role:assign 权限，并且 role 的每个权限当前用户都拥有，避免通过分配角色提升权限
+func (a *Authorizer) AuthorizeRoleAssignment(ctx context.Context, role string) error {
+	if !a.RoleExists(ctx, role) {
+		return userpb.ErrorInvalidRole("role %s does not exist", role)
+	}
+	if err := a.Authorize(ctx, PermUserAssignRole); err != nil {
+		return err
+	}
+	if p, ok := a.covers(ctx, role); !ok {
+		return authpb.ErrorUnauthorized("cannot assign role %s: permission %s exceeds your own", role, p)
+	}
+	return nil
+}
+
+// AuthorizeUserManagement 检查当前用户能否修改或删除角色为 role 的其他用户，
+// 对方的权限不能超过当前用户，避免通过修改密码或邮箱接管权限更高的账号
+func (a *Authorizer) AuthorizeUserManagement(ctx context.Context, role string) error {
+	if p, ok := a.covers(ctx, role); !ok {
+		return authpb.ErrorUnauthorized("cannot manage a %s user: permission %s exceeds your own", role, p)
+	}
+	return nil
+}
+
+// covers 判断当前用户是否拥有 role 的全部权限，不满足时返回第一个超出的权限
+func (a *Authorizer) covers(ctx context.Context, role string) (string, bool) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return role, false
+	}
+	own, _ := a.permissions(ctx, claims.Role)
+	target, _ := a.permissions(ctx, role)
+	for _, p := range target {
+		if !grants(own, p) {
+			return p, false
+		}
+	}
+	return "", true
+}
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rowsRoleRepo 返回固定的数据库角色
+type rowsRoleRepo []*po.Role
+
+func (r rowsRoleRepo) ListRoles(context.Context) ([]*po.Role, error) { return r, nil }
+
+func newTestAuthorizer(t *testing.T) *Authorizer {
+	t.Helper()
+	authz, err := NewAuthorizer(rowsRoleRepo{
+		{Name: "helpdesk", Permissions: "user:read:any, user:unlock,user:unlock"},
+		{Name: RoleGuest, Permissions: ""},
+	}, log.DefaultLogger, &conf.App{Rbac: &conf.App_Rbac{Roles: []*conf.App_Rbac_Role{
+		{Name: "support", Permissions: []string{"user:read:*", "account:manage:self"}},
+		{Name: "user-manager", Permissions: []string{"user:*"}},
+		{Name: "self-reader", Permissions: []string{PermUserReadSelf}},
+	}}})
+	require.NoError(t, err)
+	return authz
+}
+
+func TestAuthorizer_Can(t *testing.T) {
+	authz := newTestAuthorizer(t)
+	tests := []struct {
+		role, permission string
+		want             bool
+	}{
+		{role: RoleUser, permission: PermUserReadSelf, want: true},
+		{role: RoleUser, permission: PermUserReadAny},
+		{role: RoleAdmin, permission: PermUserReadAny, want: true},
+		{role: RoleAdmin, permission: "billing:read"},
+		// * 包含全部权限
+		{role: RoleOperator, permission: "billing:read", want: true},
+		// 资源:* 只包含同一资源的权限，按完整的段匹配
+		{role: "user-manager", permission: PermUserDeleteAny, want: true},
+		{role: "user-manager", permission: PermUserReadSelf, want: true},
+		{role: "user-manager", permission: "users:read"},
+		{role: "user-manager", permission: PermWebhookManage},
+		{role: "support", permission: PermUserReadAny, want: true},
+		{role: "support", permission: PermUserReadSelf, want: true},
+		{role: "support", permission: PermUserUpdateSelf},
+		// :self 权限只包含自身，不包含 :any
+		{role: "self-reader", permission: PermUserReadSelf, want: true},
+		{role: "self-reader", permission: PermUserReadAny},
+		// 数据库角色去掉空白和重复项，并覆盖同名内置角色
+		{role: "helpdesk", permission: PermUserUnlock, want: true},
+		{role: "helpdesk", permission: PermUserReadAny, want: true},
+		{role: RoleGuest, permission: PermUserReadSelf},
+		{role: "missing", permission: PermUserReadSelf},
+		{role: "", permission: PermUserReadSelf},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, authz.Can(context.Background(), tt.role, tt.permission), "%s can %s", tt.role, tt.permission)
+	}
+	assert.True(t, authz.RoleExists(context.Background(), "helpdesk"))
+	assert.False(t, authz.RoleExists(context.Background(), "missing"))
+}
+
+func TestAuthorizer_Covers(t *testing.T) {
+	authz := newTestAuthorizer(t)
+	tests := []struct {
+		actor, target string
+		want          bool
+		exceeding     string
+	}{
+		{actor: RoleAdmin, target: RoleUser, want: true},
+		{actor: RoleAdmin, target: RoleAdmin, want: true},
+		{actor: RoleUser, target: RoleAdmin, exceeding: PermUserReadAny},
+		{actor: RoleOperator, target: RoleAdmin, want: true},
+		{actor: RoleAdmin, target: RoleOperator, exceeding: PermAll},
+		// 通配权限包含目标角色的具体权限，反之不成立
+		{actor: "user-manager", target: "support", exceeding: PermAccountManageSelf},
+		{actor: "support", target: "self-reader", want: true},
+		{actor: "self-reader", target: "support", exceeding: "user:read:*"},
+		{actor: RoleAdmin, target: "user-manager", exceeding: "user:*"},
+		{actor: "user-manager", target: "helpdesk", want: true},
+		// 不存在的角色没有任何权限
+		{actor: RoleUser, target: "missing", want: true},
+		{actor: "missing", target: RoleGuest, want: true},
+		{actor: "missing", target: RoleUser, exceeding: PermAccountManageSelf},
+	}
+	for _, tt := range tests {
+		ctx := jwt.NewContext(context.Background(), &UserClaims{ID: 1, Role: tt.actor})
+		exceeding, ok := authz.covers(ctx, tt.target)
+		assert.Equal(t, tt.want, ok, "%s covers %s", tt.actor, tt.target)
+		assert.Equal(t, tt.exceeding, exceeding, "%s covers %s", tt.actor, tt.target)
+	}
+
+	// 没有登录用户时不满足
+	_, ok := authz.covers(context.Background(), RoleGuest)
+	assert.False(t, ok)
+}
+
+func TestAuthorizer_AuthorizeRoleAssignment(t *testing.T) {
+	authz := newTestAuthorizer(t)
+	as := func(role string) context.Context {
+		return jwt.NewContext(context.Background(), &UserClaims{ID: 1, Role: role})
+	}
+
+	require.NoError(t, authz.AuthorizeRoleAssignment(as(RoleAdmin), RoleUser))
+	require.NoError(t, authz.AuthorizeRoleAssignment(as(RoleAdmin), "helpdesk"))
+	// 不能分配权限超过自己的角色
+	err := authz.AuthorizeRoleAssignment(as(RoleAdmin), RoleOperator)
+	assert.True(t, authpb.IsUnauthorized(err))
+	// 没有 user:role:assign 权限时不能分配任何角色
+	assert.True(t, authpb.IsUnauthorized(authz.AuthorizeRoleAssignment(as(RoleUser), RoleGuest)))
+	assert.True(t, userpb.IsInvalidRole(authz.AuthorizeRoleAssignment(as(RoleAdmin), "missing")))
+}
@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TokenRevocationRepo Access Token 吊销记录存储
+type TokenRevocationRepo interface {
+	// DenyAccessToken 把 jti 加入黑名单，ttl 为 token 的剩余有效期
+	DenyAccessToken(ctx context.Context, jti string, ttl time.Duration) error
+	IsAccessTokenDenied(ctx context.Context, jti string) (bool, error)
+	// SetTokenWatermark 设置用户的 token 水位线，不晚于该时间签发的 Access Token 全部失效，精确到毫秒
+	SetTokenWatermark(ctx context.Context, userID int64, at time.Time, ttl time.Duration) error
+	// GetTokenWatermark 获取用户的 token 水位线，未设置时返回零值
+	GetTokenWatermark(ctx context.Context, userID int64) (time.Time, error)
+	// DenySession 吊销会话内签发的全部 Access Token
+	DenySession(ctx context.Context, sessionID string, ttl time.Duration) error
+	IsSessionDenied(ctx context.Context, sessionID string) (bool, error)
+}
+
+// TokenRevoker 使尚未过期的 Access Token 提前失效，供认证中间件校验
+type TokenRevoker struct {
+	repo TokenRevocationRepo
+	log  *log.Helper
+	// 水位线和会话黑名单需要保留到此前签发的 token 全部过期，取可签发的最长有效期：
+	// 普通 Access Token 的有效期和代登录 token 的有效期上限中较大的一个
+	watermarkTTL time.Duration
+}
+
+// NewTokenRevoker new a token revoker.
+func NewTokenRevoker(repo TokenRevocationRepo, logger log.Logger, cfg *conf.App) *TokenRevoker {
+	return &TokenRevoker{
+		repo:         repo,
+		log:          log.NewHelper(pkglogger.WithModule(logger, "revocation/biz/krathub-service")),
+		watermarkTTL: max(time.Duration(cfg.GetJwt().GetAccessExpire())*time.Second, maxImpersonationTTL),
+	}
+}
+
+// RevokeToken 吊销单个 Access Token，用于登出
+func (r *TokenRevoker) RevokeToken(ctx context.Context, claims *UserClaims) error {
+	if claims.RegisteredClaims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	return r.repo.DenyAccessToken(ctx, claims.RegisteredClaims.ID, ttl)
+}
+
+// RevokeUserTokens 吊销用户在此之前签发的全部 Access Token，用于修改密码、修改角色和删除账号
+func (r *TokenRevoker) RevokeUserTokens(ctx context.Context, userID int64) error {
+	// 水位线和 token 的毫秒签发时间比较，同一毫秒内签发的 token 一并吊销
+	watermark := time.Now().Truncate(time.Millisecond)
+	if err := r.repo.SetTokenWatermark(ctx, userID, watermark, r.watermarkTTL); err != nil {
+		return err
+	}
+	// 等到下一毫秒再返回，保证同一流程中随后签发的新 token 晚于水位线
+	time.Sleep(time.Until(watermark.Add(time.Millisecond)))
+	return nil
+}
+
+// RevokeSession 吊销会话内签发的全部 Access Token，用于注销会话
+func (r *TokenRevoker) RevokeSession(ctx context.Context, sessionID string) error {
+	return r.repo.DenySession(ctx, sessionID, r.watermarkTTL)
+}
+
+// Check 校验 Access Token 是否已被吊销。存储不可用时拒绝请求，避免已吊销的 token 被放行
+func (r *TokenRevoker) Check(ctx context.Context, claims *UserClaims) error {
+	if claims.RegisteredClaims.ID != "" {
+		denied, err := r.repo.IsAccessTokenDenied(ctx, claims.RegisteredClaims.ID)
+		if err != nil {
+			r.log.Errorf("check access token denylist failed: %v", err)
+			return authpb.ErrorUnauthorized("failed to verify token")
+		}
+		if denied {
+			return authpb.ErrorUnauthorized("token has been revoked")
+		}
+	}
+
+	if claims.SessionID != "" {
+		denied, err := r.repo.IsSessionDenied(ctx, claims.SessionID)
+		if err != nil {
+			r.log.Errorf("check session denylist failed: %v", err)
+			return authpb.ErrorUnauthorized("failed to verify token")
+		}
+		if denied {
+			return authpb.ErrorUnauthorized("session has been revoked")
+		}
+	}
+
+	if err := r.checkWatermark(ctx, claims.ID, claims); err != nil {
+		return err
+	}
+	// 代登录的管理员被停用或修改密码后，其代登录的 token 同样失效
+	if claims.Act != nil {
+		return r.checkWatermark(ctx, claims.Act.ID, claims)
+	}
+	return nil
+}
+
+func (r *TokenRevoker) checkWatermark(ctx context.Context, userID int64, claims *UserClaims) error {
+	watermark, err := r.repo.GetTokenWatermark(ctx, userID)
+	if err != nil {
+		r.log.Errorf("get token watermark of user %d failed: %v", userID, err)
+		return authpb.ErrorUnauthorized("failed to verify token")
+	}
+	if !watermark.IsZero() && !claims.issuedAt().After(watermark) {
+		return authpb.ErrorUnauthorized("token has been revoked")
+	}
+	return nil
+}
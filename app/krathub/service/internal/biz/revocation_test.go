@@ -0,0 +1,112 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRevoker_Revoke(t *testing.T) {
+	ctx := context.Background()
+	revoker := NewTokenRevoker(newMemRevocationRepo(), log.DefaultLogger, &conf.App{Jwt: &conf.App_Jwt{AccessExpire: 900}})
+	issued := time.Now().Add(-time.Minute)
+	token := func(userID int64, jti, sessionID string) *UserClaims {
+		return &UserClaims{ID: userID, SessionID: sessionID, RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(issued),
+			ExpiresAt: jwt.NewNumericDate(issued.Add(15 * time.Minute)),
+		}}
+	}
+
+	// 登出只吊销当前 token
+	require.NoError(t, revoker.RevokeToken(ctx, token(1, "jti-1", "s1")))
+	assert.True(t, authpb.IsUnauthorized(revoker.Check(ctx, token(1, "jti-1", "s1"))))
+	assert.NoError(t, revoker.Check(ctx, token(1, "jti-2", "s1")))
+
+	// 注销会话吊销会话内签发的全部 token
+	require.NoError(t, revoker.RevokeSession(ctx, "s1"))
+	assert.True(t, authpb.IsUnauthorized(revoker.Check(ctx, token(1, "jti-2", "s1"))))
+	assert.NoError(t, revoker.Check(ctx, token(1, "jti-3", "s2")))
+
+	// 水位线之前签发的 token 全部失效，之后签发的和其他用户的不受影响
+	require.NoError(t, revoker.RevokeUserTokens(ctx, 1))
+	assert.True(t, authpb.IsUnauthorized(revoker.Check(ctx, token(1, "jti-3", "s2"))))
+	later := token(1, "jti-4", "s2")
+	later.IssuedAt = jwt.NewNumericDate(time.Now().Add(2 * time.Second))
+	assert.NoError(t, revoker.Check(ctx, later))
+	assert.NoError(t, revoker.Check(ctx, token(2, "jti-5", "s3")))
+}
+
+func TestTokenRevoker_TTLCoversLongestToken(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		accessExpire int32
+		want         time.Duration
+	}{
+		// 代登录 token 的有效期可能长于普通 Access Token，吊销记录要保留到它也过期
+		{accessExpire: 900, want: maxImpersonationTTL},
+		{accessExpire: 7200, want: 2 * time.Hour},
+	} {
+		repo := newMemRevocationRepo()
+		revoker := NewTokenRevoker(repo, log.DefaultLogger, &conf.App{Jwt: &conf.App_Jwt{AccessExpire: tc.accessExpire}})
+		require.NoError(t, revoker.RevokeUserTokens(ctx, 1))
+		require.NoError(t, revoker.RevokeSession(ctx, "s1"))
+		assert.Equal(t, tc.want, repo.ttls["watermark:1"], tc.accessExpire)
+		assert.Equal(t, tc.want, repo.ttls["session:s1"], tc.accessExpire)
+	}
+}
+
+// claimsIssuedAt 返回在 at 签发的 token claims，iat 按 JWT 序列化取整到秒
+func claimsIssuedAt(userID int64, at time.Time) *UserClaims {
+	return &UserClaims{
+		ID:               userID,
+		IssuedAtMs:       at.UnixMilli(),
+		RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(at.Truncate(time.Second))},
+	}
+}
+
+func TestTokenRevoker_WatermarkWithinSameSecond(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemRevocationRepo()
+	revoker := NewTokenRevoker(repo, log.DefaultLogger, &conf.App{Jwt: &conf.App_Jwt{AccessExpire: 900}})
+
+	require.NoError(t, revoker.RevokeUserTokens(ctx, 1))
+	watermark := repo.watermarks[1]
+	after := time.Now()
+
+	// 吊销前在同一秒、同一毫秒内签发的 token 都要失效
+	for _, issued := range []time.Time{watermark.Truncate(time.Second), watermark} {
+		assert.True(t, authpb.IsUnauthorized(revoker.Check(ctx, claimsIssuedAt(1, issued))), issued)
+	}
+	// 没有毫秒签发时间的旧 token 按 iat 比较，同一秒内签发的同样失效
+	legacy := claimsIssuedAt(1, watermark)
+	legacy.IssuedAtMs = 0
+	assert.True(t, authpb.IsUnauthorized(revoker.Check(ctx, legacy)))
+
+	// 吊销之后签发的 token 不受影响，即使 iat 与水位线在同一秒
+	assert.True(t, after.After(watermark))
+	assert.NoError(t, revoker.Check(ctx, claimsIssuedAt(1, after)))
+	assert.NoError(t, revoker.Check(ctx, claimsIssuedAt(2, watermark)))
+}
+
+func TestAuthUsecase_AccessTokenCarriesMillisecondIssueTime(t *testing.T) {
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+
+	before := time.Now().Truncate(time.Millisecond)
+	token, err := uc.newAccessToken(alice, "", 0)
+	require.NoError(t, err)
+	claims, err := uc.accessJWT.ParseToken(token)
+	require.NoError(t, err)
+	assert.False(t, claims.issuedAt().Before(before))
+	assert.False(t, claims.issuedAt().After(time.Now()))
+}
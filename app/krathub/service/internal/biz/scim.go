@@ -0,0 +1,674 @@
+package biz
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/scim"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SCIMBasePath SCIM 接口的路径前缀，资源的 meta.location 基于它生成
+const SCIMBasePath = "/scim/v2"
+
+// IdentityProviderSCIM SCIM 资源的 externalId 保存为该 provider 下的外部身份关联
+const IdentityProviderSCIM = "scim"
+
+const (
+	defaultSCIMPageSize = 100
+	maxSCIMPageSize     = 500
+)
+
+// SCIMUsecase 身份提供方通过 SCIM 2.0 创建、修改、停用和删除用户。
+// 用户资源对应 po.User：userName 为用户名，主邮箱为邮箱，active 对应停用状态；
+// 用户组对应角色，组成员即拥有该角色的用户，一个用户同时只能属于一个组。
+// 写操作复用 UserUsecase，权限校验、审计日志、Token 吊销和事件与管理接口一致
+type SCIMUsecase struct {
+	users        *UserUsecase
+	repo         UserRepo
+	authRepo     AuthRepo
+	identityRepo IdentityRepo
+	passwords    *PasswordPolicy
+	authz        *Authorizer
+	log          *log.Helper
+}
+
+// NewSCIMUsecase new a SCIM usecase.
+func NewSCIMUsecase(users *UserUsecase, repo UserRepo, authRepo AuthRepo, identityRepo IdentityRepo, passwords *PasswordPolicy, authz *Authorizer, logger log.Logger) *SCIMUsecase {
+	return &SCIMUsecase{
+		users:        users,
+		repo:         repo,
+		authRepo:     authRepo,
+		identityRepo: identityRepo,
+		passwords:    passwords,
+		authz:        authz,
+		log:          log.NewHelper(pkglogger.WithModule(logger, "scim/biz/krathub-service")),
+	}
+}
+
+// ServiceProviderConfig 返回支持的 SCIM 功能
+func (uc *SCIMUsecase) ServiceProviderConfig() *scim.ServiceProviderConfig {
+	return &scim.ServiceProviderConfig{
+		Schemas: []string{scim.SchemaServiceProviderConfig},
+		Patch:   scim.Supported{Supported: true},
+		Filter:  scim.FilterSupport{Supported: true, MaxResults: maxSCIMPageSize},
+		AuthenticationSchemes: []scim.AuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "Personal Access Token",
+			Description: "Bearer token with the scim scope, issued to an account with the user:provision permission",
+			Primary:     true,
+		}},
+		Meta: &scim.Meta{ResourceType: "ServiceProviderConfig", Location: SCIMBasePath + "/ServiceProviderConfig"},
+	}
+}
+
+// ResourceTypes 返回支持的资源类型
+func (uc *SCIMUsecase) ResourceTypes() []*scim.ResourceType {
+	return []*scim.ResourceType{
+		{
+			Schemas: []string{scim.SchemaResourceType}, ID: "User", Name: "User", Endpoint: "/Users", Schema: scim.SchemaUser,
+			Meta: &scim.Meta{ResourceType: "ResourceType", Location: SCIMBasePath + "/ResourceTypes/User"},
+		},
+		{
+			Schemas: []string{scim.SchemaResourceType}, ID: "Group", Name: "Group", Endpoint: "/Groups", Schema: scim.SchemaGroup,
+			Meta: &scim.Meta{ResourceType: "ResourceType", Location: SCIMBasePath + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+// ListUsers 按过滤条件分页查询用户，startIndex 从 1 开始。
+// 按 id、userName、邮箱或 externalId 相等查询时直接查库，其他条件需要遍历全部用户
+func (uc *SCIMUsecase) ListUsers(ctx context.Context, filter string, startIndex, count int) (*scim.ListResponse[*scim.User], error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	f, err := parseSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	startIndex, count = scimPage(startIndex, count)
+	page := pager[*scim.User]{start: startIndex - 1, count: count}
+
+	users, direct, err := uc.lookupUsers(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if direct {
+		err = uc.collectUsers(ctx, users, f, &page)
+	} else {
+		err = uc.scanUsers(ctx, UserFilter{}, func(users []*po.User) error {
+			return uc.collectUsers(ctx, users, f, &page)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return scim.NewListResponse(page.items, page.total, startIndex), nil
+}
+
+// lookupUsers 过滤条件是 id、userName、邮箱或 externalId 的相等比较时直接查询候选用户，
+// direct 为 false 表示需要遍历全部用户
+func (uc *SCIMUsecase) lookupUsers(ctx context.Context, f scim.Filter) (users []*po.User, direct bool, err error) {
+	if f == nil {
+		return nil, false, nil
+	}
+	attr, value, ok := scim.Equality(f)
+	if !ok {
+		return nil, false, nil
+	}
+	var user *po.User
+	switch attr {
+	case "id":
+		id, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			return nil, true, nil
+		}
+		// 查询失败按不存在处理
+		user, _ = uc.repo.GetUserById(ctx, id)
+	case "username":
+		user, err = uc.authRepo.GetUserByUserName(ctx, value)
+	case "emails", "emails.value":
+		user, err = uc.authRepo.GetUserByEmail(ctx, value)
+	case "externalid":
+		var identity *po.UserIdentity
+		if identity, err = uc.identityRepo.GetIdentity(ctx, IdentityProviderSCIM, value); identity != nil {
+			user, _ = uc.repo.GetUserById(ctx, identity.UserID)
+		}
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, userpb.ErrorUserNotFound("failed to find user: %v", err)
+	}
+	if user == nil {
+		return nil, true, nil
+	}
+	return []*po.User{user}, true, nil
+}
+
+// collectUsers 把一批用户转换为资源，满足过滤条件的计入结果
+func (uc *SCIMUsecase) collectUsers(ctx context.Context, users []*po.User, f scim.Filter, page *pager[*scim.User]) error {
+	resources, err := uc.toSCIMUsers(ctx, users)
+	if err != nil {
+		return err
+	}
+	for _, r := range resources {
+		ok, err := matchSCIM(f, r)
+		if err != nil {
+			return err
+		}
+		if ok {
+			page.add(r)
+		}
+	}
+	return nil
+}
+
+// scanUsers 按 ID 顺序分批遍历用户
+func (uc *SCIMUsecase) scanUsers(ctx context.Context, filter UserFilter, fn func([]*po.User) error) error {
+	opts := &UserListOptions{Filter: filter, SortBy: UserSortID, Limit: maxUserPageSize}
+	for {
+		users, err := uc.repo.ListUsers(ctx, opts)
+		if err != nil {
+			return userpb.ErrorUserNotFound("failed to list users: %v", err)
+		}
+		if err := fn(users); err != nil {
+			return err
+		}
+		if len(users) < opts.Limit {
+			return nil
+		}
+		last := users[len(users)-1]
+		opts.After = &UserCursor{Value: strconv.FormatInt(last.ID, 10), ID: last.ID}
+	}
+}
+
+// GetUser 查询用户
+func (uc *SCIMUsecase) GetUser(ctx context.Context, id int64) (*scim.User, error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toSCIMUser(ctx, user)
+}
+
+func (uc *SCIMUsecase) getUser(ctx context.Context, id int64) (*po.User, error) {
+	user, err := uc.repo.GetUserById(ctx, id)
+	if err != nil {
+		return nil, userpb.ErrorUserNotFound("user %d not found", id)
+	}
+	return user, nil
+}
+
+// CreateUser 创建用户。身份提供方负责认证，邮箱视为已验证；未提供密码时生成随机密码，
+// 用户通过第三方登录或重置密码登录。active 为 false 时创建后立即停用
+func (uc *SCIMUsecase) CreateUser(ctx context.Context, in *scim.User) (*scim.User, error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	if err := uc.authz.Authorize(ctx, PermUserCreate); err != nil {
+		return nil, err
+	}
+	name, email, err := validateSCIMUser(in)
+	if err != nil {
+		return nil, err
+	}
+	if in.ExternalID != "" {
+		if err := uc.checkExternalID(ctx, in.ExternalID, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	user := &po.User{
+		Name:          name,
+		Email:         email,
+		Password:      in.Password,
+		Role:          RoleUser,
+		EmailVerified: true,
+	}
+	if in.Password != "" {
+		user, err = uc.users.SaveUser(ctx, user)
+	} else {
+		// 未提供密码时保存随机密码的哈希，已经是哈希，不再按密码策略校验和加密
+		if user.Password, err = uc.passwords.RandomHash(); err != nil {
+			return nil, userpb.ErrorSaveUserFailed("failed to generate password: %v", err)
+		}
+		user, err = uc.users.saveUser(ctx, user, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if in.ExternalID != "" {
+		if err := uc.setExternalID(ctx, user.ID, in.ExternalID); err != nil {
+			return nil, err
+		}
+	}
+	if in.Active != nil && !bool(*in.Active) {
+		if err := uc.setActive(ctx, user, false); err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetUser(ctx, user.ID)
+}
+
+// ReplaceUser 用请求中的资源替换用户，未提供的 active 保持不变
+func (uc *SCIMUsecase) ReplaceUser(ctx context.Context, id int64, in *scim.User) (*scim.User, error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := uc.toSCIMUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.updateUser(ctx, user, current, in); err != nil {
+		return nil, err
+	}
+	return uc.GetUser(ctx, id)
+}
+
+// PatchUser 对用户执行 PATCH 操作，active 改为 false 时立即注销用户的全部会话和 Token
+func (uc *SCIMUsecase) PatchUser(ctx context.Context, id int64, req *scim.PatchRequest) (*scim.User, error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	current, err := uc.toSCIMUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := scim.Patch(*current, req.Operations)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.updateUser(ctx, user, current, &patched); err != nil {
+		return nil, err
+	}
+	return uc.GetUser(ctx, id)
+}
+
+// updateUser 按修改后的资源更新用户，只写入有变化的字段。id、groups 等只读属性忽略
+func (uc *SCIMUsecase) updateUser(ctx context.Context, user *po.User, current, in *scim.User) error {
+	name, email, err := validateSCIMUser(in)
+	if err != nil {
+		return err
+	}
+	if name != user.Name || !strings.EqualFold(email, user.Email) || in.Password != "" {
+		update := &po.User{ID: user.ID, Name: name, Email: email, Password: in.Password}
+		if _, err := uc.users.updateUser(ctx, update, true); err != nil {
+			return err
+		}
+		// 邮箱由身份提供方管理，修改后仍视为已验证
+		if email != user.Email {
+			if err := uc.authRepo.SetEmailVerified(ctx, user.ID, true); err != nil {
+				uc.log.Errorf("set email verification of user %d failed: %v", user.ID, err)
+			}
+		}
+	}
+	if in.ExternalID != current.ExternalID {
+		if err := uc.checkExternalID(ctx, in.ExternalID, user.ID); err != nil {
+			return err
+		}
+		if err := uc.setExternalID(ctx, user.ID, in.ExternalID); err != nil {
+			return err
+		}
+	}
+	if in.Active != nil {
+		return uc.setActive(ctx, user, bool(*in.Active))
+	}
+	return nil
+}
+
+// DeleteUser 删除用户，宽限期内管理员仍可以恢复
+func (uc *SCIMUsecase) DeleteUser(ctx context.Context, id int64) error {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return err
+	}
+	if err := uc.authz.Authorize(ctx, PermUserDeleteAny); err != nil {
+		return err
+	}
+	_, err := uc.users.DeleteUser(ctx, &po.User{ID: id})
+	return err
+}
+
+// setActive 停用或重新启用用户，停用时注销用户的全部会话和 Token
+func (uc *SCIMUsecase) setActive(ctx context.Context, user *po.User, active bool) error {
+	if active != IsDisabled(user) {
+		return nil
+	}
+	apply := uc.users.DisableUsers
+	if active {
+		apply = uc.users.EnableUsers
+	}
+	result, err := apply(ctx, []int64{user.ID})
+	if err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return result.Failed[0].Err
+	}
+	return nil
+}
+
+// checkExternalID externalId 不能与其他用户重复。关联的用户已被删除时清除旧关联
+func (uc *SCIMUsecase) checkExternalID(ctx context.Context, externalID string, userID int64) error {
+	if externalID == "" {
+		return nil
+	}
+	identity, err := uc.identityRepo.GetIdentity(ctx, IdentityProviderSCIM, externalID)
+	if err != nil {
+		return userpb.ErrorUserNotFound("failed to check externalId: %v", err)
+	}
+	if identity == nil || identity.UserID == userID {
+		return nil
+	}
+	if _, err := uc.repo.GetUserById(ctx, identity.UserID); err == nil {
+		return scim.NewError(http.StatusConflict, scim.ErrUniqueness, "externalId %s is already in use", externalID)
+	}
+	if _, err := uc.identityRepo.DeleteIdentity(ctx, identity.UserID, IdentityProviderSCIM); err != nil {
+		return userpb.ErrorUpdateUserFailed("failed to release externalId: %v", err)
+	}
+	return nil
+}
+
+// setExternalID 替换用户的 externalId，为空表示清除
+func (uc *SCIMUsecase) setExternalID(ctx context.Context, userID int64, externalID string) error {
+	if _, err := uc.identityRepo.DeleteIdentity(ctx, userID, IdentityProviderSCIM); err != nil {
+		return userpb.ErrorUpdateUserFailed("failed to update externalId: %v", err)
+	}
+	if externalID == "" {
+		return nil
+	}
+	identity := &po.UserIdentity{UserID: userID, Provider: IdentityProviderSCIM, Subject: externalID}
+	if err := uc.identityRepo.CreateIdentity(ctx, identity); err != nil {
+		return userpb.ErrorUpdateUserFailed("failed to update externalId: %v", err)
+	}
+	return nil
+}
+
+// validateSCIMUser 校验用户名和邮箱。没有邮箱而用户名是邮箱格式时用作邮箱，
+// 很多身份提供方以 UPN 或邮箱作为 userName
+func validateSCIMUser(in *scim.User) (name, email string, err error) {
+	name = strings.TrimSpace(in.UserName)
+	if name == "" {
+		return "", "", scim.BadRequest(scim.ErrInvalidValue, "userName is required")
+	}
+	if len(name) > maxUserNameLength {
+		return "", "", scim.BadRequest(scim.ErrInvalidValue, "userName must be at most %d characters", maxUserNameLength)
+	}
+	email = strings.TrimSpace(in.PrimaryEmail())
+	if email == "" && strings.Contains(name, "@") {
+		email = name
+	}
+	if email == "" {
+		return "", "", scim.BadRequest(scim.ErrInvalidValue, "an email address is required")
+	}
+	return name, email, nil
+}
+
+func (uc *SCIMUsecase) toSCIMUser(ctx context.Context, user *po.User) (*scim.User, error) {
+	users, err := uc.toSCIMUsers(ctx, []*po.User{user})
+	if err != nil {
+		return nil, err
+	}
+	return users[0], nil
+}
+
+// toSCIMUsers 批量转换用户，externalId 一次查询
+func (uc *SCIMUsecase) toSCIMUsers(ctx context.Context, users []*po.User) ([]*scim.User, error) {
+	ids := make([]int64, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	identities, err := uc.identityRepo.ListProviderIdentities(ctx, IdentityProviderSCIM, ids)
+	if err != nil {
+		return nil, userpb.ErrorUserNotFound("failed to list external ids: %v", err)
+	}
+	externalIDs := make(map[int64]string, len(identities))
+	for _, i := range identities {
+		externalIDs[i.UserID] = i.Subject
+	}
+
+	out := make([]*scim.User, len(users))
+	for i, u := range users {
+		active := scim.Bool(!IsDisabled(u))
+		id := strconv.FormatInt(u.ID, 10)
+		created, updated := u.CreatedAt, u.UpdatedAt
+		out[i] = &scim.User{
+			Schemas:    []string{scim.SchemaUser},
+			ID:         id,
+			ExternalID: externalIDs[u.ID],
+			UserName:   u.Name,
+			Active:     &active,
+			Emails:     []scim.MultiValue{{Value: u.Email, Type: "work", Primary: true}},
+			Groups:     []scim.MultiValue{{Value: u.Role, Display: u.Role, Ref: scimGroupLocation(u.Role)}},
+			Meta: &scim.Meta{
+				ResourceType: "User",
+				Created:      &created,
+				LastModified: &updated,
+				Location:     SCIMBasePath + "/Users/" + id,
+			},
+		}
+	}
+	return out, nil
+}
+
+func scimGroupLocation(role string) string {
+	return SCIMBasePath + "/Groups/" + role
+}
+
+// ListGroups 分页查询用户组，即全部角色。excludeMembers 为 true 时不返回成员，
+// 身份提供方检查组是否存在时通常会这样请求，避免列出大量成员
+func (uc *SCIMUsecase) ListGroups(ctx context.Context, filter string, startIndex, count int, excludeMembers bool) (*scim.ListResponse[*scim.Group], error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	f, err := parseSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	startIndex, count = scimPage(startIndex, count)
+	page := pager[*scim.Group]{start: startIndex - 1, count: count}
+	for _, role := range uc.authz.Roles(ctx) {
+		group, err := uc.toSCIMGroup(ctx, role, excludeMembers)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := matchSCIM(f, group)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			page.add(group)
+		}
+	}
+	return scim.NewListResponse(page.items, page.total, startIndex), nil
+}
+
+// GetGroup 查询用户组
+func (uc *SCIMUsecase) GetGroup(ctx context.Context, id string, excludeMembers bool) (*scim.Group, error) {
+	if err := uc.authz.Authorize(ctx, PermUserProvision); err != nil {
+		return nil, err
+	}
+	if !uc.authz.RoleExists(ctx, id) {
+		return nil, scim.NewError(http.StatusNotFound, "", "group %s not found", id)
+	}
+	return uc.toSCIMGroup(ctx, id, excludeMembers)
+}
+
+// ReplaceGroup 替换用户组成员。新成员改为该角色；被移出的成员改为普通用户，因此不能从普通用户组移出成员
+func (uc *SCIMUsecase) ReplaceGroup(ctx context.Context, id string, in *scim.Group) (*scim.Group, error) {
+	current, err := uc.GetGroup(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.updateGroup(ctx, current, in); err != nil {
+		return nil, err
+	}
+	return uc.GetGroup(ctx, id, false)
+}
+
+// PatchGroup 对用户组执行 PATCH 操作，只能修改成员
+func (uc *SCIMUsecase) PatchGroup(ctx context.Context, id string, req *scim.PatchRequest) (*scim.Group, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	current, err := uc.GetGroup(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := scim.Patch(*current, req.Operations)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.updateGroup(ctx, current, &patched); err != nil {
+		return nil, err
+	}
+	return uc.GetGroup(ctx, id, false)
+}
+
+func (uc *SCIMUsecase) updateGroup(ctx context.Context, current, in *scim.Group) error {
+	if in.DisplayName != "" && in.DisplayName != current.DisplayName {
+		return scim.BadRequest(scim.ErrMutability, "groups are roles and cannot be renamed")
+	}
+	want, err := memberIDs(in.Members)
+	if err != nil {
+		return err
+	}
+	have, err := memberIDs(current.Members)
+	if err != nil {
+		return err
+	}
+	var added, removed []int64
+	for _, id := range want {
+		if !slices.Contains(have, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range have {
+		if !slices.Contains(want, id) {
+			removed = append(removed, id)
+		}
+	}
+	if len(removed) > 0 && current.ID == RoleUser {
+		return scim.BadRequest(scim.ErrMutability, "members cannot be removed from the default %s group", RoleUser)
+	}
+	if err := uc.updateRoles(ctx, added, current.ID); err != nil {
+		return err
+	}
+	return uc.updateRoles(ctx, removed, RoleUser)
+}
+
+// updateRoles 修改成员角色，任何一个失败都返回错误
+func (uc *SCIMUsecase) updateRoles(ctx context.Context, ids []int64, role string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	result, err := uc.users.BulkUpdateRole(ctx, ids, role)
+	if err != nil {
+		return err
+	}
+	if len(result.Failed) > 0 {
+		return result.Failed[0].Err
+	}
+	return nil
+}
+
+func memberIDs(members []scim.MultiValue) ([]int64, error) {
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m.Value, 10, 64)
+		if err != nil {
+			return nil, scim.BadRequest(scim.ErrInvalidValue, "invalid member %q", m.Value)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (uc *SCIMUsecase) toSCIMGroup(ctx context.Context, role string, excludeMembers bool) (*scim.Group, error) {
+	group := &scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          role,
+		DisplayName: role,
+		Meta:        &scim.Meta{ResourceType: "Group", Location: scimGroupLocation(role)},
+	}
+	if excludeMembers {
+		return group, nil
+	}
+	err := uc.scanUsers(ctx, UserFilter{Role: role}, func(users []*po.User) error {
+		for _, u := range users {
+			id := strconv.FormatInt(u.ID, 10)
+			group.Members = append(group.Members, scim.MultiValue{Value: id, Display: u.Name, Ref: SCIMBasePath + "/Users/" + id})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func parseSCIMFilter(filter string) (scim.Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	return scim.ParseFilter(filter)
+}
+
+func matchSCIM(f scim.Filter, resource any) (bool, error) {
+	if f == nil {
+		return true, nil
+	}
+	m, err := scim.ToMap(resource)
+	if err != nil {
+		return false, err
+	}
+	return f.Match(m), nil
+}
+
+// scimPage 规范化分页参数，count 小于 0 表示未指定，为 0 时只返回总数
+func scimPage(startIndex, count int) (int, int) {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	if count < 0 {
+		count = defaultSCIMPageSize
+	}
+	return startIndex, min(count, maxSCIMPageSize)
+}
+
+// pager 统计满足条件的资源总数，只保留当前页
+type pager[T any] struct {
+	start, count int
+	total        int
+	items        []T
+}
+
+func (p *pager[T]) add(item T) {
+	if p.total >= p.start && len(p.items) < p.count {
+		p.items = append(p.items, item)
+	}
+	p.total++
+}
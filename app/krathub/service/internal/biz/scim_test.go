@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/scim"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSCIM 创建 SCIM 用例和一个管理员，返回以管理员身份调用的 context
+func newTestSCIM(t *testing.T) (*SCIMUsecase, *memStore, context.Context) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	admin := e.store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin, CreatedAt: time.Now(), UpdatedAt: time.Now()})
+	return NewSCIMUsecase(e.users(), e.userRepo(), e.authRepo(), e.identityRepo, e.passwords, e.authz, e.logger), e.store, asUser(admin)
+}
+
+func scimPatch(t *testing.T, body string) *scim.PatchRequest {
+	t.Helper()
+	var req scim.PatchRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+	return &req
+}
+
+func TestSCIM_UserLifecycle(t *testing.T) {
+	uc, store, ctx := newTestSCIM(t)
+
+	created, err := uc.CreateUser(ctx, &scim.User{
+		Schemas:    []string{scim.SchemaUser},
+		UserName:   "alice@corp.example",
+		ExternalID: "00u1",
+		Emails:     []scim.MultiValue{{Value: "alice@corp.example", Type: "work", Primary: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice@corp.example", created.UserName)
+	assert.Equal(t, "00u1", created.ExternalID)
+	assert.True(t, bool(*created.Active))
+	assert.Equal(t, RoleUser, created.Groups[0].Value)
+	assert.Equal(t, SCIMBasePath+"/Users/"+created.ID, created.Meta.Location)
+	alice := store.find(func(u *po.User) bool { return u.Name == "alice@corp.example" })
+	require.NotNil(t, alice)
+	assert.True(t, alice.EmailVerified)
+
+	// 身份提供方按 userName、externalId 或任意条件查找
+	for filter, want := range map[string]int{
+		`userName eq "alice@corp.example"`:                          1,
+		`externalId eq "00u1"`:                                      1,
+		`externalId eq "00u2"`:                                      0,
+		`emails[type eq "work" and value ew "@corp.example"]`:       1,
+		`active eq true`:                                            2,
+		`userName sw "alice" and not (groups.value eq "admin")`:     1,
+		`id eq "` + created.ID + `" or userName eq "admin"`:         2,
+		`meta.lastModified gt "2000-01-01T00:00:00Z" and active pr`: 2,
+	} {
+		list, err := uc.ListUsers(ctx, filter, 1, -1)
+		require.NoError(t, err, filter)
+		assert.Equal(t, want, list.TotalResults, filter)
+		assert.Len(t, list.Resources, want, filter)
+	}
+	page, err := uc.ListUsers(ctx, "", 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, page.TotalResults)
+	require.Len(t, page.Resources, 1)
+	assert.Equal(t, created.ID, page.Resources[0].ID)
+
+	// userName、externalId 不能重复
+	_, err = uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "alice@corp.example"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	_, err = uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "bob@corp.example", ExternalID: "00u1"})
+	var scimErr *scim.Error
+	require.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, scim.ErrUniqueness, scimErr.ScimType)
+
+	// 停用后立即生效，并写入审计日志
+	patched, err := uc.PatchUser(ctx, alice.ID, scimPatch(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "alice@new.example"}
+		]
+	}`))
+	require.NoError(t, err)
+	assert.False(t, bool(*patched.Active))
+	assert.Equal(t, "alice@new.example", patched.PrimaryEmail())
+	stored := store.find(func(u *po.User) bool { return u.ID == alice.ID })
+	assert.NotNil(t, stored.DisabledAt)
+	assert.True(t, stored.EmailVerified)
+	assert.True(t, slices.ContainsFunc(store.audits, func(e *po.AuditEvent) bool { return e.Action == AuditActionUserDisable }))
+
+	// PUT 替换 externalId 并重新启用
+	active := scim.Bool(true)
+	replaced, err := uc.ReplaceUser(ctx, alice.ID, &scim.User{
+		Schemas:    []string{scim.SchemaUser},
+		UserName:   "alice@corp.example",
+		ExternalID: "00u9",
+		Active:     &active,
+		Emails:     []scim.MultiValue{{Value: "alice@new.example"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, bool(*replaced.Active))
+	assert.Equal(t, "00u9", replaced.ExternalID)
+	require.Len(t, store.identities, 1)
+
+	require.NoError(t, uc.DeleteUser(ctx, alice.ID))
+	_, err = uc.GetUser(ctx, alice.ID)
+	assert.True(t, userpb.IsUserNotFound(err))
+
+	// 宽限期内删除的用户仍占用用户名，返回 USER_ALREADY_EXISTS 而不是数据库错误
+	_, err = uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "alice@corp.example"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+
+	// 删除后可以用同一个 externalId 重新创建
+	_, err = uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "alice2@corp.example", ExternalID: "00u9"})
+	require.NoError(t, err)
+}
+
+func TestSCIM_UpdateEmailWithPersonalAccessToken(t *testing.T) {
+	uc, store, ctx := newTestSCIM(t)
+	claims, _ := jwt.FromContext[UserClaims](ctx)
+	pat := *claims
+	pat.PATID = 1
+	ctx = jwt.NewContext(context.Background(), &pat)
+
+	// 身份提供方使用个人访问令牌同步，邮箱由它管理，不受令牌不能修改邮箱的限制
+	_, err := uc.CreateUser(ctx, &scim.User{
+		Schemas:  []string{scim.SchemaUser},
+		UserName: "alice@corp.example",
+		Emails:   []scim.MultiValue{{Value: "alice@corp.example", Primary: true}},
+	})
+	require.NoError(t, err)
+	alice := store.find(func(u *po.User) bool { return u.Name == "alice@corp.example" })
+	require.NotNil(t, alice)
+	patched, err := uc.PatchUser(ctx, alice.ID, scimPatch(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Replace", "path": "emails[primary eq true].value", "value": "alice@new.example"}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@new.example", patched.PrimaryEmail())
+	assert.Equal(t, "alice@new.example", store.find(func(u *po.User) bool { return u.ID == alice.ID }).Email)
+}
+
+func TestSCIM_Groups(t *testing.T) {
+	uc, store, ctx := newTestSCIM(t)
+	bob, err := uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "bob@corp.example"})
+	require.NoError(t, err)
+
+	list, err := uc.ListGroups(ctx, `displayName eq "admin"`, 1, -1, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalResults)
+	assert.Empty(t, list.Resources[0].Members)
+
+	group, err := uc.PatchGroup(ctx, RoleAdmin, scimPatch(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Add", "path": "members", "value": [{"value": "`+bob.ID+`"}]}]
+	}`))
+	require.NoError(t, err)
+	assert.Len(t, group.Members, 2)
+	assert.Equal(t, RoleAdmin, store.find(func(u *po.User) bool { return u.Name == "bob@corp.example" }).Role)
+
+	// 移出组后改为普通用户
+	_, err = uc.PatchGroup(ctx, RoleAdmin, scimPatch(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "remove", "path": "members[value eq \"`+bob.ID+`\"]"}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, RoleUser, store.find(func(u *po.User) bool { return u.Name == "bob@corp.example" }).Role)
+
+	_, err = uc.ReplaceGroup(ctx, RoleUser, &scim.Group{Schemas: []string{scim.SchemaGroup}, DisplayName: RoleUser})
+	require.ErrorAs(t, err, new(*scim.Error))
+	_, err = uc.PatchGroup(ctx, RoleAdmin, scimPatch(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "displayName", "value": "admins"}]
+	}`))
+	require.ErrorAs(t, err, new(*scim.Error))
+	_, err = uc.GetGroup(ctx, "missing", false)
+	require.ErrorAs(t, err, new(*scim.Error))
+}
+
+func TestSCIM_RequiresProvisionPermission(t *testing.T) {
+	uc, _, _ := newTestSCIM(t)
+	ctx := jwt.NewContext(context.Background(), &UserClaims{ID: 99, Name: "carol", Role: RoleUser})
+
+	_, err := uc.CreateUser(ctx, &scim.User{Schemas: []string{scim.SchemaUser}, UserName: strings.Repeat("a", 5) + "@corp.example"})
+	assert.True(t, authpb.IsUnauthorized(err))
+	_, err = uc.ListUsers(ctx, "", 1, -1)
+	assert.True(t, authpb.IsUnauthorized(err))
+}
+
+func TestSCIM_CreateUserWithoutPassword(t *testing.T) {
+	// 密码最大长度小于哈希长度，随机密码的哈希如果再按明文校验会被拒绝
+	e := newTestEnv(t, &conf.App{PasswordPolicy: &conf.App_PasswordPolicy{MaxLength: 40}})
+	admin := e.store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	uc := NewSCIMUsecase(e.users(), e.userRepo(), e.authRepo(), e.identityRepo, e.passwords, e.authz, e.logger)
+
+	// 未提供密码时直接保存随机密码的哈希，不再按密码策略校验和二次加密
+	_, err := uc.CreateUser(asUser(admin), &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "alice@corp.example"})
+	require.NoError(t, err)
+	alice := e.store.find(func(u *po.User) bool { return u.Name == "alice@corp.example" })
+	require.NotNil(t, alice)
+	assert.True(t, hash.IsHashed(alice.Password))
+
+	// 提供的密码仍然按密码策略校验
+	_, err = uc.CreateUser(asUser(admin), &scim.User{Schemas: []string{scim.SchemaUser}, UserName: "bob@corp.example", Password: "short"})
+	assert.True(t, authpb.IsWeakPassword(err))
+}
@@ -0,0 +1,179 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	workspacepb "github.com/ToAtlas/AtlasBackend/api/gen/go/workspace/service/v1"
+	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
+)
+
+// Session 登录会话，与Refresh Token族一一对应，ID 即族ID
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     int64     `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	// WorkspaceID 会话的活动工作空间，刷新 Access Token 时沿用
+	WorkspaceID int64 `json:"workspace_id,omitempty"`
+}
+
+// touchSession 登录或刷新Token时记录会话的设备、IP和最近使用时间，失败不影响签发Token
+func (uc *AuthUsecase) touchSession(ctx context.Context, family *TokenFamily) {
+	session, err := uc.repo.GetSession(ctx, family.ID)
+	if err != nil {
+		uc.log.Warnf("get session %s failed: %v", family.ID, err)
+	}
+	now := time.Now()
+	if session == nil {
+		session = &Session{ID: family.ID, UserID: family.UserID, CreatedAt: now}
+	}
+	client := clientinfo.FromContext(ctx)
+	if client.UserAgent != "" {
+		session.UserAgent = client.UserAgent
+	}
+	if client.IP != "" {
+		session.IP = client.IP
+	}
+	session.LastUsedAt = now
+
+	expiration := time.Duration(uc.cfg.Jwt.RefreshExpire) * time.Second
+	if err := uc.repo.SaveSession(ctx, session, expiration); err != nil {
+		uc.log.Warnf("save session %s failed: %v", family.ID, err)
+	}
+}
+
+// revokeSession 注销会话：删除该会话的全部Refresh Token，并吊销该会话已签发的Access Token
+func (uc *AuthUsecase) revokeSession(ctx context.Context, family *TokenFamily) error {
+	if err := uc.repo.RevokeTokenFamily(ctx, family); err != nil {
+		return err
+	}
+	return uc.revoker.RevokeSession(ctx, family.ID)
+}
+
+// ListSessions 查询当前用户的会话，按最近使用时间倒序，同时返回发起请求的会话ID
+func (uc *AuthUsecase) ListSessions(ctx context.Context) ([]*Session, string, error) {
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, "", authpb.ErrorUnauthorized("user not authenticated")
+	}
+	sessions, err := uc.repo.ListUserSessions(ctx, claims.ID)
+	if err != nil {
+		return nil, "", authpb.ErrorSessionNotFound("failed to list sessions: %v", err)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, claims.SessionID, nil
+}
+
+// RevokeSession 注销当前用户的指定会话，可以是当前会话
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := rejectImpersonation(ctx, "revoking sessions"); err != nil {
+		return err
+	}
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok {
+		return authpb.ErrorUnauthorized("user not authenticated")
+	}
+	session, err := uc.repo.GetSession(ctx, sessionID)
+	if err != nil {
+		return authpb.ErrorSessionNotFound("failed to get session: %v", err)
+	}
+	// 不区分会话不存在和属于其他用户，避免泄露会话ID
+	if session == nil || session.UserID != claims.ID {
+		return authpb.ErrorSessionNotFound("session %s not found", sessionID)
+	}
+	if err := uc.revokeSession(ctx, &TokenFamily{ID: session.ID, UserID: session.UserID}); err != nil {
+		uc.log.Errorf("revoke session %s failed: %v", sessionID, err)
+		return authpb.ErrorTokenGenerationFailed("failed to revoke session: %v", err)
+	}
+	return nil
+}
+
+// RevokeOtherSessions 注销当前用户除当前会话外的全部会话，返回注销的数量
+func (uc *AuthUsecase) RevokeOtherSessions(ctx context.Context) (int, error) {
+	if err := rejectImpersonation(ctx, "revoking sessions"); err != nil {
+		return 0, err
+	}
+	sessions, current, err := uc.ListSessions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == current {
+			continue
+		}
+		if err := uc.revokeSession(ctx, &TokenFamily{ID: session.ID, UserID: session.UserID}); err != nil {
+			uc.log.Errorf("revoke session %s failed: %v", session.ID, err)
+			return revoked, authpb.ErrorTokenGenerationFailed("failed to revoke session: %v", err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// sessionWorkspace 返回会话的活动工作空间，用户已不是该工作空间成员时返回 0
+func (uc *AuthUsecase) sessionWorkspace(ctx context.Context, family *TokenFamily) int64 {
+	session, err := uc.repo.GetSession(ctx, family.ID)
+	if err != nil || session == nil || session.WorkspaceID == 0 {
+		return 0
+	}
+	member, err := uc.workspaces.GetMember(ctx, session.WorkspaceID, family.UserID)
+	if err != nil || member == nil {
+		return 0
+	}
+	return session.WorkspaceID
+}
+
+// SwitchWorkspace 切换当前会话的活动工作空间并签发新的 Access Token，workspaceID 为 0 表示不选择工作空间。
+// 活动工作空间记录在会话中，之后刷新 Access Token 时保持不变
+func (uc *AuthUsecase) SwitchWorkspace(ctx context.Context, workspaceID int64) (*TokenPair, error) {
+	claims, ok := jwtpkg.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	if claims.SessionID == "" {
+		return nil, authpb.ErrorSessionNotFound("switching workspace requires a login session")
+	}
+	if workspaceID != 0 {
+		member, err := uc.workspaces.GetMember(ctx, workspaceID, claims.ID)
+		if err != nil {
+			return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to get membership: %v", err)
+		}
+		if member == nil {
+			return nil, workspacepb.ErrorWorkspaceNotFound("workspace %d not found", workspaceID)
+		}
+	}
+	session, err := uc.repo.GetSession(ctx, claims.SessionID)
+	if err != nil {
+		return nil, authpb.ErrorSessionNotFound("failed to get session: %v", err)
+	}
+	if session == nil || session.UserID != claims.ID {
+		return nil, authpb.ErrorSessionNotFound("session %s not found", claims.SessionID)
+	}
+	user, err := uc.repo.GetUserByID(ctx, claims.ID)
+	if err != nil {
+		return nil, authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	if err := checkUserEnabled(user); err != nil {
+		return nil, err
+	}
+
+	session.WorkspaceID = workspaceID
+	expiration := time.Duration(uc.cfg.Jwt.RefreshExpire) * time.Second
+	if err := uc.repo.SaveSession(ctx, session, expiration); err != nil {
+		return nil, authpb.ErrorTokenGenerationFailed("failed to save session: %v", err)
+	}
+	accessToken, err := uc.newAccessToken(user, session.ID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: accessToken, ExpiresIn: int64(uc.cfg.Jwt.AccessExpire)}, nil
+}
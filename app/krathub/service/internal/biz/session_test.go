@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loginFrom 以指定设备登录，返回Token和以该会话身份调用的 context
+func loginFrom(t *testing.T, uc *AuthUsecase, user *po.User, userAgent, ip string) (*TokenPair, context.Context) {
+	t.Helper()
+	ctx := clientinfo.NewContext(context.Background(), clientinfo.Info{UserAgent: userAgent, IP: ip})
+	pair, err := uc.issueTokenPair(ctx, user)
+	require.NoError(t, err)
+	claims, err := uc.accessJWT.ParseToken(pair.AccessToken)
+	require.NoError(t, err)
+	return pair, jwt.NewContext(ctx, claims)
+}
+
+func TestAuthUsecase_ListSessions(t *testing.T) {
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+
+	_, laptop := loginFrom(t, uc, alice, "laptop", "203.0.113.1")
+	phonePair, phone := loginFrom(t, uc, alice, "phone", "203.0.113.2")
+	loginFrom(t, uc, bob, "desktop", "203.0.113.3")
+
+	// 刷新时更新最近使用时间和 IP，列表按最近使用时间倒序
+	_, err := uc.RefreshToken(clientinfo.NewContext(context.Background(), clientinfo.Info{IP: "198.51.100.9"}), phonePair.RefreshToken)
+	require.NoError(t, err)
+
+	sessions, current, err := uc.ListSessions(laptop)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	phoneClaims, _ := jwt.FromContext[UserClaims](phone)
+	laptopClaims, _ := jwt.FromContext[UserClaims](laptop)
+	assert.Equal(t, laptopClaims.SessionID, current)
+	assert.Equal(t, phoneClaims.SessionID, sessions[0].ID)
+	assert.Equal(t, "phone", sessions[0].UserAgent)
+	assert.Equal(t, "198.51.100.9", sessions[0].IP)
+	assert.Equal(t, "laptop", sessions[1].UserAgent)
+	for _, s := range sessions {
+		assert.Equal(t, alice.ID, s.UserID)
+	}
+
+	_, _, err = uc.ListSessions(context.Background())
+	assert.True(t, authpb.IsUnauthorized(err))
+}
+
+func TestAuthUsecase_RevokeSession(t *testing.T) {
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+
+	_, laptop := loginFrom(t, uc, alice, "laptop", "203.0.113.1")
+	phonePair, phone := loginFrom(t, uc, alice, "phone", "203.0.113.2")
+	bobPair, bobCtx := loginFrom(t, uc, bob, "desktop", "203.0.113.3")
+	phoneClaims, _ := jwt.FromContext[UserClaims](phone)
+	bobClaims, _ := jwt.FromContext[UserClaims](bobCtx)
+
+	// 其他用户的会话和不存在的会话返回同样的错误
+	err := uc.RevokeSession(laptop, bobClaims.SessionID)
+	assert.True(t, authpb.IsSessionNotFound(err))
+	err = uc.RevokeSession(laptop, "unknown-session")
+	assert.True(t, authpb.IsSessionNotFound(err))
+	_, err = uc.RefreshToken(context.Background(), bobPair.RefreshToken)
+	require.NoError(t, err)
+
+	// 注销后 Refresh Token 失效，已签发的 Access Token 被吊销
+	require.NoError(t, uc.RevokeSession(laptop, phoneClaims.SessionID))
+	_, err = uc.RefreshToken(context.Background(), phonePair.RefreshToken)
+	assert.True(t, authpb.IsInvalidRefreshToken(err))
+	assert.Error(t, uc.revoker.Check(context.Background(), phoneClaims))
+
+	sessions, _, err := uc.ListSessions(laptop)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "laptop", sessions[0].UserAgent)
+}
+
+func TestAuthUsecase_RevokeOtherSessions(t *testing.T) {
+	uc, store := newTestAuth(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+
+	laptopPair, laptop := loginFrom(t, uc, alice, "laptop", "203.0.113.1")
+	loginFrom(t, uc, alice, "phone", "203.0.113.2")
+	loginFrom(t, uc, alice, "tablet", "203.0.113.4")
+	bobPair, _ := loginFrom(t, uc, bob, "desktop", "203.0.113.3")
+
+	n, err := uc.RevokeOtherSessions(laptop)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// 保留当前会话，不影响其他用户
+	sessions, current, err := uc.ListSessions(laptop)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, current, sessions[0].ID)
+	_, err = uc.RefreshToken(context.Background(), laptopPair.RefreshToken)
+	require.NoError(t, err)
+	_, err = uc.RefreshToken(context.Background(), bobPair.RefreshToken)
+	require.NoError(t, err)
+
+	n, err = uc.RevokeOtherSessions(laptop)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
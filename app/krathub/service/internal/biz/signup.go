@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 注册方式
+const (
+	SignupModeOpen   = "open"   // 任何人都可以注册
+	SignupModeInvite = "invite" // 必须使用邀请码
+	SignupModeDomain = "domain" // 邮箱域名在白名单中，或使用邀请码
+)
+
+const (
+	defaultInviteMaxUses = 1
+	inviteCodeBytes      = 16
+	inviteHintLength     = 8
+)
+
+// SignupInviteRepo 注册邀请码仓库，只保存邀请码的摘要
+type SignupInviteRepo interface {
+	CreateInvite(context.Context, *po.SignupInvite) error
+	// ListInvites activeAt 不为空时只返回在该时间仍可使用的邀请码
+	ListInvites(ctx context.Context, activeAt *time.Time) ([]*po.SignupInvite, error)
+	// ConsumeInvite 邀请码未过期且未用完时使用次数加一，返回是否成功
+	ConsumeInvite(ctx context.Context, codeHash string, now time.Time) (bool, error)
+	// ReleaseInvite 注册失败时退回一次使用次数
+	ReleaseInvite(ctx context.Context, codeHash string) error
+	// DeleteInvite 返回是否删除了记录
+	DeleteInvite(ctx context.Context, id int64) (bool, error)
+}
+
+// SignupUsecase 按配置的注册方式决定新用户能否注册，并管理注册邀请码
+type SignupUsecase struct {
+	repo  SignupInviteRepo
+	authz *Authorizer
+	audit *AuditUsecase
+	log   *log.Helper
+
+	mode    string
+	domains []string
+}
+
+// NewSignupUsecase new a signup usecase. 注册方式配置无效时返回错误
+func NewSignupUsecase(repo SignupInviteRepo, authz *Authorizer, audit *AuditUsecase, logger log.Logger, cfg *conf.App) (*SignupUsecase, error) {
+	c := cfg.GetSignup()
+	uc := &SignupUsecase{
+		repo:  repo,
+		authz: authz,
+		audit: audit,
+		log:   log.NewHelper(pkglogger.WithModule(logger, "signup/biz/krathub-service")),
+		mode:  strings.ToLower(strings.TrimSpace(c.GetMode())),
+	}
+	for _, d := range c.GetAllowedDomains() {
+		if d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@")); d != "" && !slices.Contains(uc.domains, d) {
+			uc.domains = append(uc.domains, d)
+		}
+	}
+	switch uc.mode {
+	case "":
+		uc.mode = SignupModeOpen
+	case SignupModeOpen, SignupModeInvite:
+	case SignupModeDomain:
+		if len(uc.domains) == 0 {
+			return nil, fmt.Errorf("signup: allowed_domains is required in domain mode")
+		}
+	default:
+		return nil, fmt.Errorf("signup: unsupported mode %q", uc.mode)
+	}
+	return uc, nil
+}
+
+// Mode 当前的注册方式
+func (uc *SignupUsecase) Mode() string {
+	return uc.mode
+}
+
+// domainAllowed 判断邮箱域名是否在白名单中
+func (uc *SignupUsecase) domainAllowed(email string) bool {
+	at := strings.LastIndex(email, "@")
+	return at >= 0 && slices.Contains(uc.domains, strings.ToLower(email[at+1:]))
+}
+
+// RequiresVerification 判断该邮箱是否必须先验证才能登录。domain 模式下白名单域名的邮箱无需邀请码即可注册，
+// 只有验证过邮箱才能证明确实属于该域名
+func (uc *SignupUsecase) RequiresVerification(email string) bool {
+	return uc.mode == SignupModeDomain && uc.domainAllowed(email)
+}
+
+// CheckEmailChange 校验用户自行修改邮箱。domain 模式下原邮箱在白名单中时新邮箱也必须在白名单中，
+// 避免按域名准入后改成任意邮箱；使用邀请码注册的白名单外邮箱不受限制
+func (uc *SignupUsecase) CheckEmailChange(from, to string) error {
+	if uc.mode != SignupModeDomain || !uc.domainAllowed(from) || uc.domainAllowed(to) {
+		return nil
+	}
+	return authpb.ErrorSignupNotAllowed("email must belong to domains %s", strings.Join(uc.domains, ", "))
+}
+
+// Admit 校验邮箱注册是否被允许。需要使用邀请码时立即占用一次使用次数，
+// 注册失败时调用方需调用返回的 release 退回
+func (uc *SignupUsecase) Admit(ctx context.Context, email, inviteCode string) (release func(), err error) {
+	release = func() {}
+	switch uc.mode {
+	case SignupModeOpen:
+		return release, nil
+	case SignupModeDomain:
+		if uc.domainAllowed(email) {
+			return release, nil
+		}
+		if inviteCode == "" {
+			return nil, authpb.ErrorSignupNotAllowed("signup is restricted to email domains %s, or an invite code is required", strings.Join(uc.domains, ", "))
+		}
+	default:
+		if inviteCode == "" {
+			return nil, authpb.ErrorSignupNotAllowed("an invite code is required to sign up")
+		}
+	}
+
+	codeHash := hashToken(normalizeInviteCode(inviteCode))
+	ok, err := uc.repo.ConsumeInvite(ctx, codeHash, time.Now())
+	if err != nil {
+		return nil, userpb.ErrorSaveInviteCodeFailed("failed to use invite code: %v", err)
+	}
+	if !ok {
+		return nil, authpb.ErrorInvalidInviteCode("invite code is invalid, expired or used up")
+	}
+	return func() {
+		if err := uc.repo.ReleaseInvite(context.WithoutCancel(ctx), codeHash); err != nil {
+			uc.log.Warnf("release invite code failed: %v", err)
+		}
+	}, nil
+}
+
+// AdmitExternal 校验第三方登录能否自动注册新用户。第三方登录无法提交邀请码，invite 模式下不允许自动注册；
+// domain 模式下邮箱必须经身份提供方验证
+func (uc *SignupUsecase) AdmitExternal(email string, verified bool) error {
+	switch uc.mode {
+	case SignupModeOpen:
+		return nil
+	case SignupModeDomain:
+		if !uc.domainAllowed(email) {
+			return authpb.ErrorSignupNotAllowed("signup is restricted to email domains %s", strings.Join(uc.domains, ", "))
+		}
+		if !verified {
+			return authpb.ErrorSignupNotAllowed("email %s is not verified by the identity provider", email)
+		}
+		return nil
+	default:
+		return authpb.ErrorSignupNotAllowed("signup requires an invite code, sign up with email first")
+	}
+}
+
+// CreateInviteCode 创建邀请码，maxUses 为 0 时只能使用一次。返回的邀请码明文只在此时可见
+func (uc *SignupUsecase) CreateInviteCode(ctx context.Context, maxUses int32, expiresAt *time.Time, note string) (invite *po.SignupInvite, code string, err error) {
+	if err := uc.authz.Authorize(ctx, PermUserInvite); err != nil {
+		return nil, "", err
+	}
+	claims, _ := jwt.FromContext[UserClaims](ctx)
+	if maxUses <= 0 {
+		maxUses = defaultInviteMaxUses
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", userpb.ErrorInvalidInviteRequest("expires_at must be in the future")
+	}
+	defer func() {
+		entry := AuditEntry{Action: AuditActionInviteCreate, TargetType: AuditTargetInviteCode, Err: err, Metadata: map[string]any{"max_uses": maxUses}}
+		if invite != nil {
+			entry.TargetID = invite.ID
+		}
+		uc.audit.Record(ctx, entry)
+	}()
+
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", userpb.ErrorSaveInviteCodeFailed("failed to generate invite code: %v", err)
+	}
+	code = hex.EncodeToString(b)
+	invite = &po.SignupInvite{
+		CodeHash:  hashToken(code),
+		Hint:      code[:inviteHintLength],
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		Note:      note,
+		CreatedBy: claims.ID,
+	}
+	if err := uc.repo.CreateInvite(ctx, invite); err != nil {
+		invite = nil
+		return nil, "", userpb.ErrorSaveInviteCodeFailed("failed to save invite code: %v", err)
+	}
+	uc.log.Infof("user %d created invite code %d (%s)", claims.ID, invite.ID, invite.Hint)
+	return invite, code, nil
+}
+
+// ListInviteCodes 列出邀请码，includeInactive 为 false 时只返回仍可使用的
+func (uc *SignupUsecase) ListInviteCodes(ctx context.Context, includeInactive bool) ([]*po.SignupInvite, error) {
+	if err := uc.authz.Authorize(ctx, PermUserInvite); err != nil {
+		return nil, err
+	}
+	var activeAt *time.Time
+	if !includeInactive {
+		now := time.Now()
+		activeAt = &now
+	}
+	invites, err := uc.repo.ListInvites(ctx, activeAt)
+	if err != nil {
+		return nil, userpb.ErrorSaveInviteCodeFailed("failed to list invite codes: %v", err)
+	}
+	return invites, nil
+}
+
+// RevokeInviteCode 删除邀请码，已使用该邀请码注册的用户不受影响
+func (uc *SignupUsecase) RevokeInviteCode(ctx context.Context, id int64) (err error) {
+	if err := uc.authz.Authorize(ctx, PermUserInvite); err != nil {
+		return err
+	}
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionInviteRevoke, TargetType: AuditTargetInviteCode, TargetID: id, Err: err})
+	}()
+	deleted, err := uc.repo.DeleteInvite(ctx, id)
+	if err != nil {
+		return userpb.ErrorSaveInviteCodeFailed("failed to revoke invite code: %v", err)
+	}
+	if !deleted {
+		return userpb.ErrorInviteCodeNotFound("invite code %d not found", id)
+	}
+	return nil
+}
+
+// normalizeInviteCode 邀请码是十六进制，忽略首尾空白和大小写
+func normalizeInviteCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
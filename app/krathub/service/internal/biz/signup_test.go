@@ -0,0 +1,284 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memInviteRepo 注册邀请码的内存仓库，条件与数据库的 UPDATE 语句一致
+type memInviteRepo struct {
+	mu      sync.Mutex
+	nextID  int64
+	invites []*po.SignupInvite
+}
+
+func (r *memInviteRepo) CreateInvite(_ context.Context, invite *po.SignupInvite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	invite.ID = r.nextID
+	clone := *invite
+	r.invites = append(r.invites, &clone)
+	return nil
+}
+
+func (r *memInviteRepo) ListInvites(_ context.Context, activeAt *time.Time) ([]*po.SignupInvite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.SignupInvite
+	for _, i := range r.invites {
+		if activeAt == nil || (i.UsedCount < i.MaxUses && (i.ExpiresAt == nil || i.ExpiresAt.After(*activeAt))) {
+			clone := *i
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memInviteRepo) ConsumeInvite(_ context.Context, codeHash string, now time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.invites {
+		if i.CodeHash == codeHash && i.UsedCount < i.MaxUses && (i.ExpiresAt == nil || i.ExpiresAt.After(now)) {
+			i.UsedCount++
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memInviteRepo) ReleaseInvite(_ context.Context, codeHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.invites {
+		if i.CodeHash == codeHash && i.UsedCount > 0 {
+			i.UsedCount--
+		}
+	}
+	return nil
+}
+
+func (r *memInviteRepo) DeleteInvite(_ context.Context, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, i := range r.invites {
+		if i.ID == id {
+			r.invites = append(r.invites[:n], r.invites[n+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memInviteRepo) usedCount(id int64) int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.invites {
+		if i.ID == id {
+			return i.UsedCount
+		}
+	}
+	return -1
+}
+
+// newTestSignup 创建注册用例，返回管理员身份的 context 用于创建邀请码
+func newTestSignup(t *testing.T, c *conf.App_Signup) (*SignupUsecase, *memInviteRepo, context.Context) {
+	t.Helper()
+	e := newTestEnv(t, &conf.App{Signup: c})
+	repo := &memInviteRepo{}
+	signup, err := NewSignupUsecase(repo, e.authz, e.audit, e.logger, e.cfg)
+	require.NoError(t, err)
+	admin := e.store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	return signup, repo, asUser(admin)
+}
+
+func TestNewSignupUsecase_RejectsInvalidConfig(t *testing.T) {
+	for _, c := range []*conf.App_Signup{
+		{Mode: SignupModeDomain},
+		{Mode: SignupModeDomain, AllowedDomains: []string{" ", "@"}},
+		{Mode: "closed"},
+	} {
+		_, err := NewSignupUsecase(nil, nil, nil, log.DefaultLogger, &conf.App{Signup: c})
+		assert.Error(t, err, c.Mode)
+	}
+}
+
+func TestSignupUsecase_Admit(t *testing.T) {
+	domain := &conf.App_Signup{Mode: SignupModeDomain, AllowedDomains: []string{"@Example.com"}}
+	invite := &conf.App_Signup{Mode: " Invite "}
+	tests := []struct {
+		name   string
+		cfg    *conf.App_Signup
+		email  string
+		code   string // valid 表示使用有效的邀请码
+		check  func(error) bool
+		usesUp bool // 是否占用邀请码的使用次数
+	}{
+		{name: "open", email: "alice@other.com"},
+		{name: "open ignores code", email: "alice@other.com", code: "bogus"},
+		{name: "domain allowed", cfg: domain, email: "alice@EXAMPLE.com"},
+		{name: "domain allowed ignores code", cfg: domain, email: "alice@example.com", code: "valid"},
+		{name: "domain subdomain", cfg: domain, email: "alice@mail.example.com", check: authpb.IsSignupNotAllowed},
+		{name: "domain other", cfg: domain, email: "alice@other.com", check: authpb.IsSignupNotAllowed},
+		{name: "domain other with invite", cfg: domain, email: "alice@other.com", code: "valid", usesUp: true},
+		{name: "domain other with bad invite", cfg: domain, email: "alice@other.com", code: "bogus", check: authpb.IsInvalidInviteCode},
+		{name: "invite missing", cfg: invite, email: "alice@example.com", check: authpb.IsSignupNotAllowed},
+		{name: "invite", cfg: invite, email: "alice@example.com", code: "valid", usesUp: true},
+		{name: "invite bad", cfg: invite, email: "alice@example.com", code: "bogus", check: authpb.IsInvalidInviteCode},
+	}
+	for _, tt := range tests {
+		signup, repo, admin := newTestSignup(t, tt.cfg)
+		created, code, err := signup.CreateInviteCode(admin, 1, nil, tt.name)
+		require.NoError(t, err, tt.name)
+		if tt.code == "valid" {
+			// 邀请码不区分大小写，忽略首尾空白
+			tt.code = " " + code + " "
+		}
+
+		release, err := signup.Admit(context.Background(), tt.email, tt.code)
+		if tt.check != nil {
+			assert.True(t, tt.check(err), "%s: %v", tt.name, err)
+			assert.Zero(t, repo.usedCount(created.ID), tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		var used int32
+		if tt.usesUp {
+			used = 1
+		}
+		assert.Equal(t, used, repo.usedCount(created.ID), tt.name)
+		release()
+		assert.Zero(t, repo.usedCount(created.ID), tt.name)
+	}
+}
+
+func TestSignupUsecase_InviteExhaustion(t *testing.T) {
+	ctx := context.Background()
+	signup, repo, admin := newTestSignup(t, &conf.App_Signup{Mode: SignupModeInvite})
+	invite, code, err := signup.CreateInviteCode(admin, 2, nil, "")
+	require.NoError(t, err)
+
+	// 使用次数用完后拒绝，退回一次后可以再使用一次
+	_, err = signup.Admit(ctx, "a@example.com", code)
+	require.NoError(t, err)
+	release, err := signup.Admit(ctx, "b@example.com", code)
+	require.NoError(t, err)
+	_, err = signup.Admit(ctx, "c@example.com", code)
+	assert.True(t, authpb.IsInvalidInviteCode(err))
+	assert.Equal(t, int32(2), repo.usedCount(invite.ID))
+
+	release()
+	_, err = signup.Admit(ctx, "c@example.com", code)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), repo.usedCount(invite.ID))
+
+	// 过期和已作废的邀请码不能使用
+	expiring := time.Now().Add(time.Hour)
+	expired, expiredCode, err := signup.CreateInviteCode(admin, 1, &expiring, "")
+	require.NoError(t, err)
+	past := time.Now().Add(-time.Second)
+	repo.mu.Lock()
+	repo.invites[len(repo.invites)-1].ExpiresAt = &past
+	repo.mu.Unlock()
+	_, err = signup.Admit(ctx, "d@example.com", expiredCode)
+	assert.True(t, authpb.IsInvalidInviteCode(err))
+	assert.Zero(t, repo.usedCount(expired.ID))
+
+	revoked, revokedCode, err := signup.CreateInviteCode(admin, 1, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, signup.RevokeInviteCode(admin, revoked.ID))
+	_, err = signup.Admit(ctx, "e@example.com", revokedCode)
+	assert.True(t, authpb.IsInvalidInviteCode(err))
+}
+
+// failingSaveAuthRepo 创建用户时失败，模拟检查通过后写入数据库失败
+type failingSaveAuthRepo struct {
+	memAuthRepo
+}
+
+func (failingSaveAuthRepo) SaveUser(context.Context, *po.User) (*po.User, error) {
+	return nil, errors.New("insert failed")
+}
+
+func TestAuthUsecase_SignupReleasesInviteOnFailure(t *testing.T) {
+	ctx := context.Background()
+	uc, store := newTestAuth(t, nil)
+	_, err := uc.SignupByEmail(ctx, &po.User{Name: InitialAdminName, Email: "admin@example.com", Password: "Admin-Secret-1"}, "")
+	require.NoError(t, err)
+	signup, repo, admin := newTestSignup(t, &conf.App_Signup{Mode: SignupModeInvite})
+	uc.signup = signup
+	invite, code, err := signup.CreateInviteCode(admin, 1, nil, "")
+	require.NoError(t, err)
+
+	// 写入用户失败时退回邀请码的使用次数
+	uc.repo = failingSaveAuthRepo{memAuthRepo{s: store}}
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "bob", Email: "bob@example.com", Password: "Bob-Secret-1"}, code)
+	require.Error(t, err)
+	assert.Zero(t, repo.usedCount(invite.ID))
+
+	// 邀请码仍可用于注册，注册成功后用完
+	uc.repo = memAuthRepo{s: store}
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "bob", Email: "bob@example.com", Password: "Bob-Secret-1"}, code)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.usedCount(invite.ID))
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "carol", Email: "carol@example.com", Password: "Carol-Secret-1"}, code)
+	assert.True(t, authpb.IsInvalidInviteCode(err))
+
+	// 邀请模式下不提交邀请码不能注册
+	_, err = uc.SignupByEmail(ctx, &po.User{Name: "carol", Email: "carol@example.com", Password: "Carol-Secret-1"}, "")
+	assert.True(t, authpb.IsSignupNotAllowed(err))
+}
+
+func TestAuthUsecase_DomainSignupRequiresVerification(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, &conf.App{
+		Signup:  &conf.App_Signup{Mode: SignupModeDomain, AllowedDomains: []string{"example.com"}},
+		Lockout: &conf.App_Lockout{Disabled: true},
+	})
+	uc := e.auth()
+	_, err := uc.SignupByEmail(ctx, &po.User{Name: InitialAdminName, Email: "admin@example.com", Password: "Admin-Secret-1"}, "")
+	require.NoError(t, err)
+	alice, err := uc.SignupByEmail(ctx, &po.User{Name: "alice", Email: "alice@example.com", Password: "Alice-Secret-1"}, "")
+	require.NoError(t, err)
+
+	// 按域名准入的账号验证邮箱后才能登录
+	login := &po.User{Email: "alice@example.com", Password: "Alice-Secret-1"}
+	_, _, err = uc.LoginByEmailPassword(ctx, login)
+	assert.True(t, authpb.IsEmailNotVerified(err))
+	require.NoError(t, uc.VerifyEmail(ctx, e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)))
+	_, _, err = uc.LoginByEmailPassword(ctx, login)
+	require.NoError(t, err)
+
+	// 自行修改邮箱时新邮箱也必须在白名单中，修改后需要重新验证
+	users := e.users()
+	_, err = users.UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: alice.Name, Email: "alice@other.com"})
+	assert.True(t, authpb.IsSignupNotAllowed(err))
+	assert.Equal(t, "alice@example.com", e.store.user(alice.ID).Email)
+	_, err = users.UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: alice.Name, Email: "alice2@example.com"})
+	require.NoError(t, err)
+	_, _, err = uc.LoginByEmailPassword(ctx, &po.User{Email: "alice2@example.com", Password: "Alice-Secret-1"})
+	assert.True(t, authpb.IsEmailNotVerified(err))
+
+	// 使用邀请码注册的白名单外邮箱可以改成其他邮箱
+	assert.NoError(t, e.signup().CheckEmailChange("bob@other.com", "bob@another.com"))
+}
+
+func TestSignupUsecase_AdmitExternalRequiresVerifiedEmailInDomainMode(t *testing.T) {
+	signup, _, _ := newTestSignup(t, &conf.App_Signup{Mode: SignupModeDomain, AllowedDomains: []string{"example.com"}})
+
+	// 身份提供方未验证的邮箱不能证明属于白名单域名
+	assert.NoError(t, signup.AdmitExternal("alice@example.com", true))
+	assert.True(t, authpb.IsSignupNotAllowed(signup.AdmitExternal("alice@example.com", false)))
+	assert.True(t, authpb.IsSignupNotAllowed(signup.AdmitExternal("alice@other.com", true)))
+}
@@ -0,0 +1,265 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	tokenpb "github.com/ToAtlas/AtlasBackend/api/gen/go/token/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 个人访问令牌的授权范围，write 包含同一资源的 read
+const (
+	ScopeUserRead     = "user:read"
+	ScopeUserWrite    = "user:write"
+	ScopeWebhookRead  = "webhook:read"
+	ScopeWebhookWrite = "webhook:write"
+	ScopeSCIM         = "scim" // 身份提供方通过 SCIM 接口同步用户和用户组
+)
+
+// TokenScopes 当前支持的全部授权范围
+var TokenScopes = []string{ScopeUserRead, ScopeUserWrite, ScopeWebhookRead, ScopeWebhookWrite, ScopeSCIM}
+
+const (
+	// PATPrefix 个人访问令牌的固定前缀，认证中间件据此区分令牌和 JWT
+	PATPrefix = "pat_"
+
+	defaultMaxTokensPerUser = 50
+	patLookupBytes          = 6  // 查找用前缀的随机字节数
+	patSecretBytes          = 32 // 令牌密钥的随机字节数
+	// 最近使用时间的更新间隔，避免每个请求都写数据库
+	patTouchInterval = time.Minute
+	tokenScopeSep    = ","
+)
+
+// TokenRepo 个人访问令牌仓库
+type TokenRepo interface {
+	CreateToken(context.Context, *po.PersonalAccessToken) error
+	ListUserTokens(ctx context.Context, userID int64) ([]*po.PersonalAccessToken, error)
+	CountUserTokens(ctx context.Context, userID int64) (int64, error)
+	// GetTokenByPrefix 不存在时返回 nil, nil
+	GetTokenByPrefix(ctx context.Context, prefix string) (*po.PersonalAccessToken, error)
+	// DeleteToken 删除用户自己的令牌，返回是否删除了记录
+	DeleteToken(ctx context.Context, userID, id int64) (bool, error)
+	TouchToken(ctx context.Context, id int64, at time.Time) error
+}
+
+// TokenUsecase 管理个人访问令牌，并在认证中间件中验证令牌
+type TokenUsecase struct {
+	repo     TokenRepo
+	authRepo AuthRepo
+	log      *log.Helper
+
+	maxPerUser  int64
+	maxLifetime time.Duration
+}
+
+// NewTokenUsecase new a token usecase.
+func NewTokenUsecase(repo TokenRepo, authRepo AuthRepo, logger log.Logger, cfg *conf.App) *TokenUsecase {
+	c := cfg.GetPersonalAccessToken()
+	uc := &TokenUsecase{
+		repo:        repo,
+		authRepo:    authRepo,
+		log:         log.NewHelper(pkglogger.WithModule(logger, "token/biz/krathub-service")),
+		maxPerUser:  int64(c.GetMaxPerUser()),
+		maxLifetime: c.GetMaxLifetime().AsDuration(),
+	}
+	if uc.maxPerUser <= 0 {
+		uc.maxPerUser = defaultMaxTokensPerUser
+	}
+	return uc
+}
+
+// CreateToken 为当前用户创建令牌，返回的 secret 只在此时可见
+func (uc *TokenUsecase) CreateToken(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*po.PersonalAccessToken, string, error) {
+	if err := rejectImpersonation(ctx, "creating personal access tokens"); err != nil {
+		return nil, "", err
+	}
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, "", authpb.ErrorUnauthorized("user not authenticated")
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", tokenpb.ErrorInvalidTokenRequest("expires_at must be in the future")
+	}
+	if uc.maxLifetime > 0 && (expiresAt == nil || expiresAt.Sub(now) > uc.maxLifetime) {
+		return nil, "", tokenpb.ErrorInvalidTokenRequest("expires_at is required and must be within %s", uc.maxLifetime)
+	}
+	count, err := uc.repo.CountUserTokens(ctx, claims.ID)
+	if err != nil {
+		return nil, "", tokenpb.ErrorSaveTokenFailed("failed to count tokens: %v", err)
+	}
+	if count >= uc.maxPerUser {
+		return nil, "", tokenpb.ErrorTooManyTokens("a user can have at most %d tokens", uc.maxPerUser)
+	}
+
+	prefix, secret, err := generatePAT()
+	if err != nil {
+		return nil, "", tokenpb.ErrorSaveTokenFailed("failed to generate token: %v", err)
+	}
+	token := &po.PersonalAccessToken{
+		UserID:    claims.ID,
+		Name:      name,
+		Prefix:    prefix,
+		TokenHash: hashToken(secret),
+		Scopes:    strings.Join(scopes, tokenScopeSep),
+		ExpiresAt: expiresAt,
+	}
+	if err := uc.repo.CreateToken(ctx, token); err != nil {
+		return nil, "", tokenpb.ErrorSaveTokenFailed("failed to save token: %v", err)
+	}
+	uc.log.Infof("user %d created personal access token %d (%s)", claims.ID, token.ID, prefix)
+	return token, secret, nil
+}
+
+// ListTokens 列出当前用户的令牌
+func (uc *TokenUsecase) ListTokens(ctx context.Context) ([]*po.PersonalAccessToken, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	return uc.repo.ListUserTokens(ctx, claims.ID)
+}
+
+// RevokeToken 删除当前用户的令牌，立即失效
+func (uc *TokenUsecase) RevokeToken(ctx context.Context, id int64) error {
+	if err := rejectImpersonation(ctx, "revoking personal access tokens"); err != nil {
+		return err
+	}
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return authpb.ErrorUnauthorized("user not authenticated")
+	}
+	deleted, err := uc.repo.DeleteToken(ctx, claims.ID, id)
+	if err != nil {
+		return tokenpb.ErrorSaveTokenFailed("failed to revoke token: %v", err)
+	}
+	if !deleted {
+		return tokenpb.ErrorTokenNotFound("token %d not found", id)
+	}
+	return nil
+}
+
+// Authenticate 验证个人访问令牌，返回令牌所属用户的 claims 和令牌的授权范围。
+// 角色按用户当前角色计算，修改角色后立即生效
+func (uc *TokenUsecase) Authenticate(ctx context.Context, secret string) (*UserClaims, []string, error) {
+	prefix, ok := patLookupPrefix(secret)
+	if !ok {
+		return nil, nil, authpb.ErrorUnauthorized("invalid token")
+	}
+	token, err := uc.repo.GetTokenByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, authpb.ErrorUnauthorized("failed to verify token: %v", err)
+	}
+	if token == nil || subtle.ConstantTimeCompare([]byte(token.TokenHash), []byte(hashToken(secret))) != 1 {
+		return nil, nil, authpb.ErrorUnauthorized("invalid token")
+	}
+	now := time.Now()
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(now) {
+		return nil, nil, authpb.ErrorTokenExpired("token expired")
+	}
+	user, err := uc.authRepo.GetUserByID(ctx, token.UserID)
+	if err != nil || user == nil {
+		return nil, nil, authpb.ErrorUnauthorized("token owner not found")
+	}
+	if err := checkUserEnabled(user); err != nil {
+		return nil, nil, err
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= patTouchInterval {
+		if err := uc.repo.TouchToken(ctx, token.ID, now); err != nil {
+			uc.log.Warnf("update last used time of token %d failed: %v", token.ID, err)
+		}
+	}
+	claims := &UserClaims{ID: user.ID, Name: user.Name, Role: user.Role, PATID: token.ID}
+	return claims, TokenScopeList(token), nil
+}
+
+// rejectPersonalAccessToken 个人访问令牌不能用于修改密码、邮箱等可以接管账号的操作，
+// 令牌泄露时影响范围限于其授权范围内的数据
+func rejectPersonalAccessToken(ctx context.Context, action string) error {
+	if claims, ok := jwt.FromContext[UserClaims](ctx); ok && claims.PATID != 0 {
+		return authpb.ErrorUnauthorized("%s is not allowed with personal access tokens", action)
+	}
+	return nil
+}
+
+// TokenScopeList 令牌的授权范围列表
+func TokenScopeList(token *po.PersonalAccessToken) []string {
+	if token.Scopes == "" {
+		return nil
+	}
+	return strings.Split(token.Scopes, tokenScopeSep)
+}
+
+// HasScope 判断授权范围是否包含 required，resource:write 同时包含 resource:read
+func HasScope(scopes []string, required string) bool {
+	if slices.Contains(scopes, required) {
+		return true
+	}
+	resource, access, ok := strings.Cut(required, ":")
+	return ok && access == "read" && slices.Contains(scopes, resource+":write")
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	var out []string
+	for _, s := range scopes {
+		s = strings.TrimSpace(s)
+		if !slices.Contains(TokenScopes, s) {
+			return nil, tokenpb.ErrorInvalidTokenRequest("unknown scope %q, supported scopes: %s", s, strings.Join(TokenScopes, ", "))
+		}
+		if !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	if len(out) == 0 {
+		return nil, tokenpb.ErrorInvalidTokenRequest("at least one scope is required")
+	}
+	return out, nil
+}
+
+// generatePAT 生成令牌：pat_<查找前缀>_<密钥>，数据库保存 pat_<查找前缀> 和完整令牌的摘要
+func generatePAT() (prefix, secret string, err error) {
+	b := make([]byte, patLookupBytes+patSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	prefix = PATPrefix + hex.EncodeToString(b[:patLookupBytes])
+	return prefix, prefix + "_" + hex.EncodeToString(b[patLookupBytes:]), nil
+}
+
+// patLookupPrefix 从完整令牌中取出查找前缀
+func patLookupPrefix(secret string) (string, bool) {
+	rest, ok := strings.CutPrefix(secret, PATPrefix)
+	if !ok {
+		return "", false
+	}
+	lookup, key, ok := strings.Cut(rest, "_")
+	if !ok || len(lookup) != patLookupBytes*2 || len(key) != patSecretBytes*2 {
+		return "", false
+	}
+	return PATPrefix + lookup, true
+}
+
+// hashToken 令牌本身是高熵随机数，SHA-256 摘要足以防止数据库泄露后被直接使用
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	tokenpb "github.com/ToAtlas/AtlasBackend/api/gen/go/token/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memTokenRepo 个人访问令牌的内存仓库
+type memTokenRepo struct {
+	mu     sync.Mutex
+	nextID int64
+	tokens map[int64]*po.PersonalAccessToken
+}
+
+func (r *memTokenRepo) CreateToken(_ context.Context, token *po.PersonalAccessToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	token.ID, token.CreatedAt = r.nextID, time.Now()
+	clone := *token
+	r.tokens[token.ID] = &clone
+	return nil
+}
+
+func (r *memTokenRepo) ListUserTokens(_ context.Context, userID int64) ([]*po.PersonalAccessToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.PersonalAccessToken
+	for _, t := range r.tokens {
+		if t.UserID == userID {
+			clone := *t
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memTokenRepo) CountUserTokens(ctx context.Context, userID int64) (int64, error) {
+	tokens, err := r.ListUserTokens(ctx, userID)
+	return int64(len(tokens)), err
+}
+
+func (r *memTokenRepo) GetTokenByPrefix(_ context.Context, prefix string) (*po.PersonalAccessToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.Prefix == prefix {
+			clone := *t
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memTokenRepo) DeleteToken(_ context.Context, userID, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.tokens[id]
+	if !ok || t.UserID != userID {
+		return false, nil
+	}
+	delete(r.tokens, id)
+	return true, nil
+}
+
+func (r *memTokenRepo) TouchToken(_ context.Context, id int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.tokens[id]; ok {
+		t.LastUsedAt = &at
+	}
+	return nil
+}
+
+func (r *memTokenRepo) get(id int64) *po.PersonalAccessToken {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *r.tokens[id]
+	return &clone
+}
+
+func newTestTokens(t *testing.T, cfg *conf.App) (*TokenUsecase, *memTokenRepo, *memStore) {
+	t.Helper()
+	e := newTestEnv(t, cfg)
+	repo := &memTokenRepo{tokens: map[int64]*po.PersonalAccessToken{}}
+	return NewTokenUsecase(repo, e.authRepo(), e.logger, e.cfg), repo, e.store
+}
+
+func TestTokenUsecase_CreateToken(t *testing.T) {
+	tokens, repo, store := newTestTokens(t, &conf.App{PersonalAccessToken: &conf.App_PersonalAccessToken{
+		MaxPerUser: 2, MaxLifetime: durationpb.New(24 * time.Hour),
+	}})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	ctx := asUser(alice)
+	in := func(d time.Duration) *time.Time {
+		at := time.Now().Add(d)
+		return &at
+	}
+
+	token, secret, err := tokens.CreateToken(ctx, "ci", []string{"user:read", " user:read", "webhook:write"}, in(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, token.Prefix+"_"))
+	// 数据库只保存摘要，重复的授权范围只保存一次
+	stored := repo.get(token.ID)
+	assert.NotContains(t, stored.TokenHash, secret)
+	assert.Equal(t, hashToken(secret), stored.TokenHash)
+	assert.Equal(t, "user:read,webhook:write", stored.Scopes)
+
+	tests := []struct {
+		name      string
+		scopes    []string
+		expiresAt *time.Time
+	}{
+		{name: "unknown scope", scopes: []string{"user:delete"}, expiresAt: in(time.Hour)},
+		{name: "no scope", expiresAt: in(time.Hour)},
+		{name: "already expired", scopes: []string{ScopeUserRead}, expiresAt: in(-time.Minute)},
+		{name: "beyond max lifetime", scopes: []string{ScopeUserRead}, expiresAt: in(48 * time.Hour)},
+		{name: "never expires", scopes: []string{ScopeUserRead}},
+	}
+	for _, tt := range tests {
+		_, _, err := tokens.CreateToken(ctx, tt.name, tt.scopes, tt.expiresAt)
+		assert.True(t, tokenpb.IsInvalidTokenRequest(err), "%s: %v", tt.name, err)
+	}
+
+	// 达到每个用户的上限后拒绝创建
+	_, _, err = tokens.CreateToken(ctx, "second", []string{ScopeUserRead}, in(time.Hour))
+	require.NoError(t, err)
+	_, _, err = tokens.CreateToken(ctx, "third", []string{ScopeUserRead}, in(time.Hour))
+	assert.True(t, tokenpb.IsTooManyTokens(err))
+
+	// 代登录期间不能创建令牌
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	impersonated := jwt.NewContext(context.Background(), &UserClaims{ID: bob.ID, Name: bob.Name, Role: bob.Role, Act: &ActorClaims{ID: 99}})
+	_, _, err = tokens.CreateToken(impersonated, "ci", []string{ScopeUserRead}, in(time.Hour))
+	assert.True(t, authpb.IsImpersonationNotAllowed(err))
+}
+
+func TestTokenUsecase_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	tokens, repo, store := newTestTokens(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	token, secret, err := tokens.CreateToken(asUser(alice), "ci", []string{ScopeUserWrite}, nil)
+	require.NoError(t, err)
+
+	// 按前缀查找后比较摘要，角色取用户当前的角色
+	store.mu.Lock()
+	store.users[alice.ID].Role = RoleAdmin
+	store.mu.Unlock()
+	claims, scopes, err := tokens.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, claims.ID)
+	assert.Equal(t, RoleAdmin, claims.Role)
+	assert.Equal(t, []string{ScopeUserWrite}, scopes)
+	assert.NotNil(t, repo.get(token.ID).LastUsedAt)
+
+	// 前缀相同但密钥不同、格式错误的令牌都被拒绝
+	forged := secret[:len(secret)-1] + "0"
+	if forged == secret {
+		forged = secret[:len(secret)-1] + "1"
+	}
+	for _, s := range []string{forged, token.Prefix, "pat_short_key", strings.TrimPrefix(secret, PATPrefix)} {
+		_, _, err := tokens.Authenticate(ctx, s)
+		assert.True(t, authpb.IsUnauthorized(err), s)
+	}
+
+	// 停用的用户不能使用令牌
+	now := time.Now()
+	store.mu.Lock()
+	store.users[alice.ID].DisabledAt = &now
+	store.mu.Unlock()
+	_, _, err = tokens.Authenticate(ctx, secret)
+	assert.True(t, authpb.IsUserDisabled(err))
+	store.mu.Lock()
+	store.users[alice.ID].DisabledAt = nil
+	store.mu.Unlock()
+
+	// 过期的令牌返回 TOKEN_EXPIRED
+	past := time.Now().Add(-time.Second)
+	repo.mu.Lock()
+	repo.tokens[token.ID].ExpiresAt = &past
+	repo.mu.Unlock()
+	_, _, err = tokens.Authenticate(ctx, secret)
+	assert.True(t, authpb.IsTokenExpired(err))
+
+	// 吊销后立即失效，只能吊销自己的令牌
+	other, otherSecret, err := tokens.CreateToken(asUser(alice), "other", []string{ScopeUserRead}, nil)
+	require.NoError(t, err)
+	_, _, err = tokens.Authenticate(ctx, otherSecret)
+	require.NoError(t, err)
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	assert.True(t, tokenpb.IsTokenNotFound(tokens.RevokeToken(asUser(bob), other.ID)))
+	require.NoError(t, tokens.RevokeToken(asUser(alice), other.ID))
+	_, _, err = tokens.Authenticate(ctx, otherSecret)
+	assert.True(t, authpb.IsUnauthorized(err))
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		scopes   []string
+		required string
+		want     bool
+	}{
+		{scopes: []string{ScopeUserRead}, required: ScopeUserRead, want: true},
+		{scopes: []string{ScopeUserWrite}, required: ScopeUserRead, want: true},
+		{scopes: []string{ScopeUserRead}, required: ScopeUserWrite},
+		{scopes: []string{ScopeWebhookWrite}, required: ScopeUserRead},
+		{scopes: []string{ScopeSCIM}, required: ScopeSCIM, want: true},
+		{scopes: []string{ScopeUserWrite}, required: ScopeSCIM},
+		{required: ScopeUserRead},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, HasScope(tt.scopes, tt.required), "%v has %s", tt.scopes, tt.required)
+	}
+}
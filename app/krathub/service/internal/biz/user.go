@@ -2,11 +2,13 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
 	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
 	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
 	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
 	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
 
@@ -18,21 +20,60 @@ type UserRepo interface {
 	GetUserById(context.Context, int64) (*po.User, error)
 	DeleteUser(context.Context, *po.User) (*po.User, error)
 	UpdateUser(context.Context, *po.User) (*po.User, error)
+	ListUsers(context.Context, *UserListOptions) ([]*po.User, error)
+	UpdateRole(ctx context.Context, id int64, role string) error
+	// SetDisabledAt 停用（at 非空）或重新启用（at 为空）账号
+	SetDisabledAt(ctx context.Context, id int64, at *time.Time) error
+	// GetDeletedUser 查询已删除但个人信息尚未清除的用户，不存在时返回 nil, nil
+	GetDeletedUser(ctx context.Context, id int64) (*po.User, error)
+	RestoreUser(ctx context.Context, id int64) error
+	// ListPurgeableUsers 查询在 deletedBefore 之前删除且个人信息尚未清除的用户ID
+	ListPurgeableUsers(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error)
+	// AnonymizeUser 清除用户的个人信息，并删除其第三方账号关联、恢复码、访问令牌和待发送通知
+	AnonymizeUser(ctx context.Context, id int64, at time.Time) error
 }
 
 type UserUsecase struct {
-	repo     UserRepo
-	log      *log.Helper
-	cfg      *conf.App
-	authRepo AuthRepo // 改为依赖 AuthRepo
+	repo      UserRepo
+	log       *log.Helper
+	cfg       *conf.App
+	authRepo  AuthRepo // 改为依赖 AuthRepo
+	events    EventPublisher
+	notifier  *NotificationUsecase
+	revoker   *TokenRevoker
+	throttler *LoginThrottler
+	passwords *PasswordPolicy
+	authz     *Authorizer
+	audit     *AuditUsecase
+	signup    *SignupUsecase
+
+	gracePeriod   time.Duration
+	purgeInterval time.Duration
 }
 
-func NewUserUsecase(repo UserRepo, logger log.Logger, cfg *conf.App, authRepo AuthRepo) *UserUsecase {
+func NewUserUsecase(repo UserRepo, logger log.Logger, cfg *conf.App, authRepo AuthRepo, events EventPublisher, notifier *NotificationUsecase, revoker *TokenRevoker, throttler *LoginThrottler, passwords *PasswordPolicy, authz *Authorizer, audit *AuditUsecase, signup *SignupUsecase) *UserUsecase {
 	uc := &UserUsecase{
-		repo:     repo,
-		log:      log.NewHelper(pkglogger.WithModule(logger, "user/biz/krathub-service")),
-		cfg:      cfg,
-		authRepo: authRepo,
+		repo:      repo,
+		log:       log.NewHelper(pkglogger.WithModule(logger, "user/biz/krathub-service")),
+		cfg:       cfg,
+		authRepo:  authRepo,
+		events:    events,
+		notifier:  notifier,
+		revoker:   revoker,
+		throttler: throttler,
+		passwords: passwords,
+		authz:     authz,
+		audit:     audit,
+		signup:    signup,
+
+		gracePeriod:   cfg.GetAccount().GetDeletionGracePeriod().AsDuration(),
+		purgeInterval: cfg.GetAccount().GetPurgeInterval().AsDuration(),
+	}
+	if uc.gracePeriod <= 0 {
+		uc.gracePeriod = defaultDeletionGracePeriod
+	}
+	if uc.purgeInterval <= 0 {
+		uc.purgeInterval = defaultPurgeInterval
 	}
 	return uc
 }
@@ -54,46 +95,165 @@ func (uc *UserUsecase) CurrentUserInfo(ctx context.Context) (*po.User, error) {
 	return user, nil
 }
 
+// UpdateUser 修改用户信息。修改密码或邮箱需要登录获得的 Access Token，不能使用个人访问令牌
 func (uc *UserUsecase) UpdateUser(ctx context.Context, user *po.User) (*po.User, error) {
+	return uc.updateUser(ctx, user, false)
+}
+
+// updateUser provisioned 表示由 SCIM 同步，密码和邮箱由身份提供方管理，允许通过个人访问令牌修改
+func (uc *UserUsecase) updateUser(ctx context.Context, user *po.User, provisioned bool) (updated *po.User, err error) {
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionUserUpdate, TargetType: AuditTargetUser, TargetID: user.ID, Err: err})
+	}()
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	// 修改其他用户需要 user:update:any
+	if user.ID != claims.ID {
+		if err := uc.authz.Authorize(ctx, PermUserUpdateAny); err != nil {
+			return nil, err
+		}
+	}
+
 	// 获取原始用户信息
 	origUser, err := uc.repo.GetUserById(ctx, user.ID)
 	if err != nil {
 		return nil, userpb.ErrorUserNotFound("user not found: %v", err)
 	}
+	if user.ID != claims.ID {
+		if err := uc.authz.AuthorizeUserManagement(ctx, origUser.Role); err != nil {
+			return nil, err
+		}
+	}
+	// 代登录或使用个人访问令牌时不能修改密码和邮箱，避免借此接管账号
+	if user.Password != "" || (user.Email != "" && user.Email != origUser.Email) {
+		if err := rejectImpersonation(ctx, "changing password or email"); err != nil {
+			return nil, err
+		}
+		if !provisioned {
+			if err := rejectPersonalAccessToken(ctx, "changing password or email"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// 用户自行修改邮箱时同样遵守注册方式的域名限制
+	if user.ID == claims.ID && !provisioned && user.Email != "" && user.Email != origUser.Email {
+		if err := uc.signup.CheckEmailChange(origUser.Email, user.Email); err != nil {
+			return nil, err
+		}
+	}
+	if user.Role != "" && user.Role != origUser.Role {
+		if err := uc.authz.AuthorizeRoleAssignment(ctx, user.Role); err != nil {
+			return nil, err
+		}
+	}
 
 	// 只有当用户名发生变化时才检查重复
 	if user.Name != origUser.Name {
-		userWithSameName, err := uc.authRepo.GetUserByUserName(ctx, user.Name)
+		taken, err := uc.authRepo.IsUserNameTaken(ctx, user.Name)
 		if err != nil {
 			return nil, authpb.ErrorUserNotFound("failed to check username: %v", err)
 		}
-		if userWithSameName != nil {
+		if taken {
 			return nil, authpb.ErrorUserAlreadyExists("username already exists")
 		}
 	}
 
 	// 只有当邮箱发生变化时才检查重复
 	if user.Email != origUser.Email {
-		userWithSameEmail, err := uc.authRepo.GetUserByEmail(ctx, user.Email)
+		taken, err := uc.authRepo.IsEmailTaken(ctx, user.Email)
 		if err != nil {
 			return nil, authpb.ErrorUserNotFound("failed to check email: %v", err)
 		}
-		if userWithSameEmail != nil {
+		if taken {
 			return nil, authpb.ErrorUserAlreadyExists("email already exists")
 		}
 	}
 
+	// 修改密码或角色后，之前签发的 token 不能继续使用
+	passwordChanged := false
+	if user.Password != "" {
+		if hash.Check(user.Password, origUser.Password) {
+			user.Password = origUser.Password // 密码未变化，保留原哈希
+		} else {
+			if user.Password, err = uc.preparePassword(user.Password); err != nil {
+				return nil, err
+			}
+			passwordChanged = true
+		}
+	}
+	roleChanged := user.Role != "" && user.Role != origUser.Role
+
 	updatedUser, err := uc.repo.UpdateUser(ctx, user)
 	if err != nil {
 		return nil, userpb.ErrorUpdateUserFailed("failed to update user: %v", err)
 	}
+	if passwordChanged || roleChanged {
+		uc.revokeUserTokens(ctx, user.ID, passwordChanged)
+	}
+	if roleChanged {
+		uc.audit.Record(ctx, AuditEntry{
+			Action: AuditActionRoleChange, TargetType: AuditTargetUser, TargetID: user.ID,
+			Metadata: map[string]any{"from": origUser.Role, "to": user.Role},
+		})
+	}
+	// 修改邮箱后需要重新验证，发往原邮箱的验证和重置链接全部作废
+	if user.Email != "" && user.Email != origUser.Email {
+		if origUser.EmailVerified {
+			if err := uc.authRepo.SetEmailVerified(ctx, user.ID, false); err != nil {
+				uc.log.Errorf("reset email verification of user %d failed: %v", user.ID, err)
+			}
+		}
+		for _, purpose := range []string{TokenPurposeVerifyEmail, TokenPurposePasswordReset} {
+			if err := uc.authRepo.DeleteVerificationToken(ctx, purpose, user.ID); err != nil {
+				uc.log.Errorf("revoke %s token of user %d failed: %v", purpose, user.ID, err)
+			}
+		}
+	}
+	// user.updated 事件由 UpdateUser 在同一事务中写入 outbox
+	// 通知发给修改前的账号信息，邮箱被修改时原邮箱也能收到提醒
+	uc.notifier.Notify(ctx, origUser, EventUserUpdated, UserEventData(updatedUser))
 	return updatedUser, nil
 }
 
+// SaveUser 创建用户，密码按密码策略校验并加密
 func (uc *UserUsecase) SaveUser(ctx context.Context, user *po.User) (*po.User, error) {
+	return uc.saveUser(ctx, user, false)
+}
+
+// ImportUser 创建用户并原样保存从其他系统迁移来的密码哈希，需要 user:import 权限。
+// 哈希必须是支持的格式且参数在允许范围内，登录成功后按当前配置重新生成
+func (uc *UserUsecase) ImportUser(ctx context.Context, user *po.User) (*po.User, error) {
+	if err := uc.authz.Authorize(ctx, PermUserImport); err != nil {
+		return nil, err
+	}
+	return uc.saveUser(ctx, user, true)
+}
+
+func (uc *UserUsecase) saveUser(ctx context.Context, user *po.User, hashed bool) (saved *po.User, err error) {
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{
+			Action: AuditActionUserCreate, TargetType: AuditTargetUser, TargetID: user.ID, Err: err,
+			Metadata: map[string]any{"name": user.Name, "role": user.Role},
+		})
+	}()
+	if user.Role == "" {
+		user.Role = RoleUser
+	}
+	if err := uc.authz.AuthorizeRoleAssignment(ctx, user.Role); err != nil {
+		return nil, err
+	}
 	if err := uc.checkUserExists(ctx, user); err != nil {
 		return nil, err
 	}
+	if hashed {
+		if !hash.IsHashed(user.Password) {
+			return nil, authpb.ErrorWeakPassword("password is not a supported password hash")
+		}
+	} else if user.Password, err = uc.preparePassword(user.Password); err != nil {
+		return nil, err
+	}
 
 	savedUser, err := uc.repo.SaveUser(ctx, user)
 	if err != nil {
@@ -102,24 +262,73 @@ func (uc *UserUsecase) SaveUser(ctx context.Context, user *po.User) (*po.User, e
 	return savedUser, nil
 }
 
+// preparePassword 提交的密码一律视为明文，按密码策略校验并加密。看起来像哈希的输入也不会原样保存，
+// 否则用户可以绕过密码策略，或者写入参数极大的哈希让登录耗尽资源；导入哈希只能通过 ImportUser
+func (uc *UserUsecase) preparePassword(password string) (string, error) {
+	return uc.passwords.HashNew(password)
+}
+
+// DeleteUser 软删除用户，宽限期内可以通过 RestoreUser 恢复，之后由清理任务清除个人信息
 func (uc *UserUsecase) DeleteUser(ctx context.Context, user *po.User) (success bool, err error) {
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionUserDelete, TargetType: AuditTargetUser, TargetID: user.ID, Err: err})
+	}()
+	target, err := uc.repo.GetUserById(ctx, user.ID)
+	if err != nil {
+		return false, userpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	if err := uc.authz.AuthorizeUserManagement(ctx, target.Role); err != nil {
+		return false, err
+	}
 	_, err = uc.repo.DeleteUser(ctx, user)
 	if err != nil {
 		return false, userpb.ErrorDeleteUserFailed("failed to delete user: %v", err)
 	}
+	uc.revokeUserTokens(ctx, user.ID, true)
+	uc.events.Publish(ctx, EventUserDeleted, map[string]any{"id": user.ID})
 	return true, nil
 }
 
+// UnlockUser 解除用户因登录失败次数过多造成的锁定
+func (uc *UserUsecase) UnlockUser(ctx context.Context, id int64) (err error) {
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionUserUnlock, TargetType: AuditTargetUser, TargetID: id, Err: err})
+	}()
+	user, err := uc.repo.GetUserById(ctx, id)
+	if err != nil {
+		return userpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	if err := uc.throttler.Unlock(ctx, user.Email); err != nil {
+		return userpb.ErrorUnlockUserFailed("failed to unlock user: %v", err)
+	}
+	uc.log.Infof("user %d unlocked", id)
+	return nil
+}
+
+// revokeUserTokens 吊销用户已签发的 Access Token，withRefresh 为 true 时同时注销全部 Refresh Token。
+// 用户数据已经修改成功，吊销失败只记录日志
+func (uc *UserUsecase) revokeUserTokens(ctx context.Context, userID int64, withRefresh bool) {
+	if err := uc.revoker.RevokeUserTokens(ctx, userID); err != nil {
+		uc.log.Errorf("revoke access tokens of user %d failed: %v", userID, err)
+	}
+	if !withRefresh {
+		return
+	}
+	if err := uc.authRepo.DeleteUserRefreshTokens(ctx, userID); err != nil {
+		uc.log.Errorf("revoke refresh tokens of user %d failed: %v", userID, err)
+	}
+}
+
 func (uc *UserUsecase) checkUserExists(ctx context.Context, user *po.User) error {
-	if existingUser, err := uc.authRepo.GetUserByUserName(ctx, user.Name); err != nil {
+	if taken, err := uc.authRepo.IsUserNameTaken(ctx, user.Name); err != nil {
 		return authpb.ErrorUserNotFound("failed to check username: %v", err)
-	} else if existingUser != nil {
+	} else if taken {
 		return authpb.ErrorUserAlreadyExists("username already exists")
 	}
 
-	if existingEmail, err := uc.authRepo.GetUserByEmail(ctx, user.Email); err != nil {
+	if taken, err := uc.authRepo.IsEmailTaken(ctx, user.Email); err != nil {
 		return authpb.ErrorUserNotFound("failed to check email: %v", err)
-	} else if existingEmail != nil {
+	} else if taken {
 		return authpb.ErrorUserAlreadyExists("email already exists")
 	}
 	return nil
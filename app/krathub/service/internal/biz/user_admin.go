@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+)
+
+// 用户列表的排序字段
+const (
+	UserSortID        = "id"
+	UserSortCreatedAt = "created_at"
+	UserSortName      = "name"
+	UserSortEmail     = "email"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+	defaultUserOrder    = "-" + UserSortCreatedAt
+)
+
+// UserFilter 用户列表的过滤条件，零值表示不过滤
+type UserFilter struct {
+	Query         string // 用户名或邮箱包含的子串
+	Role          string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Disabled      *bool
+	Deleted       bool // 只查询已删除、个人信息尚未清除的用户
+}
+
+// UserCursor 游标分页的位置：上一页最后一个用户的排序字段值和 ID
+type UserCursor struct {
+	Value string `json:"v"`
+	ID    int64  `json:"id"`
+}
+
+// UserListOptions 用户列表查询参数，结果按 SortBy、ID 排序
+type UserListOptions struct {
+	Filter UserFilter
+	SortBy string
+	Desc   bool
+	After  *UserCursor // 为空表示第一页
+	Limit  int
+}
+
+// userPageToken 分页 token 的内容，记录排序方式以拒绝与当前排序不匹配的 token
+type userPageToken struct {
+	Order string `json:"o"`
+	UserCursor
+}
+
+// BulkFailure 批量操作中失败的用户及原因
+type BulkFailure struct {
+	ID  int64
+	Err error
+}
+
+// BulkResult 批量操作结果
+type BulkResult struct {
+	Succeeded []int64
+	Failed    []BulkFailure
+}
+
+// IsDisabled 判断账号是否已被停用
+func IsDisabled(user *po.User) bool {
+	return user.DisabledAt != nil
+}
+
+// checkUserEnabled 停用的账号不能登录和刷新 Token
+func checkUserEnabled(user *po.User) error {
+	if IsDisabled(user) {
+		return authpb.ErrorUserDisabled("user %d is disabled", user.ID)
+	}
+	return nil
+}
+
+// ListUsers 分页查询用户，orderBy 为排序字段，- 前缀表示倒序
+func (uc *UserUsecase) ListUsers(ctx context.Context, filter UserFilter, orderBy, pageToken string, pageSize int) ([]*po.User, string, error) {
+	if err := uc.authz.Authorize(ctx, PermUserReadAny); err != nil {
+		return nil, "", err
+	}
+	if orderBy == "" {
+		orderBy = defaultUserOrder
+	}
+	opts := &UserListOptions{Filter: filter, Limit: pageSize}
+	opts.SortBy, opts.Desc = strings.TrimPrefix(orderBy, "-"), strings.HasPrefix(orderBy, "-")
+	switch opts.SortBy {
+	case UserSortID, UserSortCreatedAt, UserSortName, UserSortEmail:
+	default:
+		return nil, "", userpb.ErrorInvalidListRequest("unsupported order_by %s", orderBy)
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultUserPageSize
+	}
+	opts.Limit = min(opts.Limit, maxUserPageSize)
+	if pageToken != "" {
+		cursor, err := decodeUserPageToken(pageToken, orderBy)
+		if err != nil {
+			return nil, "", err
+		}
+		opts.After = cursor
+	}
+
+	// 多查一条判断是否还有下一页
+	pageSize = opts.Limit
+	opts.Limit++
+	users, err := uc.repo.ListUsers(ctx, opts)
+	if err != nil {
+		return nil, "", userpb.ErrorUserNotFound("failed to list users: %v", err)
+	}
+	if len(users) <= pageSize {
+		return users, "", nil
+	}
+	users = users[:pageSize]
+	last := users[len(users)-1]
+	next := encodeUserPageToken(userPageToken{
+		Order:      orderBy,
+		UserCursor: UserCursor{Value: userSortValue(last, opts.SortBy), ID: last.ID},
+	})
+	return users, next, nil
+}
+
+// userSortValue 用户在排序字段上的值，作为下一页的游标
+func userSortValue(user *po.User, sortBy string) string {
+	switch sortBy {
+	case UserSortCreatedAt:
+		return user.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case UserSortName:
+		return user.Name
+	case UserSortEmail:
+		return user.Email
+	default:
+		return strconv.FormatInt(user.ID, 10)
+	}
+}
+
+func encodeUserPageToken(t userPageToken) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeUserPageToken(token, orderBy string) (*UserCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, userpb.ErrorInvalidListRequest("invalid page_token")
+	}
+	var t userPageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, userpb.ErrorInvalidListRequest("invalid page_token")
+	}
+	if t.Order != orderBy {
+		return nil, userpb.ErrorInvalidListRequest("page_token does not match order_by %s", orderBy)
+	}
+	return &t.UserCursor, nil
+}
+
+// bulk 对每个用户分别校验并执行 fn：不能操作自己，也不能操作权限超过自己的用户。
+// 每个用户的结果分别写入审计日志
+func (uc *UserUsecase) bulk(ctx context.Context, action string, metadata map[string]any, ids []int64, fn func(*po.User) error) (*BulkResult, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	result := &BulkResult{}
+	for _, id := range ids {
+		err := func() error {
+			if id == claims.ID {
+				return userpb.ErrorUpdateUserFailed("cannot apply bulk actions to yourself")
+			}
+			user, err := uc.repo.GetUserById(ctx, id)
+			if err != nil {
+				return userpb.ErrorUserNotFound("user %d not found", id)
+			}
+			if err := uc.authz.AuthorizeUserManagement(ctx, user.Role); err != nil {
+				return err
+			}
+			return fn(user)
+		}()
+		uc.audit.Record(ctx, AuditEntry{Action: action, TargetType: AuditTargetUser, TargetID: id, Err: err, Metadata: metadata})
+		if err != nil {
+			result.Failed = append(result.Failed, BulkFailure{ID: id, Err: err})
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, id)
+	}
+	return result, nil
+}
+
+// BulkUpdateRole 批量修改用户角色，角色变化的用户之前签发的 Access Token 随即失效
+func (uc *UserUsecase) BulkUpdateRole(ctx context.Context, ids []int64, role string) (*BulkResult, error) {
+	if err := uc.authz.AuthorizeRoleAssignment(ctx, role); err != nil {
+		return nil, err
+	}
+	return uc.bulk(ctx, AuditActionRoleChange, map[string]any{"to": role}, ids, func(user *po.User) error {
+		if user.Role == role {
+			return nil
+		}
+		if err := uc.repo.UpdateRole(ctx, user.ID, role); err != nil {
+			return userpb.ErrorUpdateUserFailed("failed to update role: %v", err)
+		}
+		uc.revokeUserTokens(ctx, user.ID, false)
+		user.Role = role
+		uc.events.Publish(ctx, EventUserUpdated, UserEventData(user))
+		return nil
+	})
+}
+
+// DisableUsers 批量停用用户，并注销其全部会话和 Access Token
+func (uc *UserUsecase) DisableUsers(ctx context.Context, ids []int64) (*BulkResult, error) {
+	if err := uc.authz.Authorize(ctx, PermUserDisable); err != nil {
+		return nil, err
+	}
+	return uc.bulk(ctx, AuditActionUserDisable, nil, ids, func(user *po.User) error {
+		if IsDisabled(user) {
+			return nil
+		}
+		now := time.Now()
+		if err := uc.repo.SetDisabledAt(ctx, user.ID, &now); err != nil {
+			return userpb.ErrorUpdateUserFailed("failed to disable user: %v", err)
+		}
+		uc.revokeUserTokens(ctx, user.ID, true)
+		uc.events.Publish(ctx, EventUserDisabled, UserEventData(user))
+		uc.log.Infof("user %d disabled", user.ID)
+		return nil
+	})
+}
+
+// EnableUsers 批量重新启用用户
+func (uc *UserUsecase) EnableUsers(ctx context.Context, ids []int64) (*BulkResult, error) {
+	if err := uc.authz.Authorize(ctx, PermUserDisable); err != nil {
+		return nil, err
+	}
+	return uc.bulk(ctx, AuditActionUserEnable, nil, ids, func(user *po.User) error {
+		if !IsDisabled(user) {
+			return nil
+		}
+		if err := uc.repo.SetDisabledAt(ctx, user.ID, nil); err != nil {
+			return userpb.ErrorUpdateUserFailed("failed to enable user: %v", err)
+		}
+		uc.events.Publish(ctx, EventUserEnabled, UserEventData(user))
+		uc.log.Infof("user %d enabled", user.ID)
+		return nil
+	})
+}
@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingUpdateRepo 修改 failID 的角色或停用状态时失败
+type failingUpdateRepo struct {
+	memUserRepo
+	failID int64
+}
+
+func (r failingUpdateRepo) UpdateRole(ctx context.Context, id int64, role string) error {
+	if id == r.failID {
+		return errors.New("update failed")
+	}
+	return r.memUserRepo.UpdateRole(ctx, id, role)
+}
+
+func (r failingUpdateRepo) SetDisabledAt(ctx context.Context, id int64, at *time.Time) error {
+	if id == r.failID {
+		return errors.New("update failed")
+	}
+	return r.memUserRepo.SetDisabledAt(ctx, id, at)
+}
+
+// newTestBulkUsers 创建用户用例，Access Token 吊销记录保存在返回的内存仓库中
+func newTestBulkUsers(t *testing.T) (*UserUsecase, *memStore, *memRevocationRepo) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	return e.users(), e.store, e.revocations
+}
+
+// bulkFailures 按用户 ID 返回失败原因
+func bulkFailures(result *BulkResult) map[int64]error {
+	out := make(map[int64]error, len(result.Failed))
+	for _, f := range result.Failed {
+		out[f.ID] = f.Err
+	}
+	return out
+}
+
+func TestUserUsecase_BulkUpdateRole(t *testing.T) {
+	users, store, revocations := newTestBulkUsers(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleGuest})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	operator := store.addUser(&po.User{Name: "operator", Email: "operator@example.com", Role: RoleOperator})
+	carol := store.addUser(&po.User{Name: "carol", Email: "carol@example.com", Role: RoleGuest})
+	users.repo = failingUpdateRepo{memUserRepo: memUserRepo{s: store}, failID: carol.ID}
+	ctx := asUser(admin)
+
+	// 每个用户分别校验，失败的用户不影响其他用户
+	result, err := users.BulkUpdateRole(ctx, []int64{admin.ID, alice.ID, bob.ID, operator.ID, 999, carol.ID}, RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{alice.ID, bob.ID}, result.Succeeded)
+	failed := bulkFailures(result)
+	require.Len(t, failed, 4)
+	// 不能修改自己的角色，避免管理员误把自己降级
+	assert.True(t, userpb.IsUpdateUserFailed(failed[admin.ID]))
+	assert.Contains(t, failed[admin.ID].Error(), "yourself")
+	assert.True(t, authpb.IsUnauthorized(failed[operator.ID]))
+	assert.True(t, userpb.IsUserNotFound(failed[999]))
+	assert.True(t, userpb.IsUpdateUserFailed(failed[carol.ID]))
+
+	assert.Equal(t, RoleAdmin, store.find(func(u *po.User) bool { return u.ID == admin.ID }).Role)
+	assert.Equal(t, RoleUser, store.find(func(u *po.User) bool { return u.ID == alice.ID }).Role)
+	assert.Equal(t, RoleOperator, store.find(func(u *po.User) bool { return u.ID == operator.ID }).Role)
+	assert.Equal(t, RoleGuest, store.find(func(u *po.User) bool { return u.ID == carol.ID }).Role)
+	// 只有角色变化的用户的 Access Token 失效
+	assert.Contains(t, revocations.watermarks, alice.ID)
+	assert.NotContains(t, revocations.watermarks, bob.ID)
+	assert.Len(t, store.audits, 6)
+
+	// 不能分配权限超过自己的角色，整个请求被拒绝
+	_, err = users.BulkUpdateRole(ctx, []int64{alice.ID}, RoleOperator)
+	assert.True(t, authpb.IsUnauthorized(err))
+	_, err = users.BulkUpdateRole(asUser(bob), []int64{alice.ID}, RoleGuest)
+	assert.True(t, authpb.IsUnauthorized(err))
+}
+
+func TestUserUsecase_DisableAndEnableUsers(t *testing.T) {
+	ctx := context.Background()
+	users, store, revocations := newTestBulkUsers(t)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	operator := store.addUser(&po.User{Name: "operator", Email: "operator@example.com", Role: RoleOperator})
+	users.repo = failingUpdateRepo{memUserRepo: memUserRepo{s: store}, failID: bob.ID}
+	authRepo := memAuthRepo{s: store}
+	require.NoError(t, authRepo.SaveRefreshToken(ctx, alice.ID, "alice-refresh", time.Hour))
+	disabled := func(id int64) bool {
+		return IsDisabled(store.find(func(u *po.User) bool { return u.ID == id }))
+	}
+
+	// 不能停用自己，也不能停用权限更高的用户；存储失败只影响对应的用户
+	result, err := users.DisableUsers(asUser(admin), []int64{admin.ID, alice.ID, bob.ID, operator.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{alice.ID}, result.Succeeded)
+	failed := bulkFailures(result)
+	require.Len(t, failed, 3)
+	assert.Contains(t, failed[admin.ID].Error(), "yourself")
+	assert.True(t, authpb.IsUnauthorized(failed[operator.ID]))
+	assert.True(t, userpb.IsUpdateUserFailed(failed[bob.ID]))
+	assert.False(t, disabled(admin.ID))
+	assert.True(t, disabled(alice.ID))
+	assert.False(t, disabled(bob.ID))
+	assert.False(t, disabled(operator.ID))
+
+	// 停用后注销全部会话和 Access Token
+	_, err = authRepo.ConsumeRefreshToken(ctx, "alice-refresh")
+	assert.Error(t, err)
+	assert.Contains(t, revocations.watermarks, alice.ID)
+
+	// 重复停用和启用未停用的用户不报错
+	result, err = users.DisableUsers(asUser(admin), []int64{alice.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{alice.ID}, result.Succeeded)
+
+	result, err = users.EnableUsers(asUser(admin), []int64{admin.ID, alice.ID, operator.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{alice.ID}, result.Succeeded)
+	assert.Len(t, result.Failed, 2)
+	assert.False(t, disabled(alice.ID))
+
+	// 没有 user:disable 权限时整个请求被拒绝
+	_, err = users.DisableUsers(asUser(alice), []int64{bob.ID})
+	assert.True(t, authpb.IsUnauthorized(err))
+	_, err = users.EnableUsers(asUser(alice), []int64{bob.ID})
+	assert.True(t, authpb.IsUnauthorized(err))
+}
@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+)
+
+const (
+	defaultDeletionGracePeriod = 30 * 24 * time.Hour
+	defaultPurgeInterval       = time.Hour
+	purgeBatch                 = 100
+)
+
+// UserDataExport 用户个人数据导出的内容
+type UserDataExport struct {
+	ExportedAt  time.Time
+	User        *po.User
+	Preferences NotificationPrefs
+	Sessions    []*Session
+}
+
+// RestoreUser 恢复宽限期内删除的用户，恢复后用户需要重新登录
+func (uc *UserUsecase) RestoreUser(ctx context.Context, id int64) (restored *po.User, err error) {
+	defer func() {
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionUserRestore, TargetType: AuditTargetUser, TargetID: id, Err: err})
+	}()
+	if err := uc.authz.Authorize(ctx, PermUserDeleteAny); err != nil {
+		return nil, err
+	}
+	user, err := uc.repo.GetDeletedUser(ctx, id)
+	if err != nil {
+		return nil, userpb.ErrorUserNotFound("failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, userpb.ErrorUserNotRestorable("user %d is not deleted or has been purged", id)
+	}
+	if deadline := user.DeletedAt.Time.Add(uc.gracePeriod); time.Now().After(deadline) {
+		return nil, userpb.ErrorUserNotRestorable("grace period of user %d ended at %s", id, deadline.Format(time.RFC3339))
+	}
+	if err := uc.authz.AuthorizeUserManagement(ctx, user.Role); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RestoreUser(ctx, id); err != nil {
+		return nil, userpb.ErrorUpdateUserFailed("failed to restore user: %v", err)
+	}
+	user.DeletedAt.Valid = false
+	uc.events.Publish(ctx, EventUserRestored, UserEventData(user))
+	uc.log.Infof("user %d restored", id)
+	return user, nil
+}
+
+// PurgeInterval 清理任务的扫描间隔
+func (uc *UserUsecase) PurgeInterval() time.Duration {
+	return uc.purgeInterval
+}
+
+// PurgeDeletedUsers 清除宽限期已过的删除用户的个人信息，返回处理的用户数。
+// 按批处理直到没有待清除的用户，积压较多时也能在一次扫描中处理完；
+// 清除失败的用户留到下次扫描重试，一整批都失败时停止，避免反复处理同一批。
+// 用户记录本身保留，其他表中引用该用户的数据不会失去关联
+func (uc *UserUsecase) PurgeDeletedUsers(ctx context.Context) (int, error) {
+	now := time.Now()
+	purged := 0
+	defer func() {
+		if purged > 0 {
+			uc.log.Infof("anonymized %d deleted users", purged)
+		}
+	}()
+	for ctx.Err() == nil {
+		ids, err := uc.repo.ListPurgeableUsers(ctx, now.Add(-uc.gracePeriod), purgeBatch)
+		if err != nil {
+			return purged, err
+		}
+		batch := 0
+		for _, id := range ids {
+			if err := uc.repo.AnonymizeUser(ctx, id, now); err != nil {
+				uc.log.Errorf("anonymize user %d failed: %v", id, err)
+				continue
+			}
+			batch++
+		}
+		purged += batch
+		if len(ids) < purgeBatch || batch == 0 {
+			break
+		}
+	}
+	return purged, ctx.Err()
+}
+
+// ExportMyData 导出当前用户的资料、通知偏好和登录会话
+func (uc *UserUsecase) ExportMyData(ctx context.Context) (*UserDataExport, error) {
+	if err := rejectImpersonation(ctx, "exporting personal data"); err != nil {
+		return nil, err
+	}
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	user, err := uc.repo.GetUserById(ctx, claims.ID)
+	if err != nil {
+		return nil, userpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	sessions, err := uc.authRepo.ListUserSessions(ctx, claims.ID)
+	if err != nil {
+		return nil, userpb.ErrorExportDataFailed("failed to list sessions: %v", err)
+	}
+	return &UserDataExport{
+		ExportedAt:  time.Now(),
+		User:        user,
+		Preferences: ParseNotificationPrefs(user),
+		Sessions:    sessions,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// failingAnonymizeRepo 清除 fail 返回 true 的用户时失败
+type failingAnonymizeRepo struct {
+	memUserRepo
+	fail func(id int64) bool
+}
+
+func (r failingAnonymizeRepo) AnonymizeUser(ctx context.Context, id int64, at time.Time) error {
+	if r.fail(id) {
+		return errors.New("anonymize failed")
+	}
+	return r.memUserRepo.AnonymizeUser(ctx, id, at)
+}
+
+// addDeletedUsers 添加 n 个在 deletedAt 删除的用户，返回最后一个用户
+func addDeletedUsers(store *memStore, n int, deletedAt time.Time) *po.User {
+	var u *po.User
+	for range n {
+		u = store.addUser(&po.User{Role: RoleUser, DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true}})
+	}
+	return u
+}
+
+func anonymizedCount(store *memStore) int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	n := 0
+	for _, u := range store.users {
+		if u.AnonymizedAt != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func TestUserUsecase_PurgeDeletedUsersDrainsBacklog(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	expired := time.Now().Add(-defaultDeletionGracePeriod - time.Hour)
+	addDeletedUsers(store, 2*purgeBatch+5, expired)
+	recent := addDeletedUsers(store, 3, time.Now().Add(-time.Hour))
+
+	// 积压超过一批时一次扫描处理完，宽限期内的用户保留
+	purged, err := users.PurgeDeletedUsers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2*purgeBatch+5, purged)
+	assert.Equal(t, 2*purgeBatch+5, anonymizedCount(store))
+	assert.Nil(t, store.find(func(u *po.User) bool { return u.ID == recent.ID }).AnonymizedAt)
+
+	purged, err = users.PurgeDeletedUsers(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+}
+
+func TestUserUsecase_PurgeDeletedUsersSkipsFailures(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	expired := time.Now().Add(-defaultDeletionGracePeriod - time.Hour)
+	failing := addDeletedUsers(store, 1, expired)
+	addDeletedUsers(store, 2*purgeBatch, expired)
+	users.repo = failingAnonymizeRepo{memUserRepo: memUserRepo{s: store}, fail: func(id int64) bool { return id == failing.ID }}
+
+	// 清除失败的用户不影响其他用户，留到下次扫描重试
+	purged, err := users.PurgeDeletedUsers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2*purgeBatch, purged)
+
+	// 一整批都失败时停止，不会反复处理同一批
+	addDeletedUsers(store, purgeBatch-1, expired)
+	users.repo = failingAnonymizeRepo{memUserRepo: memUserRepo{s: store}, fail: func(int64) bool { return true }}
+	purged, err = users.PurgeDeletedUsers(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	users.repo = memUserRepo{s: store}
+	purged, err = users.PurgeDeletedUsers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, purgeBatch, purged)
+}
@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserUsecase_UpdateUserHashesHashLikeInput(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser, CreatedAt: time.Now()})
+
+	// 普通用户提交哈希格式的“密码”时按明文加密保存，不能借此绕过密码策略或写入参数极大的哈希
+	for _, input := range []string{
+		"$argon2id$v=19$m=4294967295,t=4294967295,p=255$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=1$c2FsdHNhbHRzYWx0$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U",
+	} {
+		_, err := users.UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: alice.Name, Email: alice.Email, Password: input})
+		require.NoError(t, err)
+		stored := store.find(func(u *po.User) bool { return u.ID == alice.ID })
+		assert.NotEqual(t, input, stored.Password)
+		assert.True(t, hash.Check(input, stored.Password))
+	}
+
+	// 提交的密码仍然按密码策略校验
+	_, err := users.UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: alice.Name, Email: alice.Email, Password: "short"})
+	assert.True(t, authpb.IsWeakPassword(err))
+}
+
+func TestUserUsecase_UpdateUserWithPersonalAccessToken(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Password: "stored-hash", Role: RoleUser})
+	ctx := jwt.NewContext(context.Background(), &UserClaims{ID: alice.ID, Name: alice.Name, Role: alice.Role, PATID: 1})
+
+	// 个人访问令牌不能修改密码和邮箱，泄露的令牌不能用来接管账号
+	for _, update := range []*po.User{
+		{ID: alice.ID, Name: alice.Name, Email: alice.Email, Password: "Alice-Secret-2"},
+		{ID: alice.ID, Name: alice.Name, Email: "mallory@example.com"},
+	} {
+		_, err := users.UpdateUser(ctx, update)
+		assert.True(t, authpb.IsUnauthorized(err), update)
+	}
+	stored := store.find(func(u *po.User) bool { return u.ID == alice.ID })
+	assert.Equal(t, "stored-hash", stored.Password)
+	assert.Equal(t, "alice@example.com", stored.Email)
+
+	// 其他资料仍然可以修改
+	_, err := users.UpdateUser(ctx, &po.User{ID: alice.ID, Name: "alice2", Email: alice.Email})
+	require.NoError(t, err)
+	assert.Equal(t, "alice2", store.find(func(u *po.User) bool { return u.ID == alice.ID }).Name)
+}
+
+func TestUserUsecase_ImportUser(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	imported, err := hash.BcryptHashCost("imported-secret", 4)
+	require.NoError(t, err)
+
+	// 只有拥有 user:import 权限的管理员可以导入哈希
+	_, err = users.ImportUser(asUser(alice), &po.User{Name: "bob", Email: "bob@example.com", Password: imported})
+	assert.True(t, authpb.IsUnauthorized(err))
+
+	bob, err := users.ImportUser(asUser(admin), &po.User{Name: "bob", Email: "bob@example.com", Password: imported})
+	require.NoError(t, err)
+	assert.Equal(t, imported, store.find(func(u *po.User) bool { return u.ID == bob.ID }).Password)
+
+	// 不是支持的哈希格式，或者参数超出上限时拒绝导入
+	for _, password := range []string{
+		"plain-secret",
+		"$argon2id$v=19$m=4294967295,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+	} {
+		_, err = users.ImportUser(asUser(admin), &po.User{Name: "carol", Email: "carol@example.com", Password: password})
+		assert.True(t, authpb.IsWeakPassword(err), password)
+	}
+
+	// 普通创建用户时哈希格式的输入同样按明文加密
+	carol, err := users.SaveUser(asUser(admin), &po.User{Name: "carol", Email: "carol@example.com", Password: imported})
+	require.NoError(t, err)
+	stored := store.find(func(u *po.User) bool { return u.ID == carol.ID })
+	assert.NotEqual(t, imported, stored.Password)
+	assert.True(t, hash.Check(imported, stored.Password))
+}
+
+func TestUserUsecase_SaveUserRejectsNameAndEmailOfDeletedUser(t *testing.T) {
+	users, store := newTestUsers(t, nil)
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleAdmin})
+	ctx := asUser(admin)
+
+	bob, err := users.SaveUser(ctx, &po.User{Name: "bob", Email: "bob@example.com", Password: "Bob-Secret-1"})
+	require.NoError(t, err)
+	carol, err := users.SaveUser(ctx, &po.User{Name: "carol", Email: "carol@example.com", Password: "Carol-Secret-1"})
+	require.NoError(t, err)
+	_, err = users.DeleteUser(ctx, &po.User{ID: bob.ID})
+	require.NoError(t, err)
+
+	// 宽限期内删除的用户仍占用唯一索引，创建和修改都返回 USER_ALREADY_EXISTS 而不是数据库错误
+	_, err = users.SaveUser(ctx, &po.User{Name: "bob", Email: "bob2@example.com", Password: "Bob-Secret-1"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	_, err = users.SaveUser(ctx, &po.User{Name: "bob2", Email: "bob@example.com", Password: "Bob-Secret-1"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	_, err = users.UpdateUser(ctx, &po.User{ID: carol.ID, Name: "bob", Email: carol.Email})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+	_, err = users.UpdateUser(ctx, &po.User{ID: carol.ID, Name: carol.Name, Email: "bob@example.com"})
+	assert.True(t, authpb.IsUserAlreadyExists(err))
+}
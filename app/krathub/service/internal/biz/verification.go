@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+)
+
+const (
+	defaultVerifyEmailTTL   = 24 * time.Hour
+	defaultPasswordResetTTL = time.Hour
+)
+
+// 一次性验证 token 的用途
+const (
+	TokenPurposeVerifyEmail   = "verify_email"
+	TokenPurposePasswordReset = "password_reset"
+)
+
+// VerificationTokenStore 邮件中一次性验证 token 的存储接口
+type VerificationTokenStore interface {
+	// SaveVerificationToken 保存 token 及签发时的收件邮箱，同一用户同一用途之前签发的 token 随即失效
+	SaveVerificationToken(ctx context.Context, purpose, token string, userID int64, email string, expiration time.Duration) error
+
+	// ConsumeVerificationToken 原子地取出并删除 token，返回用户 ID 和签发时的收件邮箱，不存在或已过期时返回 0
+	ConsumeVerificationToken(ctx context.Context, purpose, token string) (int64, string, error)
+
+	// DeleteVerificationToken 作废用户某一用途尚未使用的 token
+	DeleteVerificationToken(ctx context.Context, purpose string, userID int64) error
+}
+
+func (uc *AuthUsecase) verifyEmailTTL() time.Duration {
+	if ttl := uc.cfg.GetAccount().GetVerifyEmailTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultVerifyEmailTTL
+}
+
+func (uc *AuthUsecase) passwordResetTTL() time.Duration {
+	if ttl := uc.cfg.GetAccount().GetPasswordResetTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultPasswordResetTTL
+}
+
+// sendTokenMail 签发一次性 token 并发送带链接的邮件
+func (uc *AuthUsecase) sendTokenMail(ctx context.Context, user *po.User, purpose, kind, baseURL string, ttl time.Duration) error {
+	if uc.mailer == nil {
+		return nil
+	}
+	token, err := uc.generateRefreshToken()
+	if err != nil {
+		return authpb.ErrorTokenGenerationFailed("failed to generate %s token: %v", purpose, err)
+	}
+	if err := uc.repo.SaveVerificationToken(ctx, purpose, token, user.ID, user.Email, ttl); err != nil {
+		return authpb.ErrorTokenGenerationFailed("failed to save %s token: %v", purpose, err)
+	}
+	return uc.mailer.Notify(ctx, user.Email, kind, "", map[string]any{
+		"AppName":   uc.appName(),
+		"Name":      user.Name,
+		"Link":      tokenLink(baseURL, token),
+		"ExpiresIn": formatTTL(ttl),
+	})
+}
+
+func (uc *AuthUsecase) sendVerificationEmail(ctx context.Context, user *po.User) error {
+	return uc.sendTokenMail(ctx, user, TokenPurposeVerifyEmail, mail.KindVerifyEmail,
+		uc.cfg.GetAccount().GetVerifyEmailUrl(), uc.verifyEmailTTL())
+}
+
+// VerifyEmail 使用验证邮件中的 token 完成邮箱验证。token 只能验证签发时的邮箱，之后修改过邮箱时失效
+func (uc *AuthUsecase) VerifyEmail(ctx context.Context, token string) error {
+	userID, email, err := uc.repo.ConsumeVerificationToken(ctx, TokenPurposeVerifyEmail, token)
+	if err != nil || userID == 0 {
+		return authpb.ErrorInvalidVerificationToken("invalid or expired verification token")
+	}
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	if !strings.EqualFold(user.Email, email) {
+		return authpb.ErrorInvalidVerificationToken("invalid or expired verification token")
+	}
+	if err := uc.repo.SetEmailVerified(ctx, userID, true); err != nil {
+		return authpb.ErrorUserNotFound("failed to verify email: %v", err)
+	}
+	return nil
+}
+
+// ResendVerificationEmail 重新发送验证邮件，邮箱不存在或已验证时静默返回，避免泄露注册信息
+func (uc *AuthUsecase) ResendVerificationEmail(ctx context.Context, email string) error {
+	user, err := uc.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return authpb.ErrorUserNotFound("failed to get user: %v", err)
+	}
+	if user == nil || user.EmailVerified {
+		return nil
+	}
+	return uc.sendVerificationEmail(ctx, user)
+}
+
+// RequestPasswordReset 发送密码重置邮件，邮箱不存在时静默返回，避免泄露注册信息
+func (uc *AuthUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := uc.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return authpb.ErrorUserNotFound("failed to get user: %v", err)
+	}
+	if user == nil {
+		uc.log.Infof("password reset requested for unknown email %s", email)
+		return nil
+	}
+	return uc.sendTokenMail(ctx, user, TokenPurposePasswordReset, mail.KindPasswordReset,
+		uc.cfg.GetAccount().GetPasswordResetUrl(), uc.passwordResetTTL())
+}
+
+// ResetPassword 使用重置邮件中的 token 设置新密码，并注销该用户的全部会话
+func (uc *AuthUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	// 先校验密码，不符合规则时 token 不被消耗，用户可以换一个密码重试
+	hashed, err := uc.passwords.HashNew(password)
+	if err != nil {
+		return err
+	}
+	userID, email, err := uc.repo.ConsumeVerificationToken(ctx, TokenPurposePasswordReset, token)
+	if err != nil || userID == 0 {
+		err = authpb.ErrorInvalidVerificationToken("invalid or expired password reset token")
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionPasswordReset, Err: err})
+		return err
+	}
+	user, err := uc.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	// 发出重置邮件后修改了邮箱，原邮箱收到的链接不能再用于重置密码
+	if !strings.EqualFold(user.Email, email) {
+		err = authpb.ErrorInvalidVerificationToken("invalid or expired password reset token")
+		uc.audit.Record(ctx, AuditEntry{Action: AuditActionPasswordReset, TargetType: AuditTargetUser, TargetID: userID, Err: err})
+		return err
+	}
+	if err := uc.repo.UpdatePassword(ctx, userID, hashed); err != nil {
+		return authpb.ErrorUserNotFound("failed to reset password: %v", err)
+	}
+	uc.audit.Record(ctx, AuditEntry{
+		Action: AuditActionPasswordReset, ActorID: user.ID, ActorName: user.Name,
+		TargetType: AuditTargetUser, TargetID: user.ID,
+	})
+	// 能收到重置邮件说明邮箱属于该用户
+	if !user.EmailVerified {
+		if err := uc.repo.SetEmailVerified(ctx, userID, true); err != nil {
+			uc.log.Warnf("mark email of user %d verified failed: %v", userID, err)
+		}
+	}
+
+	if err := uc.revoker.RevokeUserTokens(ctx, userID); err != nil {
+		uc.log.Errorf("revoke access tokens of user %d failed: %v", userID, err)
+	}
+	if err := uc.repo.DeleteUserRefreshTokens(ctx, userID); err != nil {
+		uc.log.Errorf("revoke refresh tokens of user %d failed: %v", userID, err)
+	}
+	uc.sendSecurityAlert(ctx, user, "Your password was reset.")
+	return nil
+}
+
+// tokenLink 把 token 作为查询参数附加到前端页面地址，未配置地址时只返回 token
+func tokenLink(baseURL, token string) string {
+	if baseURL == "" {
+		return token
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return token
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// formatTTL 去掉 time.Duration 字符串末尾多余的零值单位，例如 24h0m0s -> 24h
+func formatTTL(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
@@ -0,0 +1,171 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// addUserWithPassword 添加使用本地密码登录的用户
+func addUserWithPassword(t *testing.T, e *testEnv, name, password string) *po.User {
+	t.Helper()
+	hashed, err := e.passwords.Hash(password)
+	require.NoError(t, err)
+	return e.store.addUser(&po.User{Name: name, Email: name + "@example.com", Password: hashed, Role: RoleUser})
+}
+
+func TestAuthUsecase_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+
+	// 未注册或已验证的邮箱不发送邮件
+	require.NoError(t, uc.ResendVerificationEmail(ctx, "nobody@example.com"))
+	assert.Empty(t, e.sentMails())
+
+	// 重新发送后之前的 token 失效
+	require.NoError(t, uc.ResendVerificationEmail(ctx, alice.Email))
+	first := e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)
+	require.NoError(t, uc.ResendVerificationEmail(ctx, alice.Email))
+	second := e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)
+	require.NotEqual(t, first, second)
+	mails := e.sentMails()
+	require.Len(t, mails, 2)
+	assert.Equal(t, mail.KindVerifyEmail, mails[1].Headers["X-Notification-Kind"])
+	assert.True(t, authpb.IsInvalidVerificationToken(uc.VerifyEmail(ctx, first)))
+
+	require.NoError(t, uc.VerifyEmail(ctx, second))
+	assert.True(t, e.store.user(alice.ID).EmailVerified)
+
+	// token 只能使用一次
+	assert.True(t, authpb.IsInvalidVerificationToken(uc.VerifyEmail(ctx, second)))
+	require.NoError(t, uc.ResendVerificationEmail(ctx, alice.Email))
+	assert.Empty(t, e.sentMails())
+}
+
+func TestAuthUsecase_VerifyEmailExpired(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+
+	require.NoError(t, uc.ResendVerificationEmail(ctx, alice.Email))
+	token := e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)
+	e.store.expireVerificationTokens()
+	assert.True(t, authpb.IsInvalidVerificationToken(uc.VerifyEmail(ctx, token)))
+	assert.False(t, e.store.user(alice.ID).EmailVerified)
+}
+
+// setEmail 直接修改存储中的邮箱，绕过 UpdateUser 对验证 token 的作废
+func setEmail(store *memStore, id int64, email string) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.users[id].Email = email
+}
+
+func TestAuthUsecase_VerifyEmailAfterEmailChange(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+
+	// token 只能验证签发时的邮箱
+	require.NoError(t, uc.ResendVerificationEmail(ctx, alice.Email))
+	token := e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)
+	setEmail(e.store, alice.ID, "alice-new@example.com")
+	assert.True(t, authpb.IsInvalidVerificationToken(uc.VerifyEmail(ctx, token)))
+	assert.False(t, e.store.user(alice.ID).EmailVerified)
+
+	// 修改邮箱时作废尚未使用的 token，已验证的邮箱需要重新验证
+	require.NoError(t, uc.ResendVerificationEmail(ctx, "alice-new@example.com"))
+	verify := e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID)
+	require.NoError(t, uc.RequestPasswordReset(ctx, "alice-new@example.com"))
+	require.NoError(t, e.authRepo().SetEmailVerified(ctx, alice.ID, true))
+	_, err := e.users().UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: "alice", Email: "alice-other@example.com"})
+	require.NoError(t, err)
+	assert.False(t, e.store.user(alice.ID).EmailVerified)
+	assert.Empty(t, e.store.verificationToken(TokenPurposeVerifyEmail, alice.ID))
+	assert.Empty(t, e.store.verificationToken(TokenPurposePasswordReset, alice.ID))
+	assert.True(t, authpb.IsInvalidVerificationToken(uc.VerifyEmail(ctx, verify)))
+}
+
+func TestAuthUsecase_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+	pair, err := uc.issueTokenPair(ctx, alice)
+	require.NoError(t, err)
+
+	// 未注册的邮箱静默返回
+	require.NoError(t, uc.RequestPasswordReset(ctx, "nobody@example.com"))
+	assert.Empty(t, e.sentMails())
+
+	require.NoError(t, uc.RequestPasswordReset(ctx, alice.Email))
+	mails := e.sentMails()
+	require.Len(t, mails, 1)
+	assert.Equal(t, mail.KindPasswordReset, mails[0].Headers["X-Notification-Kind"])
+	token := e.store.verificationToken(TokenPurposePasswordReset, alice.ID)
+
+	// 密码不符合规则时不消耗 token
+	require.Error(t, uc.ResetPassword(ctx, token, "short"))
+	require.NoError(t, uc.ResetPassword(ctx, token, "Alice-Secret-2"))
+
+	// 新密码生效，旧密码失效，重置前的会话全部注销
+	assert.False(t, hash.Check("Alice-Secret-1", e.store.user(alice.ID).Password))
+	assert.True(t, hash.Check("Alice-Secret-2", e.store.user(alice.ID).Password))
+	_, err = uc.RefreshToken(ctx, pair.RefreshToken)
+	assert.True(t, authpb.IsInvalidRefreshToken(err))
+	assert.True(t, e.store.user(alice.ID).EmailVerified)
+	assert.Contains(t, auditActions(e.store), AuditActionPasswordReset)
+
+	// token 只能使用一次
+	err = uc.ResetPassword(ctx, token, "Alice-Secret-3")
+	assert.True(t, authpb.IsInvalidVerificationToken(err))
+}
+
+func TestAuthUsecase_ResetPasswordExpired(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+
+	require.NoError(t, uc.RequestPasswordReset(ctx, alice.Email))
+	token := e.store.verificationToken(TokenPurposePasswordReset, alice.ID)
+	e.store.expireVerificationTokens()
+	err := uc.ResetPassword(ctx, token, "Alice-Secret-2")
+	assert.True(t, authpb.IsInvalidVerificationToken(err))
+	assert.Equal(t, alice.Password, e.store.user(alice.ID).Password)
+}
+
+func TestAuthUsecase_ResetPasswordAfterEmailChange(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEnv(t, nil)
+	uc := e.auth()
+	alice := addUserWithPassword(t, e, "alice", "Alice-Secret-1")
+
+	// 原邮箱收到的重置链接在修改邮箱后不能再使用
+	require.NoError(t, uc.RequestPasswordReset(ctx, alice.Email))
+	token := e.store.verificationToken(TokenPurposePasswordReset, alice.ID)
+	setEmail(e.store, alice.ID, "alice-new@example.com")
+	err := uc.ResetPassword(ctx, token, "Alice-Secret-2")
+	assert.True(t, authpb.IsInvalidVerificationToken(err))
+	assert.Equal(t, alice.Password, e.store.user(alice.ID).Password)
+
+	// 通过 UpdateUser 修改邮箱时直接作废重置 token
+	require.NoError(t, uc.RequestPasswordReset(ctx, "alice-new@example.com"))
+	token = e.store.verificationToken(TokenPurposePasswordReset, alice.ID)
+	_, err = e.users().UpdateUser(asUser(alice), &po.User{ID: alice.ID, Name: "alice", Email: "alice-other@example.com"})
+	require.NoError(t, err)
+	err = uc.ResetPassword(ctx, token, "Alice-Secret-2")
+	assert.True(t, authpb.IsInvalidVerificationToken(err))
+	assert.Equal(t, alice.Password, e.store.user(alice.ID).Password)
+}
@@ -0,0 +1,440 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	webhookpb "github.com/ToAtlas/AtlasBackend/api/gen/go/webhook/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/webhook"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// 可订阅的事件类型
+const (
+	EventUserSignup  = "user.signup"
+	EventUserLogin   = "user.login"
+	EventUserUpdated = "user.updated"
+	EventUserDeleted = "user.deleted"
+	// EventUserRestored 管理员在宽限期内恢复了已删除的账号
+	EventUserRestored = "user.restored"
+	// EventUserDisabled、EventUserEnabled 管理员停用或重新启用账号
+	EventUserDisabled = "user.disabled"
+	EventUserEnabled  = "user.enabled"
+	// EventUserTokenReuse 已轮换的 Refresh Token 被重放，相关会话已被注销
+	EventUserTokenReuse = "user.token_reuse"
+	// EventUserNotification 用户选择通过 webhook 渠道接收的通知
+	EventUserNotification = "user.notification"
+
+	// EventAll 订阅全部事件
+	EventAll = "*"
+)
+
+// WebhookEvents 当前支持的全部事件类型
+var WebhookEvents = []string{EventUserSignup, EventUserLogin, EventUserUpdated, EventUserDeleted, EventUserRestored, EventUserDisabled, EventUserEnabled, EventUserTokenReuse, EventUserNotification}
+
+// 投递状态
+const (
+	DeliveryPending = "pending"
+	DeliverySuccess = "success"
+	DeliveryFailed  = "failed"
+)
+
+const (
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookRetryBackoff = 30 * time.Second
+	defaultWebhookMaxBackoff   = time.Hour
+	defaultWebhookPollInterval = 5 * time.Second
+	// 领取投递记录后的租约时长，超过后其他实例可以重新领取
+	webhookDeliveryLease = 2 * time.Minute
+	webhookDispatchBatch = 50
+	webhookEventSep      = ","
+)
+
+// EventPublisher 业务事件发布接口，发布失败不应影响业务操作本身
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType string, data any)
+}
+
+// WebhookRepo Webhook 订阅与投递记录仓库
+type WebhookRepo interface {
+	CreateWebhook(context.Context, *po.Webhook) (*po.Webhook, error)
+	GetWebhook(context.Context, int64) (*po.Webhook, error)
+	ListWebhooks(context.Context) ([]*po.Webhook, error)
+	ListActiveWebhooks(context.Context) ([]*po.Webhook, error)
+	UpdateWebhook(context.Context, *po.Webhook) (*po.Webhook, error)
+	DeleteWebhook(context.Context, int64) error
+
+	CreateDeliveries(context.Context, []*po.WebhookDelivery) error
+	GetDelivery(context.Context, int64) (*po.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, webhookID int64, status string, offset, limit int) ([]*po.WebhookDelivery, int64, error)
+	// ListDueDeliveries 查询 next_attempt_at 已到期的待投递记录
+	ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*po.WebhookDelivery, error)
+	// ClaimDelivery 通过条件更新把 next_attempt_at 推迟到 leaseUntil，返回是否领取成功，
+	// 保证多实例部署时同一条记录只会被一个实例投递
+	ClaimDelivery(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error)
+	UpdateDelivery(context.Context, *po.WebhookDelivery) error
+}
+
+// WebhookEvent 投递给订阅方的事件内容
+type WebhookEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// WebhookUsecase 管理 Webhook 订阅并负责事件投递
+type WebhookUsecase struct {
+	repo   WebhookRepo
+	log    *log.Helper
+	client *webhook.Client
+
+	maxAttempts  int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	pollInterval time.Duration
+
+	notify chan struct{}
+}
+
+// NewWebhookUsecase new a webhook usecase.
+func NewWebhookUsecase(repo WebhookRepo, logger log.Logger, cfg *conf.App) *WebhookUsecase {
+	wc := cfg.GetWebhook()
+	uc := &WebhookUsecase{
+		repo:         repo,
+		log:          log.NewHelper(pkglogger.WithModule(logger, "webhook/biz/krathub-service")),
+		client:       webhook.NewClient(wc.GetTimeout().AsDuration(), "", wc.GetAllowedInternalHosts()),
+		maxAttempts:  int(wc.GetMaxAttempts()),
+		retryBackoff: wc.GetRetryBackoff().AsDuration(),
+		maxBackoff:   wc.GetMaxBackoff().AsDuration(),
+		pollInterval: wc.GetPollInterval().AsDuration(),
+		notify:       make(chan struct{}, 1),
+	}
+	if uc.maxAttempts <= 0 {
+		uc.maxAttempts = defaultWebhookMaxAttempts
+	}
+	if uc.retryBackoff <= 0 {
+		uc.retryBackoff = defaultWebhookRetryBackoff
+	}
+	if uc.maxBackoff <= 0 {
+		uc.maxBackoff = defaultWebhookMaxBackoff
+	}
+	if uc.pollInterval <= 0 {
+		uc.pollInterval = defaultWebhookPollInterval
+	}
+	return uc
+}
+
+// CreateWebhook 创建订阅，secret 为空时自动生成，返回的 secret 只在此处可见
+func (uc *WebhookUsecase) CreateWebhook(ctx context.Context, hook *po.Webhook, events []string) (*po.Webhook, error) {
+	if err := uc.normalize(hook, events); err != nil {
+		return nil, err
+	}
+	if hook.Secret == "" {
+		hook.Secret = webhook.GenerateSecret()
+	}
+	hook.Active = true
+	created, err := uc.repo.CreateWebhook(ctx, hook)
+	if err != nil {
+		return nil, webhookpb.ErrorSaveWebhookFailed("failed to create webhook: %v", err)
+	}
+	return created, nil
+}
+
+// ListWebhooks 列出所有订阅
+func (uc *WebhookUsecase) ListWebhooks(ctx context.Context) ([]*po.Webhook, error) {
+	return uc.repo.ListWebhooks(ctx)
+}
+
+// UpdateWebhook 更新订阅，rotateSecret 为 true 时重新生成密钥
+func (uc *WebhookUsecase) UpdateWebhook(ctx context.Context, hook *po.Webhook, events []string, rotateSecret bool) (*po.Webhook, error) {
+	orig, err := uc.repo.GetWebhook(ctx, hook.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.normalize(hook, events); err != nil {
+		return nil, err
+	}
+	hook.Secret = orig.Secret
+	if rotateSecret {
+		hook.Secret = webhook.GenerateSecret()
+	}
+	hook.CreatedAt = orig.CreatedAt
+	updated, err := uc.repo.UpdateWebhook(ctx, hook)
+	if err != nil {
+		return nil, webhookpb.ErrorSaveWebhookFailed("failed to update webhook: %v", err)
+	}
+	return updated, nil
+}
+
+// DeleteWebhook 删除订阅，历史投递记录保留
+func (uc *WebhookUsecase) DeleteWebhook(ctx context.Context, id int64) error {
+	if _, err := uc.repo.GetWebhook(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.DeleteWebhook(ctx, id)
+}
+
+// ListDeliveries 分页查询投递记录，page 从 1 开始
+func (uc *WebhookUsecase) ListDeliveries(ctx context.Context, webhookID int64, status string, page, pageSize int) ([]*po.WebhookDelivery, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return uc.repo.ListDeliveries(ctx, webhookID, status, (page-1)*pageSize, pageSize)
+}
+
+// RedeliverEvent 使用原事件内容生成一条新的投递记录并立即投递
+func (uc *WebhookUsecase) RedeliverEvent(ctx context.Context, deliveryID int64) (*po.WebhookDelivery, error) {
+	orig, err := uc.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetWebhook(ctx, orig.WebhookID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	d := &po.WebhookDelivery{
+		WebhookID:     orig.WebhookID,
+		EventID:       orig.EventID,
+		EventType:     orig.EventType,
+		Payload:       orig.Payload,
+		Status:        DeliveryPending,
+		NextAttemptAt: &now,
+	}
+	if err := uc.repo.CreateDeliveries(ctx, []*po.WebhookDelivery{d}); err != nil {
+		return nil, webhookpb.ErrorSaveWebhookFailed("failed to create delivery: %v", err)
+	}
+	uc.wake()
+	return d, nil
+}
+
+// Publish 为所有订阅了该事件的启用中 Webhook 生成投递记录，由后台投递。
+// 只记录日志不返回错误，webhook 故障不能影响登录、注册等业务操作。
+func (uc *WebhookUsecase) Publish(ctx context.Context, eventType string, data any) {
+	event := &WebhookEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	if err := uc.publish(ctx, event); err != nil {
+		uc.log.Errorf("publish %s failed: %v", eventType, err)
+	}
+}
+
+// PublishEvent 把事件总线上的领域事件转为 webhook 投递记录，
+// 失败时返回错误由事件总线重新投递，事件 ID 保持不变便于订阅方去重
+func (uc *WebhookUsecase) PublishEvent(ctx context.Context, e *DomainEvent) error {
+	return uc.publish(ctx, &WebhookEvent{
+		ID:        e.ID,
+		Type:      e.Type,
+		CreatedAt: e.OccurredAt.UTC(),
+		Data:      e.Data,
+	})
+}
+
+func (uc *WebhookUsecase) publish(ctx context.Context, event *WebhookEvent) error {
+	hooks, err := uc.repo.ListActiveWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+	var targets []*po.Webhook
+	for _, h := range hooks {
+		if subscribed(h, event.Type) {
+			targets = append(targets, h)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	now := time.Now()
+	deliveries := make([]*po.WebhookDelivery, 0, len(targets))
+	for _, h := range targets {
+		deliveries = append(deliveries, &po.WebhookDelivery{
+			WebhookID:     h.ID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        DeliveryPending,
+			NextAttemptAt: &now,
+		})
+	}
+	if err := uc.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("save deliveries: %w", err)
+	}
+	uc.wake()
+	return nil
+}
+
+// Notify 有新的投递记录时会收到信号，供后台投递器及时处理
+func (uc *WebhookUsecase) Notify() <-chan struct{} {
+	return uc.notify
+}
+
+// PollInterval 后台投递器扫描到期记录的间隔
+func (uc *WebhookUsecase) PollInterval() time.Duration {
+	return uc.pollInterval
+}
+
+// DispatchDue 投递所有已到期的记录，返回本次处理的数量
+func (uc *WebhookUsecase) DispatchDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := uc.repo.ListDueDeliveries(ctx, now, webhookDispatchBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	hooks := make(map[int64]*po.Webhook)
+	n := 0
+	for _, d := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := uc.repo.ClaimDelivery(ctx, d.ID, now, now.Add(webhookDeliveryLease))
+		if err != nil {
+			uc.log.Warnf("claim webhook delivery %d failed: %v", d.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		hook, found := hooks[d.WebhookID]
+		if !found {
+			hook, err = uc.repo.GetWebhook(ctx, d.WebhookID)
+			if err != nil {
+				hook = nil
+			}
+			hooks[d.WebhookID] = hook
+		}
+		uc.deliver(ctx, hook, d)
+		n++
+	}
+	return n, nil
+}
+
+// deliver 执行一次投递并记录结果
+func (uc *WebhookUsecase) deliver(ctx context.Context, hook *po.Webhook, d *po.WebhookDelivery) {
+	d.Attempts++
+	if hook == nil || !hook.Active {
+		// 订阅已删除或停用，不再重试
+		d.Status = DeliveryFailed
+		d.NextAttemptAt = nil
+		d.LastError = strPtr("webhook deleted or disabled")
+		uc.saveDelivery(ctx, d)
+		return
+	}
+
+	res, err := uc.client.Deliver(ctx, &webhook.Request{
+		URL:        hook.URL,
+		Secret:     hook.Secret,
+		Event:      d.EventType,
+		DeliveryID: strconv.FormatInt(d.ID, 10),
+		Body:       []byte(d.Payload),
+	})
+	d.ResponseCode = int32(res.StatusCode)
+	d.DurationMs = res.Duration.Milliseconds()
+
+	switch {
+	case err == nil:
+		now := time.Now()
+		d.Status = DeliverySuccess
+		d.DeliveredAt = &now
+		d.NextAttemptAt = nil
+		d.LastError = nil
+	case int(d.Attempts) >= uc.maxAttempts:
+		d.Status = DeliveryFailed
+		d.NextAttemptAt = nil
+		d.LastError = strPtr(err.Error())
+		uc.log.Warnf("webhook delivery %d to %s failed after %d attempts: %v", d.ID, hook.URL, d.Attempts, err)
+	default:
+		next := time.Now().Add(webhook.Backoff(int(d.Attempts), uc.retryBackoff, uc.maxBackoff))
+		d.Status = DeliveryPending
+		d.NextAttemptAt = &next
+		d.LastError = strPtr(err.Error())
+	}
+	uc.saveDelivery(ctx, d)
+}
+
+func (uc *WebhookUsecase) saveDelivery(ctx context.Context, d *po.WebhookDelivery) {
+	if err := uc.repo.UpdateDelivery(ctx, d); err != nil {
+		uc.log.Errorf("save webhook delivery %d failed: %v", d.ID, err)
+	}
+}
+
+// normalize 校验 URL 与事件类型，并把事件列表编码到 po
+func (uc *WebhookUsecase) normalize(hook *po.Webhook, events []string) error {
+	u, err := url.Parse(hook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return webhookpb.ErrorInvalidWebhook("url must be an absolute http(s) url")
+	}
+	var cleaned []string
+	for _, e := range events {
+		e = strings.TrimSpace(e)
+		if e != EventAll && !slices.Contains(WebhookEvents, e) {
+			return webhookpb.ErrorInvalidWebhook("unknown event type %q", e)
+		}
+		if !slices.Contains(cleaned, e) {
+			cleaned = append(cleaned, e)
+		}
+	}
+	if len(cleaned) == 0 {
+		return webhookpb.ErrorInvalidWebhook("at least one event type is required")
+	}
+	hook.Events = strings.Join(cleaned, webhookEventSep)
+	return nil
+}
+
+func (uc *WebhookUsecase) wake() {
+	select {
+	case uc.notify <- struct{}{}:
+	default:
+	}
+}
+
+// WebhookEventList 解析 po 中以逗号分隔的事件类型
+func WebhookEventList(hook *po.Webhook) []string {
+	if hook.Events == "" {
+		return nil
+	}
+	return strings.Split(hook.Events, webhookEventSep)
+}
+
+// UserEventData 用户相关事件的数据，不包含密码等敏感字段
+func UserEventData(u *po.User) map[string]any {
+	return map[string]any{
+		"id":    u.ID,
+		"name":  u.Name,
+		"email": u.Email,
+		"role":  u.Role,
+	}
+}
+
+func subscribed(hook *po.Webhook, eventType string) bool {
+	events := WebhookEventList(hook)
+	return slices.Contains(events, EventAll) || slices.Contains(events, eventType)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,391 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	webhookpb "github.com/ToAtlas/AtlasBackend/api/gen/go/webhook/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/webhook"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memWebhookRepo Webhook 订阅与投递记录的内存仓库
+type memWebhookRepo struct {
+	mu         sync.Mutex
+	nextID     int64
+	hooks      map[int64]*po.Webhook
+	deliveries []*po.WebhookDelivery
+}
+
+func newMemWebhookRepo() *memWebhookRepo {
+	return &memWebhookRepo{hooks: map[int64]*po.Webhook{}}
+}
+
+func (r *memWebhookRepo) CreateWebhook(_ context.Context, hook *po.Webhook) (*po.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	hook.ID = r.nextID
+	clone := *hook
+	r.hooks[hook.ID] = &clone
+	return hook, nil
+}
+
+func (r *memWebhookRepo) GetWebhook(_ context.Context, id int64) (*po.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hook, ok := r.hooks[id]
+	if !ok {
+		return nil, webhookpb.ErrorWebhookNotFound("webhook %d not found", id)
+	}
+	clone := *hook
+	return &clone, nil
+}
+
+func (r *memWebhookRepo) ListWebhooks(context.Context) ([]*po.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.Webhook
+	for _, h := range r.hooks {
+		clone := *h
+		out = append(out, &clone)
+	}
+	return out, nil
+}
+
+func (r *memWebhookRepo) ListActiveWebhooks(ctx context.Context) ([]*po.Webhook, error) {
+	hooks, _ := r.ListWebhooks(ctx)
+	var out []*po.Webhook
+	for _, h := range hooks {
+		if h.Active {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func (r *memWebhookRepo) UpdateWebhook(_ context.Context, hook *po.Webhook) (*po.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *hook
+	r.hooks[hook.ID] = &clone
+	return hook, nil
+}
+
+func (r *memWebhookRepo) DeleteWebhook(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.hooks, id)
+	return nil
+}
+
+func (r *memWebhookRepo) CreateDeliveries(_ context.Context, deliveries []*po.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, d := range deliveries {
+		r.nextID++
+		d.ID = r.nextID
+		clone := *d
+		r.deliveries = append(r.deliveries, &clone)
+	}
+	return nil
+}
+
+func (r *memWebhookRepo) GetDelivery(_ context.Context, id int64) (*po.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			clone := *d
+			return &clone, nil
+		}
+	}
+	return nil, webhookpb.ErrorDeliveryNotFound("delivery %d not found", id)
+}
+
+func (r *memWebhookRepo) ListDeliveries(_ context.Context, webhookID int64, status string, offset, limit int) ([]*po.WebhookDelivery, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.WebhookID == webhookID && (status == "" || d.Status == status) {
+			clone := *d
+			out = append(out, &clone)
+		}
+	}
+	total := int64(len(out))
+	out = out[min(offset, len(out)):]
+	return out[:min(limit, len(out))], total, nil
+}
+
+func (r *memWebhookRepo) ListDueDeliveries(_ context.Context, now time.Time, limit int) ([]*po.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.WebhookDelivery
+	for _, d := range r.deliveries {
+		if d.Status == DeliveryPending && d.NextAttemptAt != nil && !d.NextAttemptAt.After(now) && len(out) < limit {
+			clone := *d
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memWebhookRepo) ClaimDelivery(_ context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, d := range r.deliveries {
+		if d.ID == id && d.Status == DeliveryPending && d.NextAttemptAt != nil && !d.NextAttemptAt.After(now) {
+			d.NextAttemptAt = &leaseUntil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memWebhookRepo) UpdateDelivery(_ context.Context, delivery *po.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, d := range r.deliveries {
+		if d.ID == delivery.ID {
+			clone := *delivery
+			r.deliveries[i] = &clone
+		}
+	}
+	return nil
+}
+
+func (r *memWebhookRepo) delivery(id int64) *po.WebhookDelivery {
+	d, _ := r.GetDelivery(context.Background(), id)
+	return d
+}
+
+// makeDue 让投递记录立即到期，跳过重试等待
+func (r *memWebhookRepo) makeDue() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, d := range r.deliveries {
+		if d.Status == DeliveryPending {
+			d.NextAttemptAt = &now
+		}
+	}
+}
+
+// webhookReceiver 记录收到的请求，status 为返回的状态码
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newWebhookReceiver(t *testing.T) (*webhookReceiver, *httptest.Server) {
+	t.Helper()
+	rcv := &webhookReceiver{status: http.StatusOK}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rcv.mu.Lock()
+		defer rcv.mu.Unlock()
+		rcv.requests = append(rcv.requests, r)
+		rcv.bodies = append(rcv.bodies, body)
+		w.WriteHeader(rcv.status)
+		_, _ = w.Write([]byte("received"))
+	}))
+	t.Cleanup(srv.Close)
+	return rcv, srv
+}
+
+func (r *webhookReceiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+// newTestWebhooks 允许投递到回环地址，以便使用 httptest 启动的接收端
+func newTestWebhooks(cfg *conf.App_Webhook) (*WebhookUsecase, *memWebhookRepo) {
+	if cfg == nil {
+		cfg = &conf.App_Webhook{}
+	}
+	cfg.AllowedInternalHosts = append(cfg.AllowedInternalHosts, "127.0.0.1")
+	repo := newMemWebhookRepo()
+	return NewWebhookUsecase(repo, log.DefaultLogger, &conf.App{Webhook: cfg}), repo
+}
+
+func TestWebhookUsecase_CreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newTestWebhooks(nil)
+
+	tests := []struct {
+		url    string
+		events []string
+	}{
+		{url: "ftp://example.com/hook", events: []string{EventUserSignup}},
+		{url: "/relative", events: []string{EventUserSignup}},
+		{url: "https://example.com/hook", events: []string{"user.unknown"}},
+		{url: "https://example.com/hook"},
+	}
+	for _, tt := range tests {
+		_, err := uc.CreateWebhook(ctx, &po.Webhook{URL: tt.url}, tt.events)
+		assert.True(t, webhookpb.IsInvalidWebhook(err), "%s %v: %v", tt.url, tt.events, err)
+	}
+
+	// 未指定密钥时自动生成，重复的事件类型只保存一次
+	hook, err := uc.CreateWebhook(ctx, &po.Webhook{URL: "https://example.com/hook"}, []string{EventUserSignup, " user.signup", EventUserLogin})
+	require.NoError(t, err)
+	assert.NotEmpty(t, hook.Secret)
+	assert.True(t, hook.Active)
+	assert.Equal(t, []string{EventUserSignup, EventUserLogin}, WebhookEventList(hook))
+
+	// 更新时保留原密钥，rotateSecret 时重新生成
+	updated, err := uc.UpdateWebhook(ctx, &po.Webhook{ID: hook.ID, URL: "https://example.com/v2", Active: true}, []string{EventAll}, false)
+	require.NoError(t, err)
+	assert.Equal(t, hook.Secret, updated.Secret)
+	rotated, err := uc.UpdateWebhook(ctx, &po.Webhook{ID: hook.ID, URL: "https://example.com/v2", Active: true}, []string{EventAll}, true)
+	require.NoError(t, err)
+	assert.NotEqual(t, hook.Secret, rotated.Secret)
+}
+
+func TestWebhookUsecase_DispatchDue(t *testing.T) {
+	ctx := context.Background()
+	rcv, srv := newWebhookReceiver(t)
+	uc, repo := newTestWebhooks(nil)
+	signup, err := uc.CreateWebhook(ctx, &po.Webhook{URL: srv.URL, Secret: "signup-secret"}, []string{EventUserSignup})
+	require.NoError(t, err)
+	all, err := uc.CreateWebhook(ctx, &po.Webhook{URL: srv.URL}, []string{EventAll})
+	require.NoError(t, err)
+
+	// 只为订阅了该事件的 webhook 生成投递记录
+	uc.Publish(ctx, EventUserLogin, map[string]any{"id": 1})
+	uc.Publish(ctx, EventUserSignup, map[string]any{"id": 2})
+	require.Len(t, repo.deliveries, 3)
+	n, err := uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	require.Equal(t, 3, rcv.count())
+
+	// 请求带有可校验的签名，投递结果写回记录
+	var signupBody []byte
+	for i, r := range rcv.requests {
+		if r.Header.Get(webhook.HeaderEvent) == EventUserSignup && webhook.Verify(signup.Secret, r.Header, rcv.bodies[i], 0, time.Now()) == nil {
+			signupBody = rcv.bodies[i]
+		}
+	}
+	require.NotNil(t, signupBody)
+	var event WebhookEvent
+	require.NoError(t, json.Unmarshal(signupBody, &event))
+	assert.Equal(t, EventUserSignup, event.Type)
+	assert.NotEmpty(t, event.ID)
+	for _, d := range repo.deliveries {
+		assert.Equal(t, DeliverySuccess, d.Status)
+		assert.Equal(t, int32(1), d.Attempts)
+		assert.Equal(t, int32(http.StatusOK), d.ResponseCode)
+		assert.NotNil(t, d.DeliveredAt)
+		assert.Nil(t, d.NextAttemptAt)
+	}
+	list, total, err := uc.ListDeliveries(ctx, all.ID, DeliverySuccess, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, list, 1)
+
+	// 已投递的记录不会再次投递
+	n, err = uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 重新投递生成新的记录，事件 ID 不变
+	redelivered, err := uc.RedeliverEvent(ctx, repo.deliveries[0].ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, repo.deliveries[0].ID, redelivered.ID)
+	assert.Equal(t, repo.deliveries[0].EventID, redelivered.EventID)
+	n, err = uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, DeliverySuccess, repo.delivery(redelivered.ID).Status)
+}
+
+func TestWebhookUsecase_Retry(t *testing.T) {
+	ctx := context.Background()
+	rcv, srv := newWebhookReceiver(t)
+	rcv.setStatus(http.StatusInternalServerError)
+	uc, repo := newTestWebhooks(&conf.App_Webhook{MaxAttempts: 3, RetryBackoff: durationpb.New(time.Minute)})
+	hook, err := uc.CreateWebhook(ctx, &po.Webhook{URL: srv.URL}, []string{EventAll})
+	require.NoError(t, err)
+
+	uc.Publish(ctx, EventUserUpdated, map[string]any{"id": 1})
+	id := repo.deliveries[0].ID
+
+	// 非 2xx 响应视为失败，按退避时间重试
+	_, err = uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	d := repo.delivery(id)
+	assert.Equal(t, DeliveryPending, d.Status)
+	assert.Equal(t, int32(http.StatusInternalServerError), d.ResponseCode)
+	require.NotNil(t, d.LastError)
+	require.NotNil(t, d.NextAttemptAt)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *d.NextAttemptAt, 5*time.Second)
+
+	// 未到重试时间时不投递
+	n, err := uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 达到最大次数后不再重试
+	for range 2 {
+		repo.makeDue()
+		_, err = uc.DispatchDue(ctx)
+		require.NoError(t, err)
+	}
+	d = repo.delivery(id)
+	assert.Equal(t, DeliveryFailed, d.Status)
+	assert.Equal(t, int32(3), d.Attempts)
+	assert.Nil(t, d.NextAttemptAt)
+	assert.Equal(t, 3, rcv.count())
+
+	// 订阅停用后待投递的记录直接失败，不发出请求
+	uc.Publish(ctx, EventUserUpdated, map[string]any{"id": 2})
+	_, err = uc.UpdateWebhook(ctx, &po.Webhook{ID: hook.ID, URL: srv.URL, Active: false}, []string{EventAll}, false)
+	require.NoError(t, err)
+	_, err = uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	d = repo.deliveries[len(repo.deliveries)-1]
+	assert.Equal(t, DeliveryFailed, d.Status)
+	assert.Equal(t, 3, rcv.count())
+}
+
+func TestWebhookUsecase_DispatchInternalTarget(t *testing.T) {
+	ctx := context.Background()
+	rcv, srv := newWebhookReceiver(t)
+	repo := newMemWebhookRepo()
+	uc := NewWebhookUsecase(repo, log.DefaultLogger, &conf.App{Webhook: &conf.App_Webhook{MaxAttempts: 1}})
+	_, err := uc.CreateWebhook(ctx, &po.Webhook{URL: srv.URL}, []string{EventAll})
+	require.NoError(t, err)
+
+	// 默认拒绝投递到内网地址，请求不会发出
+	uc.Publish(ctx, EventUserUpdated, map[string]any{"id": 1})
+	_, err = uc.DispatchDue(ctx)
+	require.NoError(t, err)
+	d := repo.deliveries[0]
+	assert.Equal(t, DeliveryFailed, d.Status)
+	require.NotNil(t, d.LastError)
+	assert.Contains(t, *d.LastError, webhook.ErrForbiddenAddress.Error())
+	assert.Zero(t, rcv.count())
+}
@@ -0,0 +1,395 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	workspacepb "github.com/ToAtlas/AtlasBackend/api/gen/go/workspace/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 工作空间角色
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleAdmin  = "admin"
+	WorkspaceRoleMember = "member"
+	WorkspaceRoleGuest  = "guest"
+)
+
+// workspaceRoleRank 工作空间角色的级别。管理成员需要 admin 及以上角色，
+// 并且只能操作级别不高于自己的成员、分配不高于自己的角色
+var workspaceRoleRank = map[string]int{
+	WorkspaceRoleGuest:  1,
+	WorkspaceRoleMember: 2,
+	WorkspaceRoleAdmin:  3,
+	WorkspaceRoleOwner:  4,
+}
+
+const (
+	defaultInvitationTTL = 7 * 24 * time.Hour
+	invitationTokenBytes = 32
+)
+
+// UserWorkspace 用户加入的工作空间及其在其中的角色
+type UserWorkspace struct {
+	Workspace *po.Workspace
+	Role      string
+}
+
+// WorkspaceMemberInfo 工作空间成员及其用户资料
+type WorkspaceMemberInfo struct {
+	Member *po.WorkspaceMember
+	User   *po.User
+}
+
+// WorkspaceRepo 工作空间仓库
+type WorkspaceRepo interface {
+	// CreateWorkspace 创建工作空间并把创建者加入为 owner
+	CreateWorkspace(ctx context.Context, workspace *po.Workspace, owner *po.WorkspaceMember) error
+	GetWorkspace(ctx context.Context, id int64) (*po.Workspace, error)
+	ListUserWorkspaces(ctx context.Context, userID int64) ([]*UserWorkspace, error)
+	// GetMember 不是成员时返回 nil, nil
+	GetMember(ctx context.Context, workspaceID, userID int64) (*po.WorkspaceMember, error)
+	ListMembers(ctx context.Context, workspaceID int64) ([]*WorkspaceMemberInfo, error)
+	CountMembersByRole(ctx context.Context, workspaceID int64, role string) (int64, error)
+	UpdateMemberRole(ctx context.Context, workspaceID, userID int64, role string) error
+	RemoveMember(ctx context.Context, workspaceID, userID int64) error
+	// SaveInvitation 保存邀请，同一工作空间同一邮箱之前的邀请被替换
+	SaveInvitation(ctx context.Context, invitation *po.WorkspaceInvitation) error
+	ListInvitations(ctx context.Context, workspaceID int64) ([]*po.WorkspaceInvitation, error)
+	// GetInvitationByTokenHash 不存在时返回 nil, nil
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*po.WorkspaceInvitation, error)
+	// DeleteInvitation 返回是否删除了记录
+	DeleteInvitation(ctx context.Context, workspaceID, id int64) (bool, error)
+	// AcceptInvitation 删除邀请并加入成员
+	AcceptInvitation(ctx context.Context, invitation *po.WorkspaceInvitation, member *po.WorkspaceMember) error
+}
+
+// WorkspaceUsecase 管理工作空间、成员和邀请。工作空间角色只在工作空间内有效，与全局 RBAC 角色无关
+type WorkspaceUsecase struct {
+	repo     WorkspaceRepo
+	authRepo AuthRepo
+	mailer   *mail.Mailer
+	cfg      *conf.App
+	log      *log.Helper
+}
+
+// NewWorkspaceUsecase new a workspace usecase.
+func NewWorkspaceUsecase(repo WorkspaceRepo, authRepo AuthRepo, mailer *mail.Mailer, logger log.Logger, cfg *conf.App) *WorkspaceUsecase {
+	return &WorkspaceUsecase{
+		repo:     repo,
+		authRepo: authRepo,
+		mailer:   mailer,
+		cfg:      cfg,
+		log:      log.NewHelper(pkglogger.WithModule(logger, "workspace/biz/krathub-service")),
+	}
+}
+
+// IsWorkspaceRole 判断是否是合法的工作空间角色
+func IsWorkspaceRole(role string) bool {
+	_, ok := workspaceRoleRank[role]
+	return ok
+}
+
+// requireMember 检查当前用户是工作空间成员且角色不低于 minRole。
+// 不是成员时返回工作空间不存在，不泄露其他工作空间的信息
+func (uc *WorkspaceUsecase) requireMember(ctx context.Context, workspaceID int64, minRole string) (*po.WorkspaceMember, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	member, err := uc.repo.GetMember(ctx, workspaceID, claims.ID)
+	if err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to get membership: %v", err)
+	}
+	if member == nil {
+		return nil, workspacepb.ErrorWorkspaceNotFound("workspace %d not found", workspaceID)
+	}
+	if workspaceRoleRank[member.Role] < workspaceRoleRank[minRole] {
+		return nil, workspacepb.ErrorWorkspacePermissionDenied("%s role is required", minRole)
+	}
+	return member, nil
+}
+
+// CreateWorkspace 创建工作空间，当前用户成为 owner
+func (uc *WorkspaceUsecase) CreateWorkspace(ctx context.Context, name string) (*UserWorkspace, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	workspace := &po.Workspace{Name: strings.TrimSpace(name), CreatedBy: claims.ID}
+	if workspace.Name == "" {
+		return nil, workspacepb.ErrorInvalidWorkspaceRequest("workspace name is required")
+	}
+	owner := &po.WorkspaceMember{UserID: claims.ID, Role: WorkspaceRoleOwner}
+	if err := uc.repo.CreateWorkspace(ctx, workspace, owner); err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to create workspace: %v", err)
+	}
+	uc.log.Infof("user %d created workspace %d", claims.ID, workspace.ID)
+	return &UserWorkspace{Workspace: workspace, Role: WorkspaceRoleOwner}, nil
+}
+
+// ListWorkspaces 列出当前用户加入的工作空间
+func (uc *WorkspaceUsecase) ListWorkspaces(ctx context.Context) ([]*UserWorkspace, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	return uc.repo.ListUserWorkspaces(ctx, claims.ID)
+}
+
+// ListMembers 列出工作空间成员，只有成员可以查看
+func (uc *WorkspaceUsecase) ListMembers(ctx context.Context, workspaceID int64) ([]*WorkspaceMemberInfo, error) {
+	if _, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleGuest); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListMembers(ctx, workspaceID)
+}
+
+// UpdateMemberRole 修改成员角色，工作空间至少保留一个 owner
+func (uc *WorkspaceUsecase) UpdateMemberRole(ctx context.Context, workspaceID, userID int64, role string) error {
+	if !IsWorkspaceRole(role) {
+		return workspacepb.ErrorInvalidWorkspaceRequest("invalid workspace role %s", role)
+	}
+	actor, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleAdmin)
+	if err != nil {
+		return err
+	}
+	target, err := uc.manageableMember(ctx, actor, userID)
+	if err != nil {
+		return err
+	}
+	if workspaceRoleRank[role] > workspaceRoleRank[actor.Role] {
+		return workspacepb.ErrorWorkspacePermissionDenied("cannot grant the %s role", role)
+	}
+	if target.Role == role {
+		return nil
+	}
+	if target.Role == WorkspaceRoleOwner {
+		if err := uc.ensureAnotherOwner(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+	if err := uc.repo.UpdateMemberRole(ctx, workspaceID, userID, role); err != nil {
+		return workspacepb.ErrorSaveWorkspaceFailed("failed to update member role: %v", err)
+	}
+	uc.log.Infof("user %d changed role of user %d in workspace %d to %s", actor.UserID, userID, workspaceID, role)
+	return nil
+}
+
+// RemoveMember 移除成员，userID 为当前用户时表示退出工作空间
+func (uc *WorkspaceUsecase) RemoveMember(ctx context.Context, workspaceID, userID int64) error {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return authpb.ErrorUnauthorized("user not authenticated")
+	}
+	var target *po.WorkspaceMember
+	if userID == claims.ID {
+		member, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleGuest)
+		if err != nil {
+			return err
+		}
+		target = member
+	} else {
+		actor, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleAdmin)
+		if err != nil {
+			return err
+		}
+		if target, err = uc.manageableMember(ctx, actor, userID); err != nil {
+			return err
+		}
+	}
+	if target.Role == WorkspaceRoleOwner {
+		if err := uc.ensureAnotherOwner(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+	if err := uc.repo.RemoveMember(ctx, workspaceID, userID); err != nil {
+		return workspacepb.ErrorSaveWorkspaceFailed("failed to remove member: %v", err)
+	}
+	uc.log.Infof("user %d removed user %d from workspace %d", claims.ID, userID, workspaceID)
+	return nil
+}
+
+// manageableMember 查询 actor 要管理的成员，成员的角色不能高于 actor
+func (uc *WorkspaceUsecase) manageableMember(ctx context.Context, actor *po.WorkspaceMember, userID int64) (*po.WorkspaceMember, error) {
+	target, err := uc.repo.GetMember(ctx, actor.WorkspaceID, userID)
+	if err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to get member: %v", err)
+	}
+	if target == nil {
+		return nil, workspacepb.ErrorMemberNotFound("user %d is not a member", userID)
+	}
+	if workspaceRoleRank[target.Role] > workspaceRoleRank[actor.Role] {
+		return nil, workspacepb.ErrorWorkspacePermissionDenied("cannot manage a member with the %s role", target.Role)
+	}
+	return target, nil
+}
+
+// ensureAnotherOwner 降级或移除 owner 前确认工作空间还有其他 owner
+func (uc *WorkspaceUsecase) ensureAnotherOwner(ctx context.Context, workspaceID int64) error {
+	owners, err := uc.repo.CountMembersByRole(ctx, workspaceID, WorkspaceRoleOwner)
+	if err != nil {
+		return workspacepb.ErrorSaveWorkspaceFailed("failed to count owners: %v", err)
+	}
+	if owners <= 1 {
+		return workspacepb.ErrorInvalidWorkspaceRequest("a workspace must have at least one owner, transfer ownership first")
+	}
+	return nil
+}
+
+func (uc *WorkspaceUsecase) invitationTTL() time.Duration {
+	if ttl := uc.cfg.GetWorkspace().GetInvitationTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return defaultInvitationTTL
+}
+
+// InviteMember 通过邮件邀请成员加入工作空间，受邀邮箱可以尚未注册
+func (uc *WorkspaceUsecase) InviteMember(ctx context.Context, workspaceID int64, email, role string) (*po.WorkspaceInvitation, error) {
+	if !IsWorkspaceRole(role) || role == WorkspaceRoleOwner {
+		return nil, workspacepb.ErrorInvalidWorkspaceRequest("invalid invitation role %s", role)
+	}
+	actor, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if workspaceRoleRank[role] > workspaceRoleRank[actor.Role] {
+		return nil, workspacepb.ErrorWorkspacePermissionDenied("cannot grant the %s role", role)
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if user, err := uc.authRepo.GetUserByEmail(ctx, email); err == nil && user != nil {
+		if member, err := uc.repo.GetMember(ctx, workspaceID, user.ID); err == nil && member != nil {
+			return nil, workspacepb.ErrorInvalidWorkspaceRequest("%s is already a member", email)
+		}
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to generate invitation token: %v", err)
+	}
+	ttl := uc.invitationTTL()
+	invitation := &po.WorkspaceInvitation{
+		WorkspaceID: workspaceID,
+		Email:       email,
+		Role:        role,
+		TokenHash:   hashToken(token),
+		InvitedBy:   actor.UserID,
+		ExpiresAt:   time.Now().Add(ttl),
+	}
+	if err := uc.repo.SaveInvitation(ctx, invitation); err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to save invitation: %v", err)
+	}
+	if err := uc.sendInvitationMail(ctx, invitation, token, ttl); err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to send invitation: %v", err)
+	}
+	uc.log.Infof("user %d invited %s to workspace %d as %s", actor.UserID, email, workspaceID, role)
+	return invitation, nil
+}
+
+func (uc *WorkspaceUsecase) sendInvitationMail(ctx context.Context, invitation *po.WorkspaceInvitation, token string, ttl time.Duration) error {
+	if uc.mailer == nil {
+		return nil
+	}
+	inviter := "A teammate"
+	if user, err := uc.authRepo.GetUserByID(ctx, invitation.InvitedBy); err == nil && user != nil {
+		inviter = user.Name
+	}
+	workspace, err := uc.repo.GetWorkspace(ctx, invitation.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	return uc.mailer.Notify(ctx, invitation.Email, mail.KindWorkspaceInvite, "", map[string]any{
+		"AppName":   appDisplayName(uc.cfg),
+		"Inviter":   inviter,
+		"Workspace": workspace.Name,
+		"Role":      invitation.Role,
+		"Link":      tokenLink(uc.cfg.GetWorkspace().GetInvitationUrl(), token),
+		"ExpiresIn": formatTTL(ttl),
+	})
+}
+
+// ListInvitations 列出工作空间待接受的邀请
+func (uc *WorkspaceUsecase) ListInvitations(ctx context.Context, workspaceID int64) ([]*po.WorkspaceInvitation, error) {
+	if _, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleAdmin); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListInvitations(ctx, workspaceID)
+}
+
+// RevokeInvitation 撤销邀请
+func (uc *WorkspaceUsecase) RevokeInvitation(ctx context.Context, workspaceID, id int64) error {
+	if _, err := uc.requireMember(ctx, workspaceID, WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+	deleted, err := uc.repo.DeleteInvitation(ctx, workspaceID, id)
+	if err != nil {
+		return workspacepb.ErrorSaveWorkspaceFailed("failed to revoke invitation: %v", err)
+	}
+	if !deleted {
+		return workspacepb.ErrorInvitationNotFound("invitation %d not found", id)
+	}
+	return nil
+}
+
+// AcceptInvitation 当前用户接受邀请加入工作空间，用户邮箱必须与受邀邮箱一致。
+// 不区分邀请不存在、已过期和不属于当前用户，避免泄露邀请信息
+func (uc *WorkspaceUsecase) AcceptInvitation(ctx context.Context, token string) (*UserWorkspace, error) {
+	claims, ok := jwt.FromContext[UserClaims](ctx)
+	if !ok {
+		return nil, authpb.ErrorUnauthorized("user not authenticated")
+	}
+	invitation, err := uc.repo.GetInvitationByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to get invitation: %v", err)
+	}
+	if invitation == nil || !invitation.ExpiresAt.After(time.Now()) {
+		return nil, workspacepb.ErrorInvitationNotFound("invalid or expired invitation")
+	}
+	user, err := uc.authRepo.GetUserByID(ctx, claims.ID)
+	if err != nil || user == nil {
+		return nil, authpb.ErrorUserNotFound("user not found: %v", err)
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, workspacepb.ErrorInvitationNotFound("invalid or expired invitation")
+	}
+
+	member, err := uc.repo.GetMember(ctx, invitation.WorkspaceID, user.ID)
+	if err != nil {
+		return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to get membership: %v", err)
+	}
+	if member == nil {
+		member = &po.WorkspaceMember{WorkspaceID: invitation.WorkspaceID, UserID: user.ID, Role: invitation.Role}
+		if err := uc.repo.AcceptInvitation(ctx, invitation, member); err != nil {
+			return nil, workspacepb.ErrorSaveWorkspaceFailed("failed to accept invitation: %v", err)
+		}
+		uc.log.Infof("user %d joined workspace %d as %s", user.ID, invitation.WorkspaceID, member.Role)
+	} else if _, err := uc.repo.DeleteInvitation(ctx, invitation.WorkspaceID, invitation.ID); err != nil {
+		uc.log.Warnf("delete invitation %d failed: %v", invitation.ID, err)
+	}
+
+	workspace, err := uc.repo.GetWorkspace(ctx, invitation.WorkspaceID)
+	if err != nil {
+		return nil, workspacepb.ErrorWorkspaceNotFound("workspace %d not found: %v", invitation.WorkspaceID, err)
+	}
+	return &UserWorkspace{Workspace: workspace, Role: member.Role}, nil
+}
+
+// generateInvitationToken 生成邀请 token，数据库只保存摘要
+func generateInvitationToken() (string, error) {
+	b := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
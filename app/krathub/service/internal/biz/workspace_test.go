@@ -0,0 +1,380 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	workspacepb "github.com/ToAtlas/AtlasBackend/api/gen/go/workspace/service/v1"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memWorkspaceRepo 工作空间的内存仓库
+type memWorkspaceRepo struct {
+	mu          sync.Mutex
+	nextID      int64
+	workspaces  map[int64]*po.Workspace
+	members     []*po.WorkspaceMember
+	invitations []*po.WorkspaceInvitation
+}
+
+func newMemWorkspaceRepo() *memWorkspaceRepo {
+	return &memWorkspaceRepo{workspaces: map[int64]*po.Workspace{}}
+}
+
+func (r *memWorkspaceRepo) CreateWorkspace(_ context.Context, workspace *po.Workspace, owner *po.WorkspaceMember) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	workspace.ID = r.nextID
+	owner.WorkspaceID = workspace.ID
+	clone := *workspace
+	r.workspaces[workspace.ID] = &clone
+	member := *owner
+	r.members = append(r.members, &member)
+	return nil
+}
+
+func (r *memWorkspaceRepo) GetWorkspace(_ context.Context, id int64) (*po.Workspace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	workspace, ok := r.workspaces[id]
+	if !ok {
+		return nil, errors.New("workspace not found")
+	}
+	clone := *workspace
+	return &clone, nil
+}
+
+func (r *memWorkspaceRepo) ListUserWorkspaces(_ context.Context, userID int64) ([]*UserWorkspace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*UserWorkspace
+	for _, m := range r.members {
+		if m.UserID == userID {
+			clone := *r.workspaces[m.WorkspaceID]
+			out = append(out, &UserWorkspace{Workspace: &clone, Role: m.Role})
+		}
+	}
+	return out, nil
+}
+
+func (r *memWorkspaceRepo) GetMember(_ context.Context, workspaceID, userID int64) (*po.WorkspaceMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.members {
+		if m.WorkspaceID == workspaceID && m.UserID == userID {
+			clone := *m
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memWorkspaceRepo) ListMembers(_ context.Context, workspaceID int64) ([]*WorkspaceMemberInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*WorkspaceMemberInfo
+	for _, m := range r.members {
+		if m.WorkspaceID == workspaceID {
+			clone := *m
+			out = append(out, &WorkspaceMemberInfo{Member: &clone, User: &po.User{ID: m.UserID}})
+		}
+	}
+	return out, nil
+}
+
+func (r *memWorkspaceRepo) CountMembersByRole(_ context.Context, workspaceID int64, role string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int64
+	for _, m := range r.members {
+		if m.WorkspaceID == workspaceID && m.Role == role {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memWorkspaceRepo) UpdateMemberRole(_ context.Context, workspaceID, userID int64, role string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.members {
+		if m.WorkspaceID == workspaceID && m.UserID == userID {
+			m.Role = role
+		}
+	}
+	return nil
+}
+
+func (r *memWorkspaceRepo) RemoveMember(_ context.Context, workspaceID, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, m := range r.members {
+		if m.WorkspaceID == workspaceID && m.UserID == userID {
+			r.members = append(r.members[:n], r.members[n+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *memWorkspaceRepo) SaveInvitation(_ context.Context, invitation *po.WorkspaceInvitation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.invitations[:0]
+	for _, i := range r.invitations {
+		if i.WorkspaceID != invitation.WorkspaceID || i.Email != invitation.Email {
+			kept = append(kept, i)
+		}
+	}
+	r.nextID++
+	invitation.ID = r.nextID
+	clone := *invitation
+	r.invitations = append(kept, &clone)
+	return nil
+}
+
+func (r *memWorkspaceRepo) ListInvitations(_ context.Context, workspaceID int64) ([]*po.WorkspaceInvitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*po.WorkspaceInvitation
+	for _, i := range r.invitations {
+		if i.WorkspaceID == workspaceID {
+			clone := *i
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *memWorkspaceRepo) GetInvitationByTokenHash(_ context.Context, tokenHash string) (*po.WorkspaceInvitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.invitations {
+		if i.TokenHash == tokenHash {
+			clone := *i
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memWorkspaceRepo) DeleteInvitation(_ context.Context, workspaceID, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, i := range r.invitations {
+		if i.WorkspaceID == workspaceID && i.ID == id {
+			r.invitations = append(r.invitations[:n], r.invitations[n+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memWorkspaceRepo) AcceptInvitation(ctx context.Context, invitation *po.WorkspaceInvitation, member *po.WorkspaceMember) error {
+	if _, err := r.DeleteInvitation(ctx, invitation.WorkspaceID, invitation.ID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *member
+	r.members = append(r.members, &clone)
+	return nil
+}
+
+// addMember 直接加入成员，跳过邀请流程
+func (r *memWorkspaceRepo) addMember(workspaceID, userID int64, role string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members = append(r.members, &po.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: role})
+}
+
+func (r *memWorkspaceRepo) roleOf(workspaceID, userID int64) string {
+	member, _ := r.GetMember(context.Background(), workspaceID, userID)
+	if member == nil {
+		return ""
+	}
+	return member.Role
+}
+
+func newTestWorkspaces(t *testing.T) (*WorkspaceUsecase, *memWorkspaceRepo, *memStore) {
+	t.Helper()
+	e := newTestEnv(t, nil)
+	repo := newMemWorkspaceRepo()
+	return NewWorkspaceUsecase(repo, e.authRepo(), nil, e.logger, e.cfg), repo, e.store
+}
+
+func TestWorkspaceUsecase_MembershipChecks(t *testing.T) {
+	uc, repo, store := newTestWorkspaces(t)
+	owner := store.addUser(&po.User{Name: "owner", Email: "owner@example.com", Role: RoleUser})
+	guest := store.addUser(&po.User{Name: "guest", Email: "guest@example.com", Role: RoleUser})
+	outsider := store.addUser(&po.User{Name: "outsider", Email: "outsider@example.com", Role: RoleAdmin})
+
+	created, err := uc.CreateWorkspace(asUser(owner), " team ")
+	require.NoError(t, err)
+	assert.Equal(t, "team", created.Workspace.Name)
+	assert.Equal(t, WorkspaceRoleOwner, created.Role)
+	assert.Equal(t, WorkspaceRoleOwner, repo.roleOf(created.Workspace.ID, owner.ID))
+	id := created.Workspace.ID
+	repo.addMember(id, guest.ID, WorkspaceRoleGuest)
+
+	_, err = uc.CreateWorkspace(asUser(owner), " ")
+	assert.True(t, workspacepb.IsInvalidWorkspaceRequest(err))
+	_, err = uc.ListMembers(context.Background(), id)
+	assert.True(t, authpb.IsUnauthorized(err))
+
+	// 非成员看到的是工作空间不存在，全局管理员也一样
+	_, err = uc.ListMembers(asUser(outsider), id)
+	assert.True(t, workspacepb.IsWorkspaceNotFound(err))
+	_, err = uc.ListMembers(asUser(owner), 999)
+	assert.True(t, workspacepb.IsWorkspaceNotFound(err))
+
+	// guest 可以查看成员，但不能管理成员和邀请
+	members, err := uc.ListMembers(asUser(guest), id)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+	_, err = uc.ListInvitations(asUser(guest), id)
+	assert.True(t, workspacepb.IsWorkspacePermissionDenied(err))
+	_, err = uc.InviteMember(asUser(guest), id, "new@example.com", WorkspaceRoleGuest)
+	assert.True(t, workspacepb.IsWorkspacePermissionDenied(err))
+	err = uc.UpdateMemberRole(asUser(guest), id, owner.ID, WorkspaceRoleGuest)
+	assert.True(t, workspacepb.IsWorkspacePermissionDenied(err))
+	err = uc.RemoveMember(asUser(guest), id, owner.ID)
+	assert.True(t, workspacepb.IsWorkspacePermissionDenied(err))
+
+	workspaces, err := uc.ListWorkspaces(asUser(guest))
+	require.NoError(t, err)
+	require.Len(t, workspaces, 1)
+	assert.Equal(t, WorkspaceRoleGuest, workspaces[0].Role)
+}
+
+func TestWorkspaceUsecase_ManageMembers(t *testing.T) {
+	uc, repo, store := newTestWorkspaces(t)
+	owner := store.addUser(&po.User{Name: "owner", Email: "owner@example.com", Role: RoleUser})
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleUser})
+	member := store.addUser(&po.User{Name: "member", Email: "member@example.com", Role: RoleUser})
+	guest := store.addUser(&po.User{Name: "guest", Email: "guest@example.com", Role: RoleUser})
+	created, err := uc.CreateWorkspace(asUser(owner), "team")
+	require.NoError(t, err)
+	id := created.Workspace.ID
+	repo.addMember(id, admin.ID, WorkspaceRoleAdmin)
+	repo.addMember(id, member.ID, WorkspaceRoleMember)
+	repo.addMember(id, guest.ID, WorkspaceRoleGuest)
+
+	tests := []struct {
+		name   string
+		actor  *po.User
+		target int64
+		role   string
+		check  func(error) bool
+	}{
+		{name: "invalid role", actor: owner, target: member.ID, role: "superuser", check: workspacepb.IsInvalidWorkspaceRequest},
+		// admin 不能管理 owner，也不能分配高于自己的角色
+		{name: "admin demotes owner", actor: admin, target: owner.ID, role: WorkspaceRoleMember, check: workspacepb.IsWorkspacePermissionDenied},
+		{name: "admin grants owner", actor: admin, target: member.ID, role: WorkspaceRoleOwner, check: workspacepb.IsWorkspacePermissionDenied},
+		{name: "member promotes guest", actor: member, target: guest.ID, role: WorkspaceRoleMember, check: workspacepb.IsWorkspacePermissionDenied},
+		{name: "missing member", actor: admin, target: 999, role: WorkspaceRoleGuest, check: workspacepb.IsMemberNotFound},
+		// 唯一的 owner 不能降级自己
+		{name: "last owner", actor: owner, target: owner.ID, role: WorkspaceRoleAdmin, check: workspacepb.IsInvalidWorkspaceRequest},
+		{name: "admin promotes guest", actor: admin, target: guest.ID, role: WorkspaceRoleAdmin},
+		{name: "unchanged role", actor: admin, target: guest.ID, role: WorkspaceRoleAdmin},
+		{name: "owner grants owner", actor: owner, target: member.ID, role: WorkspaceRoleOwner},
+	}
+	for _, tt := range tests {
+		before := repo.roleOf(id, tt.target)
+		err := uc.UpdateMemberRole(asUser(tt.actor), id, tt.target, tt.role)
+		if tt.check != nil {
+			assert.True(t, tt.check(err), "%s: %v", tt.name, err)
+			assert.Equal(t, before, repo.roleOf(id, tt.target), tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.role, repo.roleOf(id, tt.target), tt.name)
+	}
+
+	// 有其他 owner 后，owner 可以降级自己
+	require.NoError(t, uc.UpdateMemberRole(asUser(owner), id, owner.ID, WorkspaceRoleAdmin))
+	assert.Equal(t, WorkspaceRoleAdmin, repo.roleOf(id, owner.ID))
+	newOwner := member
+
+	// 唯一的 owner 不能退出，admin 不能移除 owner，可以移除同级成员
+	err = uc.RemoveMember(asUser(newOwner), id, newOwner.ID)
+	assert.True(t, workspacepb.IsInvalidWorkspaceRequest(err))
+	err = uc.RemoveMember(asUser(admin), id, newOwner.ID)
+	assert.True(t, workspacepb.IsWorkspacePermissionDenied(err))
+	require.NoError(t, uc.RemoveMember(asUser(admin), id, owner.ID))
+	assert.Empty(t, repo.roleOf(id, owner.ID))
+
+	// 任何成员都可以退出工作空间，退出后不能再访问
+	require.NoError(t, uc.RemoveMember(asUser(guest), id, guest.ID))
+	_, err = uc.ListMembers(asUser(guest), id)
+	assert.True(t, workspacepb.IsWorkspaceNotFound(err))
+}
+
+func TestWorkspaceUsecase_Invitations(t *testing.T) {
+	uc, repo, store := newTestWorkspaces(t)
+	owner := store.addUser(&po.User{Name: "owner", Email: "owner@example.com", Role: RoleUser})
+	admin := store.addUser(&po.User{Name: "admin", Email: "admin@example.com", Role: RoleUser})
+	alice := store.addUser(&po.User{Name: "alice", Email: "alice@example.com", Role: RoleUser})
+	bob := store.addUser(&po.User{Name: "bob", Email: "bob@example.com", Role: RoleUser})
+	created, err := uc.CreateWorkspace(asUser(owner), "team")
+	require.NoError(t, err)
+	id := created.Workspace.ID
+	repo.addMember(id, admin.ID, WorkspaceRoleAdmin)
+
+	// 不能邀请为 owner，不能邀请已有成员
+	_, err = uc.InviteMember(asUser(owner), id, alice.Email, WorkspaceRoleOwner)
+	assert.True(t, workspacepb.IsInvalidWorkspaceRequest(err))
+	_, err = uc.InviteMember(asUser(owner), id, " ADMIN@example.com ", WorkspaceRoleMember)
+	assert.True(t, workspacepb.IsInvalidWorkspaceRequest(err))
+
+	invitation, err := uc.InviteMember(asUser(admin), id, " Alice@Example.com ", WorkspaceRoleMember)
+	require.NoError(t, err)
+	assert.Equal(t, alice.Email, invitation.Email)
+	assert.Equal(t, admin.ID, invitation.InvitedBy)
+	assert.WithinDuration(t, time.Now().Add(defaultInvitationTTL), invitation.ExpiresAt, 5*time.Second)
+
+	// 只保存 token 摘要，无法从仓库取回原始 token，这里直接替换为已知 token
+	const token = "alice-invitation-token"
+	repo.mu.Lock()
+	repo.invitations[len(repo.invitations)-1].TokenHash = hashToken(token)
+	repo.mu.Unlock()
+
+	// 邮箱不一致和 token 错误都返回邀请不存在
+	_, err = uc.AcceptInvitation(asUser(bob), token)
+	assert.True(t, workspacepb.IsInvitationNotFound(err))
+	_, err = uc.AcceptInvitation(asUser(alice), "bogus")
+	assert.True(t, workspacepb.IsInvitationNotFound(err))
+
+	joined, err := uc.AcceptInvitation(asUser(alice), token)
+	require.NoError(t, err)
+	assert.Equal(t, id, joined.Workspace.ID)
+	assert.Equal(t, WorkspaceRoleMember, joined.Role)
+	assert.Equal(t, WorkspaceRoleMember, repo.roleOf(id, alice.ID))
+	_, err = uc.AcceptInvitation(asUser(alice), token)
+	assert.True(t, workspacepb.IsInvitationNotFound(err))
+
+	// 过期的邀请不能接受，撤销后不存在
+	expired, err := uc.InviteMember(asUser(owner), id, bob.Email, WorkspaceRoleGuest)
+	require.NoError(t, err)
+	repo.mu.Lock()
+	repo.invitations[len(repo.invitations)-1].TokenHash = hashToken("bob-invitation-token")
+	repo.invitations[len(repo.invitations)-1].ExpiresAt = time.Now().Add(-time.Second)
+	repo.mu.Unlock()
+	_, err = uc.AcceptInvitation(asUser(bob), "bob-invitation-token")
+	assert.True(t, workspacepb.IsInvitationNotFound(err))
+	assert.Empty(t, repo.roleOf(id, bob.ID))
+
+	require.NoError(t, uc.RevokeInvitation(asUser(admin), id, expired.ID))
+	assert.True(t, workspacepb.IsInvitationNotFound(uc.RevokeInvitation(asUser(admin), id, expired.ID)))
+	invitations, err := uc.ListInvitations(asUser(admin), id)
+	require.NoError(t, err)
+	assert.Empty(t, invitations)
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type auditRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAuditRepo(data *Data, logger log.Logger) biz.AuditRepo {
+	return &auditRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "audit/data/krathub-service")),
+	}
+}
+
+// CreateAuditEvent 追加审计事件
+func (r *auditRepo) CreateAuditEvent(ctx context.Context, event *po.AuditEvent) error {
+	return r.data.query.AuditEvent.WithContext(ctx).Create(event)
+}
+
+// ListAuditEvents 按条件查询审计事件，id 倒序
+func (r *auditRepo) ListAuditEvents(ctx context.Context, filter *biz.AuditFilter, beforeID int64, limit int) ([]*po.AuditEvent, error) {
+	a := r.data.query.AuditEvent
+	q := a.WithContext(ctx)
+	if beforeID > 0 {
+		q = q.Where(a.ID.Lt(beforeID))
+	}
+	if filter.ActorID != 0 {
+		q = q.Where(a.ActorID.Eq(filter.ActorID))
+	}
+	if filter.Action != "" {
+		q = q.Where(a.Action.Eq(filter.Action))
+	}
+	if filter.TargetType != "" {
+		q = q.Where(a.TargetType.Eq(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		q = q.Where(a.TargetID.Eq(filter.TargetID))
+	}
+	if filter.Result != "" {
+		q = q.Where(a.Result.Eq(filter.Result))
+	}
+	if filter.Since != nil {
+		q = q.Where(a.CreatedAt.Gte(*filter.Since))
+	}
+	if filter.Until != nil {
+		q = q.Where(a.CreatedAt.Lt(*filter.Until))
+	}
+	return q.Order(a.ID.Desc()).Limit(limit).Find()
+}
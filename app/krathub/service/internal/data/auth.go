@@ -2,17 +2,21 @@ package data
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
-
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
 	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
 
 	"github.com/go-kratos/kratos/v2/log"
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
 )
 
 type authRepo struct {
@@ -30,14 +34,16 @@ func NewAuthRepo(data *Data, logger log.Logger) biz.AuthRepo {
 // 数据库操作方法
 
 func (r *authRepo) SaveUser(ctx context.Context, user *po.User) (*po.User, error) {
-	if !hash.BcryptIsHashed(user.Password) {
-		bcryptPassword, err := hash.BcryptHash(user.Password)
-		if err != nil {
-			return nil, err
-		}
-		user.Password = bcryptPassword
+	if err := requirePasswordHash(user.Password); err != nil {
+		return nil, err
 	}
-	err := r.data.query.User.WithContext(ctx).Create(user)
+	// 用户与 user.signup 事件在同一事务中写入，保证事件不丢失
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		if err := tx.User.WithContext(ctx).Create(user); err != nil {
+			return err
+		}
+		return saveOutboxEvent(ctx, tx, biz.EventUserSignup, biz.AggregateUser, user.ID, biz.UserEventData(user))
+	})
 	if err != nil {
 		r.log.Errorf("SaveUser failed: %v", err)
 		return nil, err
@@ -47,6 +53,9 @@ func (r *authRepo) SaveUser(ctx context.Context, user *po.User) (*po.User, error
 
 func (r *authRepo) GetUserByUserName(ctx context.Context, name string) (*po.User, error) {
 	user, err := r.data.query.User.WithContext(ctx).Where(r.data.query.User.Name.Eq(name)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -55,12 +64,29 @@ func (r *authRepo) GetUserByUserName(ctx context.Context, name string) (*po.User
 
 func (r *authRepo) GetUserByEmail(ctx context.Context, email string) (*po.User, error) {
 	user, err := r.data.query.User.WithContext(ctx).Where(r.data.query.User.Email.Eq(email)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
 
+// IsUserNameTaken 包括宽限期内已删除、仍占用唯一索引的用户
+func (r *authRepo) IsUserNameTaken(ctx context.Context, name string) (bool, error) {
+	u := r.data.query.User
+	n, err := u.WithContext(ctx).Unscoped().Where(u.Name.Eq(name)).Count()
+	return n > 0, err
+}
+
+// IsEmailTaken 包括宽限期内已删除、仍占用唯一索引的用户
+func (r *authRepo) IsEmailTaken(ctx context.Context, email string) (bool, error) {
+	u := r.data.query.User
+	n, err := u.WithContext(ctx).Unscoped().Where(u.Email.Eq(email)).Count()
+	return n > 0, err
+}
+
 func (r *authRepo) GetUserByID(ctx context.Context, id int64) (*po.User, error) {
 	user, err := r.data.query.User.WithContext(ctx).Where(r.data.query.User.ID.Eq(id)).First()
 	if err != nil {
@@ -69,6 +95,27 @@ func (r *authRepo) GetUserByID(ctx context.Context, id int64) (*po.User, error)
 	return user, nil
 }
 
+func (r *authRepo) SetEmailVerified(ctx context.Context, userID int64, verified bool) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(userID)).Update(u.EmailVerified, verified)
+	if err != nil {
+		r.log.Errorf("SetEmailVerified failed: %v", err)
+	}
+	return err
+}
+
+func (r *authRepo) UpdatePassword(ctx context.Context, userID int64, password string) error {
+	if err := requirePasswordHash(password); err != nil {
+		return err
+	}
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(userID)).Update(u.Password, password)
+	if err != nil {
+		r.log.Errorf("UpdatePassword failed: %v", err)
+	}
+	return err
+}
+
 // TokenStore methods implementation
 
 // SaveRefreshToken 保存Refresh Token到Redis
@@ -159,8 +206,21 @@ func (r *authRepo) DeleteRefreshToken(ctx context.Context, token string) error {
 	return nil
 }
 
-// DeleteUserRefreshTokens 删除用户所有Refresh Token
+// DeleteUserRefreshTokens 删除用户所有Refresh Token及其token族
 func (r *authRepo) DeleteUserRefreshTokens(ctx context.Context, userID int64) error {
+	// 先注销token族，包括其中已轮换的token，避免之后出现误报的重放检测
+	userFamiliesKey := fmt.Sprintf("user_token_families:%d", userID)
+	families, err := r.data.redis.SMembers(ctx, userFamiliesKey)
+	if err != nil {
+		r.log.Errorf("Failed to get user token families: %v", err)
+		return err
+	}
+	for _, id := range families {
+		if err := r.RevokeTokenFamily(ctx, &biz.TokenFamily{ID: id, UserID: userID}); err != nil {
+			return err
+		}
+	}
+
 	userTokensKey := fmt.Sprintf("user_tokens:%d", userID)
 
 	// 获取用户的所有token
@@ -187,3 +247,264 @@ func (r *authRepo) DeleteUserRefreshTokens(ctx context.Context, userID int64) er
 
 	return nil
 }
+
+// ConsumeRefreshToken 通过GETDEL原子地取出并删除Refresh Token
+func (r *authRepo) ConsumeRefreshToken(ctx context.Context, token string) (int64, error) {
+	tokenKey := fmt.Sprintf("refresh_token:%s", token)
+	userIDStr, err := r.data.redis.GetDel(ctx, tokenKey)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		r.log.Errorf("Failed to parse user ID: %v", err)
+		return 0, err
+	}
+
+	userTokensKey := fmt.Sprintf("user_tokens:%d", userID)
+	if err := r.data.redis.SRem(ctx, userTokensKey, token); err != nil {
+		r.log.Warnf("Failed to remove token from user set: %v", err)
+	}
+	return userID, nil
+}
+
+// SaveTokenFamily 记录token -> 族的映射，并把token和族分别加入族成员集合和用户族集合
+func (r *authRepo) SaveTokenFamily(ctx context.Context, family *biz.TokenFamily, token string, expiration time.Duration) error {
+	tokenFamilyKey := fmt.Sprintf("refresh_token_family:%s", token)
+	if err := r.data.redis.Set(ctx, tokenFamilyKey, fmt.Sprintf("%d:%s", family.UserID, family.ID), expiration); err != nil {
+		r.log.Errorf("Failed to save token family: %v", err)
+		return err
+	}
+
+	// 族成员集合的过期时间随最新token延长，族内最后一个token过期后整个族随之过期
+	familyKey := fmt.Sprintf("token_family:%s", family.ID)
+	if err := r.data.redis.SAdd(ctx, familyKey, token); err != nil {
+		r.log.Errorf("Failed to add token to family: %v", err)
+		return err
+	}
+	if err := r.data.redis.Expire(ctx, familyKey, expiration); err != nil {
+		r.log.Errorf("Failed to set expiration for token family: %v", err)
+		return err
+	}
+
+	userFamiliesKey := fmt.Sprintf("user_token_families:%d", family.UserID)
+	if err := r.data.redis.SAdd(ctx, userFamiliesKey, family.ID); err != nil {
+		r.log.Errorf("Failed to add family to user set: %v", err)
+		return err
+	}
+	if err := r.data.redis.Expire(ctx, userFamiliesKey, expiration); err != nil {
+		r.log.Errorf("Failed to set expiration for user token families: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetTokenFamily 查询token所属的族，映射不存在时返回nil
+func (r *authRepo) GetTokenFamily(ctx context.Context, token string) (*biz.TokenFamily, error) {
+	tokenFamilyKey := fmt.Sprintf("refresh_token_family:%s", token)
+	value, err := r.data.redis.Get(ctx, tokenFamilyKey)
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get token family: %v", err)
+		return nil, err
+	}
+
+	userIDStr, familyID, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed token family %q", value)
+	}
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.TokenFamily{ID: familyID, UserID: userID}, nil
+}
+
+// SaveRotatedTokenPair 记录旧token -> 新token对的映射，过期后自动删除
+func (r *authRepo) SaveRotatedTokenPair(ctx context.Context, token string, pair *biz.TokenPair, expiration time.Duration) error {
+	data, err := json.Marshal(pair)
+	if err != nil {
+		return err
+	}
+	rotatedKey := fmt.Sprintf("refresh_token_rotated:%s", token)
+	if err := r.data.redis.Set(ctx, rotatedKey, data, expiration); err != nil {
+		r.log.Errorf("Failed to save rotated token pair: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetRotatedTokenPair 查询旧token轮换得到的新token对，映射不存在时返回nil
+func (r *authRepo) GetRotatedTokenPair(ctx context.Context, token string) (*biz.TokenPair, error) {
+	rotatedKey := fmt.Sprintf("refresh_token_rotated:%s", token)
+	value, err := r.data.redis.Get(ctx, rotatedKey)
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get rotated token pair: %v", err)
+		return nil, err
+	}
+	var pair biz.TokenPair
+	if err := json.Unmarshal([]byte(value), &pair); err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
+
+// RevokeTokenFamily 删除族内所有token（包括已轮换的token）的映射以及族本身
+func (r *authRepo) RevokeTokenFamily(ctx context.Context, family *biz.TokenFamily) error {
+	familyKey := fmt.Sprintf("token_family:%s", family.ID)
+	tokens, err := r.data.redis.SMembers(ctx, familyKey)
+	if err != nil {
+		r.log.Errorf("Failed to get family tokens: %v", err)
+		return err
+	}
+
+	if len(tokens) > 0 {
+		keys := make([]string, 0, 3*len(tokens))
+		members := make([]any, 0, len(tokens))
+		for _, t := range tokens {
+			keys = append(keys, fmt.Sprintf("refresh_token:%s", t), fmt.Sprintf("refresh_token_family:%s", t), fmt.Sprintf("refresh_token_rotated:%s", t))
+			members = append(members, t)
+		}
+		if err := r.data.redis.Del(ctx, keys...); err != nil {
+			r.log.Errorf("Failed to delete family tokens: %v", err)
+			return err
+		}
+		userTokensKey := fmt.Sprintf("user_tokens:%d", family.UserID)
+		if err := r.data.redis.SRem(ctx, userTokensKey, members...); err != nil {
+			r.log.Errorf("Failed to remove family tokens from user set: %v", err)
+			return err
+		}
+	}
+
+	sessionKey := fmt.Sprintf("token_family_session:%s", family.ID)
+	if err := r.data.redis.Del(ctx, familyKey, sessionKey); err != nil {
+		r.log.Errorf("Failed to delete token family: %v", err)
+		return err
+	}
+	userFamiliesKey := fmt.Sprintf("user_token_families:%d", family.UserID)
+	if err := r.data.redis.SRem(ctx, userFamiliesKey, family.ID); err != nil {
+		r.log.Errorf("Failed to remove family from user set: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SaveSession 以JSON保存会话信息
+func (r *authRepo) SaveSession(ctx context.Context, session *biz.Session, expiration time.Duration) error {
+	value, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	sessionKey := fmt.Sprintf("token_family_session:%s", session.ID)
+	if err := r.data.redis.Set(ctx, sessionKey, string(value), expiration); err != nil {
+		r.log.Errorf("Failed to save session: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetSession 查询会话信息，不存在时返回nil
+func (r *authRepo) GetSession(ctx context.Context, sessionID string) (*biz.Session, error) {
+	sessionKey := fmt.Sprintf("token_family_session:%s", sessionID)
+	value, err := r.data.redis.Get(ctx, sessionKey)
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get session: %v", err)
+		return nil, err
+	}
+	var session biz.Session
+	if err := json.Unmarshal([]byte(value), &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListUserSessions 通过用户的token族集合查询会话，顺带清理已过期的族
+func (r *authRepo) ListUserSessions(ctx context.Context, userID int64) ([]*biz.Session, error) {
+	userFamiliesKey := fmt.Sprintf("user_token_families:%d", userID)
+	families, err := r.data.redis.SMembers(ctx, userFamiliesKey)
+	if err != nil {
+		r.log.Errorf("Failed to get user token families: %v", err)
+		return nil, err
+	}
+
+	sessions := make([]*biz.Session, 0, len(families))
+	for _, id := range families {
+		session, err := r.GetSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			if err := r.data.redis.SRem(ctx, userFamiliesKey, id); err != nil {
+				r.log.Warnf("Failed to remove expired family from user set: %v", err)
+			}
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// VerificationTokenStore methods implementation
+
+// SaveVerificationToken 保存 token -> user_id:email 映射，并记录用户当前的 token 以便签发新 token 时作废旧 token
+func (r *authRepo) SaveVerificationToken(ctx context.Context, purpose, token string, userID int64, email string, expiration time.Duration) error {
+	if err := r.DeleteVerificationToken(ctx, purpose, userID); err != nil {
+		r.log.Warnf("Failed to delete previous verification token: %v", err)
+	}
+	tokenKey := fmt.Sprintf("verification_token:%s:%s", purpose, token)
+	if err := r.data.redis.Set(ctx, tokenKey, fmt.Sprintf("%d:%s", userID, email), expiration); err != nil {
+		r.log.Errorf("Failed to save verification token: %v", err)
+		return err
+	}
+	userKey := fmt.Sprintf("user_verification_token:%s:%d", purpose, userID)
+	if err := r.data.redis.Set(ctx, userKey, token, expiration); err != nil {
+		r.log.Warnf("Failed to save user verification token: %v", err)
+	}
+	return nil
+}
+
+func (r *authRepo) ConsumeVerificationToken(ctx context.Context, purpose, token string) (int64, string, error) {
+	tokenKey := fmt.Sprintf("verification_token:%s:%s", purpose, token)
+	value, err := r.data.redis.GetDel(ctx, tokenKey)
+	if errors.Is(err, goredis.Nil) {
+		return 0, "", nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to consume verification token: %v", err)
+		return 0, "", err
+	}
+	userIDStr, email, ok := strings.Cut(value, ":")
+	if !ok {
+		return 0, "", fmt.Errorf("malformed verification token %q", value)
+	}
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := r.data.redis.Del(ctx, fmt.Sprintf("user_verification_token:%s:%d", purpose, userID)); err != nil {
+		r.log.Warnf("Failed to delete user verification token: %v", err)
+	}
+	return userID, email, nil
+}
+
+// DeleteVerificationToken 删除用户当前的 token 及其映射
+func (r *authRepo) DeleteVerificationToken(ctx context.Context, purpose string, userID int64) error {
+	userKey := fmt.Sprintf("user_verification_token:%s:%d", purpose, userID)
+	old, err := r.data.redis.GetDel(ctx, userKey)
+	if errors.Is(err, goredis.Nil) || (err == nil && old == "") {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.data.redis.Del(ctx, fmt.Sprintf("verification_token:%s:%s", purpose, old))
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// bootstrapRowID system_bootstrap 表只有一行
+const bootstrapRowID = 1
+
+type bootstrapRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBootstrapRepo(data *Data, logger log.Logger) biz.BootstrapRepo {
+	return &bootstrapRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "bootstrap/data/krathub-service")),
+	}
+}
+
+func (r *bootstrapRepo) GetBootstrapAdminID(ctx context.Context) (int64, error) {
+	b := r.data.query.SystemBootstrap
+	row, err := b.WithContext(ctx).Where(b.ID.Eq(bootstrapRowID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetBootstrapAdminID failed: %v", err)
+		return 0, err
+	}
+	return row.AdminUserID, nil
+}
+
+// claimBootstrap 写入初始化记录，已存在时不修改并返回 false。
+// 并发写入时后到的事务会等待先到的事务提交，然后因主键冲突不写入
+func claimBootstrap(ctx context.Context, tx *dao.Query, userID int64) (bool, error) {
+	res := tx.SystemBootstrap.WithContext(ctx).UnderlyingDB().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&po.SystemBootstrap{ID: bootstrapRowID, AdminUserID: userID})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *bootstrapRepo) CreateBootstrapAdmin(ctx context.Context, user *po.User) (*po.User, error) {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		if err := tx.User.WithContext(ctx).Create(user); err != nil {
+			return err
+		}
+		claimed, err := claimBootstrap(ctx, tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return biz.ErrAdminBootstrapped
+		}
+		return saveOutboxEvent(ctx, tx, biz.EventUserSignup, biz.AggregateUser, user.ID, biz.UserEventData(user))
+	})
+	if err != nil {
+		if !errors.Is(err, biz.ErrAdminBootstrapped) {
+			r.log.Errorf("CreateBootstrapAdmin failed: %v", err)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *bootstrapRepo) AdoptBootstrapAdmin(ctx context.Context, userID int64) error {
+	if _, err := claimBootstrap(ctx, r.data.query, userID); err != nil {
+		r.log.Errorf("AdoptBootstrapAdmin failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *bootstrapRepo) ResetBootstrapAdmin(ctx context.Context, userID int64, passwordHash string) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).
+		Unscoped().
+		Where(u.ID.Eq(userID)).
+		Select(u.Password, u.Role, u.DisabledAt, u.DeletedAt).
+		Updates(&po.User{Password: passwordHash, Role: biz.RoleAdmin})
+	if err != nil {
+		r.log.Errorf("ResetBootstrapAdmin failed: %v", err)
+		return err
+	}
+	return nil
+}
@@ -16,34 +16,104 @@ import (
 )
 
 var (
-	Q    = new(Query)
-	User *user
+	Q                   = new(Query)
+	AuditEvent          *auditEvent
+	OutboxEvent         *outboxEvent
+	PendingNotification *pendingNotification
+	PersonalAccessToken *personalAccessToken
+	Role                *role
+	SignupInvite        *signupInvite
+	SystemBootstrap     *systemBootstrap
+	User                *user
+	UserIdentity        *userIdentity
+	UserRecoveryCode    *userRecoveryCode
+	Webhook             *webhook
+	WebhookDelivery     *webhookDelivery
+	Workspace           *workspace
+	WorkspaceInvitation *workspaceInvitation
+	WorkspaceMember     *workspaceMember
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	AuditEvent = &Q.AuditEvent
+	OutboxEvent = &Q.OutboxEvent
+	PendingNotification = &Q.PendingNotification
+	PersonalAccessToken = &Q.PersonalAccessToken
+	Role = &Q.Role
+	SignupInvite = &Q.SignupInvite
+	SystemBootstrap = &Q.SystemBootstrap
 	User = &Q.User
+	UserIdentity = &Q.UserIdentity
+	UserRecoveryCode = &Q.UserRecoveryCode
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
+	Workspace = &Q.Workspace
+	WorkspaceInvitation = &Q.WorkspaceInvitation
+	WorkspaceMember = &Q.WorkspaceMember
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:   db,
-		User: newUser(db, opts...),
+		db:                  db,
+		AuditEvent:          newAuditEvent(db, opts...),
+		OutboxEvent:         newOutboxEvent(db, opts...),
+		PendingNotification: newPendingNotification(db, opts...),
+		PersonalAccessToken: newPersonalAccessToken(db, opts...),
+		Role:                newRole(db, opts...),
+		SignupInvite:        newSignupInvite(db, opts...),
+		SystemBootstrap:     newSystemBootstrap(db, opts...),
+		User:                newUser(db, opts...),
+		UserIdentity:        newUserIdentity(db, opts...),
+		UserRecoveryCode:    newUserRecoveryCode(db, opts...),
+		Webhook:             newWebhook(db, opts...),
+		WebhookDelivery:     newWebhookDelivery(db, opts...),
+		Workspace:           newWorkspace(db, opts...),
+		WorkspaceInvitation: newWorkspaceInvitation(db, opts...),
+		WorkspaceMember:     newWorkspaceMember(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User user
+	AuditEvent          auditEvent
+	OutboxEvent         outboxEvent
+	PendingNotification pendingNotification
+	PersonalAccessToken personalAccessToken
+	Role                role
+	SignupInvite        signupInvite
+	SystemBootstrap     systemBootstrap
+	User                user
+	UserIdentity        userIdentity
+	UserRecoveryCode    userRecoveryCode
+	Webhook             webhook
+	WebhookDelivery     webhookDelivery
+	Workspace           workspace
+	WorkspaceInvitation workspaceInvitation
+	WorkspaceMember     workspaceMember
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.clone(db),
+		db:                  db,
+		AuditEvent:          q.AuditEvent.clone(db),
+		OutboxEvent:         q.OutboxEvent.clone(db),
+		PendingNotification: q.PendingNotification.clone(db),
+		PersonalAccessToken: q.PersonalAccessToken.clone(db),
+		Role:                q.Role.clone(db),
+		SignupInvite:        q.SignupInvite.clone(db),
+		SystemBootstrap:     q.SystemBootstrap.clone(db),
+		User:                q.User.clone(db),
+		UserIdentity:        q.UserIdentity.clone(db),
+		UserRecoveryCode:    q.UserRecoveryCode.clone(db),
+		Webhook:             q.Webhook.clone(db),
+		WebhookDelivery:     q.WebhookDelivery.clone(db),
+		Workspace:           q.Workspace.clone(db),
+		WorkspaceInvitation: q.WorkspaceInvitation.clone(db),
+		WorkspaceMember:     q.WorkspaceMember.clone(db),
 	}
 }
 
@@ -57,18 +127,60 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.replaceDB(db),
+		db:                  db,
+		AuditEvent:          q.AuditEvent.replaceDB(db),
+		OutboxEvent:         q.OutboxEvent.replaceDB(db),
+		PendingNotification: q.PendingNotification.replaceDB(db),
+		PersonalAccessToken: q.PersonalAccessToken.replaceDB(db),
+		Role:                q.Role.replaceDB(db),
+		SignupInvite:        q.SignupInvite.replaceDB(db),
+		SystemBootstrap:     q.SystemBootstrap.replaceDB(db),
+		User:                q.User.replaceDB(db),
+		UserIdentity:        q.UserIdentity.replaceDB(db),
+		UserRecoveryCode:    q.UserRecoveryCode.replaceDB(db),
+		Webhook:             q.Webhook.replaceDB(db),
+		WebhookDelivery:     q.WebhookDelivery.replaceDB(db),
+		Workspace:           q.Workspace.replaceDB(db),
+		WorkspaceInvitation: q.WorkspaceInvitation.replaceDB(db),
+		WorkspaceMember:     q.WorkspaceMember.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User IUserDo
+	AuditEvent          IAuditEventDo
+	OutboxEvent         IOutboxEventDo
+	PendingNotification IPendingNotificationDo
+	PersonalAccessToken IPersonalAccessTokenDo
+	Role                IRoleDo
+	SignupInvite        ISignupInviteDo
+	SystemBootstrap     ISystemBootstrapDo
+	User                IUserDo
+	UserIdentity        IUserIdentityDo
+	UserRecoveryCode    IUserRecoveryCodeDo
+	Webhook             IWebhookDo
+	WebhookDelivery     IWebhookDeliveryDo
+	Workspace           IWorkspaceDo
+	WorkspaceInvitation IWorkspaceInvitationDo
+	WorkspaceMember     IWorkspaceMemberDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User: q.User.WithContext(ctx),
+		AuditEvent:          q.AuditEvent.WithContext(ctx),
+		OutboxEvent:         q.OutboxEvent.WithContext(ctx),
+		PendingNotification: q.PendingNotification.WithContext(ctx),
+		PersonalAccessToken: q.PersonalAccessToken.WithContext(ctx),
+		Role:                q.Role.WithContext(ctx),
+		SignupInvite:        q.SignupInvite.WithContext(ctx),
+		SystemBootstrap:     q.SystemBootstrap.WithContext(ctx),
+		User:                q.User.WithContext(ctx),
+		UserIdentity:        q.UserIdentity.WithContext(ctx),
+		UserRecoveryCode:    q.UserRecoveryCode.WithContext(ctx),
+		Webhook:             q.Webhook.WithContext(ctx),
+		WebhookDelivery:     q.WebhookDelivery.WithContext(ctx),
+		Workspace:           q.Workspace.WithContext(ctx),
+		WorkspaceInvitation: q.WorkspaceInvitation.WithContext(ctx),
+		WorkspaceMember:     q.WorkspaceMember.WithContext(ctx),
 	}
 }
 
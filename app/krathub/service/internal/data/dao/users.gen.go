@@ -38,6 +38,13 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Location = field.NewString(tableName, "location")
 	_user.Website = field.NewString(tableName, "website")
 	_user.Role = field.NewString(tableName, "role")
+	_user.NotificationPrefs = field.NewString(tableName, "notification_prefs")
+	_user.EmailVerified = field.NewBool(tableName, "email_verified")
+	_user.TotpSecret = field.NewString(tableName, "totp_secret")
+	_user.TotpEnabledAt = field.NewTime(tableName, "totp_enabled_at")
+	_user.DisabledAt = field.NewTime(tableName, "disabled_at")
+	_user.DeletedAt = field.NewField(tableName, "deleted_at")
+	_user.AnonymizedAt = field.NewTime(tableName, "anonymized_at")
 	_user.CreatedAt = field.NewTime(tableName, "created_at")
 	_user.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -49,19 +56,26 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 type user struct {
 	userDo userDo
 
-	ALL       field.Asterisk
-	ID        field.Int64
-	Name      field.String
-	Email     field.String
-	Password  field.String
-	Phone     field.String
-	Avatar    field.String
-	Bio       field.String
-	Location  field.String
-	Website   field.String
-	Role      field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
+	ALL               field.Asterisk
+	ID                field.Int64
+	Name              field.String
+	Email             field.String
+	Password          field.String
+	Phone             field.String
+	Avatar            field.String
+	Bio               field.String
+	Location          field.String
+	Website           field.String
+	Role              field.String
+	NotificationPrefs field.String
+	EmailVerified     field.Bool
+	TotpSecret        field.String
+	TotpEnabledAt     field.Time
+	DisabledAt        field.Time
+	DeletedAt         field.Field
+	AnonymizedAt      field.Time
+	CreatedAt         field.Time
+	UpdatedAt         field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -88,6 +102,13 @@ func (u *user) updateTableName(table string) *user {
 	u.Location = field.NewString(table, "location")
 	u.Website = field.NewString(table, "website")
 	u.Role = field.NewString(table, "role")
+	u.NotificationPrefs = field.NewString(table, "notification_prefs")
+	u.EmailVerified = field.NewBool(table, "email_verified")
+	u.TotpSecret = field.NewString(table, "totp_secret")
+	u.TotpEnabledAt = field.NewTime(table, "totp_enabled_at")
+	u.DisabledAt = field.NewTime(table, "disabled_at")
+	u.DeletedAt = field.NewField(table, "deleted_at")
+	u.AnonymizedAt = field.NewTime(table, "anonymized_at")
 	u.CreatedAt = field.NewTime(table, "created_at")
 	u.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -114,7 +135,7 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 12)
+	u.fieldMap = make(map[string]field.Expr, 19)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["name"] = u.Name
 	u.fieldMap["email"] = u.Email
@@ -125,6 +146,13 @@ func (u *user) fillFieldMap() {
 	u.fieldMap["location"] = u.Location
 	u.fieldMap["website"] = u.Website
 	u.fieldMap["role"] = u.Role
+	u.fieldMap["notification_prefs"] = u.NotificationPrefs
+	u.fieldMap["email_verified"] = u.EmailVerified
+	u.fieldMap["totp_secret"] = u.TotpSecret
+	u.fieldMap["totp_enabled_at"] = u.TotpEnabledAt
+	u.fieldMap["disabled_at"] = u.DisabledAt
+	u.fieldMap["deleted_at"] = u.DeletedAt
+	u.fieldMap["anonymized_at"] = u.AnonymizedAt
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
 }
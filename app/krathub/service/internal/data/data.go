@@ -19,7 +19,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewDiscovery, NewDB, NewRedis, NewData, NewAuthRepo, NewUserRepo, NewTestRepo)
+var ProviderSet = wire.NewSet(NewDiscovery, NewDB, NewRedis, NewData, NewAuthRepo, NewMFARepo, NewIdentityRepo, NewUserRepo, NewTestRepo, NewWebhookRepo, NewNotificationRepo, NewOutboxRepo, NewTokenRevocationRepo, NewLoginThrottleRepo, NewTokenRepo, NewRoleRepo, NewWorkspaceRepo, NewAuditRepo, NewSignupInviteRepo, NewBootstrapRepo, NewDomainEventBus, NewMailQueue, NewMailer)
 
 // Data .
 type Data struct {
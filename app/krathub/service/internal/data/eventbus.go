@@ -0,0 +1,33 @@
+package data
+
+import (
+	"context"
+
+	eventpb "github.com/ToAtlas/AtlasBackend/api/gen/go/event/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/pkg/eventbus"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// domainEventBus 把领域事件编码为 protobuf 发布到事件总线
+type domainEventBus struct {
+	bus eventbus.Publisher
+}
+
+// NewDomainEventBus 创建 outbox 使用的事件总线
+func NewDomainEventBus(bus eventbus.Bus) biz.EventBus {
+	return &domainEventBus{bus: bus}
+}
+
+func (b *domainEventBus) PublishEvent(ctx context.Context, e *biz.DomainEvent) error {
+	_, err := eventbus.PublishProto(ctx, b.bus, biz.DomainEventTopic, &eventpb.DomainEvent{
+		Id:            e.ID,
+		Type:          e.Type,
+		AggregateType: e.AggregateType,
+		AggregateId:   e.AggregateID,
+		OccurredAt:    timestamppb.New(e.OccurredAt),
+		Data:          e.Data,
+	})
+	return err
+}
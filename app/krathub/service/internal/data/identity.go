@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+type identityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIdentityRepo(data *Data, logger log.Logger) biz.IdentityRepo {
+	return &identityRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "identity/data/krathub-service")),
+	}
+}
+
+// 数据库操作方法
+
+func (r *identityRepo) GetIdentity(ctx context.Context, provider, subject string) (*po.UserIdentity, error) {
+	i := r.data.query.UserIdentity
+	identity, err := i.WithContext(ctx).Where(i.Provider.Eq(provider), i.Subject.Eq(subject)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetIdentity failed: %v", err)
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (r *identityRepo) ListUserIdentities(ctx context.Context, userID int64) ([]*po.UserIdentity, error) {
+	i := r.data.query.UserIdentity
+	return i.WithContext(ctx).Where(i.UserID.Eq(userID)).Order(i.ID).Find()
+}
+
+func (r *identityRepo) ListProviderIdentities(ctx context.Context, provider string, userIDs []int64) ([]*po.UserIdentity, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	i := r.data.query.UserIdentity
+	return i.WithContext(ctx).Where(i.Provider.Eq(provider), i.UserID.In(userIDs...)).Find()
+}
+
+func (r *identityRepo) CreateIdentity(ctx context.Context, identity *po.UserIdentity) error {
+	if err := r.data.query.UserIdentity.WithContext(ctx).Create(identity); err != nil {
+		r.log.Errorf("CreateIdentity failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *identityRepo) CreateUserWithIdentity(ctx context.Context, user *po.User, identity *po.UserIdentity) (*po.User, error) {
+	if err := requirePasswordHash(user.Password); err != nil {
+		return nil, err
+	}
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		if err := tx.User.WithContext(ctx).Create(user); err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		if err := tx.UserIdentity.WithContext(ctx).Create(identity); err != nil {
+			return err
+		}
+		return saveOutboxEvent(ctx, tx, biz.EventUserSignup, biz.AggregateUser, user.ID, biz.UserEventData(user))
+	})
+	if err != nil {
+		r.log.Errorf("CreateUserWithIdentity failed: %v", err)
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *identityRepo) DeleteIdentity(ctx context.Context, userID int64, provider string) (bool, error) {
+	i := r.data.query.UserIdentity
+	info, err := i.WithContext(ctx).Where(i.UserID.Eq(userID), i.Provider.Eq(provider)).Delete()
+	if err != nil {
+		r.log.Errorf("DeleteIdentity failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+// Redis 操作方法
+
+func (r *identityRepo) SaveOIDCState(ctx context.Context, state string, s *biz.OIDCState, ttl time.Duration) error {
+	value, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := r.data.redis.Set(ctx, fmt.Sprintf("oidc_state:%s", state), string(value), ttl); err != nil {
+		r.log.Errorf("Failed to save oidc state: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *identityRepo) ConsumeOIDCState(ctx context.Context, state string) (*biz.OIDCState, error) {
+	value, err := r.data.redis.GetDel(ctx, fmt.Sprintf("oidc_state:%s", state))
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to consume oidc state: %v", err)
+		return nil, err
+	}
+	var s biz.OIDCState
+	if err := json.Unmarshal([]byte(value), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
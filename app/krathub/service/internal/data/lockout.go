@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type loginThrottleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginThrottleRepo 基于 Redis 的登录失败计数存储
+func NewLoginThrottleRepo(data *Data, logger log.Logger) biz.LoginThrottleRepo {
+	return &loginThrottleRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "lockout/data/krathub-service")),
+	}
+}
+
+// AddLoginFailure 失败记录保存在以时间为分值的有序集合中，实现滑动窗口计数
+func (r *loginThrottleRepo) AddLoginFailure(ctx context.Context, subject string, at time.Time, window time.Duration) (int64, error) {
+	key := fmt.Sprintf("login_failures:%s", subject)
+	return r.data.redis.SlidingWindowAdd(ctx, key, strconv.FormatInt(at.UnixNano(), 10), at, window)
+}
+
+func (r *loginThrottleRepo) ClearLoginFailures(ctx context.Context, subject string) error {
+	return r.data.redis.Del(ctx, fmt.Sprintf("login_failures:%s", subject))
+}
+
+func (r *loginThrottleRepo) BlockLogin(ctx context.Context, kind, subject string, d time.Duration) error {
+	return r.data.redis.Set(ctx, fmt.Sprintf("login_block:%s:%s", kind, subject), "1", d)
+}
+
+func (r *loginThrottleRepo) LoginBlockRemaining(ctx context.Context, kind, subject string) (time.Duration, error) {
+	ttl, err := r.data.redis.TTL(ctx, fmt.Sprintf("login_block:%s:%s", kind, subject))
+	if err != nil {
+		return 0, err
+	}
+	// 键不存在（-2）或没有过期时间（-1）都视为未被禁止
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (r *loginThrottleRepo) ClearLoginBlock(ctx context.Context, kind, subject string) error {
+	return r.data.redis.Del(ctx, fmt.Sprintf("login_block:%s:%s", kind, subject))
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/mail"
+	"github.com/ToAtlas/AtlasBackend/pkg/redis"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultMailFrom    = "Krathub <no-reply@localhost>"
+	defaultMailFileDir = "./mails"
+)
+
+// NewMailQueue 根据配置创建邮件发送队列。
+// smtp/file 驱动使用 Redis 持久化队列，memory 驱动使用进程内队列（仅用于开发和测试）。
+func NewMailQueue(cfg *conf.App, rdb *redis.Client, logger log.Logger) (*mail.Queue, error) {
+	mc := cfg.GetMail()
+	l := log.NewHelper(pkglogger.WithModule(logger, "mail/data/krathub-service"))
+	from := mc.GetFrom()
+	if from == "" {
+		from = defaultMailFrom
+	}
+
+	var (
+		sender mail.Sender
+		store  mail.Store = mail.NewRedisStore(rdb, "mail")
+	)
+	switch strings.ToLower(mc.GetDriver()) {
+	case "smtp":
+		smtp := mc.GetSmtp()
+		sender = mail.NewSMTPSender(&mail.SMTPConfig{
+			Host:        smtp.GetHost(),
+			Port:        int(smtp.GetPort()),
+			Username:    smtp.GetUsername(),
+			Password:    smtp.GetPassword(),
+			From:        from,
+			ImplicitTLS: smtp.GetImplicitTls(),
+			Timeout:     smtp.GetTimeout().AsDuration(),
+		})
+	case "memory":
+		sender = mail.NewMemorySink(from)
+		store = mail.NewMemoryStore()
+	default:
+		// 未配置时写入本地文件，避免开发环境误发真实邮件
+		dir := mc.GetFileDir()
+		if dir == "" {
+			dir = defaultMailFileDir
+		}
+		sink, err := mail.NewFileSink(dir, from)
+		if err != nil {
+			return nil, err
+		}
+		l.Infof("mail driver is file, messages will be written to %s", dir)
+		sender = sink
+	}
+
+	return mail.NewQueue(mail.QueueConfig{
+		Workers:      int(mc.GetWorkers()),
+		MaxAttempts:  int(mc.GetMaxAttempts()),
+		RetryBackoff: mc.GetRetryBackoff().AsDuration(),
+	}, store, sender, logger), nil
+}
+
+// NewMailer 创建邮件通知服务，template_dir 为空时使用内置模板
+func NewMailer(cfg *conf.App, queue *mail.Queue) (*mail.Mailer, error) {
+	mc := cfg.GetMail()
+	var templates fs.FS
+	if dir := mc.GetTemplateDir(); dir != "" {
+		templates = os.DirFS(dir)
+	}
+	renderer, err := mail.NewRenderer(templates, mc.GetDefaultLocale())
+	if err != nil {
+		return nil, err
+	}
+	return mail.NewMailer(renderer, queue), nil
+}
@@ -0,0 +1,170 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type mfaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMFARepo(data *Data, logger log.Logger) biz.MFARepo {
+	return &mfaRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "mfa/data/krathub-service")),
+	}
+}
+
+// 数据库操作方法
+
+// SetTOTPSecret 登记待确认的密钥，启用状态保持不变
+func (r *mfaRepo) SetTOTPSecret(ctx context.Context, userID int64, secret string) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).
+		Where(u.ID.Eq(userID), u.TotpEnabledAt.IsNull()).
+		Select(u.TotpSecret).
+		Updates(&po.User{TotpSecret: &secret})
+	if err != nil {
+		r.log.Errorf("SetTOTPSecret failed: %v", err)
+	}
+	return err
+}
+
+func (r *mfaRepo) EnableTOTP(ctx context.Context, userID int64, enabledAt time.Time, recoveryCodeHashes []string) error {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		u := tx.User
+		if _, err := u.WithContext(ctx).
+			Where(u.ID.Eq(userID)).
+			Select(u.TotpEnabledAt).
+			Updates(&po.User{TotpEnabledAt: &enabledAt}); err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes, enabledAt)
+	})
+	if err != nil {
+		r.log.Errorf("EnableTOTP failed: %v", err)
+	}
+	return err
+}
+
+func (r *mfaRepo) DisableTOTP(ctx context.Context, userID int64) error {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		u := tx.User
+		if _, err := u.WithContext(ctx).
+			Where(u.ID.Eq(userID)).
+			Select(u.TotpSecret, u.TotpEnabledAt).
+			Updates(&po.User{}); err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(ctx, tx, userID, nil, time.Time{})
+	})
+	if err != nil {
+		r.log.Errorf("DisableTOTP failed: %v", err)
+	}
+	return err
+}
+
+// replaceRecoveryCodes 删除用户全部恢复码后写入新的恢复码
+func replaceRecoveryCodes(ctx context.Context, tx *dao.Query, userID int64, hashes []string, createdAt time.Time) error {
+	c := tx.UserRecoveryCode
+	if _, err := c.WithContext(ctx).Where(c.UserID.Eq(userID)).Delete(); err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	codes := make([]*po.UserRecoveryCode, 0, len(hashes))
+	for _, h := range hashes {
+		codes = append(codes, &po.UserRecoveryCode{UserID: userID, CodeHash: h, CreatedAt: createdAt})
+	}
+	return c.WithContext(ctx).Create(codes...)
+}
+
+// UseRecoveryCode 以条件更新标记恢复码，并发使用同一恢复码时只有一个请求成功
+func (r *mfaRepo) UseRecoveryCode(ctx context.Context, userID int64, codeHash string, usedAt time.Time) (bool, error) {
+	c := r.data.query.UserRecoveryCode
+	info, err := c.WithContext(ctx).
+		Where(c.UserID.Eq(userID), c.CodeHash.Eq(codeHash), c.UsedAt.IsNull()).
+		Update(c.UsedAt, usedAt)
+	if err != nil {
+		r.log.Errorf("UseRecoveryCode failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *mfaRepo) CountRecoveryCodes(ctx context.Context, userID int64) (int64, error) {
+	c := r.data.query.UserRecoveryCode
+	return c.WithContext(ctx).Where(c.UserID.Eq(userID), c.UsedAt.IsNull()).Count()
+}
+
+// Redis 操作方法
+
+func (r *mfaRepo) MarkTOTPCounterUsed(ctx context.Context, userID int64, counter uint64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("totp_used:%d:%d", userID, counter)
+	ok, err := r.data.redis.SetNX(ctx, key, "1", ttl)
+	if err != nil {
+		r.log.Errorf("Failed to mark totp counter used: %v", err)
+		return false, err
+	}
+	return ok, nil
+}
+
+func (r *mfaRepo) SaveMFAChallenge(ctx context.Context, challenge *biz.MFAChallenge, ttl time.Duration) error {
+	key := fmt.Sprintf("mfa_challenge:%s", challenge.Token)
+	if err := r.data.redis.Set(ctx, key, challenge.UserID, ttl); err != nil {
+		r.log.Errorf("Failed to save mfa challenge: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *mfaRepo) GetMFAChallenge(ctx context.Context, token string) (*biz.MFAChallenge, error) {
+	key := fmt.Sprintf("mfa_challenge:%s", token)
+	value, err := r.data.redis.Get(ctx, key)
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("Failed to get mfa challenge: %v", err)
+		return nil, err
+	}
+	userID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.MFAChallenge{Token: token, UserID: userID}, nil
+}
+
+// IncrMFAChallengeAttempts 首次计数时设置过期时间，避免计数键残留
+func (r *mfaRepo) IncrMFAChallengeAttempts(ctx context.Context, token string, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf("mfa_challenge_attempts:%s", token)
+	n, err := r.data.redis.Incr(ctx, key)
+	if err != nil {
+		r.log.Errorf("Failed to incr mfa challenge attempts: %v", err)
+		return 0, err
+	}
+	if n == 1 {
+		if err := r.data.redis.Expire(ctx, key, ttl); err != nil {
+			r.log.Errorf("Failed to set mfa challenge attempts expiration: %v", err)
+		}
+	}
+	return n, nil
+}
+
+func (r *mfaRepo) DeleteMFAChallenge(ctx context.Context, token string) error {
+	return r.data.redis.Del(ctx, fmt.Sprintf("mfa_challenge:%s", token), fmt.Sprintf("mfa_challenge_attempts:%s", token))
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "notification/data/krathub-service")),
+	}
+}
+
+// UpdateNotificationPrefs 更新用户通知偏好，prefs 为 nil 时清空
+func (r *notificationRepo) UpdateNotificationPrefs(ctx context.Context, userID int64, prefs *string) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).
+		Where(u.ID.Eq(userID)).
+		Select(u.NotificationPrefs).
+		Updates(&po.User{NotificationPrefs: prefs})
+	if err != nil {
+		r.log.Errorf("UpdateNotificationPrefs failed: %v", err)
+	}
+	return err
+}
+
+func (r *notificationRepo) CreatePendingNotification(ctx context.Context, n *po.PendingNotification) error {
+	return r.data.query.PendingNotification.WithContext(ctx).Create(n)
+}
+
+func (r *notificationRepo) ListDigestUsers(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	p := r.data.query.PendingNotification
+	var ids []int64
+	err := p.WithContext(ctx).
+		Where(p.SendAfter.Lte(now)).
+		Distinct(p.UserID).
+		Limit(limit).
+		Pluck(p.UserID, &ids)
+	return ids, err
+}
+
+func (r *notificationRepo) ListDuePendingNotifications(ctx context.Context, userID int64, now time.Time) ([]*po.PendingNotification, error) {
+	p := r.data.query.PendingNotification
+	return p.WithContext(ctx).
+		Where(p.UserID.Eq(userID), p.SendAfter.Lte(now)).
+		Order(p.ID).
+		Find()
+}
+
+func (r *notificationRepo) DeletePendingNotifications(ctx context.Context, ids []int64) (int64, error) {
+	p := r.data.query.PendingNotification
+	info, err := p.WithContext(ctx).Where(p.ID.In(ids...)).Delete()
+	if err != nil {
+		return 0, err
+	}
+	return info.RowsAffected, nil
+}
@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type outboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &outboxRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "outbox/data/krathub-service")),
+	}
+}
+
+// saveOutboxEvent 在业务事务 tx 中写入 outbox 事件，与业务数据一起提交或回滚
+func saveOutboxEvent(ctx context.Context, tx *dao.Query, eventType, aggregateType string, aggregateID int64, data any) error {
+	event, err := biz.NewOutboxEvent(eventType, aggregateType, aggregateID, data)
+	if err != nil {
+		return err
+	}
+	return tx.OutboxEvent.WithContext(ctx).Create(event)
+}
+
+func (r *outboxRepo) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*po.OutboxEvent, error) {
+	o := r.data.query.OutboxEvent
+	return o.WithContext(ctx).
+		Where(o.Status.Eq(biz.OutboxPending), o.NextAttemptAt.Lte(now)).
+		Order(o.ID).
+		Limit(limit).
+		Find()
+}
+
+func (r *outboxRepo) ClaimOutboxEvent(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	o := r.data.query.OutboxEvent
+	info, err := o.WithContext(ctx).
+		Where(o.ID.Eq(id), o.Status.Eq(biz.OutboxPending), o.NextAttemptAt.Lte(now)).
+		Update(o.NextAttemptAt, leaseUntil)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected == 1, nil
+}
+
+func (r *outboxRepo) MarkOutboxEventSent(ctx context.Context, id int64, sentAt time.Time) error {
+	o := r.data.query.OutboxEvent
+	_, err := o.WithContext(ctx).
+		Where(o.ID.Eq(id)).
+		Select(o.Status, o.SentAt, o.LastError).
+		Updates(&po.OutboxEvent{Status: biz.OutboxSent, SentAt: &sentAt})
+	return err
+}
+
+func (r *outboxRepo) MarkOutboxEventFailed(ctx context.Context, id int64, attempts int32, lastError string, nextAttemptAt time.Time) error {
+	o := r.data.query.OutboxEvent
+	_, err := o.WithContext(ctx).
+		Where(o.ID.Eq(id)).
+		Select(o.Attempts, o.LastError, o.NextAttemptAt).
+		Updates(&po.OutboxEvent{Attempts: attempts, LastError: &lastError, NextAttemptAt: nextAttemptAt})
+	return err
+}
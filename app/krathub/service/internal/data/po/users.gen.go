@@ -6,24 +6,33 @@ package po
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNameUser = "users"
 
 // User mapped from table <users>
 type User struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	Name      string    `gorm:"column:name;not null" json:"name"`
-	Email     string    `gorm:"column:email;not null" json:"email"`
-	Password  string    `gorm:"column:password;not null" json:"password"`
-	Phone     *string   `gorm:"column:phone;default:NULL" json:"phone"`
-	Avatar    *string   `gorm:"column:avatar;default:NULL" json:"avatar"`
-	Bio       *string   `gorm:"column:bio;default:NULL" json:"bio"`
-	Location  *string   `gorm:"column:location;default:NULL" json:"location"`
-	Website   *string   `gorm:"column:website;default:NULL" json:"website"`
-	Role      string    `gorm:"column:role;not null;default:user" json:"role"`
-	CreatedAt time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
+	ID                int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	Name              string         `gorm:"column:name;not null" json:"name"`
+	Email             string         `gorm:"column:email;not null" json:"email"`
+	Password          string         `gorm:"column:password;not null" json:"password"`
+	Phone             *string        `gorm:"column:phone;default:NULL" json:"phone"`
+	Avatar            *string        `gorm:"column:avatar;default:NULL" json:"avatar"`
+	Bio               *string        `gorm:"column:bio;default:NULL" json:"bio"`
+	Location          *string        `gorm:"column:location;default:NULL" json:"location"`
+	Website           *string        `gorm:"column:website;default:NULL" json:"website"`
+	Role              string         `gorm:"column:role;not null;default:user" json:"role"`
+	NotificationPrefs *string        `gorm:"column:notification_prefs;default:NULL" json:"notification_prefs"`
+	EmailVerified     bool           `gorm:"column:email_verified;not null" json:"email_verified"`
+	TotpSecret        *string        `gorm:"column:totp_secret;default:NULL" json:"totp_secret"`
+	TotpEnabledAt     *time.Time     `gorm:"column:totp_enabled_at;default:NULL" json:"totp_enabled_at"`
+	DisabledAt        *time.Time     `gorm:"column:disabled_at;default:NULL" json:"disabled_at"`
+	DeletedAt         gorm.DeletedAt `gorm:"column:deleted_at;default:NULL" json:"deleted_at"`
+	AnonymizedAt      *time.Time     `gorm:"column:anonymized_at;default:NULL" json:"anonymized_at"`
+	CreatedAt         time.Time      `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt         time.Time      `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP" json:"updated_at"`
 }
 
 // TableName User's table name
@@ -0,0 +1,27 @@
+package data
+
+import (
+	"context"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type roleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRoleRepo(data *Data, logger log.Logger) biz.RoleRepo {
+	return &roleRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "rbac/data/krathub-service")),
+	}
+}
+
+func (r *roleRepo) ListRoles(ctx context.Context) ([]*po.Role, error) {
+	return r.data.query.Role.WithContext(ctx).Find()
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type tokenRevocationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTokenRevocationRepo 基于 Redis 的 Access Token 吊销存储
+func NewTokenRevocationRepo(data *Data, logger log.Logger) biz.TokenRevocationRepo {
+	return &tokenRevocationRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "revocation/data/krathub-service")),
+	}
+}
+
+// DenyAccessToken 写入 jti 黑名单，随 token 过期自动删除
+func (r *tokenRevocationRepo) DenyAccessToken(ctx context.Context, jti string, ttl time.Duration) error {
+	key := fmt.Sprintf("access_token_denylist:%s", jti)
+	if err := r.data.redis.Set(ctx, key, "1", ttl); err != nil {
+		r.log.Errorf("Failed to deny access token: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *tokenRevocationRepo) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	key := fmt.Sprintf("access_token_denylist:%s", jti)
+	_, err := r.data.redis.Get(ctx, key)
+	if errors.Is(err, goredis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// watermarkSecondsLimit 小于该值的水位线按 Unix 秒解析，以毫秒计相当于 2001 年之前
+const watermarkSecondsLimit = 1_000_000_000_000
+
+// SetTokenWatermark 以 Unix 毫秒保存用户的 token 水位线
+func (r *tokenRevocationRepo) SetTokenWatermark(ctx context.Context, userID int64, at time.Time, ttl time.Duration) error {
+	key := fmt.Sprintf("user_token_watermark:%d", userID)
+	if err := r.data.redis.Set(ctx, key, strconv.FormatInt(at.UnixMilli(), 10), ttl); err != nil {
+		r.log.Errorf("Failed to set token watermark: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *tokenRevocationRepo) GetTokenWatermark(ctx context.Context, userID int64) (time.Time, error) {
+	key := fmt.Sprintf("user_token_watermark:%d", userID)
+	value, err := r.data.redis.Get(ctx, key)
+	if errors.Is(err, goredis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 兼容升级前以 Unix 秒保存的水位线
+	if ms < watermarkSecondsLimit {
+		return time.Unix(ms, 0), nil
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// DenySession 写入会话黑名单，保留一个 Access Token 有效期
+func (r *tokenRevocationRepo) DenySession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	key := fmt.Sprintf("session_denylist:%s", sessionID)
+	if err := r.data.redis.Set(ctx, key, "1", ttl); err != nil {
+		r.log.Errorf("Failed to deny session: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *tokenRevocationRepo) IsSessionDenied(ctx context.Context, sessionID string) (bool, error) {
+	key := fmt.Sprintf("session_denylist:%s", sessionID)
+	_, err := r.data.redis.Get(ctx, key)
+	if errors.Is(err, goredis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
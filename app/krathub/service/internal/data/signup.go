@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gen/field"
+)
+
+type signupInviteRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSignupInviteRepo(data *Data, logger log.Logger) biz.SignupInviteRepo {
+	return &signupInviteRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "signup/data/krathub-service")),
+	}
+}
+
+func (r *signupInviteRepo) CreateInvite(ctx context.Context, invite *po.SignupInvite) error {
+	if err := r.data.query.SignupInvite.WithContext(ctx).Create(invite); err != nil {
+		r.log.Errorf("CreateInvite failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *signupInviteRepo) ListInvites(ctx context.Context, activeAt *time.Time) ([]*po.SignupInvite, error) {
+	t := r.data.query.SignupInvite
+	q := t.WithContext(ctx)
+	if activeAt != nil {
+		q = q.Where(t.UsedCount.LtCol(t.MaxUses), field.Or(t.ExpiresAt.IsNull(), t.ExpiresAt.Gt(*activeAt)))
+	}
+	return q.Order(t.ID.Desc()).Find()
+}
+
+// ConsumeInvite 用条件更新保证并发注册时使用次数不会超过上限
+func (r *signupInviteRepo) ConsumeInvite(ctx context.Context, codeHash string, now time.Time) (bool, error) {
+	t := r.data.query.SignupInvite
+	info, err := t.WithContext(ctx).
+		Where(t.CodeHash.Eq(codeHash), t.UsedCount.LtCol(t.MaxUses), field.Or(t.ExpiresAt.IsNull(), t.ExpiresAt.Gt(now))).
+		UpdateSimple(t.UsedCount.Add(1))
+	if err != nil {
+		r.log.Errorf("ConsumeInvite failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *signupInviteRepo) ReleaseInvite(ctx context.Context, codeHash string) error {
+	t := r.data.query.SignupInvite
+	_, err := t.WithContext(ctx).Where(t.CodeHash.Eq(codeHash), t.UsedCount.Gt(0)).UpdateSimple(t.UsedCount.Sub(1))
+	return err
+}
+
+func (r *signupInviteRepo) DeleteInvite(ctx context.Context, id int64) (bool, error) {
+	t := r.data.query.SignupInvite
+	info, err := t.WithContext(ctx).Where(t.ID.Eq(id)).Delete()
+	if err != nil {
+		r.log.Errorf("DeleteInvite failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
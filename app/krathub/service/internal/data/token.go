@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type tokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTokenRepo(data *Data, logger log.Logger) biz.TokenRepo {
+	return &tokenRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "token/data/krathub-service")),
+	}
+}
+
+func (r *tokenRepo) CreateToken(ctx context.Context, token *po.PersonalAccessToken) error {
+	if err := r.data.query.PersonalAccessToken.WithContext(ctx).Create(token); err != nil {
+		r.log.Errorf("CreateToken failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *tokenRepo) ListUserTokens(ctx context.Context, userID int64) ([]*po.PersonalAccessToken, error) {
+	t := r.data.query.PersonalAccessToken
+	return t.WithContext(ctx).Where(t.UserID.Eq(userID)).Order(t.ID).Find()
+}
+
+func (r *tokenRepo) CountUserTokens(ctx context.Context, userID int64) (int64, error) {
+	t := r.data.query.PersonalAccessToken
+	return t.WithContext(ctx).Where(t.UserID.Eq(userID)).Count()
+}
+
+func (r *tokenRepo) GetTokenByPrefix(ctx context.Context, prefix string) (*po.PersonalAccessToken, error) {
+	t := r.data.query.PersonalAccessToken
+	token, err := t.WithContext(ctx).Where(t.Prefix.Eq(prefix)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetTokenByPrefix failed: %v", err)
+		return nil, err
+	}
+	return token, nil
+}
+
+func (r *tokenRepo) DeleteToken(ctx context.Context, userID, id int64) (bool, error) {
+	t := r.data.query.PersonalAccessToken
+	info, err := t.WithContext(ctx).Where(t.ID.Eq(id), t.UserID.Eq(userID)).Delete()
+	if err != nil {
+		r.log.Errorf("DeleteToken failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *tokenRepo) TouchToken(ctx context.Context, id int64, at time.Time) error {
+	t := r.data.query.PersonalAccessToken
+	_, err := t.WithContext(ctx).Where(t.ID.Eq(id)).UpdateSimple(t.LastUsedAt.Value(at))
+	return err
+}
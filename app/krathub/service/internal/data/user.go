@@ -2,14 +2,21 @@ package data
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ToAtlas/AtlasBackend/pkg/helpers/hash"
 
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
 	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
 )
 
 type userRepo struct {
@@ -26,12 +33,8 @@ func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 
 // SaveUser 保存用户信息
 func (r *userRepo) SaveUser(ctx context.Context, user *po.User) (*po.User, error) {
-	if !hash.BcryptIsHashed(user.Password) {
-		bcryptPassword, err := hash.BcryptHash(user.Password)
-		if err != nil {
-			return nil, err
-		}
-		user.Password = bcryptPassword
+	if err := requirePasswordHash(user.Password); err != nil {
+		return nil, err
 	}
 	err := r.data.query.User.
 		WithContext(ctx).
@@ -55,7 +58,7 @@ func (r *userRepo) GetUserById(ctx context.Context, id int64) (*po.User, error)
 	return user, nil
 }
 
-// DeleteUser 删除用户
+// DeleteUser 软删除用户，只写入 deleted_at
 func (r *userRepo) DeleteUser(ctx context.Context, user *po.User) (*po.User, error) {
 	_, err := r.data.query.User.
 		WithContext(ctx).
@@ -68,20 +71,227 @@ func (r *userRepo) DeleteUser(ctx context.Context, user *po.User) (*po.User, err
 
 // UpdateUser 更新用户信息
 func (r *userRepo) UpdateUser(ctx context.Context, user *po.User) (*po.User, error) {
-	// 判断密码是否已加密，未加密则加密
-	if !hash.BcryptIsHashed(user.Password) {
-		bcryptPassword, err := hash.BcryptHash(user.Password)
+	// 密码为空表示不修改
+	if user.Password != "" {
+		if err := requirePasswordHash(user.Password); err != nil {
+			return nil, err
+		}
+	}
+	// 用户更新与 user.updated 事件在同一事务中写入
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		if _, err := tx.User.WithContext(ctx).Where(tx.User.ID.Eq(user.ID)).Updates(user); err != nil {
+			return err
+		}
+		return saveOutboxEvent(ctx, tx, biz.EventUserUpdated, biz.AggregateUser, user.ID, biz.UserEventData(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListUsers 按条件分页查询用户，使用 (排序字段, id) 作为游标
+func (r *userRepo) ListUsers(ctx context.Context, opts *biz.UserListOptions) ([]*po.User, error) {
+	u := r.data.query.User
+	q := u.WithContext(ctx)
+
+	f := opts.Filter
+	if f.Deleted {
+		q = q.Unscoped().Where(u.DeletedAt.IsNotNull(), u.AnonymizedAt.IsNull())
+	}
+	if f.Query != "" {
+		pattern := "%" + escapeLike(f.Query) + "%"
+		q = q.Where(field.Or(likeEscaped(u.Name, pattern), likeEscaped(u.Email, pattern)))
+	}
+	if f.Role != "" {
+		q = q.Where(u.Role.Eq(f.Role))
+	}
+	if f.CreatedAfter != nil {
+		q = q.Where(u.CreatedAt.Gte(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		q = q.Where(u.CreatedAt.Lt(*f.CreatedBefore))
+	}
+	if f.Disabled != nil {
+		if *f.Disabled {
+			q = q.Where(u.DisabledAt.IsNotNull())
+		} else {
+			q = q.Where(u.DisabledAt.IsNull())
+		}
+	}
+
+	var sortField field.OrderExpr
+	switch opts.SortBy {
+	case biz.UserSortCreatedAt:
+		sortField = u.CreatedAt
+	case biz.UserSortName:
+		sortField = u.Name
+	case biz.UserSortEmail:
+		sortField = u.Email
+	default:
+		sortField = u.ID
+	}
+	if opts.After != nil {
+		after, err := r.afterCursor(opts.SortBy, opts.Desc, opts.After)
 		if err != nil {
 			return nil, err
 		}
-		user.Password = bcryptPassword
+		q = q.Where(after)
+	}
+	if opts.Desc {
+		q = q.Order(sortField.Desc(), u.ID.Desc())
+	} else {
+		q = q.Order(sortField, u.ID)
+	}
+	return q.Limit(opts.Limit).Find()
+}
+
+// afterCursor 游标之后的记录：排序字段越过游标值，或与游标值相同且 id 越过游标 id
+func (r *userRepo) afterCursor(sortBy string, desc bool, c *biz.UserCursor) (field.Expr, error) {
+	u := r.data.query.User
+	idAfter := u.ID.Gt(c.ID)
+	if desc {
+		idAfter = u.ID.Lt(c.ID)
+	}
+	switch sortBy {
+	case biz.UserSortCreatedAt:
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, err
+		}
+		if desc {
+			return field.Or(u.CreatedAt.Lt(t), field.And(u.CreatedAt.Eq(t), idAfter)), nil
+		}
+		return field.Or(u.CreatedAt.Gt(t), field.And(u.CreatedAt.Eq(t), idAfter)), nil
+	case biz.UserSortName, biz.UserSortEmail:
+		col := u.Name
+		if sortBy == biz.UserSortEmail {
+			col = u.Email
+		}
+		if desc {
+			return field.Or(col.Lt(c.Value), field.And(col.Eq(c.Value), idAfter)), nil
+		}
+		return field.Or(col.Gt(c.Value), field.And(col.Eq(c.Value), idAfter)), nil
+	default:
+		return idAfter, nil
+	}
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// likeEscaped 生成 column LIKE ? ESCAPE ?，显式指定反斜杠为转义符（SQLite 没有默认转义符）。
+// 转义符作为参数传入，避免 MySQL 把字面量 '\' 中的反斜杠当作字符串转义；SQL 中没有拼接任何输入
+func likeEscaped(col field.String, pattern string) field.Expr {
+	return field.NewUnsafeFieldRaw("? LIKE ? ESCAPE ?", col.RawExpr(), pattern, `\`)
+}
+
+// UpdateRole 修改用户角色
+func (r *userRepo) UpdateRole(ctx context.Context, id int64, role string) error {
+	u := r.data.query.User
+	if _, err := u.WithContext(ctx).Where(u.ID.Eq(id)).Update(u.Role, role); err != nil {
+		r.log.Errorf("UpdateRole failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SetDisabledAt 设置或清除停用时间
+func (r *userRepo) SetDisabledAt(ctx context.Context, id int64, at *time.Time) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).
+		Where(u.ID.Eq(id)).
+		Select(u.DisabledAt).
+		Updates(&po.User{DisabledAt: at})
+	if err != nil {
+		r.log.Errorf("SetDisabledAt failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetDeletedUser 查询已删除但个人信息尚未清除的用户
+func (r *userRepo) GetDeletedUser(ctx context.Context, id int64) (*po.User, error) {
+	u := r.data.query.User
+	user, err := u.WithContext(ctx).
+		Unscoped().
+		Where(u.ID.Eq(id), u.DeletedAt.IsNotNull(), u.AnonymizedAt.IsNull()).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
 	}
-	_, err := r.data.query.User.
-		WithContext(ctx).
-		Where(r.data.query.User.ID.Eq(user.ID)).
-		Updates(user)
 	if err != nil {
 		return nil, err
 	}
 	return user, nil
 }
+
+// RestoreUser 清除删除标记
+func (r *userRepo) RestoreUser(ctx context.Context, id int64) error {
+	u := r.data.query.User
+	_, err := u.WithContext(ctx).
+		Unscoped().
+		Where(u.ID.Eq(id)).
+		Update(u.DeletedAt, nil)
+	if err != nil {
+		r.log.Errorf("RestoreUser failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListPurgeableUsers 查询宽限期已过、个人信息尚未清除的用户ID
+func (r *userRepo) ListPurgeableUsers(ctx context.Context, deletedBefore time.Time, limit int) ([]int64, error) {
+	u := r.data.query.User
+	var ids []int64
+	err := u.WithContext(ctx).
+		Unscoped().
+		Where(u.DeletedAt.Lt(gorm.DeletedAt{Time: deletedBefore, Valid: true}), u.AnonymizedAt.IsNull()).
+		Order(u.ID).
+		Limit(limit).
+		Pluck(u.ID, &ids)
+	return ids, err
+}
+
+// AnonymizeUser 在同一事务中清除用户的个人信息和关联的凭据数据。
+// 用户名和邮箱替换为占位值，释放唯一索引，原用户名和邮箱可以重新注册
+func (r *userRepo) AnonymizeUser(ctx context.Context, id int64, at time.Time) error {
+	return r.data.query.Transaction(func(tx *dao.Query) error {
+		u := tx.User
+		_, err := u.WithContext(ctx).
+			Unscoped().
+			Where(u.ID.Eq(id)).
+			Select(u.Name, u.Email, u.Password, u.Phone, u.Avatar, u.Bio, u.Location, u.Website,
+				u.NotificationPrefs, u.EmailVerified, u.TotpSecret, u.TotpEnabledAt, u.AnonymizedAt).
+			Updates(&po.User{
+				Name:         fmt.Sprintf("deleted-user-%d", id),
+				Email:        fmt.Sprintf("deleted-user-%d@deleted.invalid", id),
+				AnonymizedAt: &at,
+			})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.UserIdentity.WithContext(ctx).Where(tx.UserIdentity.UserID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.UserRecoveryCode.WithContext(ctx).Where(tx.UserRecoveryCode.UserID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.PersonalAccessToken.WithContext(ctx).Where(tx.PersonalAccessToken.UserID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		_, err = tx.PendingNotification.WithContext(ctx).Where(tx.PendingNotification.UserID.Eq(id)).Delete()
+		return err
+	})
+}
+
+// requirePasswordHash 密码由 biz 按密码策略校验并加密后传入，这里不根据输入是否像哈希决定是否加密，
+// 只拒绝把明文或无效的哈希写入数据库
+func requirePasswordHash(password string) error {
+	if !hash.IsHashed(password) {
+		return errors.New("password must be hashed before saving")
+	}
+	return nil
+}
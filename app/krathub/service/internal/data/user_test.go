@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	dao "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupTestUserRepo 使用内存 SQLite 创建 userRepo
+func setupTestUserRepo(t *testing.T) *userRepo {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&po.User{}))
+	return &userRepo{data: &Data{query: dao.Use(db)}, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func TestUserRepo_ListUsersEscapesWildcards(t *testing.T) {
+	ctx := context.Background()
+	repo := setupTestUserRepo(t)
+	for _, name := range []string{"a_b", "axb", "100%", "1000", `back\slash`} {
+		require.NoError(t, repo.data.query.User.WithContext(ctx).Create(&po.User{Name: name, Email: name + "@example.com", Role: biz.RoleUser}))
+	}
+
+	// 查询中的 _、% 和反斜杠按字面匹配，不作为通配符
+	for query, want := range map[string][]string{
+		"_":  {"a_b"},
+		"%":  {"100%"},
+		`\`:  {`back\slash`},
+		"0%": {"100%"},
+		"b":  {"a_b", "axb", `back\slash`},
+	} {
+		users, err := repo.ListUsers(ctx, &biz.UserListOptions{Filter: biz.UserFilter{Query: query}, Limit: 10})
+		require.NoError(t, err, query)
+		names := make([]string, 0, len(users))
+		for _, u := range users {
+			names = append(names, u.Name)
+		}
+		assert.ElementsMatch(t, want, names, query)
+	}
+}
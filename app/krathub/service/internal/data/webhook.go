@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	webhookpb "github.com/ToAtlas/AtlasBackend/api/gen/go/webhook/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type webhookRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWebhookRepo(data *Data, logger log.Logger) biz.WebhookRepo {
+	return &webhookRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "webhook/data/krathub-service")),
+	}
+}
+
+func (r *webhookRepo) CreateWebhook(ctx context.Context, hook *po.Webhook) (*po.Webhook, error) {
+	if err := r.data.query.Webhook.WithContext(ctx).Create(hook); err != nil {
+		r.log.Errorf("CreateWebhook failed: %v", err)
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (r *webhookRepo) GetWebhook(ctx context.Context, id int64) (*po.Webhook, error) {
+	w := r.data.query.Webhook
+	hook, err := w.WithContext(ctx).Where(w.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, webhookpb.ErrorWebhookNotFound("webhook %d not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (r *webhookRepo) ListWebhooks(ctx context.Context) ([]*po.Webhook, error) {
+	w := r.data.query.Webhook
+	return w.WithContext(ctx).Order(w.ID).Find()
+}
+
+func (r *webhookRepo) ListActiveWebhooks(ctx context.Context) ([]*po.Webhook, error) {
+	w := r.data.query.Webhook
+	return w.WithContext(ctx).Where(w.Active.Is(true)).Find()
+}
+
+func (r *webhookRepo) UpdateWebhook(ctx context.Context, hook *po.Webhook) (*po.Webhook, error) {
+	w := r.data.query.Webhook
+	// 使用 Select 显式更新，保证 active=false 和空描述也能写入
+	_, err := w.WithContext(ctx).
+		Where(w.ID.Eq(hook.ID)).
+		Select(w.URL, w.Secret, w.Events, w.Description, w.Active).
+		Updates(hook)
+	if err != nil {
+		r.log.Errorf("UpdateWebhook failed: %v", err)
+		return nil, err
+	}
+	return r.GetWebhook(ctx, hook.ID)
+}
+
+func (r *webhookRepo) DeleteWebhook(ctx context.Context, id int64) error {
+	w := r.data.query.Webhook
+	_, err := w.WithContext(ctx).Where(w.ID.Eq(id)).Delete()
+	return err
+}
+
+func (r *webhookRepo) CreateDeliveries(ctx context.Context, deliveries []*po.WebhookDelivery) error {
+	if err := r.data.query.WebhookDelivery.WithContext(ctx).Create(deliveries...); err != nil {
+		r.log.Errorf("CreateDeliveries failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *webhookRepo) GetDelivery(ctx context.Context, id int64) (*po.WebhookDelivery, error) {
+	d := r.data.query.WebhookDelivery
+	delivery, err := d.WithContext(ctx).Where(d.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, webhookpb.ErrorDeliveryNotFound("delivery %d not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepo) ListDeliveries(ctx context.Context, webhookID int64, status string, offset, limit int) ([]*po.WebhookDelivery, int64, error) {
+	d := r.data.query.WebhookDelivery
+	q := d.WithContext(ctx)
+	if webhookID > 0 {
+		q = q.Where(d.WebhookID.Eq(webhookID))
+	}
+	if status != "" {
+		q = q.Where(d.Status.Eq(status))
+	}
+	return q.Order(d.ID.Desc()).FindByPage(offset, limit)
+}
+
+func (r *webhookRepo) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*po.WebhookDelivery, error) {
+	d := r.data.query.WebhookDelivery
+	return d.WithContext(ctx).
+		Where(d.Status.Eq(biz.DeliveryPending), d.NextAttemptAt.Lte(now)).
+		Order(d.NextAttemptAt).
+		Limit(limit).
+		Find()
+}
+
+func (r *webhookRepo) ClaimDelivery(ctx context.Context, id int64, now, leaseUntil time.Time) (bool, error) {
+	d := r.data.query.WebhookDelivery
+	info, err := d.WithContext(ctx).
+		Where(d.ID.Eq(id), d.Status.Eq(biz.DeliveryPending), d.NextAttemptAt.Lte(now)).
+		Update(d.NextAttemptAt, leaseUntil)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected == 1, nil
+}
+
+func (r *webhookRepo) UpdateDelivery(ctx context.Context, delivery *po.WebhookDelivery) error {
+	d := r.data.query.WebhookDelivery
+	_, err := d.WithContext(ctx).
+		Where(d.ID.Eq(delivery.ID)).
+		Select(d.Status, d.Attempts, d.ResponseCode, d.LastError, d.DurationMs, d.NextAttemptAt, d.DeliveredAt).
+		Updates(delivery)
+	return err
+}
@@ -0,0 +1,211 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/dao"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type workspaceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWorkspaceRepo(data *Data, logger log.Logger) biz.WorkspaceRepo {
+	return &workspaceRepo{
+		data: data,
+		log:  log.NewHelper(pkglogger.WithModule(logger, "workspace/data/krathub-service")),
+	}
+}
+
+func (r *workspaceRepo) CreateWorkspace(ctx context.Context, workspace *po.Workspace, owner *po.WorkspaceMember) error {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		if err := tx.Workspace.WithContext(ctx).Create(workspace); err != nil {
+			return err
+		}
+		owner.WorkspaceID = workspace.ID
+		return tx.WorkspaceMember.WithContext(ctx).Create(owner)
+	})
+	if err != nil {
+		r.log.Errorf("CreateWorkspace failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *workspaceRepo) GetWorkspace(ctx context.Context, id int64) (*po.Workspace, error) {
+	w := r.data.query.Workspace
+	return w.WithContext(ctx).Where(w.ID.Eq(id)).First()
+}
+
+func (r *workspaceRepo) ListUserWorkspaces(ctx context.Context, userID int64) ([]*biz.UserWorkspace, error) {
+	m := r.data.query.WorkspaceMember
+	members, err := m.WithContext(ctx).Where(m.UserID.Eq(userID)).Order(m.ID).Find()
+	if err != nil {
+		r.log.Errorf("ListUserWorkspaces failed: %v", err)
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.WorkspaceID)
+	}
+	w := r.data.query.Workspace
+	workspaces, err := w.WithContext(ctx).Where(w.ID.In(ids...)).Find()
+	if err != nil {
+		r.log.Errorf("ListUserWorkspaces failed: %v", err)
+		return nil, err
+	}
+	byID := make(map[int64]*po.Workspace, len(workspaces))
+	for _, workspace := range workspaces {
+		byID[workspace.ID] = workspace
+	}
+	result := make([]*biz.UserWorkspace, 0, len(members))
+	for _, member := range members {
+		if workspace, ok := byID[member.WorkspaceID]; ok {
+			result = append(result, &biz.UserWorkspace{Workspace: workspace, Role: member.Role})
+		}
+	}
+	return result, nil
+}
+
+func (r *workspaceRepo) GetMember(ctx context.Context, workspaceID, userID int64) (*po.WorkspaceMember, error) {
+	m := r.data.query.WorkspaceMember
+	member, err := m.WithContext(ctx).Where(m.WorkspaceID.Eq(workspaceID), m.UserID.Eq(userID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetMember failed: %v", err)
+		return nil, err
+	}
+	return member, nil
+}
+
+func (r *workspaceRepo) ListMembers(ctx context.Context, workspaceID int64) ([]*biz.WorkspaceMemberInfo, error) {
+	m := r.data.query.WorkspaceMember
+	members, err := m.WithContext(ctx).Where(m.WorkspaceID.Eq(workspaceID)).Order(m.ID).Find()
+	if err != nil {
+		r.log.Errorf("ListMembers failed: %v", err)
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+	u := r.data.query.User
+	users, err := u.WithContext(ctx).Where(u.ID.In(ids...)).Find()
+	if err != nil {
+		r.log.Errorf("ListMembers failed: %v", err)
+		return nil, err
+	}
+	byID := make(map[int64]*po.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+	result := make([]*biz.WorkspaceMemberInfo, 0, len(members))
+	for _, member := range members {
+		if user, ok := byID[member.UserID]; ok {
+			result = append(result, &biz.WorkspaceMemberInfo{Member: member, User: user})
+		}
+	}
+	return result, nil
+}
+
+func (r *workspaceRepo) CountMembersByRole(ctx context.Context, workspaceID int64, role string) (int64, error) {
+	m := r.data.query.WorkspaceMember
+	return m.WithContext(ctx).Where(m.WorkspaceID.Eq(workspaceID), m.Role.Eq(role)).Count()
+}
+
+func (r *workspaceRepo) UpdateMemberRole(ctx context.Context, workspaceID, userID int64, role string) error {
+	m := r.data.query.WorkspaceMember
+	if _, err := m.WithContext(ctx).Where(m.WorkspaceID.Eq(workspaceID), m.UserID.Eq(userID)).Update(m.Role, role); err != nil {
+		r.log.Errorf("UpdateMemberRole failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *workspaceRepo) RemoveMember(ctx context.Context, workspaceID, userID int64) error {
+	m := r.data.query.WorkspaceMember
+	if _, err := m.WithContext(ctx).Where(m.WorkspaceID.Eq(workspaceID), m.UserID.Eq(userID)).Delete(); err != nil {
+		r.log.Errorf("RemoveMember failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *workspaceRepo) SaveInvitation(ctx context.Context, invitation *po.WorkspaceInvitation) error {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		i := tx.WorkspaceInvitation
+		if _, err := i.WithContext(ctx).Where(i.WorkspaceID.Eq(invitation.WorkspaceID), i.Email.Eq(invitation.Email)).Delete(); err != nil {
+			return err
+		}
+		return i.WithContext(ctx).Create(invitation)
+	})
+	if err != nil {
+		r.log.Errorf("SaveInvitation failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *workspaceRepo) ListInvitations(ctx context.Context, workspaceID int64) ([]*po.WorkspaceInvitation, error) {
+	i := r.data.query.WorkspaceInvitation
+	return i.WithContext(ctx).Where(i.WorkspaceID.Eq(workspaceID)).Order(i.ID).Find()
+}
+
+func (r *workspaceRepo) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*po.WorkspaceInvitation, error) {
+	i := r.data.query.WorkspaceInvitation
+	invitation, err := i.WithContext(ctx).Where(i.TokenHash.Eq(tokenHash)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("GetInvitationByTokenHash failed: %v", err)
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (r *workspaceRepo) DeleteInvitation(ctx context.Context, workspaceID, id int64) (bool, error) {
+	i := r.data.query.WorkspaceInvitation
+	info, err := i.WithContext(ctx).Where(i.WorkspaceID.Eq(workspaceID), i.ID.Eq(id)).Delete()
+	if err != nil {
+		r.log.Errorf("DeleteInvitation failed: %v", err)
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *workspaceRepo) AcceptInvitation(ctx context.Context, invitation *po.WorkspaceInvitation, member *po.WorkspaceMember) error {
+	err := r.data.query.Transaction(func(tx *dao.Query) error {
+		i := tx.WorkspaceInvitation
+		info, err := i.WithContext(ctx).Where(i.ID.Eq(invitation.ID)).Delete()
+		if err != nil {
+			return err
+		}
+		// 邀请已被并发接受或撤销
+		if info.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.WorkspaceMember.WithContext(ctx).Create(member)
+	})
+	if err != nil {
+		r.log.Errorf("AcceptInvitation failed: %v", err)
+		return err
+	}
+	return nil
+}
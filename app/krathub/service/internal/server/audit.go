@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	auditpb "github.com/ToAtlas/AtlasBackend/api/gen/go/audit/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// OperationAuditServiceExportAuditEvents 审计日志导出接口的 operation，用于权限校验
+const OperationAuditServiceExportAuditEvents = auditpb.AuditService_ExportAuditEvents_FullMethodName
+
+// registerAuditExport 注册审计日志导出接口。响应是 JSON Lines 文件而不是 JSON 对象，
+// 无法使用生成的路由，这里按生成代码的方式手动注册，同样经过中间件链
+func registerAuditExport(srv *http.Server, audit *service.AuditService) {
+	r := srv.Route("/")
+	r.GET("/v1/audit-events/export", func(ctx http.Context) error {
+		var in auditpb.ExportAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuditServiceExportAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req any) (any, error) {
+			return audit.ExportAuditEvents(ctx, req.(*auditpb.ExportAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*auditpb.ExportAuditEventsResponse)
+		filename := fmt.Sprintf("audit-events-%s.jsonl", time.Now().UTC().Format("20060102T150405Z"))
+		w := ctx.Response()
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		_, err = w.Write(reply.Data)
+		return err
+	})
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+
+	eventpb "github.com/ToAtlas/AtlasBackend/api/gen/go/event/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/pkg/eventbus"
+	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// webhookConsumerGroup webhook 投递使用的消费者组
+const webhookConsumerGroup = "krathub-webhook"
+
+// EventConsumer 订阅事件总线上的领域事件并交给各业务处理，实现 transport.Server 交给 kratos.App 管理生命周期
+type EventConsumer struct {
+	bus     eventbus.Bus
+	webhook *biz.WebhookUsecase
+	log     *log.Helper
+
+	subs []eventbus.Subscription
+}
+
+// NewEventConsumer 创建领域事件消费者
+func NewEventConsumer(bus eventbus.Bus, webhook *biz.WebhookUsecase, logger log.Logger) *EventConsumer {
+	return &EventConsumer{
+		bus:     bus,
+		webhook: webhook,
+		log:     log.NewHelper(logpkg.WithModule(logger, "event/server/krathub-service")),
+	}
+}
+
+// Start 订阅领域事件
+func (c *EventConsumer) Start(context.Context) error {
+	sub, err := c.bus.Subscribe(biz.DomainEventTopic, webhookConsumerGroup, eventbus.ProtoHandler(
+		func(ctx context.Context, _ *eventbus.Message, e *eventpb.DomainEvent) error {
+			return c.webhook.PublishEvent(ctx, &biz.DomainEvent{
+				ID:            e.GetId(),
+				Type:          e.GetType(),
+				AggregateType: e.GetAggregateType(),
+				AggregateID:   e.GetAggregateId(),
+				OccurredAt:    e.GetOccurredAt().AsTime(),
+				Data:          e.GetData(),
+			})
+		}))
+	if err != nil {
+		return err
+	}
+	c.subs = append(c.subs, sub)
+	c.log.Infof("subscribed to %s as %s", biz.DomainEventTopic, webhookConsumerGroup)
+	return nil
+}
+
+// Stop 取消订阅，等待正在处理的事件完成
+func (c *EventConsumer) Stop(context.Context) error {
+	for _, sub := range c.subs {
+		_ = sub.Close()
+	}
+	c.subs = nil
+	return nil
+}
@@ -5,11 +5,11 @@ import (
 
 	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
 	krathubv1 "github.com/ToAtlas/AtlasBackend/api/gen/go/krathub/service/v1"
-	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/consts"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
 	mwinter "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/server/middleware"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
 	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
-	mwpkg "github.com/ToAtlas/AtlasBackend/pkg/middleware"
+	"github.com/ToAtlas/AtlasBackend/pkg/middleware/clientinfo"
 	"github.com/ToAtlas/AtlasBackend/pkg/middleware/cors"
 
 	"github.com/go-kratos/kratos/contrib/middleware/validate/v2"
@@ -19,7 +19,6 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
-	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
@@ -27,8 +26,9 @@ import (
 // HTTPMiddleware 用于 Wire 注入的中间件切片包装类型
 type HTTPMiddleware []middleware.Middleware
 
-// NewHTTPMiddleware 创建 HTTP 中间件（使用白名单机制）
+// NewHTTPMiddleware 创建 HTTP 中间件（按接口声明的权限校验）
 func NewHTTPMiddleware(
+	c *conf.Server,
 	trace *conf.Trace,
 	m *Metrics,
 	logger log.Logger,
@@ -42,6 +42,7 @@ func NewHTTPMiddleware(
 		logging.Server(httpLogger),
 		ratelimit.Server(),
 		validate.ProtoValidate(),
+		clientinfo.Server(clientinfo.WithTrustForwarded(c.GetHttp().GetTrustForwardedHeaders())),
 	)
 
 	if trace != nil && trace.Endpoint != "" {
@@ -55,37 +56,108 @@ func NewHTTPMiddleware(
 		))
 	}
 
-	// 公开接口白名单（无需认证）
-	publicWhitelist := mwpkg.NewWhiteList(mwpkg.Exact,
-		krathubv1.OperationAuthServiceLoginByEmailPassword,
-		krathubv1.OperationAuthServiceRefreshToken,
-		krathubv1.OperationAuthServiceSignupByEmail,
-		krathubv1.OperationTestServiceTest,
-		krathubv1.OperationTestServiceHello,
-	)
-
-	// User 级接口白名单（需要 User 权限但跳过 Admin 检查）
-	userWhitelist := mwpkg.NewWhiteList(mwpkg.Exact,
-		krathubv1.OperationUserServiceCurrentUserInfo,
-		krathubv1.OperationUserServiceUpdateUser,
-		krathubv1.OperationTestServicePrivateTest,
-	)
-
-	// Admin 权限排除白名单 = 公开接口 ∪ User 级接口
-	adminExcludeWhitelist := publicWhitelist.Merge(userWhitelist)
-
-	ms = append(ms,
-		selector.Server(authJWT(consts.User)).
-			Match(publicWhitelist.MatchFunc()).
-			Build(),
-		selector.Server(authJWT(consts.Admin)).
-			Match(adminExcludeWhitelist.MatchFunc()).
-			Build(),
-	)
+	// 每个请求按 httpPermissions 校验权限，未列出的接口拒绝访问
+	ms = append(ms, authJWT(httpPermissions))
 
 	return ms
 }
 
+// httpPermissions 各 HTTP 接口所需的权限，新增接口时需要在这里声明，否则会被拒绝访问
+var httpPermissions = mwinter.OperationPermissions{
+	// AuthService
+	krathubv1.OperationAuthServiceSignupByEmail:           mwinter.Public,
+	krathubv1.OperationAuthServiceLoginByEmailPassword:    mwinter.Public,
+	krathubv1.OperationAuthServiceRefreshToken:            mwinter.Public,
+	krathubv1.OperationAuthServiceVerifyMFA:               mwinter.Public,
+	krathubv1.OperationAuthServiceVerifyEmail:             mwinter.Public,
+	krathubv1.OperationAuthServiceResendVerificationEmail: mwinter.Public,
+	krathubv1.OperationAuthServiceRequestPasswordReset:    mwinter.Public,
+	krathubv1.OperationAuthServiceResetPassword:           mwinter.Public,
+	krathubv1.OperationAuthServiceListOIDCProviders:       mwinter.Public,
+	krathubv1.OperationAuthServiceStartOIDCLogin:          mwinter.Public,
+	krathubv1.OperationAuthServiceOIDCCallback:            mwinter.OptionalAuth,
+	krathubv1.OperationAuthServiceLogout:                  biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceListSessions:            biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceRevokeSession:           biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceRevokeOtherSessions:     biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceEnrollTOTP:              biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceConfirmTOTP:             biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceDisableTOTP:             biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceStartOIDCLink:           biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceListIdentities:          biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceUnlinkIdentity:          biz.PermAccountManageSelf,
+	krathubv1.OperationAuthServiceImpersonate:             biz.PermUserImpersonate,
+	krathubv1.OperationAuthServiceEndImpersonation:        biz.PermUserReadSelf,
+
+	// UserService，修改其他用户和修改角色在 biz 中进一步校验 user:update:any 和 user:role:assign
+	krathubv1.OperationUserServiceCurrentUserInfo:   biz.PermUserReadSelf,
+	krathubv1.OperationUserServiceGetPreferences:    biz.PermUserReadSelf,
+	krathubv1.OperationUserServiceUpdateUser:        biz.PermUserUpdateSelf,
+	krathubv1.OperationUserServiceUpdatePreferences: biz.PermUserUpdateSelf,
+	krathubv1.OperationUserServiceSaveUser:          biz.PermUserCreate,
+	krathubv1.OperationUserServiceDeleteUser:        biz.PermUserDeleteAny,
+	krathubv1.OperationUserServiceUnlockUser:        biz.PermUserUnlock,
+	krathubv1.OperationUserServiceListUsers:         biz.PermUserReadAny,
+	krathubv1.OperationUserServiceBulkUpdateRole:    biz.PermUserAssignRole,
+	krathubv1.OperationUserServiceDisableUsers:      biz.PermUserDisable,
+	krathubv1.OperationUserServiceEnableUsers:       biz.PermUserDisable,
+	krathubv1.OperationUserServiceRestoreUser:       biz.PermUserDeleteAny,
+	krathubv1.OperationUserServiceExportMyData:      biz.PermUserReadSelf,
+	krathubv1.OperationUserServiceCreateInviteCode:  biz.PermUserInvite,
+	krathubv1.OperationUserServiceListInviteCodes:   biz.PermUserInvite,
+	krathubv1.OperationUserServiceRevokeInviteCode:  biz.PermUserInvite,
+
+	// TestService
+	krathubv1.OperationTestServiceTest:        mwinter.Public,
+	krathubv1.OperationTestServiceHello:       mwinter.Public,
+	krathubv1.OperationTestServicePrivateTest: biz.PermTestPrivate,
+
+	// WebhookService
+	krathubv1.OperationWebhookServiceCreateWebhook:  biz.PermWebhookManage,
+	krathubv1.OperationWebhookServiceListWebhooks:   biz.PermWebhookManage,
+	krathubv1.OperationWebhookServiceUpdateWebhook:  biz.PermWebhookManage,
+	krathubv1.OperationWebhookServiceDeleteWebhook:  biz.PermWebhookManage,
+	krathubv1.OperationWebhookServiceListDeliveries: biz.PermWebhookManage,
+	krathubv1.OperationWebhookServiceRedeliverEvent: biz.PermWebhookManage,
+
+	// TokenService
+	krathubv1.OperationTokenServiceCreateToken: biz.PermTokenManageSelf,
+	krathubv1.OperationTokenServiceListTokens:  biz.PermTokenManageSelf,
+	krathubv1.OperationTokenServiceRevokeToken: biz.PermTokenManageSelf,
+
+	// WorkspaceService，工作空间内的操作在 biz 中按工作空间角色进一步校验
+	krathubv1.OperationWorkspaceServiceCreateWorkspace:  biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceListWorkspaces:   biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceListMembers:      biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceUpdateMemberRole: biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceRemoveMember:     biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceInviteMember:     biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceListInvitations:  biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceRevokeInvitation: biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceAcceptInvitation: biz.PermWorkspaceUse,
+	krathubv1.OperationWorkspaceServiceSwitchWorkspace:  biz.PermWorkspaceUse,
+
+	// AuditService
+	krathubv1.OperationAuditServiceListAuditEvents: biz.PermAuditRead,
+	OperationAuditServiceExportAuditEvents:         biz.PermAuditRead,
+
+	// SCIM，写操作在 biz 中进一步校验对应的用户管理权限
+	service.OperationSCIMServiceProviderConfig: mwinter.Public,
+	service.OperationSCIMResourceTypes:         mwinter.Public,
+	service.OperationSCIMListUsers:             biz.PermUserProvision,
+	service.OperationSCIMGetUser:               biz.PermUserProvision,
+	service.OperationSCIMCreateUser:            biz.PermUserProvision,
+	service.OperationSCIMReplaceUser:           biz.PermUserProvision,
+	service.OperationSCIMPatchUser:             biz.PermUserProvision,
+	service.OperationSCIMDeleteUser:            biz.PermUserProvision,
+	service.OperationSCIMListGroups:            biz.PermUserProvision,
+	service.OperationSCIMGetGroup:              biz.PermUserProvision,
+	service.OperationSCIMCreateGroup:           biz.PermUserProvision,
+	service.OperationSCIMReplaceGroup:          biz.PermUserProvision,
+	service.OperationSCIMPatchGroup:            biz.PermUserProvision,
+	service.OperationSCIMDeleteGroup:           biz.PermUserProvision,
+}
+
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(
 	c *conf.Server,
@@ -95,6 +167,11 @@ func NewHTTPServer(
 	auth *service.AuthService,
 	user *service.UserService,
 	test *service.TestService,
+	webhook *service.WebhookService,
+	token *service.TokenService,
+	workspace *service.WorkspaceService,
+	audit *service.AuditService,
+	scim *service.SCIMService,
 ) *http.Server {
 	httpLogger := logpkg.WithModule(logger, "http/server/krathub-service")
 
@@ -135,9 +212,18 @@ func NewHTTPServer(
 		srv.Handle("/metrics", m.Handler)
 	}
 
+	// 公钥集合，供其他服务验证 Access Token，不经过认证中间件
+	srv.HandleFunc("/.well-known/jwks.json", auth.JWKS)
+
 	krathubv1.RegisterAuthServiceHTTPServer(srv, auth)
 	krathubv1.RegisterUserServiceHTTPServer(srv, user)
 	krathubv1.RegisterTestServiceHTTPServer(srv, test)
+	krathubv1.RegisterWebhookServiceHTTPServer(srv, webhook)
+	krathubv1.RegisterTokenServiceHTTPServer(srv, token)
+	krathubv1.RegisterWorkspaceServiceHTTPServer(srv, workspace)
+	krathubv1.RegisterAuditServiceHTTPServer(srv, audit)
+	registerAuditExport(srv, audit)
+	registerSCIM(srv, scim)
 
 	return srv
 }
@@ -5,64 +5,90 @@ import (
 	"strings"
 
 	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
-	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
-	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/consts"
 	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// Public 公开接口所需的权限，不校验 token
+const Public = ""
+
+// OptionalAuth 公开接口，但请求带有有效的 Access Token 时把用户信息放入 context。
+// token 无效或是个人访问令牌时按未登录处理
+const OptionalAuth = "?"
+
+// OperationPermissions 接口（operation）到所需权限的映射，未列出的接口一律拒绝访问
+type OperationPermissions map[string]string
+
 // AuthJWT 定义认证中间件生成器函数类型
-type AuthJWT func(minRole consts.UserRole) middleware.Middleware
+type AuthJWT func(perms OperationPermissions) middleware.Middleware
 
-// NewAuthMiddleware 创建认证中间件生成器
-func NewAuthMiddleware(appConf *conf.App) AuthJWT {
-	return func(minRole consts.UserRole) middleware.Middleware {
+// NewAuthMiddleware 创建认证中间件生成器，与签发方共用 accessJWT，revoker 用于拒绝已吊销的 token，
+// tokens 用于验证以 pat_ 开头的个人访问令牌，authorizer 按角色的权限判断能否访问接口
+func NewAuthMiddleware(accessJWT *jwt.JWT[biz.UserClaims], revoker *biz.TokenRevoker, tokens *biz.TokenUsecase, authorizer *biz.Authorizer) AuthJWT {
+	return func(perms OperationPermissions) middleware.Middleware {
 		return func(handler middleware.Handler) middleware.Handler {
 			return func(ctx context.Context, req any) (reply any, err error) {
 				tr, ok := transport.FromServerContext(ctx)
 				if !ok {
 					return nil, authpb.ErrorMissingToken("missing transport context")
 				}
-				authHeader := tr.RequestHeader().Get("Authorization")
-				tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-
-				// 如果未设置 minRole（即为 0），允许无 token 访问
-				if minRole == 0 && tokenString == "" {
+				required, ok := perms[tr.Operation()]
+				if !ok {
+					return nil, authpb.ErrorUnauthorized("operation %s is not allowed", tr.Operation())
+				}
+				if required == Public {
+					return handler(ctx, req)
+				}
+				if required == OptionalAuth {
+					tokenString := strings.TrimPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+					if tokenString != "" && !strings.HasPrefix(tokenString, biz.PATPrefix) {
+						if claims, err := accessJWT.ParseToken(tokenString); err == nil && revoker.Check(ctx, claims) == nil {
+							ctx = jwt.NewContext(ctx, claims)
+						}
+					}
 					return handler(ctx, req)
 				}
 
+				authHeader := tr.RequestHeader().Get("Authorization")
+				tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 				if tokenString == "" {
 					return nil, authpb.ErrorMissingToken("missing Authorization header")
 				}
 
-				// 创建JWT实例并解析Token
-				jwtInstance := jwt.NewJWT[biz.UserClaims](&jwt.Config{
-					SecretKey: appConf.Jwt.AccessSecret,
-				})
-				claims, err := jwtInstance.ParseToken(tokenString)
-				if err != nil {
-					return nil, authpb.ErrorUnauthorized("invalid token: %v", err)
-				}
+				var claims *biz.UserClaims
+				if strings.HasPrefix(tokenString, biz.PATPrefix) {
+					// 个人访问令牌只能访问 operationScopes 中列出的接口，并且需要对应的授权范围
+					patClaims, scopes, err := tokens.Authenticate(ctx, tokenString)
+					if err != nil {
+						return nil, err
+					}
+					scope, ok := operationScopes[tr.Operation()]
+					if !ok {
+						return nil, authpb.ErrorUnauthorized("personal access tokens cannot access %s", tr.Operation())
+					}
+					if !biz.HasScope(scopes, scope) {
+						return nil, authpb.ErrorUnauthorized("token is missing the %s scope", scope)
+					}
+					claims = patClaims
+				} else {
+					// 解析Token，非对称签名时按 kid 选择公钥
+					jwtClaims, err := accessJWT.ParseToken(tokenString)
+					if err != nil {
+						return nil, authpb.ErrorUnauthorized("invalid token: %v", err)
+					}
 
-				// 验证用户角色
-				var userRole consts.UserRole
-				switch claims.Role {
-				case "guest":
-					userRole = consts.Guest
-				case "user":
-					userRole = consts.User
-				case "admin":
-					userRole = consts.Admin
-				case "operator":
-					userRole = consts.Operator
-				default:
-					return nil, authpb.ErrorUnauthorized("unknown role")
+					// 检查token是否已被吊销（登出、修改密码、修改角色、删除账号）
+					if err := revoker.Check(ctx, jwtClaims); err != nil {
+						return nil, err
+					}
+					claims = jwtClaims
 				}
 
-				if userRole < minRole {
-					return nil, authpb.ErrorUnauthorized("permission denied, you at least need %s role", minRole.String())
+				// 验证角色是否拥有接口所需的权限
+				if !authorizer.Can(ctx, claims.Role, required) {
+					return nil, authpb.ErrorUnauthorized("permission denied, %s is required", required)
 				}
 
 				// 将用户claims存入context
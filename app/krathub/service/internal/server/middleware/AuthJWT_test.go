@@ -0,0 +1,242 @@
+package middleware
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	krathubv1 "github.com/ToAtlas/AtlasBackend/api/gen/go/krathub/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+	"github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransport struct {
+	transport.Transporter
+	operation string
+	header    headerCarrier
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// memTokenRepo 个人访问令牌的内存仓库
+type memTokenRepo struct {
+	mu     sync.Mutex
+	tokens []*po.PersonalAccessToken
+}
+
+func (r *memTokenRepo) CreateToken(_ context.Context, token *po.PersonalAccessToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token.ID = int64(len(r.tokens) + 1)
+	clone := *token
+	r.tokens = append(r.tokens, &clone)
+	return nil
+}
+
+func (r *memTokenRepo) ListUserTokens(context.Context, int64) ([]*po.PersonalAccessToken, error) {
+	return nil, nil
+}
+
+func (r *memTokenRepo) CountUserTokens(context.Context, int64) (int64, error) { return 0, nil }
+
+func (r *memTokenRepo) GetTokenByPrefix(_ context.Context, prefix string) (*po.PersonalAccessToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range r.tokens {
+		if t.Prefix == prefix {
+			clone := *t
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memTokenRepo) DeleteToken(context.Context, int64, int64) (bool, error) { return false, nil }
+
+func (r *memTokenRepo) TouchToken(context.Context, int64, time.Time) error { return nil }
+
+func (r *memTokenRepo) expire(id int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	past := time.Now().Add(-time.Second)
+	r.tokens[id-1].ExpiresAt = &past
+}
+
+type memAuthRepo struct {
+	biz.AuthRepo
+	users map[int64]*po.User
+}
+
+func (r memAuthRepo) GetUserByID(_ context.Context, id int64) (*po.User, error) {
+	return r.users[id], nil
+}
+
+type memRoleRepo struct{}
+
+func (memRoleRepo) ListRoles(context.Context) ([]*po.Role, error) { return nil, nil }
+
+func TestAuthMiddleware_PersonalAccessToken(t *testing.T) {
+	logger := log.DefaultLogger
+	cfg := &conf.App{Jwt: &conf.App_Jwt{AccessSecret: "test-access-secret", AccessExpire: 900}}
+	users := map[int64]*po.User{1: {ID: 1, Name: "alice", Role: biz.RoleUser}}
+	repo := &memTokenRepo{}
+	tokens := biz.NewTokenUsecase(repo, memAuthRepo{users: users}, logger, cfg)
+	accessJWT, err := biz.NewAccessTokenJWT(cfg)
+	require.NoError(t, err)
+	authz, err := biz.NewAuthorizer(memRoleRepo{}, logger, cfg)
+	require.NoError(t, err)
+	auth := NewAuthMiddleware(accessJWT, biz.NewTokenRevoker(nil, logger, cfg), tokens, authz)
+
+	owner := jwt.NewContext(context.Background(), &biz.UserClaims{ID: 1, Name: "alice", Role: biz.RoleUser})
+	_, readToken, err := tokens.CreateToken(owner, "read", []string{biz.ScopeUserRead}, nil)
+	require.NoError(t, err)
+	_, writeToken, err := tokens.CreateToken(owner, "write", []string{biz.ScopeUserWrite}, nil)
+	require.NoError(t, err)
+	expired, expiredToken, err := tokens.CreateToken(owner, "expired", []string{biz.ScopeUserRead}, nil)
+	require.NoError(t, err)
+	repo.expire(expired.ID)
+
+	perms := OperationPermissions{
+		krathubv1.OperationUserServiceCurrentUserInfo:      biz.PermUserReadSelf,
+		krathubv1.OperationUserServiceUpdateUser:           biz.PermUserUpdateSelf,
+		krathubv1.OperationUserServiceListUsers:            biz.PermUserReadAny,
+		krathubv1.OperationAuthServiceListSessions:         biz.PermAccountManageSelf,
+		krathubv1.OperationAuthServiceLoginByEmailPassword: Public,
+	}
+	tests := []struct {
+		name      string
+		operation string
+		token     string
+		check     func(error) bool
+		errText   string
+	}{
+		{name: "read scope", operation: krathubv1.OperationUserServiceCurrentUserInfo, token: readToken},
+		{name: "write scope covers read", operation: krathubv1.OperationUserServiceCurrentUserInfo, token: writeToken},
+		{name: "write scope", operation: krathubv1.OperationUserServiceUpdateUser, token: writeToken},
+		{name: "missing scope", operation: krathubv1.OperationUserServiceUpdateUser, token: readToken,
+			check: authpb.IsUnauthorized, errText: "missing the user:write scope"},
+		{name: "operation not open to tokens", operation: krathubv1.OperationAuthServiceListSessions, token: writeToken,
+			check: authpb.IsUnauthorized, errText: "cannot access"},
+		{name: "role still required", operation: krathubv1.OperationUserServiceListUsers, token: writeToken,
+			check: authpb.IsUnauthorized, errText: "permission denied"},
+		{name: "expired", operation: krathubv1.OperationUserServiceCurrentUserInfo, token: expiredToken, check: authpb.IsTokenExpired},
+		{name: "unknown token", operation: krathubv1.OperationUserServiceCurrentUserInfo,
+			token: strings.Replace(readToken, readToken[4:16], "000000000000", 1), check: authpb.IsUnauthorized},
+		{name: "missing token", operation: krathubv1.OperationUserServiceCurrentUserInfo, check: authpb.IsMissingToken},
+		{name: "public operation", operation: krathubv1.OperationAuthServiceLoginByEmailPassword},
+	}
+	for _, tt := range tests {
+		header := headerCarrier(nethttp.Header{})
+		if tt.token != "" {
+			header.Set("Authorization", "Bearer "+tt.token)
+		}
+		ctx := transport.NewServerContext(context.Background(), &testTransport{operation: tt.operation, header: header})
+		var claims *biz.UserClaims
+		_, err := auth(perms)(func(ctx context.Context, _ any) (any, error) {
+			claims, _ = jwt.FromContext[biz.UserClaims](ctx)
+			return nil, nil
+		})(ctx, nil)
+
+		if tt.check != nil {
+			assert.True(t, tt.check(err), "%s: %v", tt.name, err)
+			assert.Contains(t, errString(err), tt.errText, tt.name)
+			continue
+		}
+		require.NoError(t, err, tt.name)
+		if tt.token != "" {
+			require.NotNil(t, claims, tt.name)
+			assert.Equal(t, int64(1), claims.ID, tt.name)
+		}
+	}
+}
+
+// nopRevocationRepo 没有任何吊销记录的仓库
+type nopRevocationRepo struct{}
+
+func (nopRevocationRepo) DenyAccessToken(context.Context, string, time.Duration) error { return nil }
+
+func (nopRevocationRepo) IsAccessTokenDenied(context.Context, string) (bool, error) {
+	return false, nil
+}
+
+func (nopRevocationRepo) SetTokenWatermark(context.Context, int64, time.Time, time.Duration) error {
+	return nil
+}
+
+func (nopRevocationRepo) GetTokenWatermark(context.Context, int64) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (nopRevocationRepo) DenySession(context.Context, string, time.Duration) error { return nil }
+
+func (nopRevocationRepo) IsSessionDenied(context.Context, string) (bool, error) { return false, nil }
+
+func TestAuthMiddleware_OptionalAuth(t *testing.T) {
+	logger := log.DefaultLogger
+	cfg := &conf.App{Jwt: &conf.App_Jwt{AccessSecret: "test-access-secret", AccessExpire: 900}}
+	accessJWT, err := biz.NewAccessTokenJWT(cfg)
+	require.NoError(t, err)
+	authz, err := biz.NewAuthorizer(memRoleRepo{}, logger, cfg)
+	require.NoError(t, err)
+	auth := NewAuthMiddleware(accessJWT, biz.NewTokenRevoker(nopRevocationRepo{}, logger, cfg), nil, authz)
+	token, err := accessJWT.GenerateToken(&biz.UserClaims{ID: 1, Name: "alice", Role: biz.RoleUser})
+	require.NoError(t, err)
+
+	perms := OperationPermissions{krathubv1.OperationAuthServiceOIDCCallback: OptionalAuth}
+	// 带有效 token 时放入用户信息，没有 token 或 token 无效时按未登录处理
+	for _, tt := range []struct {
+		token  string
+		wantID int64
+	}{
+		{token: token, wantID: 1},
+		{token: ""},
+		{token: "invalid"},
+		{token: biz.PATPrefix + "unknown"},
+	} {
+		header := headerCarrier(nethttp.Header{})
+		if tt.token != "" {
+			header.Set("Authorization", "Bearer "+tt.token)
+		}
+		ctx := transport.NewServerContext(context.Background(), &testTransport{operation: krathubv1.OperationAuthServiceOIDCCallback, header: header})
+		var claims *biz.UserClaims
+		_, err := auth(perms)(func(ctx context.Context, _ any) (any, error) {
+			claims, _ = jwt.FromContext[biz.UserClaims](ctx)
+			return nil, nil
+		})(ctx, nil)
+		require.NoError(t, err, tt.token)
+		if tt.wantID == 0 {
+			assert.Nil(t, claims, tt.token)
+			continue
+		}
+		require.NotNil(t, claims)
+		assert.Equal(t, tt.wantID, claims.ID)
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
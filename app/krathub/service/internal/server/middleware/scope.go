@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	krathubv1 "github.com/ToAtlas/AtlasBackend/api/gen/go/krathub/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
+)
+
+// operationScopes 个人访问令牌可以访问的接口及所需的授权范围。
+// 未列出的接口（登录会话、两步验证、令牌管理等）只能使用登录获得的 Access Token 访问；
+// 角色要求不变，令牌按所属用户的角色检查。UpdateUser 使用令牌时不能修改密码和邮箱
+var operationScopes = map[string]string{
+	krathubv1.OperationUserServiceCurrentUserInfo:   biz.ScopeUserRead,
+	krathubv1.OperationUserServiceGetPreferences:    biz.ScopeUserRead,
+	krathubv1.OperationUserServiceUpdateUser:        biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceUpdatePreferences: biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceSaveUser:          biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceDeleteUser:        biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceUnlockUser:        biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceListUsers:         biz.ScopeUserRead,
+	krathubv1.OperationUserServiceBulkUpdateRole:    biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceDisableUsers:      biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceEnableUsers:       biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceRestoreUser:       biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceCreateInviteCode:  biz.ScopeUserWrite,
+	krathubv1.OperationUserServiceListInviteCodes:   biz.ScopeUserRead,
+	krathubv1.OperationUserServiceRevokeInviteCode:  biz.ScopeUserWrite,
+
+	krathubv1.OperationWebhookServiceListWebhooks:   biz.ScopeWebhookRead,
+	krathubv1.OperationWebhookServiceListDeliveries: biz.ScopeWebhookRead,
+	krathubv1.OperationWebhookServiceCreateWebhook:  biz.ScopeWebhookWrite,
+	krathubv1.OperationWebhookServiceUpdateWebhook:  biz.ScopeWebhookWrite,
+	krathubv1.OperationWebhookServiceDeleteWebhook:  biz.ScopeWebhookWrite,
+	krathubv1.OperationWebhookServiceRedeliverEvent: biz.ScopeWebhookWrite,
+
+	service.OperationSCIMListUsers:    biz.ScopeSCIM,
+	service.OperationSCIMGetUser:      biz.ScopeSCIM,
+	service.OperationSCIMCreateUser:   biz.ScopeSCIM,
+	service.OperationSCIMReplaceUser:  biz.ScopeSCIM,
+	service.OperationSCIMPatchUser:    biz.ScopeSCIM,
+	service.OperationSCIMDeleteUser:   biz.ScopeSCIM,
+	service.OperationSCIMListGroups:   biz.ScopeSCIM,
+	service.OperationSCIMGetGroup:     biz.ScopeSCIM,
+	service.OperationSCIMCreateGroup:  biz.ScopeSCIM,
+	service.OperationSCIMReplaceGroup: biz.ScopeSCIM,
+	service.OperationSCIMPatchGroup:   biz.ScopeSCIM,
+	service.OperationSCIMDeleteGroup:  biz.ScopeSCIM,
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// DigestWorker 定时发送汇总通知的常驻任务，实现 transport.Server 交给 kratos.App 管理生命周期
+type DigestWorker struct {
+	uc  *biz.NotificationUsecase
+	log *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDigestWorker 创建汇总通知任务
+func NewDigestWorker(uc *biz.NotificationUsecase, logger log.Logger) *DigestWorker {
+	return &DigestWorker{
+		uc:  uc,
+		log: log.NewHelper(logpkg.WithModule(logger, "notification/server/krathub-service")),
+	}
+}
+
+// Start 按扫描间隔发送到期的汇总通知
+func (w *DigestWorker) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.uc.DigestInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := w.uc.SendDueDigests(ctx); err != nil && ctx.Err() == nil {
+					w.log.Errorf("send notification digests failed: %v", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop 停止任务并等待当前批次完成
+func (w *DigestWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
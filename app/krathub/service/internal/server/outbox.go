@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OutboxWorker 把 outbox 中的事件转发到事件总线的常驻任务，实现 transport.Server 交给 kratos.App 管理生命周期
+type OutboxWorker struct {
+	relay *biz.OutboxRelay
+	log   *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOutboxWorker 创建 outbox 转发任务
+func NewOutboxWorker(relay *biz.OutboxRelay, logger log.Logger) *OutboxWorker {
+	return &OutboxWorker{
+		relay: relay,
+		log:   log.NewHelper(logpkg.WithModule(logger, "outbox/server/krathub-service")),
+	}
+}
+
+// Start 启动转发循环
+func (w *OutboxWorker) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.relay.PollInterval())
+		defer ticker.Stop()
+		for {
+			w.relayAll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop 停止转发循环并等待当前批次完成
+func (w *OutboxWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// relayAll 连续转发直到没有到期事件，积压时不必等待下一次轮询
+func (w *OutboxWorker) relayAll(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := w.relay.RelayDue(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.log.Errorf("relay outbox events failed: %v", err)
+			}
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+}
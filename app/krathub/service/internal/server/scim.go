@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+
+	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/service"
+	"github.com/ToAtlas/AtlasBackend/pkg/scim"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// maxSCIMRequestBody 请求体大小上限
+const maxSCIMRequestBody = 1 << 20
+
+// scimCall 调用 SCIMService，vars 为路径参数，req 为解码后的请求
+type scimCall func(ctx context.Context, vars url.Values, req any) (any, error)
+
+// registerSCIM 注册 SCIM 2.0 接口。请求和响应是 application/scim+json，错误也按 SCIM 格式返回，
+// 无法使用生成的路由，这里按生成代码的方式手动注册，同样经过中间件链。
+// 身份提供方使用带 scim 授权范围的个人访问令牌认证
+func registerSCIM(srv *http.Server, s *service.SCIMService) {
+	r := srv.Route(biz.SCIMBasePath)
+
+	r.GET("/ServiceProviderConfig", handleSCIM(service.OperationSCIMServiceProviderConfig, nethttp.StatusOK, nil,
+		func(ctx context.Context, _ url.Values, _ any) (any, error) {
+			return s.ServiceProviderConfig(ctx)
+		}))
+	r.GET("/ResourceTypes", handleSCIM(service.OperationSCIMResourceTypes, nethttp.StatusOK, nil,
+		func(ctx context.Context, _ url.Values, _ any) (any, error) {
+			return s.ResourceTypes(ctx)
+		}))
+
+	r.GET("/Users", handleSCIM(service.OperationSCIMListUsers, nethttp.StatusOK, decodeSCIMQuery,
+		func(ctx context.Context, _ url.Values, req any) (any, error) {
+			return s.ListUsers(ctx, req.(*service.SCIMListRequest))
+		}))
+	r.POST("/Users", handleSCIM(service.OperationSCIMCreateUser, nethttp.StatusCreated, decodeSCIMBody[scim.User],
+		func(ctx context.Context, _ url.Values, req any) (any, error) {
+			return s.CreateUser(ctx, req.(*scim.User))
+		}))
+	r.GET("/Users/{id}", handleSCIM(service.OperationSCIMGetUser, nethttp.StatusOK, nil,
+		func(ctx context.Context, vars url.Values, _ any) (any, error) {
+			return s.GetUser(ctx, vars.Get("id"))
+		}))
+	r.PUT("/Users/{id}", handleSCIM(service.OperationSCIMReplaceUser, nethttp.StatusOK, decodeSCIMBody[scim.User],
+		func(ctx context.Context, vars url.Values, req any) (any, error) {
+			return s.ReplaceUser(ctx, vars.Get("id"), req.(*scim.User))
+		}))
+	r.PATCH("/Users/{id}", handleSCIM(service.OperationSCIMPatchUser, nethttp.StatusOK, decodeSCIMBody[scim.PatchRequest],
+		func(ctx context.Context, vars url.Values, req any) (any, error) {
+			return s.PatchUser(ctx, vars.Get("id"), req.(*scim.PatchRequest))
+		}))
+	r.DELETE("/Users/{id}", handleSCIM(service.OperationSCIMDeleteUser, nethttp.StatusNoContent, nil,
+		func(ctx context.Context, vars url.Values, _ any) (any, error) {
+			return nil, s.DeleteUser(ctx, vars.Get("id"))
+		}))
+
+	r.GET("/Groups", handleSCIM(service.OperationSCIMListGroups, nethttp.StatusOK, decodeSCIMQuery,
+		func(ctx context.Context, _ url.Values, req any) (any, error) {
+			return s.ListGroups(ctx, req.(*service.SCIMListRequest))
+		}))
+	r.POST("/Groups", handleSCIM(service.OperationSCIMCreateGroup, nethttp.StatusCreated, decodeSCIMBody[scim.Group],
+		func(ctx context.Context, _ url.Values, req any) (any, error) {
+			return s.CreateGroup(ctx, req.(*scim.Group))
+		}))
+	r.GET("/Groups/{id}", handleSCIM(service.OperationSCIMGetGroup, nethttp.StatusOK, decodeSCIMQuery,
+		func(ctx context.Context, vars url.Values, req any) (any, error) {
+			return s.GetGroup(ctx, vars.Get("id"), req.(*service.SCIMListRequest))
+		}))
+	r.PUT("/Groups/{id}", handleSCIM(service.OperationSCIMReplaceGroup, nethttp.StatusOK, decodeSCIMBody[scim.Group],
+		func(ctx context.Context, vars url.Values, req any) (any, error) {
+			return s.ReplaceGroup(ctx, vars.Get("id"), req.(*scim.Group))
+		}))
+	r.PATCH("/Groups/{id}", handleSCIM(service.OperationSCIMPatchGroup, nethttp.StatusOK, decodeSCIMBody[scim.PatchRequest],
+		func(ctx context.Context, vars url.Values, req any) (any, error) {
+			return s.PatchGroup(ctx, vars.Get("id"), req.(*scim.PatchRequest))
+		}))
+	r.DELETE("/Groups/{id}", handleSCIM(service.OperationSCIMDeleteGroup, nethttp.StatusNoContent, nil,
+		func(ctx context.Context, vars url.Values, _ any) (any, error) {
+			return nil, s.DeleteGroup(ctx, vars.Get("id"))
+		}))
+}
+
+// handleSCIM 解码请求、经过中间件链调用 call 并写入 SCIM 响应，decode 为空表示没有请求参数
+func handleSCIM(operation string, status int, decode func(http.Context) (any, error), call scimCall) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		var in any
+		if decode != nil {
+			var err error
+			if in, err = decode(ctx); err != nil {
+				return writeSCIMError(ctx, err)
+			}
+		}
+		vars := ctx.Vars()
+		http.SetOperation(ctx, operation)
+		h := ctx.Middleware(func(ctx context.Context, req any) (any, error) {
+			return call(ctx, vars, req)
+		})
+		out, err := h(ctx, in)
+		if err != nil {
+			return writeSCIMError(ctx, err)
+		}
+		return writeSCIM(ctx, status, out)
+	}
+}
+
+func decodeSCIMBody[T any](ctx http.Context) (any, error) {
+	in := new(T)
+	body := nethttp.MaxBytesReader(ctx.Response(), ctx.Request().Body, maxSCIMRequestBody)
+	if err := json.NewDecoder(body).Decode(in); err != nil {
+		return nil, scim.BadRequest(scim.ErrInvalidSyntax, "invalid request body: %v", err)
+	}
+	return in, nil
+}
+
+func decodeSCIMQuery(ctx http.Context) (any, error) {
+	q := ctx.Request().URL.Query()
+	in := &service.SCIMListRequest{
+		Filter:             q.Get("filter"),
+		Count:              -1,
+		ExcludedAttributes: q.Get("excludedAttributes"),
+	}
+	for name, dst := range map[string]*int{"startIndex": &in.StartIndex, "count": &in.Count} {
+		v := q.Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, scim.BadRequest(scim.ErrInvalidValue, "invalid %s %q", name, v)
+		}
+		*dst = max(n, 0)
+	}
+	return in, nil
+}
+
+func writeSCIM(ctx http.Context, status int, v any) error {
+	w := ctx.Response()
+	if status == nethttp.StatusNoContent {
+		w.WriteHeader(status)
+		return nil
+	}
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// writeSCIMError 把业务错误转换为 SCIM 错误响应
+func writeSCIMError(ctx http.Context, err error) error {
+	var se *scim.Error
+	if !stderrors.As(err, &se) {
+		e := errors.FromError(err)
+		se = scim.NewError(int(e.Code), "", "%s", e.Message)
+		switch {
+		case authpb.IsUserAlreadyExists(err):
+			se.Status, se.ScimType = nethttp.StatusConflict, scim.ErrUniqueness
+		case se.Status == nethttp.StatusBadRequest:
+			se.ScimType = scim.ErrInvalidValue
+		}
+	}
+	if se.Status == nethttp.StatusUnauthorized {
+		ctx.Response().Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+	}
+	return writeSCIM(ctx, se.Status, se)
+}
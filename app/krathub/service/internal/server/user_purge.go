@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PurgeWorker 定时清除宽限期已过的删除用户个人信息的常驻任务
+type PurgeWorker struct {
+	uc  *biz.UserUsecase
+	log *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPurgeWorker 创建删除用户清理任务
+func NewPurgeWorker(uc *biz.UserUsecase, logger log.Logger) *PurgeWorker {
+	return &PurgeWorker{
+		uc:  uc,
+		log: log.NewHelper(logpkg.WithModule(logger, "user/server/krathub-service")),
+	}
+}
+
+// Start 启动时立即清理一次，之后按扫描间隔清理，每次清理完所有到期的用户
+func (w *PurgeWorker) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.uc.PurgeInterval())
+		defer ticker.Stop()
+		for {
+			w.purge(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+func (w *PurgeWorker) purge(ctx context.Context) {
+	if _, err := w.uc.PurgeDeletedUsers(ctx); err != nil && ctx.Err() == nil {
+		w.log.Errorf("purge deleted users failed: %v", err)
+	}
+}
+
+// Stop 停止任务并等待当前批次完成
+func (w *PurgeWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	logpkg "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WebhookDispatcher 后台投递 webhook 的常驻任务，实现 transport.Server 交给 kratos.App 管理生命周期。
+// 投递记录保存在数据库中，进程重启后未完成的投递会继续进行。
+type WebhookDispatcher struct {
+	uc  *biz.WebhookUsecase
+	log *log.Helper
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWebhookDispatcher 创建 webhook 投递器
+func NewWebhookDispatcher(uc *biz.WebhookUsecase, logger log.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		uc:  uc,
+		log: log.NewHelper(logpkg.WithModule(logger, "webhook/server/krathub-service")),
+	}
+}
+
+// Start 启动投递循环：定时扫描到期记录，有新事件时立即处理
+func (d *WebhookDispatcher) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(d.uc.PollInterval())
+		defer ticker.Stop()
+		for {
+			d.dispatch(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-d.uc.Notify():
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop 停止投递循环并等待当前批次完成
+func (d *WebhookDispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dispatch 持续处理直到没有到期记录，避免积压时只能按轮询间隔慢慢消化
+func (d *WebhookDispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := d.uc.DispatchDue(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				d.log.Errorf("dispatch webhook deliveries failed: %v", err)
+			}
+			return
+		}
+		if n == 0 {
+			return
+		}
+	}
+}
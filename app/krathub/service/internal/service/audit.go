@@ -0,0 +1,102 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	auditpb "github.com/ToAtlas/AtlasBackend/api/gen/go/audit/service/v1"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditService struct {
+	auditpb.UnimplementedAuditServiceServer
+
+	uc *biz.AuditUsecase
+}
+
+func NewAuditService(uc *biz.AuditUsecase) *AuditService {
+	return &AuditService{uc: uc}
+}
+
+// ListAuditEvents 分页查询审计日志（管理员）
+func (s *AuditService) ListAuditEvents(ctx context.Context, req *auditpb.ListAuditEventsRequest) (*auditpb.ListAuditEventsResponse, error) {
+	filter := auditFilter(req.ActorId, req.Action, req.TargetType, req.TargetId, req.Result, req.Since, req.Until)
+	events, next, err := s.uc.ListAuditEvents(ctx, filter, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := &auditpb.ListAuditEventsResponse{
+		Events:        make([]*auditpb.AuditEvent, 0, len(events)),
+		NextPageToken: next,
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, toAuditEventPB(e))
+	}
+	return resp, nil
+}
+
+// ExportAuditEvents 按条件导出审计日志，每行一个与 ListAuditEvents 格式相同的 JSON 对象
+func (s *AuditService) ExportAuditEvents(ctx context.Context, req *auditpb.ExportAuditEventsRequest) (*auditpb.ExportAuditEventsResponse, error) {
+	filter := auditFilter(req.ActorId, req.Action, req.TargetType, req.TargetId, req.Result, req.Since, req.Until)
+	events, err := s.uc.ExportAuditEvents(ctx, filter, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	codec := encoding.GetCodec(json.Name)
+	var buf bytes.Buffer
+	for _, e := range events {
+		line, err := codec.Marshal(toAuditEventPB(e))
+		if err != nil {
+			return nil, auditpb.ErrorListAuditEventsFailed("failed to encode audit event %d: %v", e.ID, err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return &auditpb.ExportAuditEventsResponse{Data: buf.Bytes()}, nil
+}
+
+func auditFilter(actorID int64, action, targetType, targetID, result string, since, until *timestamppb.Timestamp) *biz.AuditFilter {
+	filter := &biz.AuditFilter{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Result:     result,
+	}
+	if since != nil {
+		t := since.AsTime()
+		filter.Since = &t
+	}
+	if until != nil {
+		t := until.AsTime()
+		filter.Until = &t
+	}
+	return filter
+}
+
+func toAuditEventPB(e *po.AuditEvent) *auditpb.AuditEvent {
+	pb := &auditpb.AuditEvent{
+		Id:         e.ID,
+		ActorName:  derefString(e.ActorName),
+		Action:     e.Action,
+		TargetType: derefString(e.TargetType),
+		TargetId:   derefString(e.TargetID),
+		Ip:         derefString(e.IP),
+		UserAgent:  derefString(e.UserAgent),
+		Result:     e.Result,
+		Reason:     derefString(e.Reason),
+		TraceId:    derefString(e.TraceID),
+		Metadata:   derefString(e.Metadata),
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+	if e.ActorID != nil {
+		pb.ActorId = *e.ActorID
+	}
+	return pb
+}
@@ -2,23 +2,28 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AuthService is a auth service.
 type AuthService struct {
 	authpb.UnimplementedAuthServiceServer
 
-	uc *biz.AuthUsecase
+	uc            *biz.AuthUsecase
+	impersonation *biz.ImpersonationUsecase
 }
 
 // NewAuthService new a auth service.
-func NewAuthService(uc *biz.AuthUsecase) *AuthService {
-	return &AuthService{uc: uc}
+func NewAuthService(uc *biz.AuthUsecase, impersonation *biz.ImpersonationUsecase) *AuthService {
+	return &AuthService{uc: uc, impersonation: impersonation}
 }
 
 func (s *AuthService) SignupByEmail(ctx context.Context, req *authpb.SignupByEmailRequest) (*authpb.SignupByEmailResponse, error) {
@@ -31,7 +36,7 @@ func (s *AuthService) SignupByEmail(ctx context.Context, req *authpb.SignupByEma
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: req.Password,
-	})
+	}, req.InviteCode)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +55,17 @@ func (s *AuthService) LoginByEmailPassword(ctx context.Context, req *authpb.Logi
 		Email:    req.Email,
 		Password: req.Password,
 	}
-	tokenPair, err := s.uc.LoginByEmailPassword(ctx, user)
+	tokenPair, challenge, err := s.uc.LoginByEmailPassword(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("login by email password failed: %w", err)
 	}
+	if challenge != nil {
+		return &authpb.LoginByEmailPasswordResponse{
+			MfaRequired:  true,
+			MfaToken:     challenge.Token,
+			MfaExpiresIn: challenge.ExpiresIn,
+		}, nil
+	}
 	return &authpb.LoginByEmailPasswordResponse{
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
@@ -61,6 +73,184 @@ func (s *AuthService) LoginByEmailPassword(ctx context.Context, req *authpb.Logi
 	}, nil
 }
 
+// VerifyMFA completes a login that requires two-factor authentication
+func (s *AuthService) VerifyMFA(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.VerifyMFAResponse, error) {
+	tokenPair, remaining, err := s.uc.VerifyMFA(ctx, req.MfaToken, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.VerifyMFAResponse{
+		AccessToken:            tokenPair.AccessToken,
+		RefreshToken:           tokenPair.RefreshToken,
+		ExpiresIn:              tokenPair.ExpiresIn,
+		RecoveryCodesRemaining: int32(remaining),
+	}, nil
+}
+
+// EnrollTOTP generates a TOTP secret for the current user
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *authpb.EnrollTOTPRequest) (*authpb.EnrollTOTPResponse, error) {
+	secret, uri, err := s.uc.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+// ConfirmTOTP enables TOTP after verifying the first code
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *authpb.ConfirmTOTPRequest) (*authpb.ConfirmTOTPResponse, error) {
+	codes, err := s.uc.ConfirmTOTP(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.ConfirmTOTPResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// DisableTOTP disables TOTP with a valid code or recovery code
+func (s *AuthService) DisableTOTP(ctx context.Context, req *authpb.DisableTOTPRequest) (*authpb.DisableTOTPResponse, error) {
+	if err := s.uc.DisableTOTP(ctx, req.Code); err != nil {
+		return nil, err
+	}
+	return &authpb.DisableTOTPResponse{
+		Success: true,
+	}, nil
+}
+
+// VerifyEmail confirms the email address with the token from the verification email
+func (s *AuthService) VerifyEmail(ctx context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	if err := s.uc.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &authpb.VerifyEmailResponse{
+		Success: true,
+	}, nil
+}
+
+// ResendVerificationEmail sends a new verification email
+func (s *AuthService) ResendVerificationEmail(ctx context.Context, req *authpb.ResendVerificationEmailRequest) (*authpb.ResendVerificationEmailResponse, error) {
+	if err := s.uc.ResendVerificationEmail(ctx, req.Email); err != nil {
+		return nil, err
+	}
+	return &authpb.ResendVerificationEmailResponse{
+		Success: true,
+	}, nil
+}
+
+// RequestPasswordReset sends a password reset email
+func (s *AuthService) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	if err := s.uc.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, err
+	}
+	return &authpb.RequestPasswordResetResponse{
+		Success: true,
+	}, nil
+}
+
+// ResetPassword sets a new password with the token from the password reset email
+func (s *AuthService) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	if err := s.uc.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		return nil, err
+	}
+	return &authpb.ResetPasswordResponse{
+		Success: true,
+	}, nil
+}
+
+// ListOIDCProviders lists the configured third-party sign-in providers
+func (s *AuthService) ListOIDCProviders(ctx context.Context, req *authpb.ListOIDCProvidersRequest) (*authpb.ListOIDCProvidersResponse, error) {
+	providers := s.uc.ListOIDCProviders()
+	resp := &authpb.ListOIDCProvidersResponse{Providers: make([]*authpb.OIDCProvider, 0, len(providers))}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, &authpb.OIDCProvider{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+		})
+	}
+	return resp, nil
+}
+
+// StartOIDCLogin returns the authorization url of the identity provider
+func (s *AuthService) StartOIDCLogin(ctx context.Context, req *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	authURL, state, expiresIn, err := s.uc.StartOIDCLogin(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.StartOIDCLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+		ExpiresIn:        expiresIn,
+	}, nil
+}
+
+// OIDCCallback completes a third-party sign-in or account linking
+func (s *AuthService) OIDCCallback(ctx context.Context, req *authpb.OIDCCallbackRequest) (*authpb.OIDCCallbackResponse, error) {
+	result, err := s.uc.OIDCCallback(ctx, req.Provider, req.Code, req.State)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authpb.OIDCCallbackResponse{
+		Linked:  result.Linked,
+		Created: result.Created,
+	}
+	if result.Tokens != nil {
+		resp.AccessToken = result.Tokens.AccessToken
+		resp.RefreshToken = result.Tokens.RefreshToken
+		resp.ExpiresIn = result.Tokens.ExpiresIn
+	}
+	if result.Challenge != nil {
+		resp.MfaRequired = true
+		resp.MfaToken = result.Challenge.Token
+		resp.MfaExpiresIn = result.Challenge.ExpiresIn
+	}
+	return resp, nil
+}
+
+// StartOIDCLink starts linking an identity provider to the current user
+func (s *AuthService) StartOIDCLink(ctx context.Context, req *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	authURL, state, expiresIn, err := s.uc.StartOIDCLink(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.StartOIDCLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+		ExpiresIn:        expiresIn,
+	}, nil
+}
+
+// ListIdentities lists the identity providers linked to the current user
+func (s *AuthService) ListIdentities(ctx context.Context, req *authpb.ListIdentitiesRequest) (*authpb.ListIdentitiesResponse, error) {
+	identities, err := s.uc.ListIdentities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authpb.ListIdentitiesResponse{Identities: make([]*authpb.Identity, 0, len(identities))}
+	for _, identity := range identities {
+		item := &authpb.Identity{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			CreatedAt: timestamppb.New(identity.CreatedAt),
+		}
+		if identity.Email != nil {
+			item.Email = *identity.Email
+		}
+		resp.Identities = append(resp.Identities, item)
+	}
+	return resp, nil
+}
+
+// UnlinkIdentity unlinks an identity provider from the current user
+func (s *AuthService) UnlinkIdentity(ctx context.Context, req *authpb.UnlinkIdentityRequest) (*authpb.UnlinkIdentityResponse, error) {
+	if err := s.uc.UnlinkIdentity(ctx, req.Provider); err != nil {
+		return nil, err
+	}
+	return &authpb.UnlinkIdentityResponse{Success: true}, nil
+}
+
 // RefreshToken refreshes the access token using a valid refresh token
 func (s *AuthService) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
 	tokenPair, err := s.uc.RefreshToken(ctx, req.RefreshToken)
@@ -74,7 +264,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *authpb.RefreshToken
 	}, nil
 }
 
-// Logout invalidates the refresh token
+// Logout invalidates the refresh token family and the current access token
 func (s *AuthService) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
 	err := s.uc.Logout(ctx, req.RefreshToken)
 	if err != nil {
@@ -84,3 +274,67 @@ func (s *AuthService) Logout(ctx context.Context, req *authpb.LogoutRequest) (*a
 		Success: true,
 	}, nil
 }
+
+// Impersonate issues a short-lived access token for another user (admin only)
+func (s *AuthService) Impersonate(ctx context.Context, req *authpb.ImpersonateRequest) (*authpb.ImpersonateResponse, error) {
+	pair, err := s.impersonation.Impersonate(ctx, req.UserId, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.ImpersonateResponse{
+		AccessToken: pair.AccessToken,
+		ExpiresIn:   pair.ExpiresIn,
+	}, nil
+}
+
+// EndImpersonation revokes the current impersonation token
+func (s *AuthService) EndImpersonation(ctx context.Context, req *authpb.EndImpersonationRequest) (*authpb.EndImpersonationResponse, error) {
+	if err := s.impersonation.EndImpersonation(ctx); err != nil {
+		return nil, err
+	}
+	return &authpb.EndImpersonationResponse{Success: true}, nil
+}
+
+// ListSessions lists the active sessions of the current user
+func (s *AuthService) ListSessions(ctx context.Context, req *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+	sessions, current, err := s.uc.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &authpb.ListSessionsResponse{Sessions: make([]*authpb.Session, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &authpb.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			Current:    session.ID == current,
+		})
+	}
+	return resp, nil
+}
+
+// RevokeSession signs out one of the current user's sessions
+func (s *AuthService) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	if err := s.uc.RevokeSession(ctx, req.SessionId); err != nil {
+		return nil, err
+	}
+	return &authpb.RevokeSessionResponse{Success: true}, nil
+}
+
+// RevokeOtherSessions signs out all sessions of the current user except the current one
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, req *authpb.RevokeOtherSessionsRequest) (*authpb.RevokeOtherSessionsResponse, error) {
+	revoked, err := s.uc.RevokeOtherSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &authpb.RevokeOtherSessionsResponse{Revoked: int32(revoked)}, nil
+}
+
+// JWKS serves the public keys used to verify access tokens at /.well-known/jwks.json
+func (s *AuthService) JWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_ = json.NewEncoder(w).Encode(s.uc.JWKS())
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	"github.com/ToAtlas/AtlasBackend/pkg/scim"
+)
+
+// SCIM 接口的 operation，路由在 server 中手动注册，用于权限和令牌授权范围校验
+const (
+	OperationSCIMServiceProviderConfig = "/scim.v2.SCIM/ServiceProviderConfig"
+	OperationSCIMResourceTypes         = "/scim.v2.SCIM/ResourceTypes"
+	OperationSCIMListUsers             = "/scim.v2.SCIM/ListUsers"
+	OperationSCIMGetUser               = "/scim.v2.SCIM/GetUser"
+	OperationSCIMCreateUser            = "/scim.v2.SCIM/CreateUser"
+	OperationSCIMReplaceUser           = "/scim.v2.SCIM/ReplaceUser"
+	OperationSCIMPatchUser             = "/scim.v2.SCIM/PatchUser"
+	OperationSCIMDeleteUser            = "/scim.v2.SCIM/DeleteUser"
+	OperationSCIMListGroups            = "/scim.v2.SCIM/ListGroups"
+	OperationSCIMGetGroup              = "/scim.v2.SCIM/GetGroup"
+	OperationSCIMCreateGroup           = "/scim.v2.SCIM/CreateGroup"
+	OperationSCIMReplaceGroup          = "/scim.v2.SCIM/ReplaceGroup"
+	OperationSCIMPatchGroup            = "/scim.v2.SCIM/PatchGroup"
+	OperationSCIMDeleteGroup           = "/scim.v2.SCIM/DeleteGroup"
+)
+
+// SCIMListRequest 查询参数（RFC 7644 3.4.2），Count 小于 0 表示未指定
+type SCIMListRequest struct {
+	Filter             string
+	StartIndex         int
+	Count              int
+	ExcludedAttributes string
+}
+
+// excludeMembers 请求是否排除了用户组成员
+func (r *SCIMListRequest) excludeMembers() bool {
+	for _, attr := range strings.Split(r.ExcludedAttributes, ",") {
+		attr = strings.TrimSpace(attr)
+		if i := strings.LastIndexByte(attr, ':'); i >= 0 {
+			attr = attr[i+1:]
+		}
+		if strings.EqualFold(attr, "members") {
+			return true
+		}
+	}
+	return false
+}
+
+// SCIMService SCIM 2.0 接口，请求和响应是 SCIM 的 JSON 结构而不是 proto 消息，路由在 server 中手动注册
+type SCIMService struct {
+	uc *biz.SCIMUsecase
+}
+
+func NewSCIMService(uc *biz.SCIMUsecase) *SCIMService {
+	return &SCIMService{uc: uc}
+}
+
+func (s *SCIMService) ServiceProviderConfig(context.Context) (*scim.ServiceProviderConfig, error) {
+	return s.uc.ServiceProviderConfig(), nil
+}
+
+func (s *SCIMService) ResourceTypes(context.Context) (*scim.ListResponse[*scim.ResourceType], error) {
+	types := s.uc.ResourceTypes()
+	return scim.NewListResponse(types, len(types), 1), nil
+}
+
+func (s *SCIMService) ListUsers(ctx context.Context, req *SCIMListRequest) (*scim.ListResponse[*scim.User], error) {
+	return s.uc.ListUsers(ctx, req.Filter, req.StartIndex, req.Count)
+}
+
+func (s *SCIMService) GetUser(ctx context.Context, id string) (*scim.User, error) {
+	userID, err := parseSCIMUserID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.uc.GetUser(ctx, userID)
+}
+
+func (s *SCIMService) CreateUser(ctx context.Context, req *scim.User) (*scim.User, error) {
+	if !scim.HasSchema(req.Schemas, scim.SchemaUser) {
+		return nil, scim.BadRequest(scim.ErrInvalidSyntax, "schemas must contain %s", scim.SchemaUser)
+	}
+	return s.uc.CreateUser(ctx, req)
+}
+
+func (s *SCIMService) ReplaceUser(ctx context.Context, id string, req *scim.User) (*scim.User, error) {
+	userID, err := parseSCIMUserID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !scim.HasSchema(req.Schemas, scim.SchemaUser) {
+		return nil, scim.BadRequest(scim.ErrInvalidSyntax, "schemas must contain %s", scim.SchemaUser)
+	}
+	return s.uc.ReplaceUser(ctx, userID, req)
+}
+
+func (s *SCIMService) PatchUser(ctx context.Context, id string, req *scim.PatchRequest) (*scim.User, error) {
+	userID, err := parseSCIMUserID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.uc.PatchUser(ctx, userID, req)
+}
+
+func (s *SCIMService) DeleteUser(ctx context.Context, id string) error {
+	userID, err := parseSCIMUserID(id)
+	if err != nil {
+		return err
+	}
+	return s.uc.DeleteUser(ctx, userID)
+}
+
+func (s *SCIMService) ListGroups(ctx context.Context, req *SCIMListRequest) (*scim.ListResponse[*scim.Group], error) {
+	return s.uc.ListGroups(ctx, req.Filter, req.StartIndex, req.Count, req.excludeMembers())
+}
+
+func (s *SCIMService) GetGroup(ctx context.Context, id string, req *SCIMListRequest) (*scim.Group, error) {
+	return s.uc.GetGroup(ctx, id, req.excludeMembers())
+}
+
+func (s *SCIMService) ReplaceGroup(ctx context.Context, id string, req *scim.Group) (*scim.Group, error) {
+	if !scim.HasSchema(req.Schemas, scim.SchemaGroup) {
+		return nil, scim.BadRequest(scim.ErrInvalidSyntax, "schemas must contain %s", scim.SchemaGroup)
+	}
+	return s.uc.ReplaceGroup(ctx, id, req)
+}
+
+func (s *SCIMService) PatchGroup(ctx context.Context, id string, req *scim.PatchRequest) (*scim.Group, error) {
+	return s.uc.PatchGroup(ctx, id, req)
+}
+
+// CreateGroup、DeleteGroup 用户组即角色，角色通过配置或数据库定义，不能由身份提供方创建和删除
+func (s *SCIMService) CreateGroup(context.Context, *scim.Group) (*scim.Group, error) {
+	return nil, scim.NewError(http.StatusNotImplemented, "", "groups map to roles and cannot be created through SCIM")
+}
+
+func (s *SCIMService) DeleteGroup(context.Context, string) error {
+	return scim.NewError(http.StatusNotImplemented, "", "groups map to roles and cannot be deleted through SCIM")
+}
+
+// parseSCIMUserID 用户资源的 id 为用户ID，格式不正确视为不存在
+func parseSCIMUserID(id string) (int64, error) {
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, userpb.ErrorUserNotFound("user %s not found", id)
+	}
+	return userID, nil
+}
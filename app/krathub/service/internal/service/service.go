@@ -3,4 +3,4 @@ package service
 import "github.com/google/wire"
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewAuthService, NewUserService, NewTestService)
+var ProviderSet = wire.NewSet(NewAuthService, NewUserService, NewTestService, NewWebhookService, NewTokenService, NewWorkspaceService, NewAuditService, NewSCIMService)
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	tokenpb "github.com/ToAtlas/AtlasBackend/api/gen/go/token/service/v1"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TokenService struct {
+	tokenpb.UnimplementedTokenServiceServer
+
+	uc *biz.TokenUsecase
+}
+
+func NewTokenService(uc *biz.TokenUsecase) *TokenService {
+	return &TokenService{uc: uc}
+}
+
+// CreateToken 创建个人访问令牌
+func (s *TokenService) CreateToken(ctx context.Context, req *tokenpb.CreateTokenRequest) (*tokenpb.CreateTokenResponse, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	token, secret, err := s.uc.CreateToken(ctx, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenpb.CreateTokenResponse{
+		Token:  toTokenPB(token),
+		Secret: secret,
+	}, nil
+}
+
+// ListTokens 列出当前用户的个人访问令牌
+func (s *TokenService) ListTokens(ctx context.Context, req *tokenpb.ListTokensRequest) (*tokenpb.ListTokensResponse, error) {
+	tokens, err := s.uc.ListTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &tokenpb.ListTokensResponse{Tokens: make([]*tokenpb.Token, 0, len(tokens))}
+	for _, t := range tokens {
+		resp.Tokens = append(resp.Tokens, toTokenPB(t))
+	}
+	return resp, nil
+}
+
+// RevokeToken 吊销个人访问令牌
+func (s *TokenService) RevokeToken(ctx context.Context, req *tokenpb.RevokeTokenRequest) (*tokenpb.RevokeTokenResponse, error) {
+	if err := s.uc.RevokeToken(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &tokenpb.RevokeTokenResponse{Success: true}, nil
+}
+
+func toTokenPB(t *po.PersonalAccessToken) *tokenpb.Token {
+	return &tokenpb.Token{
+		Id:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     biz.TokenScopeList(t),
+		ExpiresAt:  optionalTimestamp(t.ExpiresAt),
+		LastUsedAt: optionalTimestamp(t.LastUsedAt),
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+}
@@ -3,23 +3,29 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
-	authpb "github.com/ToAtlas/AtlasBackend/api/gen/go/auth/service/v1"
 	userpb "github.com/ToAtlas/AtlasBackend/api/gen/go/user/service/v1"
 
 	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
-	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/consts"
 	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserService struct {
 	userpb.UnimplementedUserServiceServer
 
-	uc *biz.UserUsecase
+	uc       *biz.UserUsecase
+	notifyUc *biz.NotificationUsecase
+	signupUc *biz.SignupUsecase
 }
 
-func NewUserService(uc *biz.UserUsecase) *UserService {
-	return &UserService{uc: uc}
+func NewUserService(uc *biz.UserUsecase, notifyUc *biz.NotificationUsecase, signupUc *biz.SignupUsecase) *UserService {
+	return &UserService{uc: uc, notifyUc: notifyUc, signupUc: signupUc}
 }
 
 func (s *UserService) CurrentUserInfo(ctx context.Context, req *userpb.CurrentUserInfoRequest) (*userpb.CurrentUserInfoResponse, error) {
@@ -27,38 +33,20 @@ func (s *UserService) CurrentUserInfo(ctx context.Context, req *userpb.CurrentUs
 	if err != nil {
 		return nil, err
 	}
-	return &userpb.CurrentUserInfoResponse{
+	resp := &userpb.CurrentUserInfoResponse{
 		Id:   user.ID,
 		Name: user.Name,
 		Role: user.Role,
-	}, nil
+	}
+	if act, ok := biz.ImpersonatorFromContext(ctx); ok {
+		resp.Impersonator = &userpb.Impersonator{Id: act.ID, Name: act.Name}
+	}
+	return resp, nil
 }
 
 // UpdateUser 更新用户信息
 func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequest) (*userpb.UpdateUserResponse, error) {
-	currentUser, err := s.uc.CurrentUserInfo(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	switch currentUser.Role {
-	case consts.User.String():
-		if currentUser.ID != req.Id {
-			return nil, authpb.ErrorUnauthorized("you only can update your own information")
-		}
-		if req.Role != "" && req.Role != consts.User.String() {
-			return nil, authpb.ErrorUnauthorized("you do not have permission to change your role")
-		}
-	case consts.Admin.String():
-		if req.Role != "" && req.Role >= consts.Admin.String() {
-			return nil, authpb.ErrorUnauthorized("admin cannot assign role higher than admin")
-		}
-	case consts.Operator.String():
-		if req.Role != "" && req.Role > consts.Operator.String() {
-			return nil, authpb.ErrorUnauthorized("operator cannot assign role higher than operator")
-		}
-	}
-
+	// 修改其他用户和修改角色的权限由 biz 校验
 	user := &po.User{
 		ID:       req.Id,
 		Name:     req.Name,
@@ -71,8 +59,7 @@ func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 		Website:  &req.Website,
 		Role:     req.Role,
 	}
-	_, err = s.uc.UpdateUser(ctx, user)
-	if err != nil {
+	if _, err := s.uc.UpdateUser(ctx, user); err != nil {
 		return nil, err
 	}
 	return &userpb.UpdateUserResponse{
@@ -80,7 +67,7 @@ func (s *UserService) UpdateUser(ctx context.Context, req *userpb.UpdateUserRequ
 	}, nil
 }
 
-// SaveUser 保存用户
+// SaveUser 保存用户，password_hashed 为 true 时导入已有的密码哈希
 func (s *UserService) SaveUser(ctx context.Context, req *userpb.SaveUserRequest) (*userpb.SaveUserResponse, error) {
 	user := &po.User{
 		Name:     req.Name,
@@ -93,7 +80,11 @@ func (s *UserService) SaveUser(ctx context.Context, req *userpb.SaveUserRequest)
 		Website:  &req.Website,
 		Role:     req.Role,
 	}
-	user, err := s.uc.SaveUser(ctx, user)
+	save := s.uc.SaveUser
+	if req.PasswordHashed {
+		save = s.uc.ImportUser
+	}
+	user, err := save(ctx, user)
 	if err != nil {
 		return nil, err
 	}
@@ -110,3 +101,253 @@ func (s *UserService) DeleteUser(ctx context.Context, req *userpb.DeleteUserRequ
 	}
 	return &userpb.DeleteUserResponse{Success: success}, err
 }
+
+// UnlockUser 解除用户的登录锁定（管理员）
+func (s *UserService) UnlockUser(ctx context.Context, req *userpb.UnlockUserRequest) (*userpb.UnlockUserResponse, error) {
+	if err := s.uc.UnlockUser(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &userpb.UnlockUserResponse{Success: true}, nil
+}
+
+// GetPreferences 获取当前用户的通知偏好
+func (s *UserService) GetPreferences(ctx context.Context, req *userpb.GetPreferencesRequest) (*userpb.GetPreferencesResponse, error) {
+	prefs, err := s.notifyUc.GetPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userpb.GetPreferencesResponse{Preferences: toPreferencesPB(prefs)}
+	for _, channel := range biz.NotificationChannels {
+		resp.Defaults = append(resp.Defaults, &userpb.NotificationPreference{
+			EventType: biz.EventAll,
+			Channel:   channel,
+			Mode:      s.notifyUc.DefaultMode(channel),
+		})
+	}
+	return resp, nil
+}
+
+// UpdatePreferences 更新当前用户的通知偏好
+func (s *UserService) UpdatePreferences(ctx context.Context, req *userpb.UpdatePreferencesRequest) (*userpb.UpdatePreferencesResponse, error) {
+	prefs := biz.NotificationPrefs{}
+	for _, p := range req.Preferences {
+		if prefs[p.EventType] == nil {
+			prefs[p.EventType] = map[string]string{}
+		}
+		prefs[p.EventType][p.Channel] = p.Mode
+	}
+	prefs, err := s.notifyUc.UpdatePreferences(ctx, prefs)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.UpdatePreferencesResponse{Preferences: toPreferencesPB(prefs)}, nil
+}
+
+// ListUsers 分页查询用户（管理员）
+func (s *UserService) ListUsers(ctx context.Context, req *userpb.ListUsersRequest) (*userpb.ListUsersResponse, error) {
+	filter := biz.UserFilter{
+		Query:    req.Query,
+		Role:     req.Role,
+		Disabled: req.Disabled,
+		Deleted:  req.Deleted,
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &t
+	}
+	users, next, err := s.uc.ListUsers(ctx, filter, req.OrderBy, req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := &userpb.ListUsersResponse{
+		Users:         make([]*userpb.User, 0, len(users)),
+		NextPageToken: next,
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toUserPB(u))
+	}
+	return resp, nil
+}
+
+// BulkUpdateRole 批量修改用户角色（管理员）
+func (s *UserService) BulkUpdateRole(ctx context.Context, req *userpb.BulkUpdateRoleRequest) (*userpb.BulkUserResponse, error) {
+	result, err := s.uc.BulkUpdateRole(ctx, req.Ids, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return toBulkUserPB(result), nil
+}
+
+// DisableUsers 批量停用用户（管理员）
+func (s *UserService) DisableUsers(ctx context.Context, req *userpb.DisableUsersRequest) (*userpb.BulkUserResponse, error) {
+	result, err := s.uc.DisableUsers(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return toBulkUserPB(result), nil
+}
+
+// EnableUsers 批量启用用户（管理员）
+func (s *UserService) EnableUsers(ctx context.Context, req *userpb.EnableUsersRequest) (*userpb.BulkUserResponse, error) {
+	result, err := s.uc.EnableUsers(ctx, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return toBulkUserPB(result), nil
+}
+
+// RestoreUser 恢复宽限期内删除的用户（管理员）
+func (s *UserService) RestoreUser(ctx context.Context, req *userpb.RestoreUserRequest) (*userpb.RestoreUserResponse, error) {
+	user, err := s.uc.RestoreUser(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.RestoreUserResponse{User: toUserPB(user)}, nil
+}
+
+// ExportMyData 导出当前用户的个人数据，HTTP 调用时作为 JSON 附件下载
+func (s *UserService) ExportMyData(ctx context.Context, req *userpb.ExportMyDataRequest) (*userpb.ExportMyDataResponse, error) {
+	export, err := s.uc.ExportMyData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u := export.User
+	resp := &userpb.ExportMyDataResponse{
+		ExportedAt: timestamppb.New(export.ExportedAt),
+		Profile: &userpb.ExportMyDataResponse_Profile{
+			Id:            u.ID,
+			Name:          u.Name,
+			Email:         u.Email,
+			Phone:         derefString(u.Phone),
+			Avatar:        derefString(u.Avatar),
+			Bio:           derefString(u.Bio),
+			Location:      derefString(u.Location),
+			Website:       derefString(u.Website),
+			Role:          u.Role,
+			EmailVerified: u.EmailVerified,
+			MfaEnabled:    u.TotpEnabledAt != nil,
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+			UpdatedAt:     timestamppb.New(u.UpdatedAt),
+		},
+		Preferences: toPreferencesPB(export.Preferences),
+	}
+	for _, session := range export.Sessions {
+		resp.Sessions = append(resp.Sessions, &userpb.ExportMyDataResponse_Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+		})
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok && tr.Kind() == transport.KindHTTP {
+		filename := fmt.Sprintf("user-%d-export-%s.json", u.ID, export.ExportedAt.UTC().Format("20060102T150405Z"))
+		tr.ReplyHeader().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	return resp, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// CreateInviteCode 创建注册邀请码（管理员）
+func (s *UserService) CreateInviteCode(ctx context.Context, req *userpb.CreateInviteCodeRequest) (*userpb.CreateInviteCodeResponse, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	invite, code, err := s.signupUc.CreateInviteCode(ctx, req.MaxUses, expiresAt, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.CreateInviteCodeResponse{InviteCode: toInviteCodePB(invite), Code: code}, nil
+}
+
+// ListInviteCodes 列出注册邀请码（管理员）
+func (s *UserService) ListInviteCodes(ctx context.Context, req *userpb.ListInviteCodesRequest) (*userpb.ListInviteCodesResponse, error) {
+	invites, err := s.signupUc.ListInviteCodes(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &userpb.ListInviteCodesResponse{InviteCodes: make([]*userpb.InviteCode, 0, len(invites))}
+	for _, invite := range invites {
+		resp.InviteCodes = append(resp.InviteCodes, toInviteCodePB(invite))
+	}
+	return resp, nil
+}
+
+// RevokeInviteCode 作废注册邀请码（管理员）
+func (s *UserService) RevokeInviteCode(ctx context.Context, req *userpb.RevokeInviteCodeRequest) (*userpb.RevokeInviteCodeResponse, error) {
+	if err := s.signupUc.RevokeInviteCode(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &userpb.RevokeInviteCodeResponse{Success: true}, nil
+}
+
+func toInviteCodePB(invite *po.SignupInvite) *userpb.InviteCode {
+	return &userpb.InviteCode{
+		Id:        invite.ID,
+		Hint:      invite.Hint,
+		MaxUses:   invite.MaxUses,
+		UsedCount: invite.UsedCount,
+		ExpiresAt: optionalTimestamp(invite.ExpiresAt),
+		Note:      invite.Note,
+		CreatedBy: invite.CreatedBy,
+		CreatedAt: timestamppb.New(invite.CreatedAt),
+	}
+}
+
+func toUserPB(u *po.User) *userpb.User {
+	pb := &userpb.User{
+		Id:            u.ID,
+		Name:          u.Name,
+		Email:         u.Email,
+		Role:          u.Role,
+		EmailVerified: u.EmailVerified,
+		MfaEnabled:    u.TotpEnabledAt != nil,
+		Disabled:      biz.IsDisabled(u),
+		DisabledAt:    optionalTimestamp(u.DisabledAt),
+		CreatedAt:     timestamppb.New(u.CreatedAt),
+		UpdatedAt:     timestamppb.New(u.UpdatedAt),
+	}
+	if u.DeletedAt.Valid {
+		pb.DeletedAt = timestamppb.New(u.DeletedAt.Time)
+	}
+	return pb
+}
+
+func toBulkUserPB(result *biz.BulkResult) *userpb.BulkUserResponse {
+	resp := &userpb.BulkUserResponse{Succeeded: result.Succeeded}
+	for _, f := range result.Failed {
+		resp.Failed = append(resp.Failed, &userpb.BulkUserResponse_Failure{
+			Id:     f.ID,
+			Reason: errors.FromError(f.Err).Message,
+		})
+	}
+	return resp
+}
+
+func toPreferencesPB(prefs biz.NotificationPrefs) []*userpb.NotificationPreference {
+	list := make([]*userpb.NotificationPreference, 0, len(prefs))
+	for event, channels := range prefs {
+		for channel, mode := range channels {
+			list = append(list, &userpb.NotificationPreference{EventType: event, Channel: channel, Mode: mode})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].EventType != list[j].EventType {
+			return list[i].EventType < list[j].EventType
+		}
+		return list[i].Channel < list[j].Channel
+	})
+	return list
+}
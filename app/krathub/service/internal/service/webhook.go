@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	webhookpb "github.com/ToAtlas/AtlasBackend/api/gen/go/webhook/service/v1"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookService struct {
+	webhookpb.UnimplementedWebhookServiceServer
+
+	uc *biz.WebhookUsecase
+}
+
+func NewWebhookService(uc *biz.WebhookUsecase) *WebhookService {
+	return &WebhookService{uc: uc}
+}
+
+// CreateWebhook 创建 Webhook 订阅
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *webhookpb.CreateWebhookRequest) (*webhookpb.CreateWebhookResponse, error) {
+	hook, err := s.uc.CreateWebhook(ctx, &po.Webhook{
+		URL:         req.Url,
+		Secret:      req.Secret,
+		Description: &req.Description,
+	}, req.Events)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookpb.CreateWebhookResponse{
+		Webhook: toWebhookPB(hook),
+		Secret:  hook.Secret,
+	}, nil
+}
+
+// ListWebhooks 列出所有 Webhook 订阅
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *webhookpb.ListWebhooksRequest) (*webhookpb.ListWebhooksResponse, error) {
+	hooks, err := s.uc.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &webhookpb.ListWebhooksResponse{Webhooks: make([]*webhookpb.Webhook, 0, len(hooks))}
+	for _, h := range hooks {
+		resp.Webhooks = append(resp.Webhooks, toWebhookPB(h))
+	}
+	return resp, nil
+}
+
+// UpdateWebhook 更新 Webhook 订阅
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *webhookpb.UpdateWebhookRequest) (*webhookpb.UpdateWebhookResponse, error) {
+	hook, err := s.uc.UpdateWebhook(ctx, &po.Webhook{
+		ID:          req.Id,
+		URL:         req.Url,
+		Description: &req.Description,
+		Active:      req.Active,
+	}, req.Events, req.RotateSecret)
+	if err != nil {
+		return nil, err
+	}
+	resp := &webhookpb.UpdateWebhookResponse{Webhook: toWebhookPB(hook)}
+	if req.RotateSecret {
+		resp.Secret = hook.Secret
+	}
+	return resp, nil
+}
+
+// DeleteWebhook 删除 Webhook 订阅
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *webhookpb.DeleteWebhookRequest) (*webhookpb.DeleteWebhookResponse, error) {
+	if err := s.uc.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &webhookpb.DeleteWebhookResponse{Success: true}, nil
+}
+
+// ListDeliveries 查询投递记录
+func (s *WebhookService) ListDeliveries(ctx context.Context, req *webhookpb.ListDeliveriesRequest) (*webhookpb.ListDeliveriesResponse, error) {
+	deliveries, total, err := s.uc.ListDeliveries(ctx, req.WebhookId, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := &webhookpb.ListDeliveriesResponse{
+		Deliveries: make([]*webhookpb.Delivery, 0, len(deliveries)),
+		Total:      total,
+	}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toDeliveryPB(d))
+	}
+	return resp, nil
+}
+
+// RedeliverEvent 重新投递事件
+func (s *WebhookService) RedeliverEvent(ctx context.Context, req *webhookpb.RedeliverEventRequest) (*webhookpb.RedeliverEventResponse, error) {
+	d, err := s.uc.RedeliverEvent(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookpb.RedeliverEventResponse{Delivery: toDeliveryPB(d)}, nil
+}
+
+func toWebhookPB(h *po.Webhook) *webhookpb.Webhook {
+	w := &webhookpb.Webhook{
+		Id:        h.ID,
+		Url:       h.URL,
+		Events:    biz.WebhookEventList(h),
+		Active:    h.Active,
+		CreatedAt: timestamppb.New(h.CreatedAt),
+		UpdatedAt: timestamppb.New(h.UpdatedAt),
+	}
+	if h.Description != nil {
+		w.Description = *h.Description
+	}
+	return w
+}
+
+func toDeliveryPB(d *po.WebhookDelivery) *webhookpb.Delivery {
+	pb := &webhookpb.Delivery{
+		Id:            d.ID,
+		WebhookId:     d.WebhookID,
+		EventId:       d.EventID,
+		EventType:     d.EventType,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		ResponseCode:  d.ResponseCode,
+		DurationMs:    d.DurationMs,
+		Payload:       d.Payload,
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+		NextAttemptAt: optionalTimestamp(d.NextAttemptAt),
+		DeliveredAt:   optionalTimestamp(d.DeliveredAt),
+	}
+	if d.LastError != nil {
+		pb.LastError = *d.LastError
+	}
+	return pb
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+
+	workspacepb "github.com/ToAtlas/AtlasBackend/api/gen/go/workspace/service/v1"
+
+	"github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/biz"
+	po "github.com/ToAtlas/AtlasBackend/app/krathub/service/internal/data/po"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WorkspaceService struct {
+	workspacepb.UnimplementedWorkspaceServiceServer
+
+	uc   *biz.WorkspaceUsecase
+	auth *biz.AuthUsecase
+}
+
+func NewWorkspaceService(uc *biz.WorkspaceUsecase, auth *biz.AuthUsecase) *WorkspaceService {
+	return &WorkspaceService{uc: uc, auth: auth}
+}
+
+// CreateWorkspace 创建工作空间
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req *workspacepb.CreateWorkspaceRequest) (*workspacepb.CreateWorkspaceResponse, error) {
+	workspace, err := s.uc.CreateWorkspace(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &workspacepb.CreateWorkspaceResponse{Workspace: toWorkspacePB(workspace)}, nil
+}
+
+// ListWorkspaces 列出当前用户加入的工作空间
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context, req *workspacepb.ListWorkspacesRequest) (*workspacepb.ListWorkspacesResponse, error) {
+	workspaces, err := s.uc.ListWorkspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &workspacepb.ListWorkspacesResponse{Workspaces: make([]*workspacepb.Workspace, 0, len(workspaces))}
+	for _, w := range workspaces {
+		resp.Workspaces = append(resp.Workspaces, toWorkspacePB(w))
+	}
+	return resp, nil
+}
+
+// ListMembers 列出工作空间成员
+func (s *WorkspaceService) ListMembers(ctx context.Context, req *workspacepb.ListMembersRequest) (*workspacepb.ListMembersResponse, error) {
+	members, err := s.uc.ListMembers(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &workspacepb.ListMembersResponse{Members: make([]*workspacepb.Member, 0, len(members))}
+	for _, m := range members {
+		member := &workspacepb.Member{
+			UserId:   m.Member.UserID,
+			Name:     m.User.Name,
+			Email:    m.User.Email,
+			Role:     m.Member.Role,
+			JoinedAt: timestamppb.New(m.Member.CreatedAt),
+		}
+		if m.User.Avatar != nil {
+			member.Avatar = *m.User.Avatar
+		}
+		resp.Members = append(resp.Members, member)
+	}
+	return resp, nil
+}
+
+// UpdateMemberRole 修改成员角色
+func (s *WorkspaceService) UpdateMemberRole(ctx context.Context, req *workspacepb.UpdateMemberRoleRequest) (*workspacepb.UpdateMemberRoleResponse, error) {
+	if err := s.uc.UpdateMemberRole(ctx, req.WorkspaceId, req.UserId, req.Role); err != nil {
+		return nil, err
+	}
+	return &workspacepb.UpdateMemberRoleResponse{Success: true}, nil
+}
+
+// RemoveMember 移除成员或退出工作空间
+func (s *WorkspaceService) RemoveMember(ctx context.Context, req *workspacepb.RemoveMemberRequest) (*workspacepb.RemoveMemberResponse, error) {
+	if err := s.uc.RemoveMember(ctx, req.WorkspaceId, req.UserId); err != nil {
+		return nil, err
+	}
+	return &workspacepb.RemoveMemberResponse{Success: true}, nil
+}
+
+// InviteMember 邀请成员
+func (s *WorkspaceService) InviteMember(ctx context.Context, req *workspacepb.InviteMemberRequest) (*workspacepb.InviteMemberResponse, error) {
+	invitation, err := s.uc.InviteMember(ctx, req.WorkspaceId, req.Email, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &workspacepb.InviteMemberResponse{Invitation: toInvitationPB(invitation)}, nil
+}
+
+// ListInvitations 列出待接受的邀请
+func (s *WorkspaceService) ListInvitations(ctx context.Context, req *workspacepb.ListInvitationsRequest) (*workspacepb.ListInvitationsResponse, error) {
+	invitations, err := s.uc.ListInvitations(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &workspacepb.ListInvitationsResponse{Invitations: make([]*workspacepb.Invitation, 0, len(invitations))}
+	for _, i := range invitations {
+		resp.Invitations = append(resp.Invitations, toInvitationPB(i))
+	}
+	return resp, nil
+}
+
+// RevokeInvitation 撤销邀请
+func (s *WorkspaceService) RevokeInvitation(ctx context.Context, req *workspacepb.RevokeInvitationRequest) (*workspacepb.RevokeInvitationResponse, error) {
+	if err := s.uc.RevokeInvitation(ctx, req.WorkspaceId, req.Id); err != nil {
+		return nil, err
+	}
+	return &workspacepb.RevokeInvitationResponse{Success: true}, nil
+}
+
+// AcceptInvitation 接受邀请
+func (s *WorkspaceService) AcceptInvitation(ctx context.Context, req *workspacepb.AcceptInvitationRequest) (*workspacepb.AcceptInvitationResponse, error) {
+	workspace, err := s.uc.AcceptInvitation(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &workspacepb.AcceptInvitationResponse{Workspace: toWorkspacePB(workspace)}, nil
+}
+
+// SwitchWorkspace 切换当前会话的活动工作空间
+func (s *WorkspaceService) SwitchWorkspace(ctx context.Context, req *workspacepb.SwitchWorkspaceRequest) (*workspacepb.SwitchWorkspaceResponse, error) {
+	tokens, err := s.auth.SwitchWorkspace(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return &workspacepb.SwitchWorkspaceResponse{
+		AccessToken: tokens.AccessToken,
+		ExpiresIn:   tokens.ExpiresIn,
+	}, nil
+}
+
+func toWorkspacePB(w *biz.UserWorkspace) *workspacepb.Workspace {
+	return &workspacepb.Workspace{
+		Id:        w.Workspace.ID,
+		Name:      w.Workspace.Name,
+		Role:      w.Role,
+		CreatedAt: timestamppb.New(w.Workspace.CreatedAt),
+	}
+}
+
+func toInvitationPB(i *po.WorkspaceInvitation) *workspacepb.Invitation {
+	return &workspacepb.Invitation{
+		Id:          i.ID,
+		WorkspaceId: i.WorkspaceID,
+		Email:       i.Email,
+		Role:        i.Role,
+		InvitedBy:   i.InvitedBy,
+		ExpiresAt:   timestamppb.New(i.ExpiresAt),
+		CreatedAt:   timestamppb.New(i.CreatedAt),
+	}
+}
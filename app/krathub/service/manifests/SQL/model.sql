@@ -10,6 +10,198 @@ CREATE TABLE `users` (
   `location` VARCHAR(128) DEFAULT NULL COMMENT '用户位置', -- 用户所在位置
   `website` VARCHAR(255) DEFAULT NULL COMMENT '用户个人网站', -- 用户个人网站
   `role` VARCHAR(32) NOT NULL DEFAULT 'user' COMMENT '用户权限角色', -- 用户权限属性
+  `notification_prefs` TEXT DEFAULT NULL, -- 通知偏好（JSON，事件 -> 渠道 -> 方式）
+  `email_verified` TINYINT(1) NOT NULL DEFAULT 0, -- 邮箱是否已验证
+  `totp_secret` VARCHAR(64) DEFAULT NULL, -- TOTP 密钥（base32），登记后未确认时 totp_enabled_at 为空
+  `totp_enabled_at` DATETIME DEFAULT NULL, -- TOTP 两步验证启用时间，为空表示未启用
+  `disabled_at` DATETIME DEFAULT NULL, -- 停用时间，为空表示账号可用
+  `deleted_at` DATETIME DEFAULT NULL, -- 删除时间（软删除），宽限期内管理员可以恢复
+  `anonymized_at` DATETIME DEFAULT NULL, -- 宽限期结束后个人信息被清除的时间
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, -- 更新时间
+  INDEX `idx_users_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Webhook 订阅表：管理员配置的事件回调地址
+CREATE TABLE `webhooks` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- Webhook ID
+  `url` VARCHAR(512) NOT NULL, -- 回调地址
+  `secret` VARCHAR(128) NOT NULL, -- HMAC 签名密钥
+  `events` VARCHAR(512) NOT NULL, -- 订阅的事件类型，逗号分隔，* 表示全部
+  `description` VARCHAR(255) DEFAULT NULL, -- 描述
+  `active` TINYINT(1) NOT NULL DEFAULT 1, -- 是否启用
   `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
   `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP -- 更新时间
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Webhook 投递记录表：每个事件对每个订阅生成一条记录，记录最近一次尝试的结果
+CREATE TABLE `webhook_deliveries` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 投递ID
+  `webhook_id` BIGINT NOT NULL, -- 所属 Webhook
+  `event_id` VARCHAR(64) NOT NULL, -- 事件ID，重新投递时保持不变
+  `event_type` VARCHAR(64) NOT NULL, -- 事件类型
+  `payload` TEXT NOT NULL, -- 投递的 JSON 内容
+  `status` VARCHAR(16) NOT NULL DEFAULT 'pending', -- pending, success, failed
+  `attempts` INT NOT NULL DEFAULT 0, -- 已尝试次数
+  `response_code` INT NOT NULL DEFAULT 0, -- 最近一次响应状态码
+  `last_error` VARCHAR(1024) DEFAULT NULL, -- 最近一次失败原因
+  `duration_ms` BIGINT NOT NULL DEFAULT 0, -- 最近一次请求耗时（毫秒）
+  `next_attempt_at` DATETIME DEFAULT NULL, -- 下一次尝试时间
+  `delivered_at` DATETIME DEFAULT NULL, -- 投递成功时间
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, -- 更新时间
+  INDEX `idx_webhook_deliveries_due` (`status`, `next_attempt_at`),
+  INDEX `idx_webhook_deliveries_webhook` (`webhook_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 待汇总通知表：选择 hourly/daily 汇总的通知先暂存于此，到期后按用户合并为一条消息发送
+CREATE TABLE `pending_notifications` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 通知ID
+  `user_id` BIGINT NOT NULL, -- 接收用户
+  `event_type` VARCHAR(64) NOT NULL, -- 事件类型
+  `channel` VARCHAR(16) NOT NULL, -- 通知渠道：in_app, email, webhook
+  `payload` TEXT NOT NULL, -- 事件内容（JSON）
+  `send_after` DATETIME NOT NULL, -- 最早发送时间（当前小时/当天结束）
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  INDEX `idx_pending_notifications_due` (`send_after`, `user_id`),
+  INDEX `idx_pending_notifications_user` (`user_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 事务性 outbox 表：与业务数据在同一事务中写入，由后台转发到事件总线，保证事件至少投递一次
+CREATE TABLE `outbox_events` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 自增ID，决定转发顺序
+  `event_id` VARCHAR(64) NOT NULL UNIQUE, -- 事件ID，重试时保持不变，供消费方去重
+  `event_type` VARCHAR(64) NOT NULL, -- 事件类型
+  `aggregate_type` VARCHAR(32) NOT NULL, -- 聚合类型，如 user
+  `aggregate_id` VARCHAR(64) NOT NULL, -- 聚合ID
+  `payload` TEXT NOT NULL, -- 事件内容（JSON）
+  `status` VARCHAR(16) NOT NULL DEFAULT 'pending', -- pending, sent
+  `attempts` INT NOT NULL DEFAULT 0, -- 已尝试次数
+  `last_error` VARCHAR(1024) DEFAULT NULL, -- 最近一次失败原因
+  `next_attempt_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 下一次尝试时间
+  `sent_at` DATETIME DEFAULT NULL, -- 发送成功时间
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  INDEX `idx_outbox_events_due` (`status`, `next_attempt_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `user_recovery_codes` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 恢复码ID
+  `user_id` BIGINT NOT NULL, -- 所属用户
+  `code_hash` VARCHAR(64) NOT NULL, -- 恢复码的 SHA-256 摘要
+  `used_at` DATETIME DEFAULT NULL, -- 使用时间，每个恢复码只能使用一次
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  UNIQUE INDEX `idx_user_recovery_codes_code` (`user_id`, `code_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 外部身份表：OIDC 身份提供方的 subject 与本地用户的关联
+CREATE TABLE `user_identities` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 关联ID
+  `user_id` BIGINT NOT NULL, -- 本地用户
+  `provider` VARCHAR(64) NOT NULL, -- 身份提供方标识（配置中的 name）
+  `subject` VARCHAR(255) NOT NULL, -- ID Token 中的 sub
+  `email` VARCHAR(128) DEFAULT NULL, -- 关联时身份提供方返回的邮箱
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 关联时间
+  UNIQUE INDEX `idx_user_identities_subject` (`provider`, `subject`),
+  UNIQUE INDEX `idx_user_identities_user` (`user_id`, `provider`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 个人访问令牌表：脚本和 CI 使用的长期令牌，只保存摘要
+CREATE TABLE `personal_access_tokens` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 令牌ID
+  `user_id` BIGINT NOT NULL, -- 所属用户
+  `name` VARCHAR(64) NOT NULL, -- 令牌名称
+  `prefix` VARCHAR(32) NOT NULL, -- 令牌前缀，用于查找和展示
+  `token_hash` VARCHAR(64) NOT NULL, -- 完整令牌的 SHA-256 摘要
+  `scopes` VARCHAR(255) NOT NULL, -- 授权范围，逗号分隔
+  `expires_at` DATETIME DEFAULT NULL, -- 过期时间，为空表示不过期
+  `last_used_at` DATETIME DEFAULT NULL, -- 最近使用时间
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  UNIQUE INDEX `idx_personal_access_tokens_prefix` (`prefix`),
+  INDEX `idx_personal_access_tokens_user` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 角色表：覆盖或新增角色的权限，同名时替换配置和内置的角色定义
+CREATE TABLE `roles` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 角色ID
+  `name` VARCHAR(64) NOT NULL, -- 角色名，对应 users.role
+  `permissions` VARCHAR(1024) NOT NULL, -- 权限名，逗号分隔
+  `description` VARCHAR(255) DEFAULT NULL, -- 描述
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, -- 更新时间
+  UNIQUE INDEX `idx_roles_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 工作空间表
+CREATE TABLE `workspaces` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 工作空间ID
+  `name` VARCHAR(64) NOT NULL, -- 名称
+  `created_by` BIGINT NOT NULL, -- 创建者
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP -- 更新时间
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 工作空间成员表
+CREATE TABLE `workspace_members` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 成员记录ID
+  `workspace_id` BIGINT NOT NULL, -- 工作空间
+  `user_id` BIGINT NOT NULL, -- 用户
+  `role` VARCHAR(16) NOT NULL, -- 工作空间角色：owner、admin、member、guest
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 加入时间
+  UNIQUE INDEX `idx_workspace_members_workspace_user` (`workspace_id`, `user_id`),
+  INDEX `idx_workspace_members_user` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 工作空间邀请表：同一邮箱在同一工作空间只保留最新的邀请，接受后删除
+CREATE TABLE `workspace_invitations` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 邀请ID
+  `workspace_id` BIGINT NOT NULL, -- 工作空间
+  `email` VARCHAR(255) NOT NULL, -- 受邀邮箱
+  `role` VARCHAR(16) NOT NULL, -- 加入后的工作空间角色
+  `token_hash` VARCHAR(64) NOT NULL, -- 邀请 token 的 SHA-256 摘要
+  `invited_by` BIGINT NOT NULL, -- 邀请人
+  `expires_at` DATETIME NOT NULL, -- 过期时间
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  UNIQUE INDEX `idx_workspace_invitations_token` (`token_hash`),
+  UNIQUE INDEX `idx_workspace_invitations_workspace_email` (`workspace_id`, `email`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 注册邀请码：invite 和 domain 注册方式下使用
+CREATE TABLE `signup_invites` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 邀请码ID
+  `code_hash` VARCHAR(64) NOT NULL, -- 邀请码的 SHA-256 摘要
+  `hint` VARCHAR(16) NOT NULL, -- 邀请码的前几位，便于辨认
+  `max_uses` INT NOT NULL DEFAULT 1, -- 可使用次数
+  `used_count` INT NOT NULL DEFAULT 0, -- 已使用次数
+  `expires_at` DATETIME DEFAULT NULL, -- 过期时间，为空表示不过期
+  `note` VARCHAR(255) NOT NULL DEFAULT '', -- 备注
+  `created_by` BIGINT NOT NULL, -- 创建人
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 创建时间
+  UNIQUE INDEX `idx_signup_invites_code` (`code_hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 安全审计日志：登录和管理操作记录，只追加，不修改和删除
+CREATE TABLE `audit_events` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY, -- 审计事件ID
+  `actor_id` BIGINT DEFAULT NULL, -- 操作者用户ID，未认证（如登录失败）时为空
+  `actor_name` VARCHAR(255) DEFAULT NULL, -- 操作者用户名，未认证时为登录输入的邮箱
+  `action` VARCHAR(64) NOT NULL, -- 操作，例如 auth.login、user.role_change
+  `target_type` VARCHAR(32) DEFAULT NULL, -- 操作对象类型，例如 user
+  `target_id` VARCHAR(64) DEFAULT NULL, -- 操作对象ID
+  `ip` VARCHAR(64) DEFAULT NULL, -- 客户端 IP
+  `user_agent` VARCHAR(512) DEFAULT NULL, -- 客户端 User-Agent
+  `result` VARCHAR(16) NOT NULL, -- 结果：success, failure
+  `reason` VARCHAR(255) DEFAULT NULL, -- 失败原因
+  `trace_id` VARCHAR(64) DEFAULT NULL, -- 请求的链路追踪ID
+  `metadata` TEXT DEFAULT NULL, -- 附加信息（JSON）
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, -- 发生时间
+  INDEX `idx_audit_events_created` (`created_at`),
+  INDEX `idx_audit_events_actor` (`actor_id`, `id`),
+  INDEX `idx_audit_events_action` (`action`, `id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 系统初始化记录：id 固定为 1，主键保证多个实例同时启动时初始管理员只会创建一次
+CREATE TABLE `system_bootstrap` (
+  `id` INT NOT NULL PRIMARY KEY, -- 固定为 1
+  `admin_user_id` BIGINT NOT NULL, -- 初始管理员
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP -- 初始化时间
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
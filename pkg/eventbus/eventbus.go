@@ -0,0 +1,189 @@
+// Package eventbus 提供发布/订阅事件总线。
+//
+// 消息按 topic 发布，订阅方以消费者组（group）为单位消费：同一组内的多个订阅者竞争消费，
+// 不同组各自收到全部消息。Handler 返回 nil 视为确认（ack），返回错误的消息会在退避后重新投递，
+// 投递次数达到上限或返回 Permanent 错误时转入死信 topic（<topic>.dead）。
+//
+// 提供两种实现：基于 Redis Streams 的 RedisBus 和进程内的 MemoryBus（用于单元测试和本地开发）。
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxDeliveries = 5
+	DefaultRetryBackoff  = 30 * time.Second
+	DefaultBatchSize     = 16
+
+	// DeadLetterSuffix 死信 topic 后缀
+	DeadLetterSuffix = ".dead"
+)
+
+// 死信消息携带的附加头
+const (
+	HeaderOriginalID    = "x-original-id"
+	HeaderOriginalTopic = "x-original-topic"
+	HeaderError         = "x-error"
+	HeaderDeliveries    = "x-deliveries"
+)
+
+var (
+	// ErrClosed 总线已关闭
+	ErrClosed = errors.New("eventbus: closed")
+	// ErrEmptyTopic topic 或 group 为空
+	ErrEmptyTopic = errors.New("eventbus: topic and group are required")
+)
+
+// Message 总线上传递的消息
+type Message struct {
+	// ID 由总线在发布时分配
+	ID      string
+	Topic   string
+	Payload []byte
+	Headers map[string]string
+	// Attempts 当前是第几次投递，从 1 开始
+	Attempts    int
+	PublishedAt time.Time
+}
+
+// Header 读取消息头，不存在时返回空字符串
+func (m *Message) Header(key string) string {
+	if m.Headers == nil {
+		return ""
+	}
+	return m.Headers[key]
+}
+
+// Handler 处理消息，返回 nil 表示处理成功
+type Handler func(ctx context.Context, msg *Message) error
+
+// Publisher 发布消息
+type Publisher interface {
+	// Publish 发布消息到 topic，返回消息ID
+	Publish(ctx context.Context, topic string, msg *Message) (string, error)
+}
+
+// Subscriber 订阅消息
+type Subscriber interface {
+	// Subscribe 以消费者组 group 订阅 topic，组只接收创建之后发布的消息
+	Subscribe(topic, group string, handler Handler, opts ...SubscribeOption) (Subscription, error)
+}
+
+// Subscription 一个活跃的订阅
+type Subscription interface {
+	// Close 停止订阅并等待正在处理的消息完成
+	Close() error
+}
+
+// Bus 事件总线
+type Bus interface {
+	Publisher
+	Subscriber
+	Close() error
+}
+
+// SubscribeOptions 订阅参数
+type SubscribeOptions struct {
+	// MaxDeliveries 最大投递次数，达到后转入死信 topic
+	MaxDeliveries int
+	// RetryBackoff 处理失败后重新投递前的等待时间
+	RetryBackoff time.Duration
+	// BatchSize 每次读取的消息数（仅 Redis）
+	BatchSize int
+}
+
+// SubscribeOption 订阅选项
+type SubscribeOption func(*SubscribeOptions)
+
+// WithMaxDeliveries 设置最大投递次数
+func WithMaxDeliveries(n int) SubscribeOption {
+	return func(o *SubscribeOptions) { o.MaxDeliveries = n }
+}
+
+// WithRetryBackoff 设置重新投递前的等待时间
+func WithRetryBackoff(d time.Duration) SubscribeOption {
+	return func(o *SubscribeOptions) { o.RetryBackoff = d }
+}
+
+// WithBatchSize 设置每次读取的消息数
+func WithBatchSize(n int) SubscribeOption {
+	return func(o *SubscribeOptions) { o.BatchSize = n }
+}
+
+func newSubscribeOptions(defaults SubscribeOptions, opts []SubscribeOption) SubscribeOptions {
+	o := defaults
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.MaxDeliveries <= 0 {
+		o.MaxDeliveries = DefaultMaxDeliveries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRetryBackoff
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultBatchSize
+	}
+	return o
+}
+
+// DeadLetterTopic 返回 topic 对应的死信 topic
+func DeadLetterTopic(topic string) string {
+	return topic + DeadLetterSuffix
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 标记错误不可重试，消息会直接转入死信 topic
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent 判断错误是否被标记为不可重试
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// deadLetter 构造转入死信 topic 的消息
+func deadLetter(msg *Message, cause error) *Message {
+	headers := make(map[string]string, len(msg.Headers)+4)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	headers[HeaderOriginalID] = msg.ID
+	headers[HeaderOriginalTopic] = msg.Topic
+	headers[HeaderDeliveries] = strconv.Itoa(msg.Attempts)
+	if cause != nil {
+		headers[HeaderError] = cause.Error()
+	}
+	return &Message{Payload: msg.Payload, Headers: headers}
+}
+
+// shouldDeadLetter 判断处理失败的消息是否不再重试
+func shouldDeadLetter(msg *Message, err error, maxDeliveries int) bool {
+	return IsPermanent(err) || msg.Attempts >= maxDeliveries
+}
+
+// safeHandle 调用 handler，panic 视为处理失败
+func safeHandle(ctx context.Context, h Handler, msg *Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("eventbus: handler panic: %v", r)
+		}
+	}()
+	return h(ctx, msg)
+}
@@ -0,0 +1,175 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	require.Eventually(t, cond, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestMemoryBus_GroupsFanOutAndShare(t *testing.T) {
+	bus := NewMemoryBus()
+	defer bus.Close()
+
+	var mu sync.Mutex
+	got := map[string][]string{}
+	record := func(name string) Handler {
+		return func(_ context.Context, msg *Message) error {
+			mu.Lock()
+			got[name] = append(got[name], string(msg.Payload))
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	// 组 a 有两个竞争消费者，组 b 有一个
+	for _, name := range []string{"a1", "a2"} {
+		_, err := bus.Subscribe("user", "a", record(name))
+		require.NoError(t, err)
+	}
+	_, err := bus.Subscribe("user", "b", record("b"))
+	require.NoError(t, err)
+
+	for _, p := range []string{"1", "2", "3", "4"} {
+		_, err := bus.Publish(context.Background(), "user", &Message{Payload: []byte(p)})
+		require.NoError(t, err)
+	}
+
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(got["a1"])+len(got["a2"]) == 4 && len(got["b"]) == 4
+	})
+	mu.Lock()
+	assert.ElementsMatch(t, []string{"1", "2", "3", "4"}, append(append([]string{}, got["a1"]...), got["a2"]...))
+	assert.Equal(t, []string{"1", "2", "3", "4"}, got["b"])
+	mu.Unlock()
+}
+
+func TestMemoryBus_RetryThenAck(t *testing.T) {
+	bus := NewMemoryBus(WithRetryBackoff(time.Millisecond))
+	defer bus.Close()
+
+	var attempts atomic.Int32
+	_, err := bus.Subscribe("user", "g", func(_ context.Context, msg *Message) error {
+		attempts.Add(1)
+		if msg.Attempts < 3 {
+			return errors.New("temporary")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = bus.Publish(context.Background(), "user", &Message{Payload: []byte("x")})
+	require.NoError(t, err)
+
+	waitFor(t, func() bool { return attempts.Load() == 3 })
+	time.Sleep(20 * time.Millisecond)
+	assert.EqualValues(t, 3, attempts.Load())
+	assert.Empty(t, bus.Messages(DeadLetterTopic("user")))
+}
+
+func TestMemoryBus_DeadLetter(t *testing.T) {
+	bus := NewMemoryBus(WithRetryBackoff(time.Millisecond), WithMaxDeliveries(2))
+	defer bus.Close()
+
+	_, err := bus.Subscribe("user", "g", func(context.Context, *Message) error {
+		return errors.New("boom")
+	})
+	require.NoError(t, err)
+	// 显式指定 Permanent 的错误不重试
+	_, err = bus.Subscribe("order", "g", func(context.Context, *Message) error {
+		return Permanent(errors.New("bad payload"))
+	})
+	require.NoError(t, err)
+
+	id, err := bus.Publish(context.Background(), "user", &Message{Payload: []byte("x"), Headers: map[string]string{"k": "v"}})
+	require.NoError(t, err)
+	_, err = bus.Publish(context.Background(), "order", &Message{Payload: []byte("y")})
+	require.NoError(t, err)
+
+	waitFor(t, func() bool {
+		return len(bus.Messages(DeadLetterTopic("user"))) == 1 && len(bus.Messages(DeadLetterTopic("order"))) == 1
+	})
+	dead := bus.Messages(DeadLetterTopic("user"))[0]
+	assert.Equal(t, "x", string(dead.Payload))
+	assert.Equal(t, id, dead.Header(HeaderOriginalID))
+	assert.Equal(t, "user", dead.Header(HeaderOriginalTopic))
+	assert.Equal(t, "2", dead.Header(HeaderDeliveries))
+	assert.Equal(t, "boom", dead.Header(HeaderError))
+	assert.Equal(t, "v", dead.Header("k"))
+
+	assert.Equal(t, "1", bus.Messages(DeadLetterTopic("order"))[0].Header(HeaderDeliveries))
+}
+
+func TestMemoryBus_HandlerPanic(t *testing.T) {
+	bus := NewMemoryBus(WithMaxDeliveries(1))
+	defer bus.Close()
+
+	_, err := bus.Subscribe("user", "g", func(context.Context, *Message) error {
+		panic("oops")
+	})
+	require.NoError(t, err)
+	_, err = bus.Publish(context.Background(), "user", &Message{})
+	require.NoError(t, err)
+
+	waitFor(t, func() bool { return len(bus.Messages(DeadLetterTopic("user"))) == 1 })
+	assert.Contains(t, bus.Messages(DeadLetterTopic("user"))[0].Header(HeaderError), "oops")
+}
+
+func TestMemoryBus_Close(t *testing.T) {
+	bus := NewMemoryBus()
+	sub, err := bus.Subscribe("user", "g", func(context.Context, *Message) error { return nil })
+	require.NoError(t, err)
+	require.NoError(t, sub.Close())
+	require.NoError(t, bus.Close())
+
+	_, err = bus.Publish(context.Background(), "user", &Message{})
+	assert.ErrorIs(t, err, ErrClosed)
+	_, err = bus.Subscribe("user", "g", func(context.Context, *Message) error { return nil })
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestProtoHandler(t *testing.T) {
+	bus := NewMemoryBus(WithMaxDeliveries(1))
+	defer bus.Close()
+
+	received := make(chan string, 1)
+	_, err := bus.Subscribe("names", "g", ProtoHandler(func(_ context.Context, _ *Message, v *wrapperspb.StringValue) error {
+		received <- v.GetValue()
+		return nil
+	}))
+	require.NoError(t, err)
+
+	_, err = PublishProto(context.Background(), bus, "names", wrapperspb.String("alice"))
+	require.NoError(t, err)
+	select {
+	case v := <-received:
+		assert.Equal(t, "alice", v)
+	case <-time.After(2 * time.Second):
+		t.Fatal("proto message not received")
+	}
+
+	msgs := bus.Messages("names")
+	require.Len(t, msgs, 1)
+	assert.Equal(t, ContentTypeProto, msgs[0].Header(HeaderContentType))
+	assert.Equal(t, "google.protobuf.StringValue", msgs[0].Header(HeaderMessageType))
+
+	// 类型不匹配直接进入死信
+	_, err = PublishProto(context.Background(), bus, "names", durationpb.New(time.Second))
+	require.NoError(t, err)
+	waitFor(t, func() bool { return len(bus.Messages(DeadLetterTopic("names"))) == 1 })
+	assert.Contains(t, bus.Messages(DeadLetterTopic("names"))[0].Header(HeaderError), "unexpected message type")
+}
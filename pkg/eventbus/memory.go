@@ -0,0 +1,253 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// memoryRetention 每个 topic 保留的历史消息数，供 Messages 查询
+const memoryRetention = 1000
+
+// MemoryBus 进程内事件总线，语义与 RedisBus 一致但不持久化，进程退出后未处理的消息会丢失。
+// 主要用于单元测试和本地开发。
+type MemoryBus struct {
+	mu       sync.Mutex
+	defaults SubscribeOptions
+	seq      uint64
+	groups   map[string]map[string]*memoryGroup // topic -> group
+	history  map[string][]*Message
+	subs     map[*memorySubscription]struct{}
+	timers   map[*time.Timer]struct{}
+	closed   bool
+}
+
+// NewMemoryBus 创建进程内事件总线，opts 作为所有订阅的默认选项
+func NewMemoryBus(opts ...SubscribeOption) *MemoryBus {
+	return &MemoryBus{
+		defaults: newSubscribeOptions(SubscribeOptions{}, opts),
+		groups:   make(map[string]map[string]*memoryGroup),
+		history:  make(map[string][]*Message),
+		subs:     make(map[*memorySubscription]struct{}),
+		timers:   make(map[*time.Timer]struct{}),
+	}
+}
+
+// Publish 把消息投递到 topic 下已存在的所有消费者组
+func (b *MemoryBus) Publish(ctx context.Context, topic string, msg *Message) (string, error) {
+	if topic == "" {
+		return "", ErrEmptyTopic
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return "", ErrClosed
+	}
+	b.seq++
+	stored := &Message{
+		ID:          strconv.FormatUint(b.seq, 10),
+		Topic:       topic,
+		Payload:     msg.Payload,
+		Headers:     copyHeaders(msg.Headers),
+		PublishedAt: time.Now(),
+	}
+	h := append(b.history[topic], stored)
+	if len(h) > memoryRetention {
+		h = h[len(h)-memoryRetention:]
+	}
+	b.history[topic] = h
+	for _, g := range b.groups[topic] {
+		g.push(stored.clone())
+	}
+	return stored.ID, nil
+}
+
+// Subscribe 以消费者组订阅 topic
+func (b *MemoryBus) Subscribe(topic, group string, handler Handler, opts ...SubscribeOption) (Subscription, error) {
+	if topic == "" || group == "" {
+		return nil, ErrEmptyTopic
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+	if b.groups[topic] == nil {
+		b.groups[topic] = make(map[string]*memoryGroup)
+	}
+	g, ok := b.groups[topic][group]
+	if !ok {
+		g = newMemoryGroup()
+		b.groups[topic][group] = g
+	}
+	sub := &memorySubscription{
+		bus:     b,
+		group:   g,
+		handler: handler,
+		opts:    newSubscribeOptions(b.defaults, opts),
+		done:    make(chan struct{}),
+	}
+	b.subs[sub] = struct{}{}
+	go sub.run()
+	return sub, nil
+}
+
+// Messages 返回 topic 最近发布的消息，用于测试断言（包括死信 topic）
+func (b *MemoryBus) Messages(topic string) []*Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]*Message, 0, len(b.history[topic]))
+	for _, m := range b.history[topic] {
+		out = append(out, m.clone())
+	}
+	return out
+}
+
+// Close 停止所有订阅，待重试的消息会被丢弃
+func (b *MemoryBus) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	subs := make([]*memorySubscription, 0, len(b.subs))
+	for s := range b.subs {
+		subs = append(subs, s)
+	}
+	for t := range b.timers {
+		t.Stop()
+	}
+	b.timers = nil
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		_ = s.Close()
+	}
+	return nil
+}
+
+// retry 在退避后把消息放回消费者组
+func (b *MemoryBus) retry(g *memoryGroup, msg *Message, after time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	var t *time.Timer
+	t = time.AfterFunc(after, func() {
+		b.mu.Lock()
+		closed := b.closed
+		if !closed {
+			delete(b.timers, t)
+		}
+		b.mu.Unlock()
+		if !closed {
+			g.push(msg)
+		}
+	})
+	b.timers[t] = struct{}{}
+}
+
+type memoryGroup struct {
+	mu    sync.Mutex
+	cond  *sync.Cond
+	queue []*Message
+}
+
+func newMemoryGroup() *memoryGroup {
+	g := &memoryGroup{}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+func (g *memoryGroup) push(msg *Message) {
+	g.mu.Lock()
+	g.queue = append(g.queue, msg)
+	g.mu.Unlock()
+	g.cond.Signal()
+}
+
+// pop 阻塞直到取到消息或 stopped 返回 true
+func (g *memoryGroup) pop(stopped func() bool) *Message {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for len(g.queue) == 0 {
+		if stopped() {
+			return nil
+		}
+		g.cond.Wait()
+	}
+	if stopped() {
+		return nil
+	}
+	msg := g.queue[0]
+	g.queue = g.queue[1:]
+	return msg
+}
+
+type memorySubscription struct {
+	bus     *MemoryBus
+	group   *memoryGroup
+	handler Handler
+	opts    SubscribeOptions
+
+	once    sync.Once
+	stopped bool // 由 group.mu 保护
+	done    chan struct{}
+}
+
+func (s *memorySubscription) run() {
+	defer close(s.done)
+	ctx := context.Background()
+	for {
+		msg := s.group.pop(func() bool { return s.stopped })
+		if msg == nil {
+			return
+		}
+		msg.Attempts++
+		err := safeHandle(ctx, s.handler, msg)
+		if err == nil {
+			continue
+		}
+		if shouldDeadLetter(msg, err, s.opts.MaxDeliveries) {
+			_, _ = s.bus.Publish(ctx, DeadLetterTopic(msg.Topic), deadLetter(msg, err))
+			continue
+		}
+		s.bus.retry(s.group, msg, s.opts.RetryBackoff)
+	}
+}
+
+// Close 停止订阅，正在处理的消息处理完后返回
+func (s *memorySubscription) Close() error {
+	s.once.Do(func() {
+		s.group.mu.Lock()
+		s.stopped = true
+		s.group.mu.Unlock()
+		s.group.cond.Broadcast()
+
+		s.bus.mu.Lock()
+		delete(s.bus.subs, s)
+		s.bus.mu.Unlock()
+	})
+	<-s.done
+	return nil
+}
+
+func (m *Message) clone() *Message {
+	c := *m
+	c.Headers = copyHeaders(m.Headers)
+	return &c
+}
+
+func copyHeaders(h map[string]string) map[string]string {
+	if h == nil {
+		return nil
+	}
+	c := make(map[string]string, len(h))
+	for k, v := range h {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,61 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// protobuf 负载使用的消息头
+const (
+	HeaderContentType = "content-type"
+	HeaderMessageType = "message-type"
+
+	ContentTypeProto = "application/x-protobuf"
+)
+
+// NewProtoMessage 把 protobuf 消息编码为总线消息，消息头记录完整类型名
+func NewProtoMessage(m proto.Message) (*Message, error) {
+	payload, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		Payload: payload,
+		Headers: map[string]string{
+			HeaderContentType: ContentTypeProto,
+			HeaderMessageType: string(m.ProtoReflect().Descriptor().FullName()),
+		},
+	}, nil
+}
+
+// PublishProto 发布 protobuf 消息
+func PublishProto(ctx context.Context, p Publisher, topic string, m proto.Message) (string, error) {
+	msg, err := NewProtoMessage(m)
+	if err != nil {
+		return "", err
+	}
+	return p.Publish(ctx, topic, msg)
+}
+
+// ProtoHandler 把类型化的处理函数包装为 Handler。
+// 类型不匹配或无法解码的消息返回 Permanent 错误，直接转入死信 topic。
+//
+//	eventbus.ProtoHandler(func(ctx context.Context, msg *eventbus.Message, e *eventpb.DomainEvent) error { ... })
+func ProtoHandler[T any, PT interface {
+	*T
+	proto.Message
+}](fn func(ctx context.Context, msg *Message, payload PT) error) Handler {
+	return func(ctx context.Context, msg *Message) error {
+		payload := PT(new(T))
+		want := string(payload.ProtoReflect().Descriptor().FullName())
+		if got := msg.Header(HeaderMessageType); got != "" && got != want {
+			return Permanent(fmt.Errorf("eventbus: unexpected message type %q, want %q", got, want))
+		}
+		if err := proto.Unmarshal(msg.Payload, payload); err != nil {
+			return Permanent(fmt.Errorf("eventbus: decode %s: %w", want, err))
+		}
+		return fn(ctx, msg, payload)
+	}
+}
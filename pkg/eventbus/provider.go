@@ -0,0 +1,50 @@
+package eventbus
+
+import (
+	"errors"
+	"strings"
+
+	conf "github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+	"github.com/ToAtlas/AtlasBackend/pkg/redis"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet 是事件总线的依赖注入提供者集合
+var ProviderSet = wire.NewSet(
+	NewBus,
+)
+
+// NewBus 根据 data.event_bus 配置创建事件总线，未配置 driver 时使用 Redis Streams
+func NewBus(c *conf.Data, rdb *redis.Client, logger log.Logger) (Bus, func(), error) {
+	ec := c.GetEventBus()
+	defaults := SubscribeOptions{
+		MaxDeliveries: int(ec.GetMaxDeliveries()),
+		RetryBackoff:  ec.GetRetryBackoff().AsDuration(),
+		BatchSize:     int(ec.GetBatchSize()),
+	}
+
+	var bus Bus
+	switch strings.ToLower(ec.GetDriver()) {
+	case "memory":
+		bus = NewMemoryBus(func(o *SubscribeOptions) { *o = defaults })
+	case "", "redis":
+		if rdb == nil {
+			return nil, nil, errors.New("eventbus: redis driver requires a redis client")
+		}
+		bus = NewRedisBus(rdb, RedisConfig{
+			StreamMaxLen: ec.GetStreamMaxLen(),
+			BlockTimeout: ec.GetBlockTimeout().AsDuration(),
+			Defaults:     defaults,
+		}, logger)
+	default:
+		return nil, nil, errors.New("eventbus: unsupported driver " + ec.GetDriver())
+	}
+
+	cleanup := func() {
+		log.NewHelper(logger).Info("closing event bus")
+		_ = bus.Close()
+	}
+	return bus, cleanup, nil
+}
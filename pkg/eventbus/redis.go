@@ -0,0 +1,298 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/ToAtlas/AtlasBackend/pkg/redis"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	DefaultStreamPrefix = "eventbus:"
+	DefaultBlockTimeout = 5 * time.Second
+
+	fieldPayload     = "payload"
+	fieldHeaders     = "headers"
+	fieldPublishedAt = "published_at"
+
+	// 读取出错后的等待时间，避免 Redis 不可用时空转
+	redisErrorBackoff = time.Second
+)
+
+// RedisConfig Redis Streams 总线配置
+type RedisConfig struct {
+	// Prefix Stream key 前缀，topic 对应的 key 为 Prefix + topic
+	Prefix string
+	// StreamMaxLen 每个 Stream 保留的最大消息数（近似裁剪），0 表示不裁剪
+	StreamMaxLen int64
+	// BlockTimeout XREADGROUP 的阻塞等待时间
+	BlockTimeout time.Duration
+	// Consumer 消费者名称，为空时使用 hostname 加随机后缀
+	Consumer string
+	// Defaults 订阅的默认选项
+	Defaults SubscribeOptions
+}
+
+// RedisBus 基于 Redis Streams 的事件总线。
+//
+// 每个 topic 对应一个 Stream，订阅时创建同名消费者组（XGROUP CREATE ... $ MKSTREAM）。
+// 处理成功后 XACK；失败的消息留在 PEL 中，空闲超过 RetryBackoff 后被 XCLAIM 重新处理，
+// Redis 记录的投递次数达到 MaxDeliveries 后转入死信 Stream。进程崩溃时未确认的消息同样会被其他消费者认领。
+type RedisBus struct {
+	rdb      *redis.Client
+	cfg      RedisConfig
+	consumer string
+	log      *log.Helper
+
+	mu     sync.Mutex
+	subs   map[*redisSubscription]struct{}
+	closed bool
+}
+
+// NewRedisBus 创建 Redis Streams 事件总线
+func NewRedisBus(rdb *redis.Client, cfg RedisConfig, logger log.Logger) *RedisBus {
+	if cfg.Prefix == "" {
+		cfg.Prefix = DefaultStreamPrefix
+	}
+	if cfg.BlockTimeout <= 0 {
+		cfg.BlockTimeout = DefaultBlockTimeout
+	}
+	cfg.Defaults = newSubscribeOptions(cfg.Defaults, nil)
+	consumer := cfg.Consumer
+	if consumer == "" {
+		host, _ := os.Hostname()
+		consumer = fmt.Sprintf("%s-%s", host, uuid.NewString()[:8])
+	}
+	return &RedisBus{
+		rdb:      rdb,
+		cfg:      cfg,
+		consumer: consumer,
+		log:      log.NewHelper(pkglogger.WithModule(logger, "eventbus/pkg/krathub-service")),
+		subs:     make(map[*redisSubscription]struct{}),
+	}
+}
+
+func (b *RedisBus) stream(topic string) string {
+	return b.cfg.Prefix + topic
+}
+
+// Publish 通过 XADD 发布消息，返回 Stream 消息ID
+func (b *RedisBus) Publish(ctx context.Context, topic string, msg *Message) (string, error) {
+	if topic == "" {
+		return "", ErrEmptyTopic
+	}
+	headers, err := json.Marshal(msg.Headers)
+	if err != nil {
+		return "", err
+	}
+	return b.rdb.XAdd(ctx, b.stream(topic), b.cfg.StreamMaxLen, map[string]any{
+		fieldPayload:     msg.Payload,
+		fieldHeaders:     string(headers),
+		fieldPublishedAt: strconv.FormatInt(time.Now().UnixMilli(), 10),
+	})
+}
+
+// Subscribe 创建消费者组（已存在时复用）并开始消费
+func (b *RedisBus) Subscribe(topic, group string, handler Handler, opts ...SubscribeOption) (Subscription, error) {
+	if topic == "" || group == "" {
+		return nil, ErrEmptyTopic
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	stream := b.stream(topic)
+	err := b.rdb.XGroupCreateMkStream(context.Background(), stream, group, "$")
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("eventbus: create group %s on %s: %w", group, stream, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &redisSubscription{
+		bus:     b,
+		topic:   topic,
+		stream:  stream,
+		group:   group,
+		handler: handler,
+		opts:    newSubscribeOptions(b.cfg.Defaults, opts),
+		cancel:  cancel,
+	}
+	b.subs[sub] = struct{}{}
+	sub.wg.Add(2)
+	go sub.readLoop(ctx)
+	go sub.reclaimLoop(ctx)
+	return sub, nil
+}
+
+// Close 停止所有订阅
+func (b *RedisBus) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	subs := make([]*redisSubscription, 0, len(b.subs))
+	for s := range b.subs {
+		subs = append(subs, s)
+	}
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		_ = s.Close()
+	}
+	return nil
+}
+
+type redisSubscription struct {
+	bus     *RedisBus
+	topic   string
+	stream  string
+	group   string
+	handler Handler
+	opts    SubscribeOptions
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+// readLoop 读取组内新消息
+func (s *redisSubscription) readLoop(ctx context.Context) {
+	defer s.wg.Done()
+	for ctx.Err() == nil {
+		msgs, err := s.bus.rdb.XReadGroup(ctx, s.stream, s.group, s.bus.consumer, int64(s.opts.BatchSize), s.bus.cfg.BlockTimeout)
+		if err != nil {
+			if errors.Is(err, goredis.Nil) || ctx.Err() != nil {
+				continue
+			}
+			s.bus.log.Errorf("read %s as %s failed: %v", s.stream, s.group, err)
+			sleep(ctx, redisErrorBackoff)
+			continue
+		}
+		for _, x := range msgs {
+			s.process(ctx, s.decode(x, 1))
+		}
+	}
+}
+
+// reclaimLoop 认领处理失败或消费者崩溃遗留的待确认消息
+func (s *redisSubscription) reclaimLoop(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.opts.RetryBackoff)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pending, err := s.bus.rdb.XPendingIdle(ctx, s.stream, s.group, s.opts.RetryBackoff, int64(s.opts.BatchSize))
+		if err != nil {
+			if ctx.Err() == nil {
+				s.bus.log.Errorf("list pending of %s as %s failed: %v", s.stream, s.group, err)
+			}
+			continue
+		}
+		for _, p := range pending {
+			if ctx.Err() != nil {
+				return
+			}
+			msgs, err := s.bus.rdb.XClaim(ctx, s.stream, s.group, s.bus.consumer, s.opts.RetryBackoff, p.ID)
+			if err != nil {
+				s.bus.log.Warnf("claim %s of %s failed: %v", p.ID, s.stream, err)
+				continue
+			}
+			if len(msgs) == 0 {
+				// 已被其他消费者认领，或消息已被 MAXLEN 裁剪
+				continue
+			}
+			if msgs[0].Values == nil {
+				// 消息内容已被裁剪，无法重试
+				_ = s.bus.rdb.XAck(ctx, s.stream, s.group, p.ID)
+				continue
+			}
+			// RetryCount 为认领前的投递次数，本次是第 RetryCount+1 次
+			s.process(ctx, s.decode(msgs[0], int(p.RetryCount)+1))
+		}
+	}
+}
+
+// process 处理单条消息：成功确认，失败留待重试或转入死信。
+// 关闭订阅不会中断正在处理的消息。
+func (s *redisSubscription) process(ctx context.Context, msg *Message) {
+	ctx = context.WithoutCancel(ctx)
+	err := safeHandle(ctx, s.handler, msg)
+	if err == nil {
+		s.ack(ctx, msg.ID)
+		return
+	}
+	if !shouldDeadLetter(msg, err, s.opts.MaxDeliveries) {
+		s.bus.log.Warnf("handle %s/%s in %s failed (attempt %d): %v", s.topic, msg.ID, s.group, msg.Attempts, err)
+		return
+	}
+	if _, derr := s.bus.Publish(ctx, DeadLetterTopic(s.topic), deadLetter(msg, err)); derr != nil {
+		// 死信写入失败时不确认，消息稍后会再次被认领
+		s.bus.log.Errorf("dead-letter %s/%s failed: %v", s.topic, msg.ID, derr)
+		return
+	}
+	s.bus.log.Warnf("message %s/%s dead-lettered after %d attempts: %v", s.topic, msg.ID, msg.Attempts, err)
+	s.ack(ctx, msg.ID)
+}
+
+func (s *redisSubscription) ack(ctx context.Context, id string) {
+	if err := s.bus.rdb.XAck(ctx, s.stream, s.group, id); err != nil {
+		s.bus.log.Errorf("ack %s/%s failed: %v", s.topic, id, err)
+	}
+}
+
+func (s *redisSubscription) decode(x goredis.XMessage, attempts int) *Message {
+	msg := &Message{ID: x.ID, Topic: s.topic, Attempts: attempts}
+	if v, ok := x.Values[fieldPayload].(string); ok {
+		msg.Payload = []byte(v)
+	}
+	if v, ok := x.Values[fieldHeaders].(string); ok && v != "" {
+		_ = json.Unmarshal([]byte(v), &msg.Headers)
+	}
+	if v, ok := x.Values[fieldPublishedAt].(string); ok {
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			msg.PublishedAt = time.UnixMilli(ms)
+		}
+	}
+	return msg
+}
+
+// Close 停止读取并等待正在处理的消息完成，未确认的消息留在组内由其他消费者认领
+func (s *redisSubscription) Close() error {
+	s.once.Do(func() {
+		s.cancel()
+		s.bus.mu.Lock()
+		delete(s.bus.subs, s)
+		s.bus.mu.Unlock()
+	})
+	s.wg.Wait()
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -2,14 +2,246 @@
 package hash
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// 支持的密码哈希算法
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// DefaultBcryptCost bcrypt 默认 cost。建议大于 12，数值越大耗费时间越长，14目前会导致请求超时
+const DefaultBcryptCost = 12
+
+// Argon2idParams Argon2id 参数
+type Argon2idParams struct {
+	Memory      uint32 // 内存，单位 KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// Argon2id 参数上限。验证密码时按哈希中记录的参数计算，超出上限的哈希（例如 m=4294967295）
+// 会让一次登录请求耗尽内存或 CPU，视为无效哈希
+const (
+	MaxArgon2idMemory      = 1024 * 1024 // 1 GiB
+	MaxArgon2idIterations  = 10
+	MaxArgon2idParallelism = 16
+	MaxArgon2idKeyLength   = 1024
+)
+
+// Validate 检查参数是否在允许的范围内，0 表示使用默认值
+func (p Argon2idParams) Validate() error {
+	if p.Memory > MaxArgon2idMemory || p.Iterations > MaxArgon2idIterations ||
+		p.Parallelism > MaxArgon2idParallelism || p.KeyLength > MaxArgon2idKeyLength {
+		return fmt.Errorf("hash: argon2id parameters exceed limits (m<=%d, t<=%d, p<=%d, key<=%d)",
+			MaxArgon2idMemory, MaxArgon2idIterations, MaxArgon2idParallelism, MaxArgon2idKeyLength)
+	}
+	return nil
+}
+
+// DefaultArgon2idParams OWASP 推荐的最低参数：19 MiB 内存、2 次迭代、1 个线程
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Hasher 按配置的算法和参数生成密码哈希，并判断已有哈希是否需要升级
+type Hasher struct {
+	Algorithm  string // 为空时使用 argon2id
+	Argon2id   Argon2idParams
+	BcryptCost int
+}
+
+// DefaultHasher 默认使用 Argon2id
+var DefaultHasher = &Hasher{Algorithm: AlgorithmArgon2id, Argon2id: DefaultArgon2idParams, BcryptCost: DefaultBcryptCost}
+
+// Hash 使用配置的算法生成密码哈希
+func (h *Hasher) Hash(password string) (string, error) {
+	switch h.algorithm() {
+	case AlgorithmArgon2id:
+		return Argon2idHash(password, h.argon2idParams())
+	case AlgorithmBcrypt:
+		return BcryptHashCost(password, h.bcryptCost())
+	default:
+		return "", fmt.Errorf("hash: unsupported algorithm %q", h.Algorithm)
+	}
+}
+
+// NeedsRehash 判断哈希是否使用了与当前配置不同的算法或参数，需要在下次验证密码成功后重新生成
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	switch Algorithm(encoded) {
+	case AlgorithmArgon2id:
+		if h.algorithm() != AlgorithmArgon2id {
+			return true
+		}
+		p, _, key, err := decodeArgon2id(encoded)
+		if err != nil {
+			return true
+		}
+		want := h.argon2idParams()
+		return p.Memory != want.Memory || p.Iterations != want.Iterations ||
+			p.Parallelism != want.Parallelism || uint32(len(key)) != want.KeyLength
+	case AlgorithmBcrypt:
+		if h.algorithm() != AlgorithmBcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return err != nil || cost != h.bcryptCost()
+	default:
+		return true
+	}
+}
+
+func (h *Hasher) algorithm() string {
+	if h.Algorithm == "" {
+		return AlgorithmArgon2id
+	}
+	return h.Algorithm
+}
+
+func (h *Hasher) argon2idParams() Argon2idParams {
+	p := h.Argon2id
+	d := DefaultArgon2idParams
+	if p.Memory == 0 {
+		p.Memory = d.Memory
+	}
+	if p.Iterations == 0 {
+		p.Iterations = d.Iterations
+	}
+	if p.Parallelism == 0 {
+		p.Parallelism = d.Parallelism
+	}
+	if p.SaltLength == 0 {
+		p.SaltLength = d.SaltLength
+	}
+	if p.KeyLength == 0 {
+		p.KeyLength = d.KeyLength
+	}
+	return p
+}
+
+func (h *Hasher) bcryptCost() int {
+	if h.BcryptCost == 0 {
+		return DefaultBcryptCost
+	}
+	return h.BcryptCost
+}
+
+// Hash 使用默认算法（Argon2id）生成密码哈希
+func Hash(password string) (string, error) {
+	return DefaultHasher.Hash(password)
+}
+
+// Check 按哈希格式选择算法对比明文密码，不认识的格式返回 false
+func Check(password, encoded string) bool {
+	switch Algorithm(encoded) {
+	case AlgorithmArgon2id:
+		return Argon2idCheck(password, encoded)
+	case AlgorithmBcrypt:
+		return BcryptCheck(password, encoded)
+	default:
+		return false
+	}
+}
+
+// Algorithm 识别哈希格式，不是支持的哈希格式时返回空字符串
+func Algorithm(encoded string) string {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		if _, _, _, err := decodeArgon2id(encoded); err == nil {
+			return AlgorithmArgon2id
+		}
+	case BcryptIsHashed(encoded):
+		return AlgorithmBcrypt
+	}
+	return ""
+}
+
+// IsHashed 判断字符串是否是支持的密码哈希格式
+func IsHashed(str string) bool {
+	return Algorithm(str) != ""
+}
+
+// Argon2idHash 生成 PHC 格式的 Argon2id 哈希：$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>
+func Argon2idHash(password string, p Argon2idParams) (string, error) {
+	salt := make([]byte, p.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Argon2idCheck 对比明文密码和 PHC 格式的 Argon2id 哈希
+func Argon2idCheck(password, encoded string) bool {
+	p, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false
+	}
+	other := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+func decodeArgon2id(encoded string) (p Argon2idParams, salt, key []byte, err error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return p, nil, nil, errors.New("hash: invalid argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return p, nil, nil, fmt.Errorf("hash: invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("hash: unsupported argon2id version %d", version)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, fmt.Errorf("hash: invalid argon2id parameters: %w", err)
+	}
+	if p.Memory == 0 || p.Iterations == 0 || p.Parallelism == 0 {
+		return p, nil, nil, errors.New("hash: invalid argon2id parameters")
+	}
+	if err := p.Validate(); err != nil {
+		return p, nil, nil, err
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, fmt.Errorf("hash: invalid argon2id salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return p, nil, nil, fmt.Errorf("hash: invalid argon2id key: %w", err)
+	}
+	if len(key) == 0 || len(key) > MaxArgon2idKeyLength {
+		return p, nil, nil, errors.New("hash: invalid argon2id key length")
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
+
 // BcryptHash 使用 bcrypt 对密码进行加密
 func BcryptHash(password string) (string, error) {
-	// GenerateFromPassword 的第二个参数是 cost 值。建议大于 12，数值越大耗费时间越长，14目前会导致请求超时
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 12)
+	return BcryptHashCost(password, DefaultBcryptCost)
+}
 
+// BcryptHashCost 使用指定 cost 的 bcrypt 对密码进行加密
+func BcryptHashCost(password string, cost int) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), cost)
 	if err != nil {
 		return "", err
 	}
@@ -22,8 +254,11 @@ func BcryptCheck(password, hash string) bool {
 	return err == nil
 }
 
-// BcryptIsHashed 判断字符串是否是哈希过的数据
+// BcryptIsHashed 判断字符串是否是 bcrypt 哈希（$2a$/$2b$/$2y$ 前缀、合法 cost 且长度为 60）
 func BcryptIsHashed(str string) bool {
-	// bcrypt 加密后的长度等于 60
-	return len(str) == 60
+	if len(str) != 60 || !(strings.HasPrefix(str, "$2a$") || strings.HasPrefix(str, "$2b$") || strings.HasPrefix(str, "$2y$")) {
+		return false
+	}
+	_, err := bcrypt.Cost([]byte(str))
+	return err == nil
 }
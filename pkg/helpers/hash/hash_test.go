@@ -0,0 +1,74 @@
+package hash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 测试使用较小的参数，避免拖慢测试
+var testArgon2idParams = Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestArgon2id_HashAndCheck(t *testing.T) {
+	encoded, err := Argon2idHash("correct horse battery staple", testArgon2idParams)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$"))
+
+	assert.Equal(t, AlgorithmArgon2id, Algorithm(encoded))
+	assert.True(t, Check("correct horse battery staple", encoded))
+	assert.False(t, Check("wrong", encoded))
+
+	other, err := Argon2idHash("correct horse battery staple", testArgon2idParams)
+	require.NoError(t, err)
+	assert.NotEqual(t, encoded, other, "salt must be random")
+}
+
+func TestAlgorithm_Detection(t *testing.T) {
+	bcryptHash, err := BcryptHashCost("password", 4)
+	require.NoError(t, err)
+	assert.Equal(t, AlgorithmBcrypt, Algorithm(bcryptHash))
+	assert.True(t, Check("password", bcryptHash))
+
+	// 长度为 60 的普通字符串不是 bcrypt 哈希
+	assert.False(t, BcryptIsHashed(strings.Repeat("a", 60)))
+	assert.False(t, IsHashed("password"))
+	assert.False(t, IsHashed("$argon2id$v=19$m=0,t=1,p=1$c2FsdA$a2V5"))
+	assert.False(t, IsHashed("$argon2id$v=19$garbage"))
+	assert.False(t, Check("password", "password"))
+}
+
+func TestArgon2id_RejectsExcessiveParameters(t *testing.T) {
+	// 参数超出上限的哈希不能用于验证密码，否则一次登录就会尝试分配数 TB 内存
+	for _, encoded := range []string{
+		"$argon2id$v=19$m=4294967295,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=19456,t=4294967295,p=1$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=255$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=4096$c2FsdHNhbHQ$a2V5a2V5a2V5a2V5",
+	} {
+		assert.False(t, IsHashed(encoded), encoded)
+		assert.False(t, Check("password", encoded), encoded)
+	}
+	assert.Error(t, Argon2idParams{Memory: MaxArgon2idMemory + 1}.Validate())
+	assert.NoError(t, DefaultArgon2idParams.Validate())
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	argon := &Hasher{Algorithm: AlgorithmArgon2id, Argon2id: testArgon2idParams}
+	bcryptHasher := &Hasher{Algorithm: AlgorithmBcrypt, BcryptCost: 4}
+
+	bcryptHash, err := bcryptHasher.Hash("password")
+	require.NoError(t, err)
+	assert.False(t, bcryptHasher.NeedsRehash(bcryptHash))
+	assert.True(t, argon.NeedsRehash(bcryptHash))
+	assert.True(t, (&Hasher{Algorithm: AlgorithmBcrypt, BcryptCost: 5}).NeedsRehash(bcryptHash))
+
+	argonHash, err := argon.Hash("password")
+	require.NoError(t, err)
+	assert.False(t, argon.NeedsRehash(argonHash))
+	assert.True(t, bcryptHasher.NeedsRehash(argonHash))
+
+	stronger := &Hasher{Algorithm: AlgorithmArgon2id, Argon2id: Argon2idParams{Memory: 128, Iterations: 1, Parallelism: 1}}
+	assert.True(t, stronger.NeedsRehash(argonHash))
+}
@@ -0,0 +1,35 @@
+package jwt
+
+import (
+	"fmt"
+
+	"github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
+)
+
+// NewKeySetFromConfig 从配置加载非对称密钥集，第一个密钥为活动签名密钥。
+// 未配置密钥时返回 nil，表示继续使用 HS256。
+func NewKeySetFromConfig(keys []*conf.App_Jwt_SigningKey) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	loaded := make([]*Key, 0, len(keys))
+	for i, c := range keys {
+		var (
+			k   *Key
+			err error
+		)
+		switch {
+		case c.GetKey() != "":
+			k, err = ParseKeyPEM(c.GetKid(), []byte(c.GetKey()))
+		case c.GetKeyFile() != "":
+			k, err = LoadKeyFile(c.GetKid(), c.GetKeyFile())
+		default:
+			err = fmt.Errorf("key or key_file is required")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jwt: load signing key %d: %w", i, err)
+		}
+		loaded = append(loaded, k)
+	}
+	return NewKeySet(loaded[0], loaded[1:]...)
+}
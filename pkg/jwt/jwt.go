@@ -14,20 +14,31 @@ import (
 // 最简单的方法是在你的结构体中嵌入 jwt.RegisteredClaims。
 type JWT[T any] struct {
 	secretKey []byte
+	keys      *KeySet
 }
 
 // Config 保存 JWT 服务的配置。
 type Config struct {
+	// SecretKey HS256 共享密钥，未设置 KeySet 时使用
 	SecretKey string
+	// KeySet 非对称密钥集。设置后使用活动密钥以 RS256/EdDSA 签名并在头部写入 kid，
+	// 验证时按 kid 选择公钥，不再接受 HS256 token。
+	KeySet *KeySet
 }
 
 // NewJWT 创建一个新的通用 JWT 服务。
 func NewJWT[T any](cfg *Config) *JWT[T] {
 	return &JWT[T]{
 		secretKey: []byte(cfg.SecretKey),
+		keys:      cfg.KeySet,
 	}
 }
 
+// KeySet 返回非对称密钥集，使用 HS256 时返回 nil
+func (j *JWT[T]) KeySet() *KeySet {
+	return j.keys
+}
+
 // GenerateToken 使用提供的 claims 创建一个新的 JWT 令牌。
 // claims 参数必须是你的自定义 claims 结构体的指针。
 func (j *JWT[T]) GenerateToken(claims *T) (string, error) {
@@ -38,8 +49,38 @@ func (j *JWT[T]) GenerateToken(claims *T) (string, error) {
 		return "", fmt.Errorf("claims type *%T does not implement jwt.Claims. Did you forget to embed jwt.RegisteredClaims?", *claims)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims)
-	return token.SignedString(j.secretKey)
+	if j.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims)
+		return token.SignedString(j.secretKey)
+	}
+
+	active := j.keys.Active()
+	if active == nil {
+		return "", errors.New("jwt: key set has no signing key")
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(active.Algorithm), jwtClaims)
+	token.Header["kid"] = active.ID
+	return token.SignedString(active.private)
+}
+
+// keyFunc 返回验证签名使用的密钥以及允许的签名算法，防止算法混淆攻击
+func (j *JWT[T]) keyFunc() (jwt.Keyfunc, []string) {
+	if j.keys == nil {
+		return func(*jwt.Token) (any, error) {
+			return j.secretKey, nil
+		}, []string{AlgHS256}
+	}
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := j.keys.Lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("jwt: unknown key id %q", kid)
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("jwt: algorithm %s does not match key %q", token.Method.Alg(), kid)
+		}
+		return key.public, nil
+	}, j.keys.algorithms()
 }
 
 // ParseToken 解析令牌字符串并返回填充的自定义 claims。
@@ -56,9 +97,8 @@ func (j *JWT[T]) ParseToken(tokenString string) (*T, error) {
 		return nil, fmt.Errorf("claims type *%T does not implement jwt.Claims. Did you forget to embed jwt.RegisteredClaims?", *claims)
 	}
 
-	token, err := jwt.ParseWithClaims(tokenString, claimsInterface, func(token *jwt.Token) (interface{}, error) {
-		return j.secretKey, nil
-	})
+	keyFunc, methods := j.keyFunc()
+	token, err := jwt.ParseWithClaims(tokenString, claimsInterface, keyFunc, jwt.WithValidMethods(methods))
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,171 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClaims struct {
+	Name string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+func newClaims(name string) *testClaims {
+	return &testClaims{
+		Name: name,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+}
+
+func rsaKey(t *testing.T, kid string) *Key {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	k, err := NewKey(kid, priv)
+	require.NoError(t, err)
+	return k
+}
+
+func edKey(t *testing.T, kid string) *Key {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k, err := NewKey(kid, priv)
+	require.NoError(t, err)
+	return k
+}
+
+func TestJWT_HS256(t *testing.T) {
+	j := NewJWT[testClaims](&Config{SecretKey: "secret"})
+	token, err := j.GenerateToken(newClaims("alice"))
+	require.NoError(t, err)
+
+	claims, err := j.ParseToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", claims.Name)
+
+	_, err = NewJWT[testClaims](&Config{SecretKey: "other"}).ParseToken(token)
+	assert.Error(t, err)
+}
+
+func TestJWT_AsymmetricRoundTrip(t *testing.T) {
+	for _, key := range []*Key{rsaKey(t, "rsa-1"), edKey(t, "ed-1")} {
+		t.Run(key.Algorithm, func(t *testing.T) {
+			keys, err := NewKeySet(key)
+			require.NoError(t, err)
+			j := NewJWT[testClaims](&Config{KeySet: keys})
+
+			token, err := j.GenerateToken(newClaims("bob"))
+			require.NoError(t, err)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &testClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, key.ID, parsed.Header["kid"])
+			assert.Equal(t, key.Algorithm, parsed.Header["alg"])
+
+			claims, err := j.ParseToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, "bob", claims.Name)
+		})
+	}
+}
+
+func TestJWT_Rotation(t *testing.T) {
+	oldKey, newKey := rsaKey(t, "old"), edKey(t, "new")
+
+	oldSet, err := NewKeySet(oldKey)
+	require.NoError(t, err)
+	oldToken, err := NewJWT[testClaims](&Config{KeySet: oldSet}).GenerateToken(newClaims("carol"))
+	require.NoError(t, err)
+
+	// 轮换后新密钥签名，旧密钥仍可验证
+	rotated, err := NewKeySet(newKey, oldKey)
+	require.NoError(t, err)
+	j := NewJWT[testClaims](&Config{KeySet: rotated})
+	_, err = j.ParseToken(oldToken)
+	require.NoError(t, err)
+
+	newToken, err := j.GenerateToken(newClaims("carol"))
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &testClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"])
+
+	// 旧密钥移除后旧 token 失效
+	retired, err := NewKeySet(newKey)
+	require.NoError(t, err)
+	_, err = NewJWT[testClaims](&Config{KeySet: retired}).ParseToken(oldToken)
+	assert.Error(t, err)
+}
+
+func TestJWT_RejectsHS256WithKeySet(t *testing.T) {
+	keys, err := NewKeySet(rsaKey(t, "rsa"))
+	require.NoError(t, err)
+	hsToken, err := NewJWT[testClaims](&Config{SecretKey: "secret"}).GenerateToken(newClaims("mallory"))
+	require.NoError(t, err)
+
+	_, err = NewJWT[testClaims](&Config{KeySet: keys}).ParseToken(hsToken)
+	assert.Error(t, err)
+}
+
+func TestJWKS_RoundTrip(t *testing.T) {
+	signer, err := NewKeySet(edKey(t, ""), rsaKey(t, "rsa-old"))
+	require.NoError(t, err)
+	token, err := NewJWT[testClaims](&Config{KeySet: signer}).GenerateToken(newClaims("dave"))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(signer.JWKS())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"d"`, "private key material must not be published")
+
+	// 其他服务只用公钥验证
+	verifier, err := ParseJWKS(data)
+	require.NoError(t, err)
+	assert.Nil(t, verifier.Active())
+	assert.Len(t, verifier.Keys(), 2)
+	claims, err := NewJWT[testClaims](&Config{KeySet: verifier}).ParseToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "dave", claims.Name)
+
+	_, err = NewJWT[testClaims](&Config{KeySet: verifier}).GenerateToken(newClaims("dave"))
+	assert.Error(t, err)
+}
+
+func TestParseKeyPEM(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	k, err := ParseKeyPEM("", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.True(t, k.CanSign())
+	assert.Equal(t, AlgRS256, k.Algorithm)
+	assert.Equal(t, k.Thumbprint(), k.ID)
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+	pub, err := ParseKeyPEM("pub", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+	require.NoError(t, err)
+	assert.False(t, pub.CanSign())
+	assert.Equal(t, k.Thumbprint(), pub.Thumbprint())
+
+	_, err = NewKeySet(pub)
+	assert.Error(t, err, "active key requires a private key")
+
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = NewKey("small", small)
+	assert.Error(t, err)
+}
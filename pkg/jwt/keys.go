@@ -0,0 +1,208 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// 支持的签名算法
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// minRSABits RSA 密钥的最小长度
+const minRSABits = 2048
+
+// Key 一把非对称密钥。持有私钥时可以签名，只有公钥时仅用于验证。
+type Key struct {
+	// ID 写入 JWT 头部的 kid
+	ID string
+	// Algorithm 由密钥类型决定：RSA 为 RS256，Ed25519 为 EdDSA
+	Algorithm string
+
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// NewKey 从 *rsa.PrivateKey、*rsa.PublicKey、ed25519.PrivateKey 或 ed25519.PublicKey 创建密钥，
+// kid 为空时使用 RFC 7638 指纹
+func NewKey(kid string, key any) (*Key, error) {
+	k := &Key{ID: kid}
+	switch v := key.(type) {
+	case *rsa.PrivateKey:
+		k.Algorithm, k.private, k.public = AlgRS256, v, &v.PublicKey
+	case *rsa.PublicKey:
+		k.Algorithm, k.public = AlgRS256, v
+	case ed25519.PrivateKey:
+		k.Algorithm, k.private, k.public = AlgEdDSA, v, v.Public()
+	case ed25519.PublicKey:
+		k.Algorithm, k.public = AlgEdDSA, v
+	default:
+		return nil, fmt.Errorf("jwt: unsupported key type %T", key)
+	}
+	if pub, ok := k.public.(*rsa.PublicKey); ok && pub.N.BitLen() < minRSABits {
+		return nil, fmt.Errorf("jwt: RSA key must be at least %d bits", minRSABits)
+	}
+	if k.ID == "" {
+		k.ID = k.Thumbprint()
+	}
+	return k, nil
+}
+
+// ParseKeyPEM 解析 PEM 格式的私钥（PKCS#8 / PKCS#1）或公钥（PKIX / PKCS#1）
+func ParseKeyPEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("jwt: no PEM block found")
+	}
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("jwt: unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt: parse %s: %w", block.Type, err)
+	}
+	return NewKey(kid, key)
+}
+
+// LoadKeyFile 从 PEM 文件加载密钥
+func LoadKeyFile(kid, path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyPEM(kid, data)
+}
+
+// CanSign 是否持有私钥
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+// PublicKey 返回公钥
+func (k *Key) PublicKey() crypto.PublicKey {
+	return k.public
+}
+
+// Thumbprint 计算 RFC 7638 JWK 指纹（SHA-256，base64url）
+func (k *Key) Thumbprint() string {
+	// 指纹只包含必需成员，且按字典序排列
+	var members any
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{encodeInt(big.NewInt(int64(pub.E))), "RSA", encodeInt(pub.N)}
+	case ed25519.PublicKey:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{"Ed25519", "OKP", base64.RawURLEncoding.EncodeToString(pub)}
+	}
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// KeySet 签名密钥集合：当前用于签名的活动密钥，以及轮换期间仍用于验证的旧密钥
+type KeySet struct {
+	active *Key
+	keys   []*Key
+	byID   map[string]*Key
+}
+
+// NewKeySet 创建密钥集，active 必须持有私钥；previous 只用于验证，可以只有公钥
+func NewKeySet(active *Key, previous ...*Key) (*KeySet, error) {
+	if active == nil || !active.CanSign() {
+		return nil, errors.New("jwt: active key must have a private key")
+	}
+	s, err := newKeySet(append([]*Key{active}, previous...))
+	if err != nil {
+		return nil, err
+	}
+	s.active = active
+	return s, nil
+}
+
+// NewVerifyKeySet 创建只用于验证的密钥集，例如从其他服务的 JWKS 加载的公钥
+func NewVerifyKeySet(keys ...*Key) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("jwt: key set is empty")
+	}
+	return newKeySet(keys)
+}
+
+func newKeySet(keys []*Key) (*KeySet, error) {
+	s := &KeySet{byID: make(map[string]*Key, len(keys))}
+	for _, k := range keys {
+		if k == nil {
+			continue
+		}
+		if _, dup := s.byID[k.ID]; dup {
+			return nil, fmt.Errorf("jwt: duplicate key id %q", k.ID)
+		}
+		s.byID[k.ID] = k
+		s.keys = append(s.keys, k)
+	}
+	return s, nil
+}
+
+// Active 返回当前签名密钥，只用于验证的密钥集返回 nil
+func (s *KeySet) Active() *Key {
+	return s.active
+}
+
+// Lookup 按 kid 查找密钥
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	k, ok := s.byID[kid]
+	return k, ok
+}
+
+// Keys 返回全部密钥，活动密钥在前
+func (s *KeySet) Keys() []*Key {
+	return append([]*Key(nil), s.keys...)
+}
+
+// algorithms 密钥集中出现的全部签名算法
+func (s *KeySet) algorithms() []string {
+	var algs []string
+	seen := map[string]bool{}
+	for _, k := range s.keys {
+		if !seen[k.Algorithm] {
+			seen[k.Algorithm] = true
+			algs = append(algs, k.Algorithm)
+		}
+	}
+	return algs
+}
+
+func encodeInt(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.Bytes())
+}
@@ -0,0 +1,216 @@
+// Package ldap 实现 LDAP 简单绑定认证：先用服务账号按过滤条件查找用户条目，
+// 再以条目的 DN 和用户输入的密码绑定，绑定成功即密码正确。
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// DefaultUserFilter 未配置过滤条件时按邮箱查找用户
+const DefaultUserFilter = "(mail={email})"
+
+// emailPlaceholder 过滤条件中替换为登录邮箱的占位符
+const emailPlaceholder = "{email}"
+
+const defaultTimeout = 10 * time.Second
+
+var (
+	// ErrUserNotFound 目录中没有匹配的用户
+	ErrUserNotFound = errors.New("ldap: user not found")
+	// ErrInvalidCredentials 密码错误，或密码为空
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+)
+
+// Config LDAP 客户端配置
+type Config struct {
+	// URL ldap://host:389 或 ldaps://host:636
+	URL string
+	// StartTLS 使用 ldap:// 时通过 StartTLS 加密连接
+	StartTLS bool
+	// TLSConfig 为空时按 URL 中的主机名校验证书
+	TLSConfig *tls.Config
+	// InsecureSkipVerify 不校验服务器证书，仅用于测试环境，TLSConfig 不为空时忽略
+	InsecureSkipVerify bool
+	// BindDN 查找用户的服务账号，为空时匿名查找
+	BindDN       string
+	BindPassword string
+	// BaseDN 查找用户的起点
+	BaseDN string
+	// UserFilter 查找用户的过滤条件，{email} 替换为转义后的登录邮箱，默认 (mail={email})
+	UserFilter string
+	// Attributes 需要读取的用户属性，为空时读取全部属性
+	Attributes []string
+	// Timeout 连接和单个请求的超时，默认 10 秒
+	Timeout time.Duration
+}
+
+// Entry 用户条目
+type Entry struct {
+	DN string
+	// attributes 属性名转为小写，LDAP 属性名不区分大小写
+	attributes map[string][][]byte
+}
+
+// Values 返回属性的全部值
+func (e *Entry) Values(name string) []string {
+	raw := e.attributes[strings.ToLower(name)]
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		values = append(values, string(v))
+	}
+	return values
+}
+
+// Get 返回属性的第一个值，属性不存在时返回空字符串
+func (e *Entry) Get(name string) string {
+	if raw := e.Raw(name); raw != nil {
+		return string(raw)
+	}
+	return ""
+}
+
+// Raw 返回属性第一个值的原始字节，用于 objectGUID 等二进制属性
+func (e *Entry) Raw(name string) []byte {
+	if raw := e.attributes[strings.ToLower(name)]; len(raw) > 0 {
+		return raw[0]
+	}
+	return nil
+}
+
+// Client LDAP 客户端。每次认证使用新的连接，目录服务器暂时不可用不会影响服务启动
+type Client struct {
+	cfg Config
+}
+
+// NewClient 创建客户端
+func NewClient(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("ldap: invalid url %q", cfg.URL)
+	}
+	switch u.Scheme {
+	case "ldap":
+	case "ldaps":
+		if cfg.StartTLS {
+			return nil, errors.New("ldap: start_tls cannot be used with ldaps://")
+		}
+	default:
+		return nil, fmt.Errorf("ldap: unsupported scheme %q", u.Scheme)
+	}
+	if cfg.BaseDN == "" {
+		return nil, errors.New("ldap: base dn is required")
+	}
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = DefaultUserFilter
+	}
+	if !strings.Contains(cfg.UserFilter, emailPlaceholder) {
+		return nil, fmt.Errorf("ldap: user filter must contain %s", emailPlaceholder)
+	}
+	if _, err := goldap.CompileFilter(strings.ReplaceAll(cfg.UserFilter, emailPlaceholder, "x")); err != nil {
+		return nil, fmt.Errorf("ldap: invalid user filter: %w", err)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.TLSConfig == nil {
+		cfg.TLSConfig = &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12, InsecureSkipVerify: cfg.InsecureSkipVerify}
+	}
+	return &Client{cfg: cfg}, nil
+}
+
+// Authenticate 查找邮箱对应的用户并用密码绑定。用户不存在返回 ErrUserNotFound，
+// 密码错误返回 ErrInvalidCredentials，匹配到多个用户或目录服务器出错时返回其他错误。
+// 空密码在 LDAP 中是未认证绑定，总会成功，因此直接拒绝
+func (c *Client) Authenticate(ctx context.Context, email, password string) (*Entry, error) {
+	if email == "" {
+		return nil, ErrUserNotFound
+	}
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+	conn, closeConn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	if c.cfg.BindDN != "" {
+		if err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: bind service account: %w", err)
+		}
+	}
+	entry, err := c.search(conn, email)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: bind user: %w", err)
+	}
+	return entry, nil
+}
+
+// dial 建立连接，ctx 取消时关闭连接以中断进行中的请求。使用完毕后调用返回的 closeConn
+func (c *Client) dial(ctx context.Context) (conn *goldap.Conn, closeConn func(), err error) {
+	conn, err = goldap.DialURL(c.cfg.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: c.cfg.Timeout}),
+		goldap.DialWithTLSConfig(c.cfg.TLSConfig),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldap: connect: %w", err)
+	}
+	conn.SetTimeout(c.cfg.Timeout)
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	closeConn = func() {
+		stop()
+		conn.Close()
+	}
+	if c.cfg.StartTLS {
+		if err := conn.StartTLS(c.cfg.TLSConfig); err != nil {
+			closeConn()
+			return nil, nil, fmt.Errorf("ldap: start tls: %w", err)
+		}
+	}
+	return conn, closeConn, nil
+}
+
+func (c *Client) search(conn *goldap.Conn, email string) (*Entry, error) {
+	filter := strings.ReplaceAll(c.cfg.UserFilter, emailPlaceholder, goldap.EscapeFilter(email))
+	// 只需要判断是否唯一，最多取两条
+	req := goldap.NewSearchRequest(
+		c.cfg.BaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, int(c.cfg.Timeout/time.Second), false,
+		filter, c.cfg.Attributes, nil,
+	)
+	res, err := conn.Search(req)
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) || (err == nil && len(res.Entries) > 1) {
+		return nil, fmt.Errorf("ldap: multiple entries match %s", filter)
+	}
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ldap: search user: %w", err)
+	}
+	if len(res.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	e := res.Entries[0]
+	entry := &Entry{DN: e.DN, attributes: make(map[string][][]byte, len(e.Attributes))}
+	for _, attr := range e.Attributes {
+		name := strings.ToLower(attr.Name)
+		entry.attributes[name] = append(entry.attributes[name], attr.ByteValues...)
+	}
+	return entry, nil
+}
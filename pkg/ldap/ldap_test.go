@@ -0,0 +1,151 @@
+package ldap_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/pkg/ldap"
+	"github.com/ToAtlas/AtlasBackend/pkg/ldap/ldaptest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	baseDN    = "ou=people,dc=example,dc=com"
+	serviceDN = "cn=svc,dc=example,dc=com"
+	aliceDN   = "uid=alice,ou=people,dc=example,dc=com"
+)
+
+func newServer(t *testing.T) *ldaptest.Server {
+	t.Helper()
+	srv := ldaptest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddEntry(ldaptest.Entry{DN: "dc=example,dc=com"})
+	srv.AddEntry(ldaptest.Entry{DN: serviceDN, Password: "svc-secret"})
+	srv.AddEntry(ldaptest.Entry{DN: baseDN})
+	srv.AddEntry(ldaptest.Entry{
+		DN:       aliceDN,
+		Password: "alice-secret",
+		Attributes: map[string][]string{
+			"objectClass": {"inetOrgPerson"},
+			"uid":         {"alice"},
+			"mail":        {"alice@example.com"},
+			"memberOf":    {"cn=admins,ou=groups,dc=example,dc=com", "cn=staff,ou=groups,dc=example,dc=com"},
+		},
+	})
+	return srv
+}
+
+func newClient(t *testing.T, srv *ldaptest.Server, mutate ...func(*ldap.Config)) *ldap.Client {
+	t.Helper()
+	cfg := ldap.Config{
+		URL:          srv.URL,
+		BindDN:       serviceDN,
+		BindPassword: "svc-secret",
+		BaseDN:       baseDN,
+		Timeout:      5 * time.Second,
+	}
+	for _, m := range mutate {
+		m(&cfg)
+	}
+	c, err := ldap.NewClient(cfg)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_Authenticate(t *testing.T) {
+	srv := newServer(t)
+	c := newClient(t, srv)
+
+	entry, err := c.Authenticate(context.Background(), "Alice@Example.com", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, aliceDN, entry.DN)
+	assert.Equal(t, "alice", entry.Get("UID"))
+	assert.Equal(t, "alice@example.com", entry.Get("mail"))
+	assert.Len(t, entry.Values("memberof"), 2)
+	assert.Empty(t, entry.Get("missing"))
+	assert.Equal(t, []string{serviceDN, aliceDN}, srv.Binds())
+}
+
+func TestClient_AuthenticateFailures(t *testing.T) {
+	srv := newServer(t)
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	_, err := c.Authenticate(ctx, "alice@example.com", "wrong")
+	assert.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	_, err = c.Authenticate(ctx, "bob@example.com", "alice-secret")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+
+	// 空密码是未认证绑定，服务器会返回成功，必须在客户端拒绝
+	_, err = c.Authenticate(ctx, "alice@example.com", "")
+	assert.ErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	// 邮箱中的过滤条件元字符需要转义，不能匹配到任意用户
+	_, err = c.Authenticate(ctx, "*", "alice-secret")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+	_, err = c.Authenticate(ctx, "x)(uid=alice", "alice-secret")
+	assert.ErrorIs(t, err, ldap.ErrUserNotFound)
+}
+
+func TestClient_ServiceAccount(t *testing.T) {
+	srv := newServer(t)
+
+	// 服务账号密码错误不能当作用户密码错误
+	c := newClient(t, srv, func(cfg *ldap.Config) { cfg.BindPassword = "wrong" })
+	_, err := c.Authenticate(context.Background(), "alice@example.com", "alice-secret")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ldap.ErrInvalidCredentials)
+
+	// 未配置服务账号时匿名查找
+	c = newClient(t, srv, func(cfg *ldap.Config) { cfg.BindDN, cfg.BindPassword = "", "" })
+	_, err = c.Authenticate(context.Background(), "alice@example.com", "alice-secret")
+	assert.Error(t, err)
+	srv.AllowAnonymousSearch = true
+	_, err = c.Authenticate(context.Background(), "alice@example.com", "alice-secret")
+	assert.NoError(t, err)
+}
+
+func TestClient_AmbiguousUser(t *testing.T) {
+	srv := newServer(t)
+	srv.AddEntry(ldaptest.Entry{
+		DN:         "uid=alice2,ou=people,dc=example,dc=com",
+		Password:   "alice-secret",
+		Attributes: map[string][]string{"mail": {"alice@example.com"}},
+	})
+	c := newClient(t, srv)
+
+	_, err := c.Authenticate(context.Background(), "alice@example.com", "alice-secret")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ldap.ErrUserNotFound)
+	assert.NotContains(t, srv.Binds(), aliceDN)
+}
+
+func TestClient_UserFilter(t *testing.T) {
+	srv := newServer(t)
+	c := newClient(t, srv, func(cfg *ldap.Config) {
+		cfg.UserFilter = "(&(objectClass=inetOrgPerson)(|(mail={email})(uid={email})))"
+	})
+	entry, err := c.Authenticate(context.Background(), "alice", "alice-secret")
+	require.NoError(t, err)
+	assert.Equal(t, aliceDN, entry.DN)
+}
+
+func TestNewClient_InvalidConfig(t *testing.T) {
+	for name, cfg := range map[string]ldap.Config{
+		"missing url":        {BaseDN: baseDN},
+		"unsupported scheme": {URL: "http://localhost", BaseDN: baseDN},
+		"missing base dn":    {URL: "ldap://localhost"},
+		"starttls on ldaps":  {URL: "ldaps://localhost", BaseDN: baseDN, StartTLS: true},
+		"missing email":      {URL: "ldap://localhost", BaseDN: baseDN, UserFilter: "(uid=alice)"},
+		"invalid filter":     {URL: "ldap://localhost", BaseDN: baseDN, UserFilter: "(mail={email}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ldap.NewClient(cfg)
+			assert.Error(t, err)
+		})
+	}
+}
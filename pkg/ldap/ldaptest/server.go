@@ -0,0 +1,408 @@
+// Package ldaptest 提供用于测试的进程内 LDAP 服务器。只实现简单绑定和搜索，
+// 过滤条件支持 and、or、not、等值匹配和存在判断，属性名和值都不区分大小写。
+package ldaptest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+)
+
+// LDAP 协议操作的应用标签（RFC 4511 第 4.2 节起）
+const (
+	appBindRequest      ber.Tag = 0
+	appBindResponse     ber.Tag = 1
+	appUnbindRequest    ber.Tag = 2
+	appSearchRequest    ber.Tag = 3
+	appSearchResultItem ber.Tag = 4
+	appSearchResultDone ber.Tag = 5
+	appExtendedRequest  ber.Tag = 23
+	appExtendedResponse ber.Tag = 24
+)
+
+// 过滤条件的上下文标签
+const (
+	filterAnd      ber.Tag = 0
+	filterOr       ber.Tag = 1
+	filterNot      ber.Tag = 2
+	filterEquality ber.Tag = 3
+	filterPresent  ber.Tag = 7
+)
+
+// 用到的结果码
+const (
+	resultSuccess            = 0
+	resultProtocolError      = 2
+	resultSizeLimitExceeded  = 4
+	resultInappropriateMatch = 18
+	resultNoSuchObject       = 32
+	resultInvalidCredentials = 49
+	resultInsufficientAccess = 50
+	resultUnwillingToPerform = 53
+)
+
+// Entry 目录中的条目，Password 不为空时可以用 DN 和该密码绑定
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+func (e *Entry) values(name string) []string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// Server 进程内 LDAP 服务器，监听 127.0.0.1 的随机端口
+type Server struct {
+	// URL 形如 ldap://127.0.0.1:port
+	URL string
+	// AllowAnonymousSearch 为 false 时未绑定的连接不能搜索
+	AllowAnonymousSearch bool
+
+	ln net.Listener
+	wg sync.WaitGroup
+
+	mu      sync.Mutex
+	entries []*Entry
+	binds   []string // 成功绑定的 DN，按时间顺序
+	conns   map[net.Conn]struct{}
+	closed  bool
+}
+
+// NewServer 启动服务器，使用完毕后调用 Close
+func NewServer() *Server {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	s := &Server{
+		URL:   "ldap://" + ln.Addr().String(),
+		ln:    ln,
+		conns: map[net.Conn]struct{}{},
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// AddEntry 添加条目
+func (s *Server) AddEntry(e Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.Attributes = maps.Clone(e.Attributes)
+	s.entries = append(s.entries, &e)
+}
+
+// SetPassword 修改条目的密码
+func (s *Server) SetPassword(dn, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) {
+			e.Password = password
+		}
+	}
+}
+
+// SetAttribute 修改条目的属性，values 为空时删除该属性
+func (s *Server) SetAttribute(dn, name string, values ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if !strings.EqualFold(e.DN, dn) {
+			continue
+		}
+		attrs := make(map[string][]string, len(e.Attributes)+1)
+		for k, v := range e.Attributes {
+			if !strings.EqualFold(k, name) {
+				attrs[k] = v
+			}
+		}
+		if len(values) > 0 {
+			attrs[name] = values
+		}
+		e.Attributes = attrs
+	}
+}
+
+// DeleteEntry 删除条目
+func (s *Server) DeleteEntry(dn string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = slices.DeleteFunc(s.entries, func(e *Entry) bool { return strings.EqualFold(e.DN, dn) })
+}
+
+// Binds 返回成功绑定过的 DN
+func (s *Server) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+// Close 关闭监听和全部连接
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.ln.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+			conn.Close()
+		}()
+	}
+}
+
+// handle 按顺序处理一个连接上的请求，boundDN 为当前绑定的身份
+func (s *Server) handle(conn net.Conn) {
+	var boundDN string
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+		msgID, _ := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+		switch op.Tag {
+		case appBindRequest:
+			code, dn := s.bind(op)
+			if code == resultSuccess {
+				boundDN = dn
+			} else {
+				boundDN = ""
+			}
+			err = writeResult(conn, msgID, appBindResponse, code, "")
+		case appUnbindRequest:
+			return
+		case appSearchRequest:
+			err = s.search(conn, msgID, op, boundDN)
+		case appExtendedRequest:
+			// 不支持 StartTLS 等扩展操作
+			err = writeResult(conn, msgID, appExtendedResponse, resultProtocolError, "extended operations are not supported")
+		default:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// bind 处理简单绑定，返回结果码和绑定的 DN。空密码是未认证绑定，按 RFC 4513 第 5.1.2 节视为匿名
+func (s *Server) bind(op *ber.Packet) (int, string) {
+	if len(op.Children) < 3 || op.Children[2].Tag != 0 {
+		return resultUnwillingToPerform, ""
+	}
+	dn := op.Children[1].Data.String()
+	password := op.Children[2].Data.String()
+	if password == "" {
+		return resultSuccess, ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			s.binds = append(s.binds, e.DN)
+			return resultSuccess, e.DN
+		}
+	}
+	return resultInvalidCredentials, ""
+}
+
+func (s *Server) search(w io.Writer, msgID int64, op *ber.Packet, boundDN string) error {
+	if len(op.Children) < 8 {
+		return writeResult(w, msgID, appSearchResultDone, resultProtocolError, "malformed search request")
+	}
+	if boundDN == "" && !s.AllowAnonymousSearch {
+		return writeResult(w, msgID, appSearchResultDone, resultInsufficientAccess, "anonymous search is not allowed")
+	}
+	baseDN := strings.ToLower(op.Children[0].Data.String())
+	sizeLimit, _ := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	var attrs []string
+	for _, a := range op.Children[7].Children {
+		attrs = append(attrs, a.Data.String())
+	}
+
+	// 复制匹配的条目，SetAttribute 替换而不修改属性表，解锁后可以安全读取
+	s.mu.Lock()
+	var matched []Entry
+	baseExists := false
+	var matchErr error
+	for _, e := range s.entries {
+		dn := strings.ToLower(e.DN)
+		if dn == baseDN {
+			baseExists = true
+		}
+		if dn != baseDN && !strings.HasSuffix(dn, ","+baseDN) {
+			continue
+		}
+		ok, err := match(e, filter)
+		if err != nil {
+			matchErr = err
+			break
+		}
+		if ok {
+			matched = append(matched, *e)
+		}
+	}
+	s.mu.Unlock()
+
+	if matchErr != nil {
+		return writeResult(w, msgID, appSearchResultDone, resultInappropriateMatch, matchErr.Error())
+	}
+	if !baseExists && len(matched) == 0 {
+		return writeResult(w, msgID, appSearchResultDone, resultNoSuchObject, "")
+	}
+	code := resultSuccess
+	if sizeLimit > 0 && int64(len(matched)) > sizeLimit {
+		matched = matched[:sizeLimit]
+		code = resultSizeLimitExceeded
+	}
+	for i := range matched {
+		if err := writeEntry(w, msgID, &matched[i], attrs); err != nil {
+			return err
+		}
+	}
+	return writeResult(w, msgID, appSearchResultDone, code, "")
+}
+
+// match 判断条目是否满足过滤条件
+func match(e *Entry, f *ber.Packet) (bool, error) {
+	if f.ClassType != ber.ClassContext {
+		return false, errors.New("invalid filter")
+	}
+	switch f.Tag {
+	case filterAnd:
+		for _, c := range f.Children {
+			if ok, err := match(e, c); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case filterOr:
+		for _, c := range f.Children {
+			if ok, err := match(e, c); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case filterNot:
+		if len(f.Children) != 1 {
+			return false, errors.New("invalid not filter")
+		}
+		ok, err := match(e, f.Children[0])
+		return !ok, err
+	case filterEquality:
+		if len(f.Children) != 2 {
+			return false, errors.New("invalid equality filter")
+		}
+		name, value := f.Children[0].Data.String(), f.Children[1].Data.String()
+		for _, v := range e.values(name) {
+			if strings.EqualFold(v, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case filterPresent:
+		name := f.Data.String()
+		return strings.EqualFold(name, "objectClass") || len(e.values(name)) > 0, nil
+	default:
+		return false, fmt.Errorf("filter type %d is not supported", f.Tag)
+	}
+}
+
+func envelope(msgID int64) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, "Message ID"))
+	return p
+}
+
+func writeResult(w io.Writer, msgID int64, tag ber.Tag, code int, message string) error {
+	p := envelope(msgID)
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, "Diagnostic Message"))
+	p.AppendChild(res)
+	_, err := w.Write(p.Bytes())
+	return err
+}
+
+// writeEntry 返回条目，attrs 为空时返回全部属性，不返回密码
+func writeEntry(w io.Writer, msgID int64, e *Entry, attrs []string) error {
+	p := envelope(msgID)
+	item := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultItem, nil, "Search Result Entry")
+	item.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range e.Attributes {
+		if len(attrs) > 0 && !containsFold(attrs, name) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		list.AppendChild(attr)
+	}
+	item.AppendChild(list)
+	p.AppendChild(item)
+	_, err := w.Write(p.Bytes())
+	return err
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoRecipient 邮件没有收件人
+	ErrNoRecipient = errors.New("mail: message has no recipient")
+	// ErrNoSender 邮件没有发件人
+	ErrNoSender = errors.New("mail: message has no sender")
+)
+
+// Message 是一封待发送的邮件，HTML 与 Text 至少需要一个。
+type Message struct {
+	ID      string            `json:"id"`
+	From    string            `json:"from,omitempty"`
+	To      []string          `json:"to"`
+	Subject string            `json:"subject"`
+	Text    string            `json:"text,omitempty"`
+	HTML    string            `json:"html,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Sender 负责把邮件真正投递出去（SMTP、文件、内存等）。
+// 实现必须是并发安全的，队列会在多个 worker 中同时调用 Send。
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Validate 检查邮件的基本字段
+func (m *Message) Validate() error {
+	if len(m.To) == 0 {
+		return ErrNoRecipient
+	}
+	for _, addr := range m.To {
+		if _, err := mail.ParseAddress(addr); err != nil {
+			return fmt.Errorf("mail: invalid recipient %q: %w", addr, err)
+		}
+	}
+	if m.HTML == "" && m.Text == "" {
+		return errors.New("mail: message has empty body")
+	}
+	return nil
+}
+
+// Bytes 将邮件编码为 RFC 5322 格式，HTML 与 Text 同时存在时使用 multipart/alternative。
+func (m *Message) Bytes() ([]byte, error) {
+	if m.From == "" {
+		return nil, ErrNoSender
+	}
+
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+	header.Set("From", m.From)
+	header.Set("To", strings.Join(m.To, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("MIME-Version", "1.0")
+	if m.ID != "" {
+		header.Set("Message-ID", "<"+m.ID+"@"+domainOf(m.From)+">")
+	}
+	for k, v := range m.Headers {
+		header.Set(k, v)
+	}
+
+	switch {
+	case m.HTML != "" && m.Text != "":
+		w := multipart.NewWriter(&buf)
+		header.Set("Content-Type", "multipart/alternative; boundary="+w.Boundary())
+		writeHeader(&buf, header)
+		if err := writePart(w, "text/plain; charset=utf-8", m.Text); err != nil {
+			return nil, err
+		}
+		if err := writePart(w, "text/html; charset=utf-8", m.HTML); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case m.HTML != "":
+		header.Set("Content-Type", "text/html; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQP(&buf, m.HTML); err != nil {
+			return nil, err
+		}
+	default:
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQP(&buf, m.Text); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// writeHeader 按键名排序写入邮件头，保证输出稳定
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+func writePart(w *multipart.Writer, contentType, body string) error {
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeQP(buf *bytes.Buffer, body string) error {
+	qp := quotedprintable.NewWriter(buf)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// domainOf 取出地址中的域名部分，用于生成 Message-ID
+func domainOf(from string) string {
+	if addr, err := mail.ParseAddress(from); err == nil {
+		from = addr.Address
+	}
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		return from[i+1:]
+	}
+	return "localhost"
+}
+
+// newID 生成随机的邮件 ID
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
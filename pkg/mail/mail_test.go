@@ -0,0 +1,299 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/pkg/logger"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLogger() log.Logger {
+	return logger.NewLogger(&logger.Config{Env: "test", Level: 1})
+}
+
+func TestRenderer_BuiltinLocales(t *testing.T) {
+	r, err := NewRenderer(nil, "")
+	require.NoError(t, err)
+
+	data := map[string]any{"AppName": "Krathub", "Name": "alice", "Link": "https://example.com/v?t=1&x=<2>", "ExpiresIn": "24h"}
+
+	en, err := r.Render(KindVerifyEmail, "en-US", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Verify your Krathub email address", en.Subject)
+	assert.Contains(t, en.Text, "https://example.com/v?t=1&x=<2>")
+	// HTML 模板必须转义
+	assert.Contains(t, en.HTML, "https://example.com/v?t=1&amp;x=%3c2%3e")
+
+	zh, err := r.Render(KindVerifyEmail, "zh_CN", data)
+	require.NoError(t, err)
+	assert.Equal(t, "验证您的 Krathub 邮箱地址", zh.Subject)
+
+	// 没有对应语言时回退到默认语言
+	fr, err := r.Render(KindWelcome, "fr-FR", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Welcome to Krathub", fr.Subject)
+
+	_, err = r.Render("unknown", "en", data)
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestRenderer_Digest(t *testing.T) {
+	r, err := NewRenderer(nil, "")
+	require.NoError(t, err)
+
+	data := map[string]any{
+		"AppName": "Krathub",
+		"Name":    "alice",
+		"Items": []map[string]any{
+			{"Event": "user.login", "Time": "2024-01-01T10:00:00Z"},
+			{"Event": "user.updated", "Time": "2024-01-01T10:30:00Z"},
+		},
+	}
+	en, err := r.Render(KindDigest, "en", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Your Krathub digest: 2 new notification(s)", en.Subject)
+	assert.Contains(t, en.Text, "- user.login (2024-01-01T10:00:00Z)")
+	assert.Contains(t, en.HTML, "<li>user.updated")
+
+	zh, err := r.Render(KindDigest, "zh-CN", data)
+	require.NoError(t, err)
+	assert.Equal(t, "Krathub 通知汇总：2 条新通知", zh.Subject)
+}
+
+func TestRenderer_CustomFSWithoutHTML(t *testing.T) {
+	fsys := fstest.MapFS{
+		"zh/notice.txt.tmpl": {Data: []byte(`{{define "subject"}}通知 {{.N}}{{end}}正文 {{.N}}`)},
+	}
+	r, err := NewRenderer(fsys, "zh")
+	require.NoError(t, err)
+
+	c, err := r.Render("notice", "zh-CN", map[string]int{"N": 1})
+	require.NoError(t, err)
+	assert.Equal(t, "通知 1", c.Subject)
+	assert.Equal(t, "正文 1\n", c.Text)
+	assert.Empty(t, c.HTML)
+}
+
+func TestMessage_Bytes(t *testing.T) {
+	msg := &Message{
+		ID:      "abc",
+		From:    "Krathub <no-reply@example.com>",
+		To:      []string{"alice@example.com"},
+		Subject: "你好",
+		Text:    "plain",
+		HTML:    "<p>html</p>",
+	}
+	require.NoError(t, msg.Validate())
+	raw, err := msg.Bytes()
+	require.NoError(t, err)
+
+	s := string(raw)
+	assert.Contains(t, s, "Content-Type: multipart/alternative; boundary=")
+	assert.Contains(t, s, "Message-Id: <abc@example.com>")
+	assert.Contains(t, s, "Subject: =?utf-8?q?")
+	assert.Contains(t, s, "text/plain; charset=utf-8")
+	assert.Contains(t, s, "text/html; charset=utf-8")
+
+	assert.ErrorIs(t, (&Message{Text: "x"}).Validate(), ErrNoRecipient)
+	assert.Error(t, (&Message{To: []string{"not-an-address"}, Text: "x"}).Validate())
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir, "no-reply@example.com")
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), &Message{To: []string{"bob@example.com"}, Subject: "hi", Text: "hello"}))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "To: bob@example.com")
+}
+
+// flakySender 前 failures 次发送失败，之后转发给 MemorySink
+type flakySender struct {
+	failures int32
+	calls    atomic.Int32
+	sink     *MemorySink
+}
+
+func (f *flakySender) Send(ctx context.Context, msg *Message) error {
+	if f.calls.Add(1) <= f.failures {
+		return errors.New("smtp unavailable")
+	}
+	return f.sink.Send(ctx, msg)
+}
+
+func newTestQueue(sender Sender, store Store, maxAttempts int) *Queue {
+	return NewQueue(QueueConfig{
+		Workers:      2,
+		MaxAttempts:  maxAttempts,
+		RetryBackoff: 10 * time.Millisecond,
+		PollInterval: 5 * time.Millisecond,
+	}, store, sender, testLogger())
+}
+
+func TestQueue_RetriesUntilDelivered(t *testing.T) {
+	sender := &flakySender{failures: 2, sink: NewMemorySink("no-reply@example.com")}
+	q := newTestQueue(sender, NewMemoryStore(), 5)
+	require.NoError(t, q.Start(context.Background()))
+	defer q.Stop(context.Background())
+
+	require.NoError(t, q.Enqueue(context.Background(), &Message{To: []string{"alice@example.com"}, Subject: "s", Text: "t"}))
+
+	assert.Eventually(t, func() bool { return len(sender.sink.Messages()) == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(3), sender.calls.Load())
+}
+
+func TestQueue_BuriesAfterMaxAttempts(t *testing.T) {
+	sender := &flakySender{failures: 100, sink: NewMemorySink("")}
+	store := NewMemoryStore()
+	q := newTestQueue(sender, store, 3)
+	require.NoError(t, q.Start(context.Background()))
+	defer q.Stop(context.Background())
+
+	require.NoError(t, q.Enqueue(context.Background(), &Message{To: []string{"alice@example.com"}, Subject: "s", Text: "t"}))
+
+	assert.Eventually(t, func() bool { return len(store.Dead()) == 1 }, 2*time.Second, 5*time.Millisecond)
+	dead := store.Dead()[0]
+	assert.Equal(t, 3, dead.Attempts)
+	assert.Equal(t, "smtp unavailable", dead.LastError)
+}
+
+func TestQueue_EnqueueDoesNotBlockOnSlowSender(t *testing.T) {
+	release := make(chan struct{})
+	slow := senderFunc(func(ctx context.Context, msg *Message) error {
+		<-release
+		return nil
+	})
+	q := newTestQueue(slow, NewMemoryStore(), 1)
+	require.NoError(t, q.Start(context.Background()))
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, q.Enqueue(context.Background(), &Message{To: []string{"alice@example.com"}, Text: "t"}))
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	close(release)
+	require.NoError(t, q.Stop(context.Background()))
+	assert.ErrorIs(t, q.Enqueue(context.Background(), &Message{To: []string{"alice@example.com"}, Text: "t"}), ErrQueueStopped)
+}
+
+type senderFunc func(ctx context.Context, msg *Message) error
+
+func (f senderFunc) Send(ctx context.Context, msg *Message) error { return f(ctx, msg) }
+
+func TestSMTPSender(t *testing.T) {
+	srv := startFakeSMTP(t)
+
+	host, port, _ := net.SplitHostPort(srv.addr)
+	p, _ := strconv.Atoi(port)
+	sender := NewSMTPSender(&SMTPConfig{Host: host, Port: p, From: "Krathub <no-reply@example.com>", Timeout: time.Second})
+
+	err := sender.Send(context.Background(), &Message{To: []string{"alice@example.com"}, Subject: "hello", Text: "body"})
+	require.NoError(t, err)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	assert.Equal(t, "<no-reply@example.com>", srv.from)
+	assert.Equal(t, []string{"<alice@example.com>"}, srv.rcpt)
+	assert.Contains(t, srv.data, "Subject: hello")
+}
+
+// fakeSMTP 是一个只实现最小命令集的 SMTP 服务器，用于测试 SMTPSender
+type fakeSMTP struct {
+	addr string
+
+	mu   sync.Mutex
+	from string
+	rcpt []string
+	data string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	srv := &fakeSMTP{addr: ln.Addr().String()}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+	return srv
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	write := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	write("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		upper := strings.ToUpper(cmd)
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			write("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			s.mu.Lock()
+			s.from = cmd[len("MAIL FROM:"):]
+			s.mu.Unlock()
+			write("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, cmd[len("RCPT TO:"):])
+			s.mu.Unlock()
+			write("250 OK")
+		case upper == "DATA":
+			write("354 End data with <CR><LF>.<CR><LF>")
+			var b strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				b.WriteString(l)
+			}
+			s.mu.Lock()
+			s.data = b.String()
+			s.mu.Unlock()
+			write("250 OK")
+		case upper == "QUIT":
+			write("221 Bye")
+			return
+		default:
+			write("250 OK")
+		}
+	}
+}
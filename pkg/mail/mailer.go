@@ -0,0 +1,34 @@
+package mail
+
+import "context"
+
+// Mailer 组合模板渲染与发送队列，业务层通过它发送通知邮件
+type Mailer struct {
+	renderer *Renderer
+	queue    *Queue
+}
+
+// NewMailer 创建 Mailer
+func NewMailer(renderer *Renderer, queue *Queue) *Mailer {
+	return &Mailer{renderer: renderer, queue: queue}
+}
+
+// Notify 按通知类型和语言渲染邮件并放入发送队列，data 会传给模板
+func (m *Mailer) Notify(ctx context.Context, to, kind, locale string, data any) error {
+	content, err := m.renderer.Render(kind, locale, data)
+	if err != nil {
+		return err
+	}
+	return m.queue.Enqueue(ctx, &Message{
+		To:      []string{to},
+		Subject: content.Subject,
+		Text:    content.Text,
+		HTML:    content.HTML,
+		Headers: map[string]string{"X-Notification-Kind": kind},
+	})
+}
+
+// Enqueue 直接发送已构造好的邮件
+func (m *Mailer) Enqueue(ctx context.Context, msg *Message) error {
+	return m.queue.Enqueue(ctx, msg)
+}
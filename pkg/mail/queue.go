@@ -0,0 +1,249 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pkglogger "github.com/ToAtlas/AtlasBackend/pkg/logger"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	DefaultWorkers      = 2
+	DefaultMaxAttempts  = 5
+	DefaultRetryBackoff = 10 * time.Second
+	DefaultMaxBackoff   = 30 * time.Minute
+	DefaultPollInterval = time.Second
+)
+
+// ErrQueueStopped 队列已停止，不再接受新邮件
+var ErrQueueStopped = errors.New("mail: queue stopped")
+
+// Job 是队列中的一次投递任务
+type Job struct {
+	Message   *Message  `json:"message"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// raw 是 Store 取出任务时的原始编码，用于 Ack/Retry 时定位任务
+	raw string
+}
+
+// Store 是队列的持久化后端。
+// Pop 取出的任务在 Ack、Retry 或 Bury 之前都处于"处理中"状态，
+// 进程崩溃后可以通过 Recover 重新放回队列，保证至少投递一次。
+type Store interface {
+	// Push 追加任务
+	Push(ctx context.Context, job *Job) error
+	// Pop 阻塞取出一个任务，超时后返回 nil, nil
+	Pop(ctx context.Context, timeout time.Duration) (*Job, error)
+	// Ack 标记任务完成
+	Ack(ctx context.Context, job *Job) error
+	// Retry 将任务延迟到 at 之后重新投递
+	Retry(ctx context.Context, job *Job, at time.Time) error
+	// Bury 将多次失败的任务移入死信队列
+	Bury(ctx context.Context, job *Job) error
+	// Promote 把已到期的延迟任务放回队列
+	Promote(ctx context.Context, now time.Time) error
+	// Recover 把上次进程退出时遗留的处理中任务放回队列
+	Recover(ctx context.Context) error
+}
+
+// QueueConfig 队列配置
+type QueueConfig struct {
+	Workers      int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+}
+
+// Queue 是异步邮件发送队列。
+// 请求处理方只需要 Enqueue，真正的发送在后台 worker 中完成，
+// 失败时按指数退避重试，超过最大次数后进入死信队列。
+// Queue 实现了 kratos 的 transport.Server，可以直接交给 kratos.App 管理生命周期。
+type Queue struct {
+	cfg    QueueConfig
+	store  Store
+	sender Sender
+	log    *log.Helper
+
+	mu      sync.Mutex
+	running bool
+	stopped bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewQueue 创建邮件队列
+func NewQueue(cfg QueueConfig, store Store, sender Sender, logger log.Logger) *Queue {
+	if cfg.Workers <= 0 {
+		cfg.Workers = DefaultWorkers
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultRetryBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	return &Queue{
+		cfg:    cfg,
+		store:  store,
+		sender: sender,
+		log:    log.NewHelper(pkglogger.WithModule(logger, "mail/pkg/krathub-service")),
+	}
+}
+
+// Enqueue 把邮件放入队列，不会等待实际发送
+func (q *Queue) Enqueue(ctx context.Context, msg *Message) error {
+	q.mu.Lock()
+	stopped := q.stopped
+	q.mu.Unlock()
+	if stopped {
+		return ErrQueueStopped
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	if msg.ID == "" {
+		msg.ID = newID()
+	}
+	return q.store.Push(ctx, &Job{Message: msg, CreatedAt: time.Now()})
+}
+
+// Start 启动后台 worker
+func (q *Queue) Start(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.running {
+		return nil
+	}
+	if err := q.store.Recover(ctx); err != nil {
+		q.log.Warnf("recover in-flight mail jobs failed: %v", err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.running = true
+	q.stopped = false
+
+	q.wg.Add(q.cfg.Workers + 1)
+	go q.schedule(runCtx)
+	for i := 0; i < q.cfg.Workers; i++ {
+		go q.work(runCtx)
+	}
+	q.log.Infof("mail queue started with %d workers", q.cfg.Workers)
+	return nil
+}
+
+// Stop 停止接收新邮件并等待正在发送的邮件完成
+func (q *Queue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	q.stopped = true
+	if !q.running {
+		q.mu.Unlock()
+		return nil
+	}
+	q.running = false
+	q.cancel()
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		q.log.Info("mail queue stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// schedule 定期把到期的重试任务放回队列
+func (q *Queue) schedule(ctx context.Context) {
+	defer q.wg.Done()
+	ticker := time.NewTicker(q.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := q.store.Promote(ctx, now); err != nil && ctx.Err() == nil {
+				q.log.Warnf("promote delayed mail jobs failed: %v", err)
+			}
+		}
+	}
+}
+
+func (q *Queue) work(ctx context.Context) {
+	defer q.wg.Done()
+	for ctx.Err() == nil {
+		job, err := q.store.Pop(ctx, q.cfg.PollInterval)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			q.log.Warnf("pop mail job failed: %v", err)
+			time.Sleep(q.cfg.PollInterval)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+		q.handle(job)
+	}
+}
+
+// handle 发送单个任务；使用独立的 context，保证 Stop 时正在发送的邮件可以完成并落盘状态
+func (q *Queue) handle(job *Job) {
+	ctx := context.Background()
+	err := q.sender.Send(ctx, job.Message)
+	if err == nil {
+		if err := q.store.Ack(ctx, job); err != nil {
+			q.log.Warnf("ack mail job %s failed: %v", job.Message.ID, err)
+		}
+		return
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+	if job.Attempts >= q.cfg.MaxAttempts {
+		q.log.Errorf("mail %s to %v dropped after %d attempts: %v", job.Message.ID, job.Message.To, job.Attempts, err)
+		if err := q.store.Bury(ctx, job); err != nil {
+			q.log.Warnf("bury mail job %s failed: %v", job.Message.ID, err)
+		}
+		return
+	}
+
+	delay := q.backoff(job.Attempts)
+	q.log.Warnf("mail %s to %v failed (attempt %d), retry in %s: %v", job.Message.ID, job.Message.To, job.Attempts, delay, err)
+	if err := q.store.Retry(ctx, job, time.Now().Add(delay)); err != nil {
+		q.log.Warnf("schedule retry for mail job %s failed: %v", job.Message.ID, err)
+	}
+}
+
+// backoff 第 n 次失败后的等待时间：RetryBackoff * 2^(n-1)，不超过 MaxBackoff
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.cfg.RetryBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= q.cfg.MaxBackoff {
+			return q.cfg.MaxBackoff
+		}
+	}
+	return d
+}
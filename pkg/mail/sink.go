@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileSink 把邮件写成 .eml 文件，开发环境下可以直接用邮件客户端打开查看
+type FileSink struct {
+	dir  string
+	from string
+}
+
+// NewFileSink 创建文件 sink，目录不存在时自动创建
+func NewFileSink(dir, from string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("mail: create sink dir: %w", err)
+	}
+	return &FileSink{dir: dir, from: from}, nil
+}
+
+// Send 写入 <dir>/<时间>-<ID>.eml
+func (s *FileSink) Send(_ context.Context, msg *Message) error {
+	if msg.From == "" {
+		msg.From = s.from
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	if msg.ID == "" {
+		msg.ID = newID()
+	}
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405.000"), msg.ID)
+	return os.WriteFile(filepath.Join(s.dir, name), body, 0o644)
+}
+
+// MemorySink 在内存中保存所有发出的邮件，供测试断言使用
+type MemorySink struct {
+	mu       sync.Mutex
+	from     string
+	messages []*Message
+}
+
+// NewMemorySink 创建内存 sink
+func NewMemorySink(from string) *MemorySink {
+	return &MemorySink{from: from}
+}
+
+// Send 保存邮件副本
+func (s *MemorySink) Send(_ context.Context, msg *Message) error {
+	if msg.From == "" {
+		msg.From = s.from
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	cp := *msg
+	s.mu.Lock()
+	s.messages = append(s.messages, &cp)
+	s.mu.Unlock()
+	return nil
+}
+
+// Messages 返回已发送邮件的快照
+func (s *MemorySink) Messages() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*Message, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+// Reset 清空已保存的邮件
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	s.messages = nil
+	s.mu.Unlock()
+}
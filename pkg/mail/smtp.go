@@ -0,0 +1,118 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const DefaultSMTPTimeout = 10 * time.Second
+
+// SMTPConfig SMTP 发送配置
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// ImplicitTLS 为 true 时直接建立 TLS 连接（通常是 465 端口），
+	// 否则在服务器支持时使用 STARTTLS 升级。
+	ImplicitTLS bool
+	Timeout     time.Duration
+}
+
+// SMTPSender 通过 SMTP 服务器投递邮件
+type SMTPSender struct {
+	cfg *SMTPConfig
+}
+
+// NewSMTPSender 创建 SMTP 发送器
+func NewSMTPSender(cfg *SMTPConfig) *SMTPSender {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultSMTPTimeout
+	}
+	return &SMTPSender{cfg: cfg}
+}
+
+// Send 每次发送都会新建连接，避免长连接在服务器端被静默关闭
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	if msg.From == "" {
+		msg.From = s.cfg.From
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("mail: invalid sender %q: %w", msg.From, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("mail: dial %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	tlsConfig := &tls.Config{ServerName: s.cfg.Host}
+	if s.cfg.ImplicitTLS {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("mail: smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if !s.cfg.ImplicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("mail: starttls: %w", err)
+			}
+		}
+	}
+	if s.cfg.Username != "" {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+				return fmt.Errorf("mail: smtp auth: %w", err)
+			}
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("mail: smtp MAIL FROM: %w", err)
+	}
+	for _, rcpt := range msg.To {
+		to, _ := mail.ParseAddress(rcpt)
+		if err := c.Rcpt(to.Address); err != nil {
+			return fmt.Errorf("mail: smtp RCPT TO %s: %w", to.Address, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("mail: smtp DATA: %w", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		w.Close()
+		return fmt.Errorf("mail: smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mail: smtp DATA: %w", err)
+	}
+	return c.Quit()
+}
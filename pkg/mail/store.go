@@ -0,0 +1,240 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ToAtlas/AtlasBackend/pkg/redis"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const DefaultKeyPrefix = "mail"
+
+// RedisStore 基于 Redis 列表的持久化队列：
+//   - <prefix>:queue       待发送任务
+//   - <prefix>:processing  处理中任务（BLMOVE 原子转移，崩溃后可恢复）
+//   - <prefix>:retry       延迟重试任务，score 为可重试的 Unix 时间
+//   - <prefix>:dead        多次失败后的死信任务
+type RedisStore struct {
+	rdb        *redis.Client
+	queue      string
+	processing string
+	retry      string
+	dead       string
+}
+
+// NewRedisStore 创建 Redis 队列存储，prefix 为空时使用 "mail"
+func NewRedisStore(rdb *redis.Client, prefix string) *RedisStore {
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	return &RedisStore{
+		rdb:        rdb,
+		queue:      prefix + ":queue",
+		processing: prefix + ":processing",
+		retry:      prefix + ":retry",
+		dead:       prefix + ":dead",
+	}
+}
+
+func (s *RedisStore) Push(ctx context.Context, job *Job) error {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.rdb.LPush(ctx, s.queue, raw)
+}
+
+func (s *RedisStore) Pop(ctx context.Context, timeout time.Duration) (*Job, error) {
+	raw, err := s.rdb.BLMove(ctx, s.queue, s.processing, timeout)
+	if errors.Is(err, goredis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	job := &Job{raw: raw}
+	if err := json.Unmarshal([]byte(raw), job); err != nil {
+		// 无法解析的任务直接移入死信，避免反复阻塞队列
+		_ = s.rdb.LPush(ctx, s.dead, raw)
+		_, _ = s.rdb.LRem(ctx, s.processing, 1, raw)
+		return nil, err
+	}
+	return job, nil
+}
+
+func (s *RedisStore) Ack(ctx context.Context, job *Job) error {
+	_, err := s.rdb.LRem(ctx, s.processing, 1, job.raw)
+	return err
+}
+
+// Retry 先写入延迟集合再从处理中移除，中途失败最多导致重复投递而不会丢失
+func (s *RedisStore) Retry(ctx context.Context, job *Job, at time.Time) error {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	if err := s.rdb.ZAdd(ctx, s.retry, float64(at.Unix()), raw); err != nil {
+		return err
+	}
+	return s.Ack(ctx, job)
+}
+
+func (s *RedisStore) Bury(ctx context.Context, job *Job) error {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	if err := s.rdb.LPush(ctx, s.dead, raw); err != nil {
+		return err
+	}
+	return s.Ack(ctx, job)
+}
+
+// Promote 多实例并发执行时依赖 ZREM 的返回值保证同一任务只会被放回一次
+func (s *RedisStore) Promote(ctx context.Context, now time.Time) error {
+	due, err := s.rdb.ZRangeByScore(ctx, s.retry, "-inf", strconv.FormatInt(now.Unix(), 10), 100)
+	if err != nil {
+		return err
+	}
+	for _, raw := range due {
+		n, err := s.rdb.ZRem(ctx, s.retry, raw)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+		if err := s.rdb.LPush(ctx, s.queue, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Recover 在启动时把处理中列表整体放回队列。
+// 多实例部署时共享同一个 processing 列表，应只在单个实例上开启队列消费。
+func (s *RedisStore) Recover(ctx context.Context) error {
+	for {
+		_, err := s.rdb.LMove(ctx, s.processing, s.queue)
+		if errors.Is(err, goredis.Nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// MemoryStore 是进程内的队列存储，适用于开发和测试，进程退出后任务会丢失
+type MemoryStore struct {
+	mu      sync.Mutex
+	ready   []*Job
+	delayed []delayedJob
+	dead    []*Job
+	notify  chan struct{}
+}
+
+type delayedJob struct {
+	job *Job
+	at  time.Time
+}
+
+// NewMemoryStore 创建内存队列存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{notify: make(chan struct{}, 1)}
+}
+
+func (s *MemoryStore) Push(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	s.ready = append(s.ready, job)
+	s.mu.Unlock()
+	s.wake()
+	return nil
+}
+
+func (s *MemoryStore) Pop(ctx context.Context, timeout time.Duration) (*Job, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		s.mu.Lock()
+		if len(s.ready) > 0 {
+			job := s.ready[0]
+			s.ready = s.ready[1:]
+			more := len(s.ready) > 0
+			s.mu.Unlock()
+			if more {
+				s.wake()
+			}
+			return job, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.notify:
+		case <-timer.C:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (s *MemoryStore) Ack(context.Context, *Job) error { return nil }
+
+func (s *MemoryStore) Retry(_ context.Context, job *Job, at time.Time) error {
+	s.mu.Lock()
+	s.delayed = append(s.delayed, delayedJob{job: job, at: at})
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Bury(_ context.Context, job *Job) error {
+	s.mu.Lock()
+	s.dead = append(s.dead, job)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStore) Promote(_ context.Context, now time.Time) error {
+	s.mu.Lock()
+	remaining := s.delayed[:0]
+	promoted := false
+	for _, d := range s.delayed {
+		if d.at.After(now) {
+			remaining = append(remaining, d)
+			continue
+		}
+		s.ready = append(s.ready, d.job)
+		promoted = true
+	}
+	s.delayed = remaining
+	s.mu.Unlock()
+	if promoted {
+		s.wake()
+	}
+	return nil
+}
+
+func (s *MemoryStore) Recover(context.Context) error { return nil }
+
+// Dead 返回死信任务的快照
+func (s *MemoryStore) Dead() []*Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*Job, len(s.dead))
+	copy(out, s.dead)
+	return out
+}
+
+func (s *MemoryStore) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,176 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+)
+
+// 内置的通知类型，模板文件位于 templates/<locale>/<kind>.{txt,html}.tmpl
+const (
+	KindWelcome         = "welcome"
+	KindVerifyEmail     = "verify_email"
+	KindPasswordReset   = "password_reset"
+	KindSecurityAlert   = "security_alert"
+	KindNotification    = "notification"
+	KindDigest          = "digest"
+	KindWorkspaceInvite = "workspace_invite"
+)
+
+const DefaultLocale = "en"
+
+// ErrTemplateNotFound 在所有回退语言中都找不到对应模板
+var ErrTemplateNotFound = errors.New("mail: template not found")
+
+//go:embed templates
+var builtinTemplates embed.FS
+
+// Content 是渲染后的邮件内容
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Renderer 按通知类型和语言渲染邮件模板。
+//
+// 每种通知类型由两个文件组成：
+//   - <kind>.txt.tmpl：纯文本正文，同时必须通过 {{define "subject"}} 定义邮件主题
+//   - <kind>.html.tmpl：HTML 正文，可选
+//
+// 语言查找顺序为 zh-CN -> zh -> 默认语言。
+type Renderer struct {
+	fsys          fs.FS
+	defaultLocale string
+
+	mu    sync.RWMutex
+	cache map[string]*templateSet
+}
+
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// NewRenderer 创建模板渲染器，fsys 为 nil 时使用内置模板
+func NewRenderer(fsys fs.FS, defaultLocale string) (*Renderer, error) {
+	if fsys == nil {
+		sub, err := fs.Sub(builtinTemplates, "templates")
+		if err != nil {
+			return nil, err
+		}
+		fsys = sub
+	}
+	if defaultLocale == "" {
+		defaultLocale = DefaultLocale
+	}
+	return &Renderer{
+		fsys:          fsys,
+		defaultLocale: defaultLocale,
+		cache:         make(map[string]*templateSet),
+	}, nil
+}
+
+// Render 渲染指定类型的邮件
+func (r *Renderer) Render(kind, locale string, data any) (*Content, error) {
+	set, err := r.lookup(kind, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := set.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("mail: render %s subject: %w", kind, err)
+	}
+	if err := set.text.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("mail: render %s text: %w", kind, err)
+	}
+	if set.html != nil {
+		if err := set.html.Execute(&html, data); err != nil {
+			return nil, fmt.Errorf("mail: render %s html: %w", kind, err)
+		}
+	}
+	return &Content{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// lookup 按语言回退顺序查找并缓存模板
+func (r *Renderer) lookup(kind, locale string) (*templateSet, error) {
+	for _, l := range r.candidates(locale) {
+		key := l + "/" + kind
+		r.mu.RLock()
+		set, ok := r.cache[key]
+		r.mu.RUnlock()
+		if ok {
+			return set, nil
+		}
+
+		set, err := r.parse(l, kind)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.mu.Lock()
+		r.cache[key] = set
+		r.mu.Unlock()
+		return set, nil
+	}
+	return nil, fmt.Errorf("%w: %s (%s)", ErrTemplateNotFound, kind, locale)
+}
+
+func (r *Renderer) parse(locale, kind string) (*templateSet, error) {
+	base := locale + "/" + kind
+	// ParseFS 对不存在的文件不会返回 fs.ErrNotExist，这里先 Stat 一次
+	if _, err := fs.Stat(r.fsys, base+".txt.tmpl"); err != nil {
+		return nil, err
+	}
+	text, err := texttemplate.ParseFS(r.fsys, base+".txt.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	if text.Lookup("subject") == nil {
+		return nil, fmt.Errorf("mail: template %s.txt.tmpl does not define \"subject\"", base)
+	}
+
+	set := &templateSet{text: text}
+	if _, err := fs.Stat(r.fsys, base+".html.tmpl"); errors.Is(err, fs.ErrNotExist) {
+		return set, nil
+	}
+	html, err := htmltemplate.ParseFS(r.fsys, base+".html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	set.html = html
+	return set, nil
+}
+
+// candidates 返回语言回退列表，例如 zh-CN -> [zh-CN zh en]
+func (r *Renderer) candidates(locale string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	add := func(l string) {
+		if l != "" && !seen[l] {
+			seen[l] = true
+			out = append(out, l)
+		}
+	}
+	locale = strings.ReplaceAll(locale, "_", "-")
+	add(locale)
+	if i := strings.Index(locale, "-"); i > 0 {
+		add(locale[:i])
+	}
+	add(r.defaultLocale)
+	add(DefaultLocale)
+	return out
+}
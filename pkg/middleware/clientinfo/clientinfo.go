@@ -0,0 +1,102 @@
+// Package clientinfo 提取请求方的 IP 和 User-Agent 并放入 context，供会话、审计等业务使用。
+package clientinfo
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Info 请求方信息
+type Info struct {
+	IP        string
+	UserAgent string
+}
+
+type infoKey struct{}
+
+// NewContext 把请求方信息放入 context
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// FromContext 读取请求方信息，未经过中间件时返回零值
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(infoKey{}).(Info)
+	return info
+}
+
+// Option 中间件选项
+type Option func(*options)
+
+type options struct {
+	trustForwarded bool
+}
+
+// WithTrustForwarded 信任 X-Forwarded-For / X-Real-IP 头，仅在服务部署于可信的反向代理之后时开启，
+// 否则客户端可以伪造 IP
+func WithTrustForwarded(trust bool) Option {
+	return func(o *options) { o.trustForwarded = trust }
+}
+
+// Server 提取请求方信息的服务端中间件
+func Server(opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			return handler(NewContext(ctx, extract(ctx, o)), req)
+		}
+	}
+}
+
+func extract(ctx context.Context, o *options) Info {
+	var info Info
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return info
+	}
+	header := tr.RequestHeader()
+	info.UserAgent = header.Get("User-Agent")
+	if o.trustForwarded {
+		if ip := forwardedIP(header.Get("X-Forwarded-For"), header.Get("X-Real-IP")); ip != "" {
+			info.IP = ip
+			return info
+		}
+	}
+	if ht, ok := tr.(http.Transporter); ok {
+		info.IP = hostOnly(ht.Request().RemoteAddr)
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.IP = hostOnly(p.Addr.String())
+	}
+	return info
+}
+
+// forwardedIP 取 X-Forwarded-For 中最左侧的地址（原始客户端），其次是 X-Real-IP
+func forwardedIP(xff, realIP string) string {
+	if xff != "" {
+		first, _, _ := strings.Cut(xff, ",")
+		if ip := strings.TrimSpace(first); net.ParseIP(ip) != nil {
+			return ip
+		}
+	}
+	if ip := strings.TrimSpace(realIP); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return ""
+}
+
+func hostOnly(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
@@ -0,0 +1,77 @@
+package clientinfo
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type testTransport struct {
+	http.Transporter
+	req    *nethttp.Request
+	header transport.Header
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) Request() *nethttp.Request       { return t.req }
+
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+func run(t *testing.T, opts []Option, remote string, header map[string]string) Info {
+	t.Helper()
+	req, _ := nethttp.NewRequest(nethttp.MethodGet, "/", nil)
+	req.RemoteAddr = remote
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	ctx := transport.NewServerContext(context.Background(), &testTransport{req: req, header: headerCarrier(req.Header)})
+
+	var got Info
+	_, _ = Server(opts...)(func(ctx context.Context, _ any) (any, error) {
+		got = FromContext(ctx)
+		return nil, nil
+	})(ctx, nil)
+	return got
+}
+
+func TestServer_RemoteAddr(t *testing.T) {
+	got := run(t, nil, "10.0.0.1:5123", map[string]string{
+		"User-Agent":      "curl/8.0",
+		"X-Forwarded-For": "1.2.3.4",
+	})
+	if got.IP != "10.0.0.1" {
+		t.Errorf("expected remote address to be used when forwarded headers are not trusted, got %q", got.IP)
+	}
+	if got.UserAgent != "curl/8.0" {
+		t.Errorf("unexpected user agent %q", got.UserAgent)
+	}
+}
+
+func TestServer_TrustForwarded(t *testing.T) {
+	opts := []Option{WithTrustForwarded(true)}
+	if got := run(t, opts, "10.0.0.1:5123", map[string]string{"X-Forwarded-For": "1.2.3.4, 10.0.0.2"}); got.IP != "1.2.3.4" {
+		t.Errorf("expected left-most X-Forwarded-For address, got %q", got.IP)
+	}
+	if got := run(t, opts, "10.0.0.1:5123", map[string]string{"X-Real-IP": "5.6.7.8"}); got.IP != "5.6.7.8" {
+		t.Errorf("expected X-Real-IP, got %q", got.IP)
+	}
+	if got := run(t, opts, "10.0.0.1:5123", map[string]string{"X-Forwarded-For": "not-an-ip"}); got.IP != "10.0.0.1" {
+		t.Errorf("expected fallback to remote address for invalid header, got %q", got.IP)
+	}
+}
+
+func TestFromContext_Empty(t *testing.T) {
+	if got := FromContext(context.Background()); got != (Info{}) {
+		t.Errorf("expected zero info, got %+v", got)
+	}
+}
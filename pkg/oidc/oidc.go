@@ -0,0 +1,337 @@
+// Package oidc 实现 OpenID Connect 授权码登录（RFC 6749 + PKCE RFC 7636）的客户端部分：
+// 读取发现文档、生成授权地址、用授权码换取 token，并校验 ID Token 的签名、iss、aud、exp 和 nonce。
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	jwtpkg "github.com/ToAtlas/AtlasBackend/pkg/jwt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultScopes 未配置 scope 时请求的 scope
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// jwksRefreshInterval 遇到未知 kid 时重新拉取 JWKS 的最小间隔，防止伪造 token 触发频繁请求
+const jwksRefreshInterval = time.Minute
+
+// Config OIDC 客户端配置
+type Config struct {
+	// Issuer 身份提供方地址，发现文档位于 {Issuer}/.well-known/openid-configuration
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL 在身份提供方登记的回调地址
+	RedirectURL string
+	Scopes      []string
+	// HTTPClient 为空时使用 http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// Discovery 发现文档中用到的字段
+type Discovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported,omitempty"`
+}
+
+// Token 令牌端点的响应
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+	IDToken      string `json:"id_token"`
+}
+
+// IDTokenClaims ID Token 中用到的 claims
+type IDTokenClaims struct {
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	AuthorizedParty   string `json:"azp,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Provider 一个 OIDC 身份提供方。发现文档和 JWKS 在第一次使用时加载并缓存，
+// 身份提供方暂时不可用不会影响服务启动
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu          sync.Mutex
+	discovery   *Discovery
+	keys        *jwtpkg.KeySet
+	keysFetched time.Time
+}
+
+// NewProvider 创建身份提供方
+func NewProvider(cfg Config) (*Provider, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("oidc: issuer is required")
+	}
+	if cfg.ClientID == "" {
+		return nil, errors.New("oidc: client id is required")
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = DefaultScopes
+	} else if !slices.Contains(cfg.Scopes, "openid") {
+		cfg.Scopes = append([]string{"openid"}, cfg.Scopes...)
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{cfg: cfg, client: client}, nil
+}
+
+// Discovery 返回发现文档，首次调用时从身份提供方加载
+func (p *Provider) Discovery(ctx context.Context) (*Discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.loadDiscovery(ctx)
+}
+
+func (p *Provider) loadDiscovery(ctx context.Context) (*Discovery, error) {
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	body, err := p.get(ctx, wellKnown)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: fetch discovery document: %w", err)
+	}
+	var d Discovery
+	if err := json.Unmarshal(body, &d); err != nil {
+		return nil, fmt.Errorf("oidc: parse discovery document: %w", err)
+	}
+	// OpenID Connect Discovery 1.0 第 4.3 节：文档中的 issuer 必须与配置一致
+	if strings.TrimSuffix(d.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return nil, fmt.Errorf("oidc: issuer mismatch, expected %q got %q", p.cfg.Issuer, d.Issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is missing required endpoints")
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// AuthCodeURL 返回跳转到身份提供方的授权地址，state 和 nonce 由调用方生成并保存
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	d, err := p.Discovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: invalid authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", ChallengeS256(verifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange 用授权码和 PKCE verifier 换取 token
+func (p *Provider) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	d, err := p.Discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	// 默认使用 client_secret_basic，身份提供方只支持 client_secret_post 时放在表单中
+	usePost := p.cfg.ClientSecret != "" &&
+		len(d.TokenEndpointAuthMethodsSupported) > 0 &&
+		!slices.Contains(d.TokenEndpointAuthMethodsSupported, "client_secret_basic") &&
+		slices.Contains(d.TokenEndpointAuthMethodsSupported, "client_secret_post")
+	if p.cfg.ClientSecret == "" || usePost {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+	if usePost {
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" && !usePost {
+		// RFC 6749 第 2.3.1 节：先进行 form-urlencoded 编码
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.Unmarshal(body, &e)
+		return nil, fmt.Errorf("oidc: token endpoint returned %d: %s %s", resp.StatusCode, e.Error, e.Description)
+	}
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("oidc: parse token response: %w", err)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	return &token, nil
+}
+
+// VerifyIDToken 校验 ID Token 的签名、iss、aud、exp 和 nonce
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	d, err := p.Discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := p.keySet(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := jwtpkg.NewJWT[IDTokenClaims](&jwtpkg.Config{KeySet: keys}).ParseToken(rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id token: %w", err)
+	}
+	if claims.Issuer != d.Issuer {
+		return nil, fmt.Errorf("oidc: id token issuer %q does not match %q", claims.Issuer, d.Issuer)
+	}
+	if !slices.Contains(claims.Audience, p.cfg.ClientID) {
+		return nil, errors.New("oidc: id token was not issued for this client")
+	}
+	// 存在多个受众时 azp 必须是本客户端
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID {
+		return nil, errors.New("oidc: id token azp does not match client id")
+	}
+	if claims.ExpiresAt == nil {
+		return nil, errors.New("oidc: id token has no exp")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("oidc: id token has no sub")
+	}
+	if nonce == "" || claims.Nonce != nonce {
+		return nil, errors.New("oidc: id token nonce mismatch")
+	}
+	return claims, nil
+}
+
+// keySet 返回验证 rawIDToken 使用的密钥集，token 的 kid 不在缓存中时重新拉取 JWKS（身份提供方轮换密钥）
+func (p *Provider) keySet(ctx context.Context, rawIDToken string) (*jwtpkg.KeySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	kid := tokenKeyID(rawIDToken)
+	if p.keys != nil {
+		if _, ok := p.keys.Lookup(kid); ok || time.Since(p.keysFetched) < jwksRefreshInterval {
+			return p.keys, nil
+		}
+	}
+	d, err := p.loadDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, err := p.get(ctx, d.JWKSURI)
+	if err != nil {
+		if p.keys != nil {
+			return p.keys, nil
+		}
+		return nil, fmt.Errorf("oidc: fetch jwks: %w", err)
+	}
+	keys, err := jwtpkg.ParseJWKS(body)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: %w", err)
+	}
+	p.keys = keys
+	p.keysFetched = time.Now()
+	return p.keys, nil
+}
+
+func (p *Provider) get(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, rawURL)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// tokenKeyID 读取 JWT 头部的 kid，不校验签名
+func tokenKeyID(raw string) string {
+	header, _, ok := strings.Cut(raw, ".")
+	if !ok {
+		return ""
+	}
+	data, err := base64.RawURLEncoding.DecodeString(header)
+	if err != nil {
+		return ""
+	}
+	var h struct {
+		Kid string `json:"kid"`
+	}
+	_ = json.Unmarshal(data, &h)
+	return h.Kid
+}
+
+// RandomString 生成 URL 安全的随机字符串，用作 state、nonce 和 PKCE verifier
+func RandomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// ChallengeS256 计算 PKCE code_challenge（S256）
+func ChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,108 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/ToAtlas/AtlasBackend/pkg/oidc"
+	"github.com/ToAtlas/AtlasBackend/pkg/oidc/oidctest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	t.Helper()
+	srv := oidctest.NewServer("client", "secret")
+	t.Cleanup(srv.Close)
+	p, err := oidc.NewProvider(oidc.Config{
+		Issuer:       srv.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://app.local/callback",
+	})
+	require.NoError(t, err)
+	return srv, p
+}
+
+func TestProvider_AuthorizationCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvider(t)
+	srv.SetUser(oidctest.User{Subject: "alice-sub", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+
+	verifier, err := oidc.RandomString()
+	require.NoError(t, err)
+	authURL, err := p.AuthCodeURL(ctx, "state-1", "nonce-1", verifier)
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", u.Query().Get("scope"))
+
+	code, state, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "state-1", state)
+
+	token, err := p.Exchange(ctx, code, verifier)
+	require.NoError(t, err)
+
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice-sub", claims.Subject)
+	assert.Equal(t, "alice@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+
+	// 授权码只能使用一次
+	_, err = p.Exchange(ctx, code, verifier)
+	assert.Error(t, err)
+}
+
+func TestProvider_RejectsWrongVerifierAndNonce(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvider(t)
+
+	verifier, _ := oidc.RandomString()
+	authURL, err := p.AuthCodeURL(ctx, "s", "n", verifier)
+	require.NoError(t, err)
+	code, _, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	_, err = p.Exchange(ctx, code, "wrong-verifier")
+	assert.Error(t, err)
+
+	authURL, err = p.AuthCodeURL(ctx, "s", "n", verifier)
+	require.NoError(t, err)
+	code, _, err = srv.Authorize(authURL)
+	require.NoError(t, err)
+	token, err := p.Exchange(ctx, code, verifier)
+	require.NoError(t, err)
+	_, err = p.VerifyIDToken(ctx, token.IDToken, "other-nonce")
+	assert.Error(t, err)
+}
+
+func TestProvider_RejectsOtherAudience(t *testing.T) {
+	ctx := context.Background()
+	srv, p := newProvider(t)
+	other, err := oidc.NewProvider(oidc.Config{Issuer: srv.URL, ClientID: "other", RedirectURL: "http://app.local/callback"})
+	require.NoError(t, err)
+
+	verifier, _ := oidc.RandomString()
+	authURL, err := p.AuthCodeURL(ctx, "s", "n", verifier)
+	require.NoError(t, err)
+	code, _, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	token, err := p.Exchange(ctx, code, verifier)
+	require.NoError(t, err)
+
+	_, err = other.VerifyIDToken(ctx, token.IDToken, "n")
+	assert.Error(t, err)
+}
+
+func TestNewProvider_IssuerMismatch(t *testing.T) {
+	srv, _ := newProvider(t)
+	p, err := oidc.NewProvider(oidc.Config{Issuer: srv.URL + "/other", ClientID: "client"})
+	require.NoError(t, err)
+	_, err = p.Discovery(context.Background())
+	assert.Error(t, err)
+}
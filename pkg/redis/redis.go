@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	conf "github.com/ToAtlas/AtlasBackend/api/gen/go/conf/v1"
@@ -108,6 +109,21 @@ func (c *Client) Get(ctx context.Context, key string) (string, error) {
 	return c.rdb.Get(ctx, key).Result()
 }
 
+// SetNX 键不存在时设置值，返回是否设置成功
+func (c *Client) SetNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	return c.rdb.SetNX(ctx, key, value, expiration).Result()
+}
+
+// Incr 自增键值，键不存在时从 0 开始
+func (c *Client) Incr(ctx context.Context, key string) (int64, error) {
+	return c.rdb.Incr(ctx, key).Result()
+}
+
+// GetDel 获取键值并删除，键不存在时返回 redis.Nil
+func (c *Client) GetDel(ctx context.Context, key string) (string, error) {
+	return c.rdb.GetDel(ctx, key).Result()
+}
+
 // Del 删除键
 func (c *Client) Del(ctx context.Context, keys ...string) error {
 	return c.rdb.Del(ctx, keys...).Err()
@@ -129,6 +145,11 @@ func (c *Client) SAdd(ctx context.Context, key string, members ...any) error {
 	return c.rdb.SAdd(ctx, key, members...).Err()
 }
 
+// SRem 从集合移除成员
+func (c *Client) SRem(ctx context.Context, key string, members ...any) error {
+	return c.rdb.SRem(ctx, key, members...).Err()
+}
+
 // SMembers 获取集合所有成员
 func (c *Client) SMembers(ctx context.Context, key string) ([]string, error) {
 	return c.rdb.SMembers(ctx, key).Result()
@@ -138,3 +159,131 @@ func (c *Client) SMembers(ctx context.Context, key string) ([]string, error) {
 func (c *Client) Expire(ctx context.Context, key string, expiration time.Duration) error {
 	return c.rdb.Expire(ctx, key, expiration).Err()
 }
+
+// LPush 从列表头部插入元素
+func (c *Client) LPush(ctx context.Context, key string, values ...any) error {
+	return c.rdb.LPush(ctx, key, values...).Err()
+}
+
+// BLMove 阻塞地从 source 尾部弹出元素并插入 destination 头部，超时返回 redis.Nil
+func (c *Client) BLMove(ctx context.Context, source, destination string, timeout time.Duration) (string, error) {
+	return c.rdb.BLMove(ctx, source, destination, "RIGHT", "LEFT", timeout).Result()
+}
+
+// LMove 从 source 尾部弹出元素并插入 destination 头部
+func (c *Client) LMove(ctx context.Context, source, destination string) (string, error) {
+	return c.rdb.LMove(ctx, source, destination, "RIGHT", "LEFT").Result()
+}
+
+// LRem 删除列表中等于 value 的元素，返回删除数量
+func (c *Client) LRem(ctx context.Context, key string, count int64, value any) (int64, error) {
+	return c.rdb.LRem(ctx, key, count, value).Result()
+}
+
+// LRange 获取列表指定区间的元素
+func (c *Client) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	return c.rdb.LRange(ctx, key, start, stop).Result()
+}
+
+// LLen 获取列表长度
+func (c *Client) LLen(ctx context.Context, key string) (int64, error) {
+	return c.rdb.LLen(ctx, key).Result()
+}
+
+// ZAdd 向有序集合添加成员
+func (c *Client) ZAdd(ctx context.Context, key string, score float64, member any) error {
+	return c.rdb.ZAdd(ctx, key, redis.Z{Score: score, Member: member}).Err()
+}
+
+// ZRangeByScore 按分值区间获取有序集合成员
+func (c *Client) ZRangeByScore(ctx context.Context, key, min, max string, count int64) ([]string, error) {
+	return c.rdb.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: min, Max: max, Count: count}).Result()
+}
+
+// ZRem 删除有序集合成员，返回删除数量
+func (c *Client) ZRem(ctx context.Context, key string, members ...any) (int64, error) {
+	return c.rdb.ZRem(ctx, key, members...).Result()
+}
+
+// SlidingWindowAdd 在滑动窗口有序集合中记录一次事件并返回窗口内的事件数。
+// 成员以时间为分值，窗口外的旧成员在同一事务中删除，键在窗口结束后过期
+func (c *Client) SlidingWindowAdd(ctx context.Context, key, member string, now time.Time, window time.Duration) (int64, error) {
+	var card *redis.IntCmd
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: member})
+		card = pipe.ZCard(ctx, key)
+		pipe.PExpire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return card.Val(), nil
+}
+
+// TTL 获取键的剩余过期时间，键不存在时返回 -2ns，没有过期时间时返回 -1ns
+func (c *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.rdb.PTTL(ctx, key).Result()
+}
+
+// XAdd 向 Stream 追加消息，maxLen 大于 0 时按近似长度裁剪，返回消息ID
+func (c *Client) XAdd(ctx context.Context, stream string, maxLen int64, values map[string]any) (string, error) {
+	return c.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: maxLen,
+		Approx: maxLen > 0,
+		Values: values,
+	}).Result()
+}
+
+// XGroupCreateMkStream 创建消费者组，Stream 不存在时自动创建；组已存在时返回 BUSYGROUP 错误
+func (c *Client) XGroupCreateMkStream(ctx context.Context, stream, group, start string) error {
+	return c.rdb.XGroupCreateMkStream(ctx, stream, group, start).Err()
+}
+
+// XReadGroup 以消费者组方式读取新消息，block 超时无消息时返回 redis.Nil
+func (c *Client) XReadGroup(ctx context.Context, stream, group, consumer string, count int64, block time.Duration) ([]redis.XMessage, error) {
+	res, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: consumer,
+		Streams:  []string{stream, ">"},
+		Count:    count,
+		Block:    block,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return res[0].Messages, nil
+}
+
+// XAck 确认消息已处理
+func (c *Client) XAck(ctx context.Context, stream, group string, ids ...string) error {
+	return c.rdb.XAck(ctx, stream, group, ids...).Err()
+}
+
+// XPendingIdle 查询空闲时间超过 idle 的待确认消息
+func (c *Client) XPendingIdle(ctx context.Context, stream, group string, idle time.Duration, count int64) ([]redis.XPendingExt, error) {
+	return c.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: stream,
+		Group:  group,
+		Idle:   idle,
+		Start:  "-",
+		End:    "+",
+		Count:  count,
+	}).Result()
+}
+
+// XClaim 把空闲时间超过 minIdle 的待确认消息转移给 consumer，返回消息内容
+func (c *Client) XClaim(ctx context.Context, stream, group, consumer string, minIdle time.Duration, ids ...string) ([]redis.XMessage, error) {
+	return c.rdb.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  minIdle,
+		Messages: ids,
+	}).Result()
+}
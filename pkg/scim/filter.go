@@ -0,0 +1,500 @@
+package scim
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Filter 解析后的过滤表达式（RFC 7644 3.4.2.2）。
+// 属性名、字符串比较都不区分大小写；多值属性只要有一项满足条件即匹配
+type Filter interface {
+	Match(resource map[string]any) bool
+}
+
+// 比较运算符
+const (
+	opEq = "eq"
+	opNe = "ne"
+	opCo = "co"
+	opSw = "sw"
+	opEw = "ew"
+	opGt = "gt"
+	opGe = "ge"
+	opLt = "lt"
+	opLe = "le"
+	opPr = "pr"
+)
+
+// attrPath 属性路径，如 name.givenName，URN 前缀已去掉
+type attrPath struct {
+	attr string
+	sub  string
+}
+
+func parseAttrPath(s string) (attrPath, error) {
+	if i := strings.LastIndexByte(s, ':'); i >= 0 {
+		s = s[i+1:]
+	}
+	attr, sub, _ := strings.Cut(s, ".")
+	if !validAttrName(attr) || (sub != "" && !validAttrName(sub)) {
+		return attrPath{}, BadRequest(ErrInvalidFilter, "invalid attribute path %q", s)
+	}
+	return attrPath{attr: attr, sub: sub}, nil
+}
+
+// validAttrName ATTRNAME = ALPHA *(nameChar)，$ref 是唯一允许以 $ 开头的属性
+func validAttrName(s string) bool {
+	if s == "$ref" {
+		return true
+	}
+	for i, r := range s {
+		isAlpha := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		if i == 0 && !isAlpha {
+			return false
+		}
+		if !isAlpha && !(r >= '0' && r <= '9') && r != '_' && r != '-' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func (p attrPath) String() string {
+	if p.sub == "" {
+		return p.attr
+	}
+	return p.attr + "." + p.sub
+}
+
+// values 取属性在资源中的全部值。多值复合属性未指定子属性时取各项的 value
+func (p attrPath) values(resource map[string]any) []any {
+	v, ok := lookup(resource, p.attr)
+	if !ok {
+		return nil
+	}
+	var out []any
+	collect := func(item any) {
+		m, isMap := item.(map[string]any)
+		switch {
+		case p.sub != "" && isMap:
+			if sv, ok := lookup(m, p.sub); ok {
+				out = append(out, flatten(sv)...)
+			}
+		case p.sub == "" && isMap:
+			if sv, ok := lookup(m, "value"); ok {
+				out = append(out, sv)
+			}
+		case p.sub == "":
+			out = append(out, item)
+		}
+	}
+	if list, ok := v.([]any); ok {
+		for _, item := range list {
+			collect(item)
+		}
+	} else {
+		collect(v)
+	}
+	return out
+}
+
+func flatten(v any) []any {
+	if list, ok := v.([]any); ok {
+		return list
+	}
+	return []any{v}
+}
+
+// lookup 按属性名查找，属性名不区分大小写
+func lookup(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+type logicalFilter struct {
+	and         bool
+	left, right Filter
+}
+
+func (f *logicalFilter) Match(r map[string]any) bool {
+	if f.and {
+		return f.left.Match(r) && f.right.Match(r)
+	}
+	return f.left.Match(r) || f.right.Match(r)
+}
+
+type notFilter struct{ f Filter }
+
+func (f *notFilter) Match(r map[string]any) bool { return !f.f.Match(r) }
+
+type presentFilter struct{ path attrPath }
+
+func (f *presentFilter) Match(r map[string]any) bool {
+	return slices.ContainsFunc(f.path.values(r), present)
+}
+
+func present(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+type compareFilter struct {
+	path  attrPath
+	op    string
+	value any // string、float64、bool 或 nil
+}
+
+func (f *compareFilter) Match(r map[string]any) bool {
+	values := f.path.values(r)
+	if f.value == nil {
+		// eq null 表示属性不存在，ne null 表示属性存在
+		return slices.ContainsFunc(values, present) == (f.op == opNe)
+	}
+	if f.op == opNe {
+		return !slices.ContainsFunc(values, func(v any) bool { return compare(v, opEq, f.value) })
+	}
+	return slices.ContainsFunc(values, func(v any) bool { return compare(v, f.op, f.value) })
+}
+
+func compare(actual any, op string, expected any) bool {
+	switch e := expected.(type) {
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case opEq:
+			return a == e
+		case opCo:
+			return strings.Contains(a, e)
+		case opSw:
+			return strings.HasPrefix(a, e)
+		case opEw:
+			return strings.HasSuffix(a, e)
+		}
+		return ordered(strings.Compare(a, e), op)
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		if op == opEq {
+			return a == e
+		}
+		switch {
+		case a < e:
+			return ordered(-1, op)
+		case a > e:
+			return ordered(1, op)
+		}
+		return ordered(0, op)
+	case bool:
+		a, ok := actual.(bool)
+		return ok && op == opEq && a == e
+	}
+	return false
+}
+
+func ordered(c int, op string) bool {
+	switch op {
+	case opGt:
+		return c > 0
+	case opGe:
+		return c >= 0
+	case opLt:
+		return c < 0
+	case opLe:
+		return c <= 0
+	}
+	return false
+}
+
+// valuePathFilter 多值属性的子过滤，如 emails[type eq "work" and value co "@example.com"]
+type valuePathFilter struct {
+	attr   string
+	filter Filter
+}
+
+func (f *valuePathFilter) Match(r map[string]any) bool {
+	v, ok := lookup(r, f.attr)
+	if !ok {
+		return false
+	}
+	for _, item := range flatten(v) {
+		if m, ok := item.(map[string]any); ok && f.filter.Match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFilter 解析过滤表达式，错误为 invalidFilter 类型的 *Error
+func ParseFilter(s string) (Filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, BadRequest(ErrInvalidFilter, "unexpected %q", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+// Equality 过滤表达式为单个字符串相等比较时，返回小写的属性路径和比较值，
+// 调用方可以据此直接查询，如 userName eq "alice" 返回 "username", "alice"
+func Equality(f Filter) (attr, value string, ok bool) {
+	c, isCompare := f.(*compareFilter)
+	if !isCompare || c.op != opEq {
+		return "", "", false
+	}
+	value, ok = c.value.(string)
+	return strings.ToLower(c.path.String()), value, ok
+}
+
+// ToMap 把资源转换为过滤和 PATCH 使用的 JSON 对象
+func ToMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type token struct {
+	text   string
+	quoted bool // 字符串字面量
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, token{text: string(c)})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, BadRequest(ErrInvalidFilter, "unterminated string in filter")
+			}
+			var text string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &text); err != nil {
+				return nil, BadRequest(ErrInvalidFilter, "invalid string %s", s[i:j+1])
+			}
+			tokens = append(tokens, token{text: text, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\r\n()[]\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, token{text: s[i:j]})
+			i = j
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, BadRequest(ErrInvalidFilter, "empty filter")
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// keyword 判断下一个 token 是否为不区分大小写的关键字，是则消费
+func (p *filterParser) keyword(kw string) bool {
+	t, ok := p.peek()
+	if ok && !t.quoted && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) expect(kw string) error {
+	if !p.keyword(kw) {
+		return BadRequest(ErrInvalidFilter, "expected %q", kw)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (Filter, error) {
+	if !p.keyword("not") {
+		return p.parseAtom()
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return &notFilter{f: f}, nil
+}
+
+func (p *filterParser) parseAtom() (Filter, error) {
+	if p.keyword("(") {
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	t, ok := p.peek()
+	if !ok || t.quoted {
+		return nil, BadRequest(ErrInvalidFilter, "expected attribute path")
+	}
+	p.pos++
+	path, err := parseAttrPath(t.text)
+	if err != nil {
+		return nil, err
+	}
+	if p.keyword("[") {
+		if path.sub != "" {
+			return nil, BadRequest(ErrInvalidFilter, "invalid value path %q", t.text)
+		}
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &valuePathFilter{attr: path.attr, filter: inner}, nil
+	}
+
+	opTok, ok := p.peek()
+	if !ok || opTok.quoted {
+		return nil, BadRequest(ErrInvalidFilter, "expected operator after %q", t.text)
+	}
+	p.pos++
+	op := strings.ToLower(opTok.text)
+	switch op {
+	case opPr:
+		return &presentFilter{path: path}, nil
+	case opEq, opNe, opCo, opSw, opEw, opGt, opGe, opLt, opLe:
+	default:
+		return nil, BadRequest(ErrInvalidFilter, "unsupported operator %q", opTok.text)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case opCo, opSw, opEw:
+		if _, ok := value.(string); !ok {
+			return nil, BadRequest(ErrInvalidFilter, "operator %s requires a string value", op)
+		}
+	case opGt, opGe, opLt, opLe:
+		if _, ok := value.(bool); ok || value == nil {
+			return nil, BadRequest(ErrInvalidFilter, "operator %s requires a string or number value", op)
+		}
+	}
+	return &compareFilter{path: path, op: op, value: value}, nil
+}
+
+func (p *filterParser) parseValue() (any, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, BadRequest(ErrInvalidFilter, "expected comparison value")
+	}
+	p.pos++
+	if t.quoted {
+		return t.text, nil
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(t.text, 64)
+	if err != nil {
+		return nil, BadRequest(ErrInvalidFilter, "invalid comparison value %q", t.text)
+	}
+	return n, nil
+}
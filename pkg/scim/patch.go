@@ -0,0 +1,278 @@
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// PATCH 操作类型
+const (
+	opAdd     = "add"
+	opRemove  = "remove"
+	opReplace = "replace"
+)
+
+// patchPath PATCH 的目标路径：attr、attr.sub、attr[filter] 或 attr[filter].sub
+type patchPath struct {
+	attr   string
+	filter Filter
+	sub    string
+}
+
+func parsePatchPath(s string) (patchPath, error) {
+	open := strings.IndexByte(s, '[')
+	if open < 0 {
+		p, err := parseAttrPath(s)
+		if err != nil {
+			return patchPath{}, BadRequest(ErrInvalidPath, "invalid path %q", s)
+		}
+		return patchPath{attr: p.attr, sub: p.sub}, nil
+	}
+	closing := strings.LastIndexByte(s, ']')
+	if closing < open {
+		return patchPath{}, BadRequest(ErrInvalidPath, "invalid path %q", s)
+	}
+	head, err := parseAttrPath(s[:open])
+	if err != nil || head.sub != "" {
+		return patchPath{}, BadRequest(ErrInvalidPath, "invalid path %q", s)
+	}
+	filter, err := ParseFilter(s[open+1 : closing])
+	if err != nil {
+		return patchPath{}, BadRequest(ErrInvalidPath, "invalid filter in path %q", s)
+	}
+	p := patchPath{attr: head.attr, filter: filter}
+	if rest := s[closing+1:]; rest != "" {
+		sub, ok := strings.CutPrefix(rest, ".")
+		if !ok || !validAttrName(sub) {
+			return patchPath{}, BadRequest(ErrInvalidPath, "invalid path %q", s)
+		}
+		p.sub = sub
+	}
+	return p, nil
+}
+
+// Patch 对资源执行 PATCH 操作，返回修改后的新资源，v 本身不变
+func Patch[T any](v T, ops []PatchOperation) (T, error) {
+	var out T
+	m, err := ToMap(v)
+	if err != nil {
+		return out, err
+	}
+	if err := Apply(m, ops); err != nil {
+		return out, err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return out, BadRequest(ErrInvalidValue, "invalid value: %v", err)
+	}
+	return out, nil
+}
+
+// Apply 依次对 JSON 对象执行 PATCH 操作（RFC 7644 3.5.2）。
+// add 到多值属性时追加；remove 多值属性时可以用 value 指定要移除的项；
+// 带过滤条件的 add、replace 没有匹配项时，如果过滤条件是单个相等比较，按该条件新增一项
+func Apply(resource map[string]any, ops []PatchOperation) error {
+	for _, op := range ops {
+		var value any
+		if len(op.Value) > 0 {
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return BadRequest(ErrInvalidValue, "invalid value for %s: %v", op.Op, err)
+			}
+		}
+		if err := applyOp(resource, strings.ToLower(op.Op), op.Path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOp(r map[string]any, op, path string, value any) error {
+	if path == "" {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return BadRequest(ErrInvalidValue, "value must be an object when path is omitted")
+		}
+		// 按属性名排序，保证结果与 map 的遍历顺序无关
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if strings.EqualFold(k, "schemas") {
+				continue
+			}
+			if err := applyOp(r, op, k, obj[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+	if p.filter == nil {
+		if p.sub == "" {
+			return applyAttr(r, op, p.attr, value)
+		}
+		key := keyOf(r, p.attr)
+		switch parent := r[key].(type) {
+		case map[string]any:
+			return applyAttr(parent, op, p.sub, value)
+		case nil:
+			if op == opRemove {
+				return nil
+			}
+			child := map[string]any{}
+			r[key] = child
+			return applyAttr(child, op, p.sub, value)
+		default:
+			return BadRequest(ErrInvalidPath, "path %q requires a value filter", path)
+		}
+	}
+	return applyValuePath(r, op, p, value)
+}
+
+// applyValuePath 对多值属性中满足过滤条件的项执行操作
+func applyValuePath(r map[string]any, op string, p patchPath, value any) error {
+	key := keyOf(r, p.attr)
+	var items []any
+	if v, ok := r[key]; ok && v != nil {
+		items = flatten(v)
+	}
+	matched := false
+	kept := make([]any, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok || !p.filter.Match(m) {
+			kept = append(kept, item)
+			continue
+		}
+		matched = true
+		switch {
+		case op == opRemove && p.sub == "":
+			continue
+		case p.sub != "":
+			if err := applyAttr(m, op, p.sub, value); err != nil {
+				return err
+			}
+		case op == opReplace:
+			obj, ok := value.(map[string]any)
+			if !ok {
+				return BadRequest(ErrInvalidValue, "value for %s must be an object", p.attr)
+			}
+			m = obj
+		default:
+			obj, ok := value.(map[string]any)
+			if !ok {
+				return BadRequest(ErrInvalidValue, "value for %s must be an object", p.attr)
+			}
+			for k, v := range obj {
+				if err := applyAttr(m, opAdd, k, v); err != nil {
+					return err
+				}
+			}
+		}
+		kept = append(kept, m)
+	}
+	if !matched {
+		if op == opRemove {
+			return nil
+		}
+		attr, v, ok := Equality(p.filter)
+		if !ok || p.sub == "" || strings.Contains(attr, ".") {
+			return BadRequest(ErrNoTarget, "no %s value matches the filter", p.attr)
+		}
+		kept = append(kept, map[string]any{attr: v, p.sub: value})
+	}
+	if len(kept) == 0 {
+		delete(r, key)
+		return nil
+	}
+	r[key] = kept
+	return nil
+}
+
+// applyAttr 对单个属性执行操作
+func applyAttr(m map[string]any, op, name string, value any) error {
+	key := keyOf(m, name)
+	existing := m[key]
+	switch op {
+	case opRemove:
+		list, isList := existing.([]any)
+		if value == nil || !isList {
+			delete(m, key)
+			return nil
+		}
+		list = slices.DeleteFunc(list, func(item any) bool {
+			return slices.ContainsFunc(flatten(value), func(v any) bool { return sameValue(item, v) })
+		})
+		if len(list) == 0 {
+			delete(m, key)
+		} else {
+			m[key] = list
+		}
+	case opReplace:
+		m[key] = value
+	case opAdd:
+		switch cur := existing.(type) {
+		case []any:
+			for _, v := range flatten(value) {
+				if !slices.ContainsFunc(cur, func(item any) bool { return sameValue(item, v) }) {
+					cur = append(cur, v)
+				}
+			}
+			m[key] = cur
+		case map[string]any:
+			obj, ok := value.(map[string]any)
+			if !ok {
+				return BadRequest(ErrInvalidValue, "value for %s must be an object", name)
+			}
+			for k, v := range obj {
+				if err := applyAttr(cur, opAdd, k, v); err != nil {
+					return err
+				}
+			}
+		default:
+			m[key] = value
+		}
+	default:
+		return BadRequest(ErrInvalidSyntax, "unsupported op %q", op)
+	}
+	return nil
+}
+
+// sameValue 判断多值属性的两项是否相同，复合值按 value 子属性比较
+func sameValue(a, b any) bool {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if aok && bok {
+		av, aHas := lookup(am, "value")
+		bv, bHas := lookup(bm, "value")
+		if aHas && bHas {
+			return reflect.DeepEqual(av, bv)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// keyOf 资源中与 name 不区分大小写相同的属性名，不存在时返回 name
+func keyOf(m map[string]any, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
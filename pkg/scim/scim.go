@@ -0,0 +1,289 @@
+// Package scim 实现 SCIM 2.0（RFC 7643、RFC 7644）协议层：资源和消息的 JSON 结构、
+// 过滤表达式和 PATCH 操作。资源的存储和权限校验由调用方负责。
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ContentType SCIM 请求和响应的媒体类型
+const ContentType = "application/scim+json"
+
+const redacted = "***"
+
+// 消息和资源的 schema
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// 错误响应中的 scimType
+const (
+	ErrInvalidFilter = "invalidFilter"
+	ErrInvalidSyntax = "invalidSyntax"
+	ErrInvalidPath   = "invalidPath"
+	ErrInvalidValue  = "invalidValue"
+	ErrNoTarget      = "noTarget"
+	ErrUniqueness    = "uniqueness"
+	ErrMutability    = "mutability"
+)
+
+// Error SCIM 错误响应
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   int      `json:"status,string"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewError 创建错误响应，scimType 可以为空
+func NewError(status int, scimType, format string, args ...any) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   status,
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// BadRequest 400 错误
+func BadRequest(scimType, format string, args ...any) *Error {
+	return NewError(http.StatusBadRequest, scimType, format, args...)
+}
+
+func (e *Error) Error() string {
+	if e.ScimType != "" {
+		return fmt.Sprintf("scim: %d %s: %s", e.Status, e.ScimType, e.Detail)
+	}
+	return fmt.Sprintf("scim: %d: %s", e.Status, e.Detail)
+}
+
+// Meta 资源的元数据
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Bool 布尔属性。部分身份提供方（如 Microsoft Entra ID）在 PATCH 中把布尔值写成 "True"/"False" 字符串
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var v bool
+	if err := json.Unmarshal(data, &v); err == nil {
+		*b = Bool(v)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("scim: invalid boolean %s", data)
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		*b = true
+	case "false":
+		*b = false
+	default:
+		return fmt.Errorf("scim: invalid boolean %q", s)
+	}
+	return nil
+}
+
+// Name 用户姓名
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// MultiValue 多值属性的一项，如 emails
+type MultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary Bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User 用户资源，Password 只写不读
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *Name        `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Password    string       `json:"password,omitempty"`
+	Active      *Bool        `json:"active,omitempty"`
+	Emails      []MultiValue `json:"emails,omitempty"`
+	Groups      []MultiValue `json:"groups,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// PrimaryEmail 标记为 primary 的邮箱，都未标记时取第一个
+func (u *User) PrimaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// Redact 日志中使用的字符串，隐藏密码
+func (u User) Redact() string {
+	if u.Password != "" {
+		u.Password = redacted
+	}
+	b, _ := json.Marshal(u)
+	return string(b)
+}
+
+// Group 用户组资源
+type Group struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []MultiValue `json:"members,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// ListResponse 查询结果，StartIndex 从 1 开始
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// NewListResponse 创建查询结果，resources 为当前页的资源
+func NewListResponse[T any](resources []T, total, startIndex int) *ListResponse[T] {
+	if resources == nil {
+		resources = []T{}
+	}
+	return &ListResponse[T]{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// PatchOperation PATCH 请求中的一个操作，Op 为 add、remove 或 replace（不区分大小写）
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// PatchRequest PATCH 请求
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Redact 日志中使用的字符串，只保留操作和路径，值中可能包含密码
+func (r PatchRequest) Redact() string {
+	ops := make([]string, len(r.Operations))
+	for i, op := range r.Operations {
+		ops[i] = op.Op + " " + op.Path
+	}
+	return "[" + strings.Join(ops, ", ") + "]"
+}
+
+// Validate 检查消息类型和操作是否合法
+func (r *PatchRequest) Validate() error {
+	if !HasSchema(r.Schemas, SchemaPatchOp) {
+		return BadRequest(ErrInvalidSyntax, "schemas must contain %s", SchemaPatchOp)
+	}
+	if len(r.Operations) == 0 {
+		return BadRequest(ErrInvalidSyntax, "Operations is required")
+	}
+	for _, op := range r.Operations {
+		switch strings.ToLower(op.Op) {
+		case opAdd, opReplace:
+			if len(op.Value) == 0 {
+				return BadRequest(ErrInvalidValue, "value is required for %s", op.Op)
+			}
+		case opRemove:
+			if op.Path == "" {
+				return BadRequest(ErrNoTarget, "path is required for remove")
+			}
+		default:
+			return BadRequest(ErrInvalidSyntax, "unsupported op %q", op.Op)
+		}
+	}
+	return nil
+}
+
+// HasSchema 判断消息或资源是否声明了 schema
+func HasSchema(schemas []string, schema string) bool {
+	for _, s := range schemas {
+		if strings.EqualFold(s, schema) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceProviderConfig 服务端支持的功能，身份提供方据此决定使用哪些请求
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	DocumentationURI      string                 `json:"documentationUri,omitempty"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  BulkSupport            `json:"bulk"`
+	Filter                FilterSupport          `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *Meta                  `json:"meta,omitempty"`
+}
+
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+type BulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type FilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary,omitempty"`
+}
+
+// ResourceType 资源类型的描述
+type ResourceType struct {
+	Schemas  []string `json:"schemas"`
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Endpoint string   `json:"endpoint"`
+	Schema   string   `json:"schema"`
+	Meta     *Meta    `json:"meta,omitempty"`
+}
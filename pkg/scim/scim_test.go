@@ -0,0 +1,247 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ToAtlas/AtlasBackend/pkg/scim"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUser() scim.User {
+	active := scim.Bool(true)
+	return scim.User{
+		Schemas:    []string{scim.SchemaUser},
+		ID:         "42",
+		ExternalID: "ext-42",
+		UserName:   "Alice",
+		Name:       &scim.Name{GivenName: "Alice", FamilyName: "Liddell"},
+		Active:     &active,
+		Emails: []scim.MultiValue{
+			{Value: "alice@example.com", Type: "work", Primary: true},
+			{Value: "alice@home.example", Type: "home"},
+		},
+		Groups: []scim.MultiValue{{Value: "admin", Display: "admin"}},
+	}
+}
+
+func TestParseFilter_Match(t *testing.T) {
+	resource, err := scim.ToMap(testUser())
+	require.NoError(t, err)
+
+	for filter, want := range map[string]bool{
+		`userName eq "alice"`:                   true,
+		`USERNAME Eq "ALICE"`:                   true,
+		`userName ne "alice"`:                   false,
+		`userName sw "al" and userName ew "CE"`: true,
+		`userName co "lic"`:                     true,
+		`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice"`: true,
+		`name.givenName eq "Alice"`:                                      true,
+		`name.familyName eq "Carroll"`:                                   false,
+		`emails eq "alice@home.example"`:                                 true,
+		`emails.value eq "alice@example.com"`:                            true,
+		`emails[type eq "work" and value co "@example.com"]`:             true,
+		`emails[type eq "home" and value co "@example.com"]`:             false,
+		`active eq true`:          true,
+		`active eq false`:         false,
+		`externalId pr`:           true,
+		`displayName pr`:          false,
+		`displayName eq null`:     true,
+		`externalId ne null`:      true,
+		`not (userName eq "bob")`: true,
+		`userName eq "bob" or (groups.value eq "admin" and active eq true)`: true,
+		`userName eq "bob" or userName eq "carol"`:                          false,
+		`userName gt "aa" and userName lt "b"`:                              true,
+		`meta.created gt "2020-01-01T00:00:00Z"`:                            false,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			f, err := scim.ParseFilter(filter)
+			require.NoError(t, err)
+			assert.Equal(t, want, f.Match(resource))
+		})
+	}
+}
+
+func TestParseFilter_Invalid(t *testing.T) {
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName like "a"`,
+		`userName eq "a`,
+		`(userName eq "a"`,
+		`userName eq "a" and`,
+		`userName co 1`,
+		`active gt true`,
+		`emails[type eq "work"`,
+		`1abc eq "a"`,
+		`userName eq alice`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			_, err := scim.ParseFilter(filter)
+			var scimErr *scim.Error
+			require.ErrorAs(t, err, &scimErr)
+			assert.Equal(t, 400, scimErr.Status)
+			assert.Equal(t, scim.ErrInvalidFilter, scimErr.ScimType)
+		})
+	}
+}
+
+func TestEquality(t *testing.T) {
+	f, err := scim.ParseFilter(`userName eq "Alice@Example.com"`)
+	require.NoError(t, err)
+	attr, value, ok := scim.Equality(f)
+	assert.True(t, ok)
+	assert.Equal(t, "username", attr)
+	assert.Equal(t, "Alice@Example.com", value)
+
+	f, err = scim.ParseFilter(`emails[type eq "work"]`)
+	require.NoError(t, err)
+	_, _, ok = scim.Equality(f)
+	assert.False(t, ok)
+
+	f, err = scim.ParseFilter(`active eq true`)
+	require.NoError(t, err)
+	_, _, ok = scim.Equality(f)
+	assert.False(t, ok)
+}
+
+func ops(t *testing.T, s string) []scim.PatchOperation {
+	t.Helper()
+	var req scim.PatchRequest
+	require.NoError(t, json.Unmarshal([]byte(s), &req))
+	require.NoError(t, req.Validate())
+	return req.Operations
+}
+
+func TestPatch_User(t *testing.T) {
+	user := testUser()
+
+	// Microsoft Entra ID 的写法：操作名首字母大写，布尔值为字符串
+	patched, err := scim.Patch(user, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "alice@corp.example"},
+			{"op": "Add", "path": "name.formatted", "value": "Alice Liddell"}
+		]
+	}`))
+	require.NoError(t, err)
+	require.NotNil(t, patched.Active)
+	assert.False(t, bool(*patched.Active))
+	assert.Equal(t, "alice@corp.example", patched.PrimaryEmail())
+	assert.Equal(t, "Alice Liddell", patched.Name.Formatted)
+	assert.Equal(t, "Alice", patched.Name.GivenName)
+	// 原资源不变
+	assert.True(t, bool(*user.Active))
+	assert.Equal(t, "alice@example.com", user.PrimaryEmail())
+
+	// 不带路径时按属性合并，属性名可以是路径
+	patched, err = scim.Patch(user, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "value": {"userName": "alice2", "name.familyName": "Carroll", "active": true}}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "alice2", patched.UserName)
+	assert.Equal(t, "Carroll", patched.Name.FamilyName)
+	assert.Equal(t, "Alice", patched.Name.GivenName)
+
+	// 过滤条件没有匹配项时按相等条件新增一项
+	patched, err = scim.Patch(user, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "remove", "path": "emails[type eq \"home\"]"},
+			{"op": "add", "path": "emails[type eq \"other\"].value", "value": "alice@other.example"}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, patched.Emails, 2)
+	assert.Equal(t, "alice@example.com", patched.Emails[0].Value)
+	assert.Equal(t, scim.MultiValue{Value: "alice@other.example", Type: "other"}, patched.Emails[1])
+
+	_, err = scim.Patch(user, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "emails[type eq \"work\" or primary eq true]", "value": {"value": "x"}}]
+	}`))
+	require.NoError(t, err)
+	_, err = scim.Patch(user, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "emails[type eq \"work\" and primary eq false].display", "value": "x"}]
+	}`))
+	var scimErr *scim.Error
+	require.ErrorAs(t, err, &scimErr)
+	assert.Equal(t, scim.ErrNoTarget, scimErr.ScimType)
+}
+
+func TestPatch_GroupMembers(t *testing.T) {
+	group := scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          "admin",
+		DisplayName: "admin",
+		Members:     []scim.MultiValue{{Value: "1"}, {Value: "2"}},
+	}
+
+	patched, err := scim.Patch(group, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "2"}, {"value": "3"}]},
+			{"op": "remove", "path": "members[value eq \"1\"]"}
+		]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, []scim.MultiValue{{Value: "2"}, {Value: "3"}}, patched.Members)
+
+	// 用 value 指定要移除的成员
+	patched, err = scim.Patch(patched, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "Remove", "path": "members", "value": [{"value": "3"}]}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, []scim.MultiValue{{Value: "2"}}, patched.Members)
+
+	patched, err = scim.Patch(patched, ops(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "remove", "path": "members"}]
+	}`))
+	require.NoError(t, err)
+	assert.Empty(t, patched.Members)
+}
+
+func TestPatchRequest_Validate(t *testing.T) {
+	for name, body := range map[string]string{
+		"missing schema":  `{"Operations": [{"op": "add", "path": "userName", "value": "a"}]}`,
+		"no operations":   `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"]}`,
+		"unknown op":      `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "move", "path": "a"}]}`,
+		"remove all":      `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "remove"}]}`,
+		"add without val": `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "add", "path": "a"}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var req scim.PatchRequest
+			require.NoError(t, json.Unmarshal([]byte(body), &req))
+			assert.Error(t, req.Validate())
+		})
+	}
+}
+
+func TestError_JSON(t *testing.T) {
+	b, err := json.Marshal(scim.NewError(409, scim.ErrUniqueness, "userName %s already exists", "alice"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:Error"],
+		"status": "409",
+		"scimType": "uniqueness",
+		"detail": "userName alice already exists"
+	}`, string(b))
+}
+
+func TestRedact(t *testing.T) {
+	user := testUser()
+	user.Password = "s3cret-Passw0rd"
+	assert.NotContains(t, user.Redact(), "s3cret")
+	assert.Contains(t, user.Redact(), `"userName":"Alice"`)
+
+	req := scim.PatchRequest{Operations: []scim.PatchOperation{{Op: "replace", Path: "password", Value: json.RawMessage(`"s3cret"`)}}}
+	assert.Equal(t, "[replace password]", req.Redact())
+}
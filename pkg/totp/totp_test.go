@@ -0,0 +1,78 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量（取低 6 位）
+func TestCode_RFC6238Vectors(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, c := range cases {
+		got, err := Code(secret, time.Unix(c.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(%d) error: %v", c.unix, err)
+		}
+		if got != c.code {
+			t.Errorf("Code(%d) = %s, want %s", c.unix, got, c.code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	code, _ := Code(secret, now)
+
+	counter, ok := Validate(secret, code, now, 1)
+	if !ok || counter != Counter(now) {
+		t.Fatalf("expected current code to validate, got ok=%v counter=%d", ok, counter)
+	}
+	if _, ok := Validate(secret, code, now.Add(Period), 1); !ok {
+		t.Error("expected previous step to be accepted within skew")
+	}
+	if _, ok := Validate(secret, code, now.Add(3*Period), 1); ok {
+		t.Error("expected code outside skew to be rejected")
+	}
+	if _, ok := Validate(secret, "12345", now, 1); ok {
+		t.Error("expected short code to be rejected")
+	}
+	if _, ok := Validate("not base32!", code, now, 1); ok {
+		t.Error("expected invalid secret to be rejected")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Krathub", "alice@example.com", "JBSWY3DPEHPK3PXP")
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Fatalf("unexpected uri %s", uri)
+	}
+	if !strings.HasPrefix(u.Path, "/Krathub:alice@example.com") {
+		t.Errorf("unexpected label %q", u.Path)
+	}
+	q := u.Query()
+	if q.Get("secret") != "JBSWY3DPEHPK3PXP" || q.Get("issuer") != "Krathub" || q.Get("digits") != "6" {
+		t.Errorf("unexpected query %v", q)
+	}
+}
@@ -0,0 +1,227 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// 投递请求携带的头部
+const (
+	HeaderEvent     = "X-Atlas-Event"     // 事件类型
+	HeaderDelivery  = "X-Atlas-Delivery"  // 投递ID
+	HeaderTimestamp = "X-Atlas-Timestamp" // 签名时间戳（Unix 秒）
+	HeaderSignature = "X-Atlas-Signature" // sha256=<hex>
+)
+
+const (
+	DefaultTimeout     = 10 * time.Second
+	DefaultTolerance   = 5 * time.Minute
+	maxResponseBodyLen = 1024
+	signaturePrefix    = "sha256="
+)
+
+var (
+	// ErrInvalidSignature 签名不匹配
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	// ErrTimestampExpired 时间戳超出允许的偏差，可能是重放请求
+	ErrTimestampExpired = errors.New("webhook: timestamp outside tolerance")
+	// ErrForbiddenAddress 目标地址是内网、回环或链路本地地址，且不在允许列表中
+	ErrForbiddenAddress = errors.New("webhook: target address is not allowed")
+)
+
+// Sign 计算签名：HMAC-SHA256(secret, "<timestamp>.<body>")，
+// 把时间戳纳入签名可以防止接收方被重放旧请求。
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 供接收方校验请求签名，tolerance 为允许的时间偏差
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	ts, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid %s header: %w", HeaderTimestamp, err)
+	}
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+		return ErrTimestampExpired
+	}
+	expected := Sign(secret, ts, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(HeaderSignature))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// GenerateSecret 生成随机签名密钥
+func GenerateSecret() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
+
+// Backoff 第 attempt 次失败后的等待时间：base * 2^(attempt-1)，不超过 max
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	return d
+}
+
+// Request 一次投递请求
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte
+}
+
+// Result 一次投递的结果，请求未发出时 StatusCode 为 0。
+// 不返回响应内容，避免订阅地址被用来读取内网服务的响应
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Client 负责发送签名后的 webhook 请求
+type Client struct {
+	http      *http.Client
+	userAgent string
+}
+
+// NewClient 创建投递客户端，timeout 为单次请求超时。
+// 默认拒绝连接内网、回环和链路本地地址，allowed 中的主机名、IP 或 CIDR 不受此限制
+func NewClient(timeout time.Duration, userAgent string, allowed []string) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	if userAgent == "" {
+		userAgent = "Atlas-Webhook/1.0"
+	}
+	g := newAddrGuard(allowed)
+	dialer := &net.Dialer{Timeout: timeout, Control: g.control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 不使用环境变量中的代理，否则校验的是代理地址而不是目标地址
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if g.hosts[strings.ToLower(host)] {
+			return (&net.Dialer{Timeout: timeout}).DialContext(ctx, network, addr)
+		}
+		return dialer.DialContext(ctx, network, addr)
+	}
+	return &Client{
+		http: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// 不跟随重定向，避免签名请求被转发到非预期地址
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		userAgent: userAgent,
+	}
+}
+
+// addrGuard 在建立连接时校验解析后的 IP，域名解析到内网地址（包括 DNS rebinding）同样会被拒绝
+type addrGuard struct {
+	hosts    map[string]bool
+	prefixes []netip.Prefix
+}
+
+func newAddrGuard(allowed []string) *addrGuard {
+	g := &addrGuard{hosts: map[string]bool{}}
+	for _, a := range allowed {
+		a = strings.TrimSpace(a)
+		if p, err := netip.ParsePrefix(a); err == nil {
+			g.prefixes = append(g.prefixes, p.Masked())
+		} else if ip, err := netip.ParseAddr(a); err == nil {
+			g.prefixes = append(g.prefixes, netip.PrefixFrom(ip, ip.BitLen()))
+		} else if a != "" {
+			g.hosts[strings.ToLower(a)] = true
+		}
+	}
+	return g
+}
+
+func (g *addrGuard) control(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	ip := ap.Addr().Unmap()
+	if !isInternal(ip) {
+		return nil
+	}
+	for _, p := range g.prefixes {
+		if p.Contains(ip) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+}
+
+// isInternal 判断是否为内网、回环、链路本地等不应从外部订阅访问的地址
+func isInternal(ip netip.Addr) bool {
+	return ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
+
+// Deliver 发送请求，非 2xx 响应也视为失败并返回错误，Result 始终不为 nil
+func (c *Client) Deliver(ctx context.Context, req *Request) (*Result, error) {
+	res := &Result{}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return res, err
+	}
+	ts := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", c.userAgent)
+	httpReq.Header.Set(HeaderEvent, req.Event)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, ts, req.Body))
+
+	start := time.Now()
+	resp, err := c.http.Do(httpReq)
+	res.Duration = time.Since(start)
+	if err != nil {
+		return res, err
+	}
+	defer resp.Body.Close()
+
+	// 读完少量响应内容以便复用连接，内容本身不保存
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodyLen))
+	res.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return res, fmt.Errorf("webhook: unexpected status %d", resp.StatusCode)
+	}
+	return res, nil
+}
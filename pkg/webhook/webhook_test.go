@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"type":"user.signup"}`)
+	now := time.Now()
+	ts := now.Unix()
+
+	header := http.Header{}
+	header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	header.Set(HeaderSignature, Sign("secret", ts, body))
+
+	assert.NoError(t, Verify("secret", header, body, time.Minute, now))
+	assert.ErrorIs(t, Verify("other", header, body, time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", header, []byte(`{}`), time.Minute, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", header, body, time.Minute, now.Add(2*time.Minute)), ErrTimestampExpired)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, Backoff(1, time.Second, time.Minute))
+	assert.Equal(t, 2*time.Second, Backoff(2, time.Second, time.Minute))
+	assert.Equal(t, 8*time.Second, Backoff(4, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(20, time.Second, time.Minute))
+}
+
+func TestClient_Deliver(t *testing.T) {
+	var got http.Header
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		gotBody, _ = io.ReadAll(r.Body)
+		if r.Header.Get(HeaderEvent) == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("boom"))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(time.Second, "", []string{"127.0.0.1"})
+	req := &Request{URL: srv.URL, Secret: "s3cret", Event: "user.login", DeliveryID: "42", Body: []byte(`{"a":1}`)}
+
+	res, err := c.Deliver(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "user.login", got.Get(HeaderEvent))
+	assert.Equal(t, "42", got.Get(HeaderDelivery))
+	assert.NoError(t, Verify("s3cret", got, gotBody, time.Minute, time.Now()))
+
+	req.Event = "fail"
+	res, err = c.Deliver(context.Background(), req)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+}
+
+func TestClient_DeliverForbiddenAddress(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+
+	// 默认拒绝回环地址，通过域名解析到回环地址同样拒绝
+	c := NewClient(time.Second, "", nil)
+	for _, url := range []string{srv.URL, "http://localhost:" + port} {
+		res, err := c.Deliver(context.Background(), &Request{URL: url, Secret: "s3cret"})
+		assert.ErrorIs(t, err, ErrForbiddenAddress, url)
+		assert.Zero(t, res.StatusCode, url)
+	}
+	assert.Zero(t, hits)
+
+	// 允许列表中的 CIDR 和主机名可以访问
+	for _, allowed := range []string{"127.0.0.0/8", "localhost"} {
+		c = NewClient(time.Second, "", []string{allowed})
+		res, err := c.Deliver(context.Background(), &Request{URL: "http://localhost:" + port, Secret: "s3cret"})
+		require.NoError(t, err, allowed)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+	assert.Equal(t, 2, hits)
+}
+
+func TestAddrGuard(t *testing.T) {
+	g := newAddrGuard([]string{"10.1.0.0/16", "192.168.1.10"})
+	for addr, allowed := range map[string]bool{
+		"93.184.216.34:443":     true,
+		"[2606:4700::1]:443":    true,
+		"10.1.2.3:80":           true,
+		"192.168.1.10:80":       true,
+		"10.2.0.1:80":           false,
+		"192.168.1.11:80":       false,
+		"172.16.0.1:80":         false,
+		"127.0.0.1:80":          false,
+		"169.254.169.254:80":    false,
+		"0.0.0.0:80":            false,
+		"[::1]:80":              false,
+		"[fe80::1]:80":          false,
+		"[fd00::1]:80":          false,
+		"[::ffff:127.0.0.1]:80": false,
+	} {
+		err := g.control("tcp", addr, nil)
+		if allowed {
+			assert.NoError(t, err, addr)
+		} else {
+			assert.ErrorIs(t, err, ErrForbiddenAddress, addr)
+		}
+	}
+}